@@ -0,0 +1,12 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// ActionsService handles communication with the GitHub Actions related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions
+type ActionsService service
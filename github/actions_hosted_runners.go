@@ -0,0 +1,238 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// HostedRunner represents a GitHub-hosted runner, such as a larger runner,
+// of an organization or enterprise.
+type HostedRunner struct {
+	ID                 *int64                   `json:"id,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	RunnerGroupID      *int64                   `json:"runner_group_id,omitempty"`
+	Platform           *string                  `json:"platform,omitempty"`
+	ImageDetails       *HostedRunnerImageDetail `json:"image_details,omitempty"`
+	MachineSizeDetails *HostedRunnerMachineSpec `json:"machine_size_details,omitempty"`
+	// Status can be one of: "Ready", "Provisioning", "Shutdown", "Deleting"
+	// and "Stuck".
+	Status          *string                 `json:"status,omitempty"`
+	MaximumRunners  *int64                  `json:"maximum_runners,omitempty"`
+	PublicIPEnabled *bool                   `json:"public_ip_enabled,omitempty"`
+	PublicIPs       []*HostedRunnerPublicIP `json:"public_ips,omitempty"`
+	LastActiveOn    *Timestamp              `json:"last_active_on,omitempty"`
+}
+
+func (r HostedRunner) String() string {
+	return Stringify(r)
+}
+
+// HostedRunnerImageDetail represents the image a GitHub-hosted runner runs.
+type HostedRunnerImageDetail struct {
+	ID          *string `json:"id,omitempty"`
+	SizeGB      *int64  `json:"size_gb,omitempty"`
+	DisplayName *string `json:"display_name,omitempty"`
+	Source      *string `json:"source,omitempty"`
+	Version     *string `json:"version,omitempty"`
+}
+
+// HostedRunnerMachineSpec represents a machine size of GitHub-hosted runners.
+type HostedRunnerMachineSpec struct {
+	ID        *string `json:"id,omitempty"`
+	CPUCores  *int    `json:"cpu_cores,omitempty"`
+	MemoryGB  *int    `json:"memory_gb,omitempty"`
+	StorageGB *int    `json:"storage_gb,omitempty"`
+}
+
+// HostedRunnerPublicIP represents a range of static public IP addresses
+// assigned to a GitHub-hosted runner.
+type HostedRunnerPublicIP struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Prefix  *string `json:"prefix,omitempty"`
+	Length  *int    `json:"length,omitempty"`
+}
+
+// HostedRunners represents a paginated list of GitHub-hosted runners.
+type HostedRunners struct {
+	TotalCount int             `json:"total_count"`
+	Runners    []*HostedRunner `json:"runners"`
+}
+
+// HostedRunnerMachineSpecs represents the list of machine sizes available
+// for GitHub-hosted runners.
+type HostedRunnerMachineSpecs struct {
+	TotalCount   int                        `json:"total_count"`
+	MachineSpecs []*HostedRunnerMachineSpec `json:"machine_specs"`
+}
+
+// HostedRunnerImage specifies the image of a GitHub-hosted runner to create.
+type HostedRunnerImage struct {
+	ID *string `json:"id,omitempty"`
+	// Source can be one of: "github", "partner" and "custom".
+	Source  *string `json:"source,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// CreateHostedRunnerRequest represents a request to create a GitHub-hosted
+// runner.
+type CreateHostedRunnerRequest struct {
+	Name          *string            `json:"name,omitempty"`
+	Image         *HostedRunnerImage `json:"image,omitempty"`
+	RunnerGroupID *int64             `json:"runner_group_id,omitempty"`
+	// Size is the ID of a machine size, as listed by
+	// ListHostedRunnerMachineSpecs.
+	Size           *string `json:"size,omitempty"`
+	MaximumRunners *int64  `json:"maximum_runners,omitempty"`
+	EnableStaticIP *bool   `json:"enable_static_ip,omitempty"`
+}
+
+// UpdateHostedRunnerRequest represents a request to update a GitHub-hosted
+// runner. Unset fields are left unchanged.
+type UpdateHostedRunnerRequest struct {
+	Name           *string `json:"name,omitempty"`
+	RunnerGroupID  *int64  `json:"runner_group_id,omitempty"`
+	Size           *string `json:"size,omitempty"`
+	ImageID        *string `json:"image_id,omitempty"`
+	ImageVersion   *string `json:"image_version,omitempty"`
+	MaximumRunners *int64  `json:"maximum_runners,omitempty"`
+	EnableStaticIP *bool   `json:"enable_static_ip,omitempty"`
+}
+
+// ListHostedRunners lists the GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#list-github-hosted-runners-for-an-organization
+func (s *ActionsService) ListHostedRunners(ctx context.Context, org string, opt *ListOptions) (*HostedRunners, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runners := new(HostedRunners)
+	resp, err := s.client.Do(ctx, req, runners)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runners, resp, nil
+}
+
+// GetHostedRunner gets a GitHub-hosted runner of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-a-github-hosted-runner-for-an-organization
+func (s *ActionsService) GetHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", org, runnerID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// CreateHostedRunner creates a GitHub-hosted runner for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#create-a-github-hosted-runner-for-an-organization
+func (s *ActionsService) CreateHostedRunner(ctx context.Context, org string, createReq CreateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners", org)
+	req, err := s.client.NewRequest("POST", u, createReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// UpdateHostedRunner updates the machine size, image, networking or
+// other settings of a GitHub-hosted runner of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#update-a-github-hosted-runner-for-an-organization
+func (s *ActionsService) UpdateHostedRunner(ctx context.Context, org string, runnerID int64, updateReq UpdateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", org, runnerID)
+	req, err := s.client.NewRequest("PATCH", u, updateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// DeleteHostedRunner deletes a GitHub-hosted runner of an organization.
+//
+// GitHub shuts the runner down in a background task, so this method returns
+// an *AcceptedError and a status code of 202 on success, along with the
+// runner being shut down.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#delete-a-github-hosted-runner-for-an-organization
+func (s *ActionsService) DeleteHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/%v", org, runnerID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		// Persist AcceptedError's metadata to the HostedRunner object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, runner); err != nil {
+				return runner, resp, err
+			}
+
+			return runner, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// ListHostedRunnerMachineSpecs lists the machine sizes available for the
+// GitHub-hosted runners of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-github-hosted-runners-machine-specs-for-an-organization
+func (s *ActionsService) ListHostedRunnerMachineSpecs(ctx context.Context, org string) (*HostedRunnerMachineSpecs, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/hosted-runners/machine-sizes", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := new(HostedRunnerMachineSpecs)
+	resp, err := s.client.Do(ctx, req, specs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return specs, resp, nil
+}
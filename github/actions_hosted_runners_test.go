@@ -0,0 +1,165 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_ListHostedRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1", "page": "2"})
+		fmt.Fprint(w, `{"total_count":2,"runners":[{"id":5,"name":"My hosted ubuntu runner","runner_group_id":2,"platform":"linux-x64","image_details":{"id":"ubuntu-20.04","size_gb":86},"machine_size_details":{"id":"4-core","cpu_cores":4,"memory_gb":16,"storage_gb":150},"status":"Ready","maximum_runners":10,"public_ip_enabled":true,"public_ips":[{"enabled":true,"prefix":"20.80.208.150","length":31}]}]}`)
+	})
+
+	runners, _, err := client.Actions.ListHostedRunners(context.Background(), "o", &ListOptions{Page: 2, PerPage: 1})
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunners returned error: %v", err)
+	}
+
+	want := &HostedRunners{
+		TotalCount: 2,
+		Runners: []*HostedRunner{{
+			ID:                 Int64(5),
+			Name:               String("My hosted ubuntu runner"),
+			RunnerGroupID:      Int64(2),
+			Platform:           String("linux-x64"),
+			ImageDetails:       &HostedRunnerImageDetail{ID: String("ubuntu-20.04"), SizeGB: Int64(86)},
+			MachineSizeDetails: &HostedRunnerMachineSpec{ID: String("4-core"), CPUCores: Int(4), MemoryGB: Int(16), StorageGB: Int(150)},
+			Status:             String("Ready"),
+			MaximumRunners:     Int64(10),
+			PublicIPEnabled:    Bool(true),
+			PublicIPs:          []*HostedRunnerPublicIP{{Enabled: Bool(true), Prefix: String("20.80.208.150"), Length: Int(31)}},
+		}},
+	}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Actions.ListHostedRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestActionsService_GetHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":5,"status":"Provisioning"}`)
+	})
+
+	runner, _, err := client.Actions.GetHostedRunner(context.Background(), "o", 5)
+	if err != nil {
+		t.Errorf("Actions.GetHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Status: String("Provisioning")}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Actions.GetHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestActionsService_CreateHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"r","image":{"id":"ubuntu-latest","source":"github"},"runner_group_id":1,"size":"8-core","maximum_runners":50,"enable_static_ip":false}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":5,"name":"r"}`)
+	})
+
+	createReq := CreateHostedRunnerRequest{
+		Name:           String("r"),
+		Image:          &HostedRunnerImage{ID: String("ubuntu-latest"), Source: String("github")},
+		RunnerGroupID:  Int64(1),
+		Size:           String("8-core"),
+		MaximumRunners: Int64(50),
+		EnableStaticIP: Bool(false),
+	}
+	runner, _, err := client.Actions.CreateHostedRunner(context.Background(), "o", createReq)
+	if err != nil {
+		t.Errorf("Actions.CreateHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Name: String("r")}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Actions.CreateHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestActionsService_UpdateHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"size":"16-core","image_version":"1.0.0","enable_static_ip":true}`+"\n")
+		fmt.Fprint(w, `{"id":5}`)
+	})
+
+	updateReq := UpdateHostedRunnerRequest{Size: String("16-core"), ImageVersion: String("1.0.0"), EnableStaticIP: Bool(true)}
+	runner, _, err := client.Actions.UpdateHostedRunner(context.Background(), "o", 5, updateReq)
+	if err != nil {
+		t.Errorf("Actions.UpdateHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5)}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Actions.UpdateHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestActionsService_DeleteHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":5,"status":"Deleting"}`)
+	})
+
+	runner, _, err := client.Actions.DeleteHostedRunner(context.Background(), "o", 5)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Actions.DeleteHostedRunner returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), Status: String("Deleting")}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Actions.DeleteHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestActionsService_ListHostedRunnerMachineSpecs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/hosted-runners/machine-sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"machine_specs":[{"id":"4-core","cpu_cores":4,"memory_gb":16,"storage_gb":150}]}`)
+	})
+
+	specs, _, err := client.Actions.ListHostedRunnerMachineSpecs(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned error: %v", err)
+	}
+
+	want := &HostedRunnerMachineSpecs{
+		TotalCount:   1,
+		MachineSpecs: []*HostedRunnerMachineSpec{{ID: String("4-core"), CPUCores: Int(4), MemoryGB: Int(16), StorageGB: Int(150)}},
+	}
+	if !reflect.DeepEqual(specs, want) {
+		t.Errorf("Actions.ListHostedRunnerMachineSpecs returned %+v, want %+v", specs, want)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ActionsPermissions represents the policy that controls which repositories
+// of an organization may run GitHub Actions, and which actions they may use.
+type ActionsPermissions struct {
+	// EnabledRepositories can be one of: "all", "none" or "selected".
+	EnabledRepositories *string `json:"enabled_repositories,omitempty"`
+	// AllowedActions can be one of: "all", "local_only" or "selected".
+	AllowedActions     *string `json:"allowed_actions,omitempty"`
+	SelectedActionsURL *string `json:"selected_actions_url,omitempty"`
+}
+
+func (a ActionsPermissions) String() string {
+	return Stringify(a)
+}
+
+// ActionsAllowed represents the actions and reusable workflows an
+// organization allows, when ActionsPermissions.AllowedActions is "selected".
+type ActionsAllowed struct {
+	GithubOwnedAllowed *bool    `json:"github_owned_allowed,omitempty"`
+	VerifiedAllowed    *bool    `json:"verified_allowed,omitempty"`
+	PatternsAllowed    []string `json:"patterns_allowed,omitempty"`
+}
+
+func (a ActionsAllowed) String() string {
+	return Stringify(a)
+}
+
+// GetActionsPermissions gets the GitHub Actions permissions policy of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#get-github-actions-permissions-for-an-organization
+func (s *ActionsService) GetActionsPermissions(ctx context.Context, org string) (*ActionsPermissions, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissions := new(ActionsPermissions)
+	resp, err := s.client.Do(ctx, req, permissions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissions, resp, nil
+}
+
+// EditActionsPermissions sets the GitHub Actions permissions policy of an
+// organization. The API returns no content, so the input is returned on
+// success.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#set-github-actions-permissions-for-an-organization
+func (s *ActionsService) EditActionsPermissions(ctx context.Context, org string, permissions ActionsPermissions) (*ActionsPermissions, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions", org)
+	req, err := s.client.NewRequest("PUT", u, permissions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &permissions, resp, nil
+}
+
+// GetActionsAllowed gets the actions and reusable workflows allowed in an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#get-allowed-actions-and-reusable-workflows-for-an-organization
+func (s *ActionsService) GetActionsAllowed(ctx context.Context, org string) (*ActionsAllowed, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowed := new(ActionsAllowed)
+	resp, err := s.client.Do(ctx, req, allowed)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return allowed, resp, nil
+}
+
+// EditActionsAllowed sets the actions and reusable workflows allowed in an
+// organization. The API returns no content, so the input is returned on
+// success.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/permissions#set-allowed-actions-and-reusable-workflows-for-an-organization
+func (s *ActionsService) EditActionsAllowed(ctx context.Context, org string, allowed ActionsAllowed) (*ActionsAllowed, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/permissions/selected-actions", org)
+	req, err := s.client.NewRequest("PUT", u, allowed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &allowed, resp, nil
+}
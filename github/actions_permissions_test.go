@@ -0,0 +1,96 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled_repositories":"all","allowed_actions":"selected"}`)
+	})
+
+	permissions, _, err := client.Actions.GetActionsPermissions(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Actions.GetActionsPermissions returned error: %v", err)
+	}
+
+	want := &ActionsPermissions{EnabledRepositories: String("all"), AllowedActions: String("selected")}
+	if !reflect.DeepEqual(permissions, want) {
+		t.Errorf("Actions.GetActionsPermissions returned %+v, want %+v", permissions, want)
+	}
+}
+
+func TestActionsService_EditActionsPermissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled_repositories":"all","allowed_actions":"local_only"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	input := ActionsPermissions{EnabledRepositories: String("all"), AllowedActions: String("local_only")}
+	permissions, _, err := client.Actions.EditActionsPermissions(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Actions.EditActionsPermissions returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(permissions, &input) {
+		t.Errorf("Actions.EditActionsPermissions returned %+v, want %+v", permissions, &input)
+	}
+}
+
+func TestActionsService_GetActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"github_owned_allowed":true,"verified_allowed":false,"patterns_allowed":["a/b"]}`)
+	})
+
+	allowed, _, err := client.Actions.GetActionsAllowed(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Actions.GetActionsAllowed returned error: %v", err)
+	}
+
+	want := &ActionsAllowed{GithubOwnedAllowed: Bool(true), VerifiedAllowed: Bool(false), PatternsAllowed: []string{"a/b"}}
+	if !reflect.DeepEqual(allowed, want) {
+		t.Errorf("Actions.GetActionsAllowed returned %+v, want %+v", allowed, want)
+	}
+}
+
+func TestActionsService_EditActionsAllowed(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/permissions/selected-actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"github_owned_allowed":true,"patterns_allowed":["a/b"]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	input := ActionsAllowed{GithubOwnedAllowed: Bool(true), PatternsAllowed: []string{"a/b"}}
+	allowed, _, err := client.Actions.EditActionsAllowed(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Actions.EditActionsAllowed returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(allowed, &input) {
+		t.Errorf("Actions.EditActionsAllowed returned %+v, want %+v", allowed, &input)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrgRequiredWorkflow represents a required workflow configured for an
+// organization.
+type OrgRequiredWorkflow struct {
+	ID                      *int64      `json:"id,omitempty"`
+	Name                    *string     `json:"name,omitempty"`
+	Path                    *string     `json:"path,omitempty"`
+	Scope                   *string     `json:"scope,omitempty"`
+	Ref                     *string     `json:"ref,omitempty"`
+	State                   *string     `json:"state,omitempty"`
+	SelectedRepositoriesURL *string     `json:"selected_repositories_url,omitempty"`
+	CreatedAt               *Timestamp  `json:"created_at,omitempty"`
+	UpdatedAt               *Timestamp  `json:"updated_at,omitempty"`
+	Repository              *Repository `json:"repository,omitempty"`
+}
+
+func (w OrgRequiredWorkflow) String() string {
+	return Stringify(w)
+}
+
+// OrgRequiredWorkflows represents a paginated list of an organization's
+// required workflows.
+type OrgRequiredWorkflows struct {
+	TotalCount        *int                   `json:"total_count,omitempty"`
+	RequiredWorkflows []*OrgRequiredWorkflow `json:"required_workflows,omitempty"`
+}
+
+// CreateUpdateRequiredWorkflowOptions represents the input to create or
+// update a required workflow.
+type CreateUpdateRequiredWorkflowOptions struct {
+	// WorkflowFilePath is the path of the workflow file in the repository
+	// identified by RepositoryID.
+	WorkflowFilePath *string `json:"workflow_file_path,omitempty"`
+	RepositoryID     *int64  `json:"repository_id,omitempty"`
+	// Scope is the repositories the workflow applies to.
+	// Possible values are: "all" and "selected".
+	Scope                 *string         `json:"scope,omitempty"`
+	SelectedRepositoryIDs SelectedRepoIDs `json:"selected_repository_ids,omitempty"`
+}
+
+// RepoRequiredWorkflow represents a required workflow that applies to a
+// repository.
+type RepoRequiredWorkflow struct {
+	ID               *int64      `json:"id,omitempty"`
+	NodeID           *string     `json:"node_id,omitempty"`
+	Name             *string     `json:"name,omitempty"`
+	Path             *string     `json:"path,omitempty"`
+	State            *string     `json:"state,omitempty"`
+	URL              *string     `json:"url,omitempty"`
+	HTMLURL          *string     `json:"html_url,omitempty"`
+	BadgeURL         *string     `json:"badge_url,omitempty"`
+	CreatedAt        *Timestamp  `json:"created_at,omitempty"`
+	UpdatedAt        *Timestamp  `json:"updated_at,omitempty"`
+	SourceRepository *Repository `json:"source_repository,omitempty"`
+}
+
+func (w RepoRequiredWorkflow) String() string {
+	return Stringify(w)
+}
+
+// RepoRequiredWorkflows represents a paginated list of the required
+// workflows that apply to a repository.
+type RepoRequiredWorkflows struct {
+	TotalCount        *int                    `json:"total_count,omitempty"`
+	RequiredWorkflows []*RepoRequiredWorkflow `json:"required_workflows,omitempty"`
+}
+
+// ListOrgRequiredWorkflows lists the required workflows of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#list-required-workflows
+func (s *ActionsService) ListOrgRequiredWorkflows(ctx context.Context, org string, opt *ListOptions) (*OrgRequiredWorkflows, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflows := new(OrgRequiredWorkflows)
+	resp, err := s.client.Do(ctx, req, workflows)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return workflows, resp, nil
+}
+
+// CreateRequiredWorkflow creates a required workflow in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#create-a-required-workflow
+func (s *ActionsService) CreateRequiredWorkflow(ctx context.Context, org string, workflow *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows", org)
+	req, err := s.client.NewRequest("POST", u, workflow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(OrgRequiredWorkflow)
+	resp, err := s.client.Do(ctx, req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, nil
+}
+
+// GetRequiredWorkflowByID gets a required workflow of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#get-a-required-workflow
+func (s *ActionsService) GetRequiredWorkflowByID(ctx context.Context, org string, requiredWorkflowID int64) (*OrgRequiredWorkflow, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", org, requiredWorkflowID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(OrgRequiredWorkflow)
+	resp, err := s.client.Do(ctx, req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, nil
+}
+
+// UpdateRequiredWorkflow updates a required workflow of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#update-a-required-workflow
+func (s *ActionsService) UpdateRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, workflow *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", org, requiredWorkflowID)
+	req, err := s.client.NewRequest("PATCH", u, workflow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(OrgRequiredWorkflow)
+	resp, err := s.client.Do(ctx, req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, nil
+}
+
+// DeleteRequiredWorkflow deletes a required workflow from an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#delete-a-required-workflow
+func (s *ActionsService) DeleteRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v", org, requiredWorkflowID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListRequiredWorkflowSelectedRepos lists the repositories a required
+// workflow applies to, when its scope is "selected".
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#list-selected-repositories-for-a-required-workflow
+func (s *ActionsService) ListRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, opt *ListOptions) (*SelectedReposList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", org, requiredWorkflowID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repos := new(SelectedReposList)
+	resp, err := s.client.Do(ctx, req, repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
+
+// SetRequiredWorkflowSelectedRepos replaces the repositories a required
+// workflow applies to.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#set-selected-repositories-for-a-required-workflow
+func (s *ActionsService) SetRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, ids SelectedRepoIDs) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories", org, requiredWorkflowID)
+	type repoIDs struct {
+		SelectedIDs SelectedRepoIDs `json:"selected_repository_ids"`
+	}
+
+	req, err := s.client.NewRequest("PUT", u, repoIDs{SelectedIDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddRepoToRequiredWorkflow adds a repository to a required workflow.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#add-a-repository-to-a-required-workflow
+func (s *ActionsService) AddRepoToRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", org, requiredWorkflowID, repoID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveRepoFromRequiredWorkflow removes a repository from a required
+// workflow.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#remove-a-repository-from-a-required-workflow
+func (s *ActionsService) RemoveRepoFromRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/required_workflows/%v/repositories/%v", org, requiredWorkflowID, repoID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListRepoRequiredWorkflows lists the required workflows that apply to a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-server@3.8/rest/actions/required-workflows#list-repository-required-workflows
+func (s *ActionsService) ListRepoRequiredWorkflows(ctx context.Context, owner, repo string, opt *ListOptions) (*RepoRequiredWorkflows, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/required_workflows", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workflows := new(RepoRequiredWorkflows)
+	resp, err := s.client.Do(ctx, req, workflows)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return workflows, resp, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_ListOrgRequiredWorkflows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"required_workflows":[{"id":30433642,"name":"Required CI","path":".github/workflows/ci.yml","scope":"selected","ref":"refs/heads/main","state":"active","repository":{"id":1}}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	workflows, _, err := client.Actions.ListOrgRequiredWorkflows(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Actions.ListOrgRequiredWorkflows returned error: %v", err)
+	}
+
+	want := &OrgRequiredWorkflows{
+		TotalCount: Int(1),
+		RequiredWorkflows: []*OrgRequiredWorkflow{{
+			ID:         Int64(30433642),
+			Name:       String("Required CI"),
+			Path:       String(".github/workflows/ci.yml"),
+			Scope:      String("selected"),
+			Ref:        String("refs/heads/main"),
+			State:      String("active"),
+			Repository: &Repository{ID: Int64(1)},
+		}},
+	}
+	if !reflect.DeepEqual(workflows, want) {
+		t.Errorf("Actions.ListOrgRequiredWorkflows returned %+v, want %+v", workflows, want)
+	}
+}
+
+func TestActionsService_CreateRequiredWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"workflow_file_path":".github/workflows/ci.yml","repository_id":53,"scope":"selected","selected_repository_ids":[32,91]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":2,"path":".github/workflows/ci.yml","scope":"selected"}`)
+	})
+
+	input := &CreateUpdateRequiredWorkflowOptions{
+		WorkflowFilePath:      String(".github/workflows/ci.yml"),
+		RepositoryID:          Int64(53),
+		Scope:                 String("selected"),
+		SelectedRepositoryIDs: SelectedRepoIDs{32, 91},
+	}
+	workflow, _, err := client.Actions.CreateRequiredWorkflow(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Actions.CreateRequiredWorkflow returned error: %v", err)
+	}
+
+	want := &OrgRequiredWorkflow{ID: Int64(2), Path: String(".github/workflows/ci.yml"), Scope: String("selected")}
+	if !reflect.DeepEqual(workflow, want) {
+		t.Errorf("Actions.CreateRequiredWorkflow returned %+v, want %+v", workflow, want)
+	}
+}
+
+func TestActionsService_GetRequiredWorkflowByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"Required CI"}`)
+	})
+
+	workflow, _, err := client.Actions.GetRequiredWorkflowByID(context.Background(), "o", 2)
+	if err != nil {
+		t.Errorf("Actions.GetRequiredWorkflowByID returned error: %v", err)
+	}
+
+	want := &OrgRequiredWorkflow{ID: Int64(2), Name: String("Required CI")}
+	if !reflect.DeepEqual(workflow, want) {
+		t.Errorf("Actions.GetRequiredWorkflowByID returned %+v, want %+v", workflow, want)
+	}
+}
+
+func TestActionsService_UpdateRequiredWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"scope":"all"}`+"\n")
+		fmt.Fprint(w, `{"id":2,"scope":"all"}`)
+	})
+
+	input := &CreateUpdateRequiredWorkflowOptions{Scope: String("all")}
+	workflow, _, err := client.Actions.UpdateRequiredWorkflow(context.Background(), "o", 2, input)
+	if err != nil {
+		t.Errorf("Actions.UpdateRequiredWorkflow returned error: %v", err)
+	}
+
+	want := &OrgRequiredWorkflow{ID: Int64(2), Scope: String("all")}
+	if !reflect.DeepEqual(workflow, want) {
+		t.Errorf("Actions.UpdateRequiredWorkflow returned %+v, want %+v", workflow, want)
+	}
+}
+
+func TestActionsService_DeleteRequiredWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Actions.DeleteRequiredWorkflow(context.Background(), "o", 2); err != nil {
+		t.Errorf("Actions.DeleteRequiredWorkflow returned error: %v", err)
+	}
+}
+
+func TestActionsService_ListRequiredWorkflowSelectedRepos(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	repos, _, err := client.Actions.ListRequiredWorkflowSelectedRepos(context.Background(), "o", 2, opt)
+	if err != nil {
+		t.Errorf("Actions.ListRequiredWorkflowSelectedRepos returned error: %v", err)
+	}
+
+	want := &SelectedReposList{TotalCount: Int(1), Repositories: []*Repository{{ID: Int64(1)}}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Actions.ListRequiredWorkflowSelectedRepos returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestActionsService_SetRequiredWorkflowSelectedRepos(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"selected_repository_ids":[32,91]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Actions.SetRequiredWorkflowSelectedRepos(context.Background(), "o", 2, SelectedRepoIDs{32, 91}); err != nil {
+		t.Errorf("Actions.SetRequiredWorkflowSelectedRepos returned error: %v", err)
+	}
+}
+
+func TestActionsService_AddRepoToRequiredWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2/repositories/32", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Actions.AddRepoToRequiredWorkflow(context.Background(), "o", 2, 32); err != nil {
+		t.Errorf("Actions.AddRepoToRequiredWorkflow returned error: %v", err)
+	}
+}
+
+func TestActionsService_RemoveRepoFromRequiredWorkflow(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/required_workflows/2/repositories/32", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Actions.RemoveRepoFromRequiredWorkflow(context.Background(), "o", 2, 32); err != nil {
+		t.Errorf("Actions.RemoveRepoFromRequiredWorkflow returned error: %v", err)
+	}
+}
+
+func TestActionsService_ListRepoRequiredWorkflows(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/required_workflows", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"required_workflows":[{"id":1,"name":"Required CI","state":"active","source_repository":{"id":2}}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	workflows, _, err := client.Actions.ListRepoRequiredWorkflows(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Actions.ListRepoRequiredWorkflows returned error: %v", err)
+	}
+
+	want := &RepoRequiredWorkflows{
+		TotalCount: Int(1),
+		RequiredWorkflows: []*RepoRequiredWorkflow{{
+			ID:               Int64(1),
+			Name:             String("Required CI"),
+			State:            String("active"),
+			SourceRepository: &Repository{ID: Int64(2)},
+		}},
+	}
+	if !reflect.DeepEqual(workflows, want) {
+		t.Errorf("Actions.ListRepoRequiredWorkflows returned %+v, want %+v", workflows, want)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (s *ActionsService) getPublicKey(ctx context.Context, u string) (*PublicKey, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey := new(PublicKey)
+	resp, err := s.client.Do(ctx, req, pubKey)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pubKey, resp, nil
+}
+
+// cachedPublicKey gets the public key at u, like getPublicKey, but keeps it
+// in a cache of the client, as GitHub rotates keys rarely: when a bulk of
+// secrets is created, only the first one costs an extra request. putSecret
+// drops the key from the cache once GitHub rejects it.
+func (s *ActionsService) cachedPublicKey(ctx context.Context, u string) (*PublicKey, error) {
+	if key, ok := s.client.secretKeys.Load(u); ok {
+		k := *key.(*PublicKey)
+		return &k, nil
+	}
+
+	pubKey, _, err := s.getPublicKey(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	k := *pubKey
+	s.client.secretKeys.Store(u, &k)
+	return pubKey, nil
+}
+
+// repoPublicKeyURL returns the URL of the public key of a repository.
+func repoPublicKeyURL(owner, repo string) string {
+	return fmt.Sprintf("repos/%v/%v/actions/secrets/public-key", owner, repo)
+}
+
+// orgPublicKeyURL returns the URL of the public key of an organization.
+func orgPublicKeyURL(org string) string {
+	return fmt.Sprintf("orgs/%v/actions/secrets/public-key", org)
+}
+
+// GetRepoPublicKey gets a public key that should be used for secret encryption.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#get-a-repository-public-key
+func (s *ActionsService) GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error) {
+	return s.getPublicKey(ctx, repoPublicKeyURL(owner, repo))
+}
+
+// GetOrgPublicKey gets a public key that should be used for secret encryption.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#get-an-organization-public-key
+func (s *ActionsService) GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
+	return s.getPublicKey(ctx, orgPublicKeyURL(org))
+}
+
+func (s *ActionsService) listSecrets(ctx context.Context, u string, opt *ListOptions) (*Secrets, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secrets := new(Secrets)
+	resp, err := s.client.Do(ctx, req, secrets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secrets, resp, nil
+}
+
+// ListRepoSecrets lists all secrets available in a repository
+// without revealing their encrypted values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#list-repository-secrets
+func (s *ActionsService) ListRepoSecrets(ctx context.Context, owner, repo string, opt *ListOptions) (*Secrets, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/secrets", owner, repo)
+	return s.listSecrets(ctx, u, opt)
+}
+
+// ListOrgSecrets lists all secrets available in an organization
+// without revealing their encrypted values.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#list-organization-secrets
+func (s *ActionsService) ListOrgSecrets(ctx context.Context, org string, opt *ListOptions) (*Secrets, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/secrets", org)
+	return s.listSecrets(ctx, u, opt)
+}
+
+func (s *ActionsService) getSecret(ctx context.Context, u string) (*Secret, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := new(Secret)
+	resp, err := s.client.Do(ctx, req, secret)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return secret, resp, nil
+}
+
+// GetRepoSecret gets a single repository secret without revealing its encrypted value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#get-a-repository-secret
+func (s *ActionsService) GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, name)
+	return s.getSecret(ctx, u)
+}
+
+// GetOrgSecret gets a single organization secret without revealing its encrypted value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#get-an-organization-secret
+func (s *ActionsService) GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, name)
+	return s.getSecret(ctx, u)
+}
+
+// putSecret creates or updates the secret at u. If GitHub rejects the
+// secret with status 422, the public key at keyURL is dropped from the cache
+// of cachedPublicKey, as it has most likely been rotated.
+func (s *ActionsService) putSecret(ctx context.Context, u, keyURL string, eSecret *EncryptedSecret) (*Response, error) {
+	req, err := s.client.NewRequest("PUT", u, eSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+		s.client.secretKeys.Delete(keyURL)
+	}
+	return resp, err
+}
+
+// CreateOrUpdateRepoSecret creates or updates a repository secret with an
+// encrypted value. Use EncryptSecret to encrypt the value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#create-or-update-a-repository-secret
+func (s *ActionsService) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, eSecret.Name)
+	return s.putSecret(ctx, u, repoPublicKeyURL(owner, repo), eSecret)
+}
+
+// CreateOrUpdateOrgSecret creates or updates an organization secret with an
+// encrypted value. Use EncryptSecret to encrypt the value.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#create-or-update-an-organization-secret
+func (s *ActionsService) CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, eSecret.Name)
+	return s.putSecret(ctx, u, orgPublicKeyURL(org), eSecret)
+}
+
+func (s *ActionsService) deleteSecret(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteRepoSecret deletes a secret in a repository using the secret name.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#delete-a-repository-secret
+func (s *ActionsService) DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/secrets/%v", owner, repo, name)
+	return s.deleteSecret(ctx, u)
+}
+
+// DeleteOrgSecret deletes a secret in an organization using the secret name.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/secrets#delete-an-organization-secret
+func (s *ActionsService) DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/actions/secrets/%v", org, name)
+	return s.deleteSecret(ctx, u)
+}
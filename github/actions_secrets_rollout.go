@@ -0,0 +1,123 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// RolloutSecretsOptions specifies the optional parameters to the
+// ActionsService.RolloutSecrets method.
+type RolloutSecretsOptions struct {
+	// Visibility is the visibility of the secrets of organizations: "all",
+	// "private" or "selected". Default is "private". Repositories given
+	// access to "selected" secrets are managed separately.
+	Visibility string
+
+	// Concurrency is the number of targets whose secrets are set at the same
+	// time. Default is 4.
+	Concurrency int
+}
+
+// RolloutSecretsResult is the result of RolloutSecrets for one target.
+type RolloutSecretsResult struct {
+	// Target is the repository, in the "owner/name" form, or the
+	// organization.
+	Target string
+
+	// Secret is the name of the secret that failed to be set, if Err is
+	// set. The secrets before it, in lexical order, were set; the ones after
+	// it were not attempted.
+	Secret string
+	Err    error
+}
+
+// RolloutSecrets creates or updates the Actions secrets in secrets, a map of
+// names to plaintext values, in each of targets, which are repositories in
+// the "owner/name" form or organizations. It is meant for rotating a
+// credential everywhere it is used. Results are returned in the order of
+// targets.
+//
+// The public key of each target is fetched, and fetched again if GitHub has
+// rotated it in the meantime, and values are encrypted with EncryptSecret.
+// Targets are processed concurrently, like in the other batch methods
+// described in the package documentation.
+func (s *ActionsService) RolloutSecrets(ctx context.Context, secrets map[string]string, targets []string, opt *RolloutSecretsOptions) []*RolloutSecretsResult {
+	visibility := "private"
+	var concurrency int
+	if opt != nil {
+		if opt.Visibility != "" {
+			visibility = opt.Visibility
+		}
+		concurrency = opt.Concurrency
+	}
+
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]*RolloutSecretsResult, len(targets))
+	s.client.forEachConcurrently(ctx, len(targets), concurrency, func(ctx context.Context, i int) {
+		result := &RolloutSecretsResult{Target: targets[i]}
+		for _, name := range names {
+			if err := s.rolloutSecret(ctx, targets[i], name, secrets[name], visibility); err != nil {
+				result.Secret, result.Err = name, err
+				break
+			}
+		}
+		results[i] = result
+	})
+	return results
+}
+
+// rolloutSecret creates or updates a secret in target for RolloutSecrets.
+func (s *ActionsService) rolloutSecret(ctx context.Context, target, name, value, visibility string) error {
+	var owner, repo string
+	if parts := strings.SplitN(target, "/", 2); len(parts) == 2 {
+		owner, repo = parts[0], parts[1]
+		if owner == "" || repo == "" {
+			return fmt.Errorf("github: invalid target %q, want owner/name or an organization", target)
+		}
+	} else {
+		owner = target
+	}
+
+	for retried := false; ; retried = true {
+		var key *PublicKey
+		var err error
+		if repo != "" {
+			key, err = s.cachedPublicKey(ctx, repoPublicKeyURL(owner, repo))
+		} else {
+			key, err = s.cachedPublicKey(ctx, orgPublicKeyURL(owner))
+		}
+		if err != nil {
+			return err
+		}
+
+		eSecret, err := EncryptSecret(key, name, value)
+		if err != nil {
+			return err
+		}
+		var resp *Response
+		if repo != "" {
+			resp, err = s.CreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+		} else {
+			eSecret.Visibility = visibility
+			resp, err = s.CreateOrUpdateOrgSecret(ctx, owner, eSecret)
+		}
+		// The key was rotated, and dropped from the cache: fetch it again.
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && !retried {
+			continue
+		}
+		return err
+	}
+}
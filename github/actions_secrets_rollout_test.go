@@ -0,0 +1,115 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestActionsService_RolloutSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const key = `{"key_id":"1","key":"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA="}`
+	var mu sync.Mutex
+	set := make(map[string]*EncryptedSecret)
+	put := func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		secret := new(EncryptedSecret)
+		json.NewDecoder(r.Body).Decode(secret)
+		mu.Lock()
+		set[r.URL.Path] = secret
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, key)
+	})
+	mux.HandleFunc("/repos/o/r/actions/secrets/", put)
+	mux.HandleFunc("/orgs/o/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, key)
+	})
+	mux.HandleFunc("/orgs/o/actions/secrets/", put)
+	mux.HandleFunc("/repos/o/locked/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	secrets := map[string]string{"TOKEN": "t", "PASSWORD": "p"}
+	targets := []string{"o/r", "o", "o/locked", "/r"}
+	results := client.Actions.RolloutSecrets(context.Background(), secrets, targets, &RolloutSecretsOptions{Visibility: "all"})
+
+	if len(results) != len(targets) {
+		t.Fatalf("Actions.RolloutSecrets returned %v results, want %v", len(results), len(targets))
+	}
+	for i, target := range targets {
+		if results[i].Target != target {
+			t.Errorf("results[%v].Target = %q, want %q", i, results[i].Target, target)
+		}
+	}
+	for _, r := range results[:2] {
+		if r.Err != nil {
+			t.Errorf("Actions.RolloutSecrets to %v returned error: %v", r.Target, r.Err)
+		}
+	}
+	for _, r := range results[2:] {
+		if r.Err == nil || r.Secret != "PASSWORD" {
+			t.Errorf("Actions.RolloutSecrets to %v returned secret %q and error %v, want an error for PASSWORD", r.Target, r.Secret, r.Err)
+		}
+	}
+
+	for _, path := range []string{
+		"/repos/o/r/actions/secrets/TOKEN",
+		"/repos/o/r/actions/secrets/PASSWORD",
+		"/orgs/o/actions/secrets/TOKEN",
+		"/orgs/o/actions/secrets/PASSWORD",
+	} {
+		secret := set[path]
+		if secret == nil {
+			t.Errorf("%v was not set", path)
+			continue
+		}
+		if secret.KeyID != "1" || secret.EncryptedValue == "" {
+			t.Errorf("%v was set to %+v, want a value encrypted with key 1", path, secret)
+		}
+	}
+	if secret := set["/orgs/o/actions/secrets/TOKEN"]; secret != nil && secret.Visibility != "all" {
+		t.Errorf("organization secret visibility = %q, want all", secret.Visibility)
+	}
+}
+
+func TestActionsService_RolloutSecrets_rotatedKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	keyID := 1
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"key_id":"%v","key":"AQIDBAUGBwgJCgsMDQ4PEBESExQVFhcYGRobHB0eHyA="}`, keyID)
+	})
+	mux.HandleFunc("/repos/o/r/actions/secrets/S", func(w http.ResponseWriter, r *http.Request) {
+		var secret EncryptedSecret
+		json.NewDecoder(r.Body).Decode(&secret)
+		if secret.KeyID != "2" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// Cache key 1, then rotate it.
+	client.Actions.cachedPublicKey(context.Background(), "repos/o/r/actions/secrets/public-key")
+	keyID = 2
+
+	results := client.Actions.RolloutSecrets(context.Background(), map[string]string{"S": "v"}, []string{"o/r"}, nil)
+	if err := results[0].Err; err != nil {
+		t.Errorf("Actions.RolloutSecrets returned error: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetRepoPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	key, _, err := client.Actions.GetRepoPublicKey(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Actions.GetRepoPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Actions.GetRepoPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestActionsService_GetOrgPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	keyRequests := 0
+	mux.HandleFunc("/orgs/o/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		keyRequests++
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Actions.cachedPublicKey(ctx, "orgs/o/actions/secrets/public-key"); err != nil {
+		t.Fatalf("Actions.cachedPublicKey returned error: %v", err)
+	}
+
+	// The public method always asks GitHub, even when the key is cached.
+	key, resp, err := client.Actions.GetOrgPublicKey(ctx, "o")
+	if err != nil {
+		t.Fatalf("Actions.GetOrgPublicKey returned error: %v", err)
+	}
+	if resp == nil {
+		t.Error("Actions.GetOrgPublicKey returned a nil Response")
+	}
+	if got, want := key.GetKeyID(), "1234"; got != want {
+		t.Errorf("Actions.GetOrgPublicKey key ID = %v, want %v", got, want)
+	}
+	if keyRequests != 2 {
+		t.Errorf("public key requested %v times, want 2", keyRequests)
+	}
+}
+
+func TestActionsService_cachedPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	keyRequests := 0
+	mux.HandleFunc("/orgs/o/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		keyRequests++
+		fmt.Fprintf(w, `{"key_id":"%v","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`, keyRequests)
+	})
+	mux.HandleFunc("/orgs/o/actions/secrets/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		var secret EncryptedSecret
+		json.NewDecoder(r.Body).Decode(&secret)
+		if secret.KeyID != fmt.Sprint(keyRequests) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	ctx := context.Background()
+	keyURL := "orgs/o/actions/secrets/public-key"
+	for _, name := range []string{"A", "B"} {
+		key, err := client.Actions.cachedPublicKey(ctx, keyURL)
+		if err != nil {
+			t.Fatalf("Actions.cachedPublicKey returned error: %v", err)
+		}
+		if _, err := client.Actions.CreateOrUpdateOrgSecret(ctx, "o", &EncryptedSecret{Name: name, KeyID: key.GetKeyID()}); err != nil {
+			t.Errorf("Actions.CreateOrUpdateOrgSecret returned error: %v", err)
+		}
+	}
+	if keyRequests != 1 {
+		t.Errorf("public key requested %v times, want 1", keyRequests)
+	}
+
+	// Simulate a key rotation: the cached key is rejected and dropped.
+	keyRequests++
+	key, _ := client.Actions.cachedPublicKey(ctx, keyURL)
+	if _, err := client.Actions.CreateOrUpdateOrgSecret(ctx, "o", &EncryptedSecret{Name: "C", KeyID: key.GetKeyID()}); err == nil {
+		t.Error("Actions.CreateOrUpdateOrgSecret returned no error for a rotated key")
+	}
+	key, err := client.Actions.cachedPublicKey(ctx, keyURL)
+	if err != nil {
+		t.Fatalf("Actions.cachedPublicKey returned error: %v", err)
+	}
+	if got, want := key.GetKeyID(), "3"; got != want {
+		t.Errorf("Actions.cachedPublicKey key ID = %v, want %v", got, want)
+	}
+}
+
+func TestActionsService_ListRepoSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":4,"secrets":[{"name":"A"},{"name":"B"}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Actions.ListRepoSecrets(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Actions.ListRepoSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 4,
+		Secrets:    []*Secret{{Name: String("A")}, {Name: String("B")}},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Actions.ListRepoSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestActionsService_GetOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","visibility":"selected"}`)
+	})
+
+	secret, _, err := client.Actions.GetOrgSecret(context.Background(), "o", "NAME")
+	if err != nil {
+		t.Errorf("Actions.GetOrgSecret returned error: %v", err)
+	}
+
+	want := &Secret{Name: String("NAME"), Visibility: String("selected")}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Actions.GetOrgSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestActionsService_CreateOrUpdateRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{Name: "NAME", EncryptedValue: "QIv=", KeyID: "1234"}
+	if _, err := client.Actions.CreateOrUpdateRepoSecret(context.Background(), "o", "r", input); err != nil {
+		t.Errorf("Actions.CreateOrUpdateRepoSecret returned error: %v", err)
+	}
+}
+
+func TestActionsService_DeleteRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	if _, err := client.Actions.DeleteRepoSecret(context.Background(), "o", "r", "NAME"); err != nil {
+		t.Errorf("Actions.DeleteRepoSecret returned error: %v", err)
+	}
+}
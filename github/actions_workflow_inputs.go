@@ -0,0 +1,410 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WorkflowDispatchInput represents an input a workflow declares for the
+// workflow_dispatch event.
+type WorkflowDispatchInput struct {
+	Description string
+	Required    bool
+	Default     string
+
+	// Type is one of "string", "boolean", "number", "choice" or
+	// "environment". Default is "string".
+	Type string
+
+	// Options are the allowed values of a "choice" input.
+	Options []string
+}
+
+// WorkflowDispatchInputsError reports the inputs of a workflow dispatch
+// event that do not match the inputs declared by the workflow.
+type WorkflowDispatchInputsError struct {
+	Errors []string
+}
+
+func (e *WorkflowDispatchInputsError) Error() string {
+	return "github: invalid workflow inputs: " + strings.Join(e.Errors, "; ")
+}
+
+// errNoWorkflowDispatch reports a workflow that cannot be dispatched.
+var errNoWorkflowDispatch = errors.New("github: workflow has no workflow_dispatch trigger")
+
+// ParseWorkflowDispatchInputs parses the inputs a workflow file declares
+// for the workflow_dispatch event. It returns an error if the workflow has
+// no workflow_dispatch trigger.
+//
+// Only the subset of YAML used by workflow triggers is understood: block
+// mappings and sequences, flow sequences, quoted and block scalars.
+func ParseWorkflowDispatchInputs(workflow []byte) (map[string]*WorkflowDispatchInput, error) {
+	lines := splitYAMLLines(string(workflow))
+	if len(lines) == 0 {
+		return nil, errNoWorkflowDispatch
+	}
+	root, _ := parseYAMLBlock(lines, 0)
+
+	on := root.child("on")
+	if on == nil {
+		return nil, errNoWorkflowDispatch
+	}
+
+	inputs := make(map[string]*WorkflowDispatchInput)
+	if on.scalar == "workflow_dispatch" {
+		return inputs, nil
+	}
+	for _, event := range on.sequence {
+		if event.scalar == "workflow_dispatch" {
+			return inputs, nil
+		}
+	}
+
+	dispatch := on.child("workflow_dispatch")
+	if dispatch == nil {
+		return nil, errNoWorkflowDispatch
+	}
+
+	declared := dispatch.child("inputs")
+	if declared == nil {
+		return inputs, nil
+	}
+	for _, name := range declared.keys {
+		n := declared.mapping[name]
+		input := &WorkflowDispatchInput{
+			Description: n.value("description"),
+			Required:    strings.EqualFold(n.value("required"), "true"),
+			Default:     n.value("default"),
+			Type:        n.value("type"),
+		}
+		if input.Type == "" {
+			input.Type = "string"
+		}
+		if options := n.child("options"); options != nil {
+			for _, o := range options.sequence {
+				input.Options = append(input.Options, o.scalar)
+			}
+		}
+		inputs[name] = input
+	}
+	return inputs, nil
+}
+
+// ValidateWorkflowDispatchInputs checks inputs against the inputs declared
+// by a workflow, as returned by ParseWorkflowDispatchInputs. It reports
+// undeclared inputs, missing required inputs without a default, and values
+// that do not match the type or the options of their input, in a
+// *WorkflowDispatchInputsError.
+func ValidateWorkflowDispatchInputs(declared map[string]*WorkflowDispatchInput, inputs map[string]interface{}) error {
+	var errs []string
+	for name := range inputs {
+		if _, ok := declared[name]; !ok {
+			errs = append(errs, fmt.Sprintf("unexpected input %q", name))
+		}
+	}
+
+	for name, input := range declared {
+		v, ok := inputs[name]
+		if !ok {
+			if input.Required && input.Default == "" {
+				errs = append(errs, fmt.Sprintf("missing required input %q", name))
+			}
+			continue
+		}
+
+		switch v.(type) {
+		case string, bool, int, int64, float64:
+		default:
+			errs = append(errs, fmt.Sprintf("input %q must be a string, boolean or number, got %T", name, v))
+			continue
+		}
+
+		s := fmt.Sprint(v)
+		switch input.Type {
+		case "boolean":
+			if s != "true" && s != "false" {
+				errs = append(errs, fmt.Sprintf("input %q must be a boolean, got %q", name, s))
+			}
+		case "number":
+			if _, err := strconv.ParseFloat(s, 64); err != nil {
+				errs = append(errs, fmt.Sprintf("input %q must be a number, got %q", name, s))
+			}
+		case "choice":
+			valid := false
+			for _, o := range input.Options {
+				if o == s {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				errs = append(errs, fmt.Sprintf("input %q must be one of %q, got %q", name, input.Options, s))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return &WorkflowDispatchInputsError{Errors: errs}
+	}
+	return nil
+}
+
+// CreateValidatedWorkflowDispatchEvent triggers a workflow like
+// CreateWorkflowDispatchEventByFileName, after validating event.Inputs
+// against the inputs the workflow file declares at event.Ref. Invalid
+// inputs are reported in a *WorkflowDispatchInputsError and the workflow is
+// not triggered.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflows#create-a-workflow-dispatch-event
+func (s *ActionsService) CreateValidatedWorkflowDispatchEvent(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest) (*Response, error) {
+	path := ".github/workflows/" + workflowFileName
+	file, _, resp, err := s.client.Repositories.GetContents(ctx, owner, repo, path, &RepositoryContentGetOptions{Ref: event.Ref})
+	if err != nil {
+		return resp, err
+	}
+	if file == nil {
+		return resp, fmt.Errorf("github: %v is not a file", path)
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return resp, err
+	}
+
+	declared, err := ParseWorkflowDispatchInputs([]byte(content))
+	if err != nil {
+		return resp, err
+	}
+	if err := ValidateWorkflowDispatchInputs(declared, event.Inputs); err != nil {
+		return resp, err
+	}
+
+	return s.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowFileName, event)
+}
+
+// yamlNode is a node of the YAML subset understood by parseYAMLBlock: a
+// scalar, a mapping or a sequence.
+type yamlNode struct {
+	scalar   string
+	keys     []string
+	mapping  map[string]*yamlNode
+	sequence []*yamlNode
+}
+
+// child returns the value of key in the mapping n, or nil.
+func (n *yamlNode) child(key string) *yamlNode {
+	if n == nil {
+		return nil
+	}
+	return n.mapping[key]
+}
+
+// value returns the scalar value of key in the mapping n, or "".
+func (n *yamlNode) value(key string) string {
+	if c := n.child(key); c != nil {
+		return c.scalar
+	}
+	return ""
+}
+
+// yamlLine is a non-empty line of YAML with its comment removed.
+type yamlLine struct {
+	indent int
+	text   string
+}
+
+// splitYAMLLines splits data into lines, dropping blank lines, comments and
+// document markers.
+func splitYAMLLines(data string) []yamlLine {
+	var lines []yamlLine
+	for _, l := range strings.Split(data, "\n") {
+		l = strings.TrimRight(l, " \t\r")
+		text := strings.TrimLeft(l, " ")
+		if text == "---" || text == "..." {
+			continue
+		}
+		indent := len(l) - len(text)
+		if text = stripYAMLComment(text); text == "" {
+			continue
+		}
+		lines = append(lines, yamlLine{indent: indent, text: text})
+	}
+	return lines
+}
+
+// stripYAMLComment removes a comment from the end of a line, ignoring '#'
+// characters in quoted strings.
+func stripYAMLComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return strings.TrimRight(s[:i], " \t")
+		}
+	}
+	return s
+}
+
+// parseYAMLBlock parses the block mapping or sequence starting at lines[i]
+// and returns it with the index of the line following it.
+func parseYAMLBlock(lines []yamlLine, i int) (*yamlNode, int) {
+	indent := lines[i].indent
+	n := new(yamlNode)
+
+	if isYAMLSequenceItem(lines[i].text) {
+		for i < len(lines) && lines[i].indent == indent && isYAMLSequenceItem(lines[i].text) {
+			item := strings.TrimSpace(lines[i].text[1:])
+			itemIndent := indent + len(lines[i].text) - len(item)
+			i++
+			child := new(yamlNode)
+			switch {
+			case item == "" && i < len(lines) && lines[i].indent > indent:
+				child, i = parseYAMLBlock(lines, i)
+			case isYAMLMappingEntry(item):
+				// "- key: value" starts a mapping whose other entries are
+				// indented like key.
+				end := skipYAMLChildren(lines, i, indent)
+				block := append([]yamlLine{{indent: itemIndent, text: item}}, lines[i:end]...)
+				child, _ = parseYAMLBlock(block, 0)
+				i = end
+			case item != "":
+				child = parseYAMLScalar(item)
+				i = skipYAMLChildren(lines, i, indent)
+			}
+			n.sequence = append(n.sequence, child)
+		}
+		return n, i
+	}
+
+	n.mapping = make(map[string]*yamlNode)
+	for i < len(lines) && lines[i].indent == indent && !isYAMLSequenceItem(lines[i].text) {
+		key, rest, ok := splitYAMLKey(lines[i].text)
+		i++
+		if !ok {
+			i = skipYAMLChildren(lines, i, indent)
+			continue
+		}
+
+		child := new(yamlNode)
+		switch {
+		case rest == "":
+			if i < len(lines) && (lines[i].indent > indent || lines[i].indent == indent && isYAMLSequenceItem(lines[i].text)) {
+				child, i = parseYAMLBlock(lines, i)
+			}
+		case rest[0] == '|' || rest[0] == '>':
+			var text []string
+			for ; i < len(lines) && lines[i].indent > indent; i++ {
+				text = append(text, lines[i].text)
+			}
+			sep := " "
+			if rest[0] == '|' {
+				sep = "\n"
+			}
+			child.scalar = strings.Join(text, sep)
+		default:
+			child = parseYAMLScalar(rest)
+			i = skipYAMLChildren(lines, i, indent)
+		}
+
+		if _, ok := n.mapping[key]; !ok {
+			n.keys = append(n.keys, key)
+		}
+		n.mapping[key] = child
+	}
+	return n, i
+}
+
+// skipYAMLChildren returns the index of the first line from i that is not
+// indented deeper than indent.
+func skipYAMLChildren(lines []yamlLine, i, indent int) int {
+	for i < len(lines) && lines[i].indent > indent {
+		i++
+	}
+	return i
+}
+
+func isYAMLSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// isYAMLMappingEntry reports whether text is a block mapping entry, rather
+// than a scalar or a flow collection.
+func isYAMLMappingEntry(text string) bool {
+	if text == "" || text[0] == '[' || text[0] == '{' {
+		return false
+	}
+	_, _, ok := splitYAMLKey(text)
+	return ok
+}
+
+// splitYAMLKey splits a mapping entry into its key and the rest of the
+// line. It reports false if text is not a mapping entry.
+func splitYAMLKey(text string) (key, rest string, ok bool) {
+	if text[0] == '"' || text[0] == '\'' {
+		end := strings.IndexByte(text[1:], text[0])
+		if end < 0 {
+			return "", "", false
+		}
+		key, text = text[1:end+1], text[end+2:]
+		if !strings.HasPrefix(text, ":") {
+			return "", "", false
+		}
+		return key, strings.TrimSpace(text[1:]), true
+	}
+
+	if strings.HasSuffix(text, ":") {
+		return text[:len(text)-1], "", true
+	}
+	if i := strings.Index(text, ": "); i >= 0 {
+		return text[:i], strings.TrimSpace(text[i+2:]), true
+	}
+	return "", "", false
+}
+
+// parseYAMLScalar parses a scalar or a flow collection.
+func parseYAMLScalar(s string) *yamlNode {
+	switch {
+	case strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]"):
+		n := new(yamlNode)
+		for _, item := range strings.Split(s[1:len(s)-1], ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				n.sequence = append(n.sequence, parseYAMLScalar(item))
+			}
+		}
+		return n
+	case strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"):
+		n := &yamlNode{mapping: make(map[string]*yamlNode)}
+		for _, entry := range strings.Split(s[1:len(s)-1], ",") {
+			if key, rest, ok := splitYAMLKey(strings.TrimSpace(entry) + " "); ok {
+				n.keys = append(n.keys, key)
+				n.mapping[key] = parseYAMLScalar(rest)
+			}
+		}
+		return n
+	case len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"':
+		if v, err := strconv.Unquote(s); err == nil {
+			return &yamlNode{scalar: v}
+		}
+		return &yamlNode{scalar: s[1 : len(s)-1]}
+	case len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'':
+		return &yamlNode{scalar: strings.Replace(s[1:len(s)-1], "''", "'", -1)}
+	}
+	return &yamlNode{scalar: s}
+}
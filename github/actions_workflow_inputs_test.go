@@ -0,0 +1,163 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testDispatchWorkflow = `name: deploy
+
+"on":
+  push:
+    branches: [main]
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: 'Where to deploy # not a comment'
+        required: true
+        type: choice
+        options:
+          - staging
+          - production
+      dry-run:
+        type: boolean
+        default: "false" # a comment
+      replicas:
+        type: number
+        description: |
+          Number of replicas
+          to start.
+      tag:
+        description: Image tag
+        options: [ignored]
+
+jobs:
+  deploy:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v2
+      - run: echo "on: workflow_dispatch"
+`
+
+func TestParseWorkflowDispatchInputs(t *testing.T) {
+	got, err := ParseWorkflowDispatchInputs([]byte(testDispatchWorkflow))
+	if err != nil {
+		t.Fatalf("ParseWorkflowDispatchInputs returned error: %v", err)
+	}
+
+	want := map[string]*WorkflowDispatchInput{
+		"environment": {Description: "Where to deploy # not a comment", Required: true, Type: "choice", Options: []string{"staging", "production"}},
+		"dry-run":     {Default: "false", Type: "boolean"},
+		"replicas":    {Description: "Number of replicas\nto start.", Type: "number"},
+		"tag":         {Description: "Image tag", Type: "string", Options: []string{"ignored"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWorkflowDispatchInputs returned %+v, want %+v", got, want)
+	}
+}
+
+func TestParseWorkflowDispatchInputs_triggers(t *testing.T) {
+	tests := []struct {
+		workflow string
+		wantErr  bool
+	}{
+		{"on: workflow_dispatch\n", false},
+		{"on: [push, workflow_dispatch]\n", false},
+		{"on:\n  - push\n  - workflow_dispatch\n", false},
+		{"on:\n  workflow_dispatch:\n  push:\n", false},
+		{"on:\n  workflow_dispatch: {}\n", false},
+		{"on: push\n", true},
+		{"on:\n  push:\n    branches: [main]\n", true},
+		{"name: x\n", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseWorkflowDispatchInputs([]byte(tt.workflow))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseWorkflowDispatchInputs(%q) returned nil error", tt.workflow)
+			}
+			continue
+		}
+		if err != nil || len(got) != 0 {
+			t.Errorf("ParseWorkflowDispatchInputs(%q) = %v, %v, want no inputs", tt.workflow, got, err)
+		}
+	}
+}
+
+func TestValidateWorkflowDispatchInputs(t *testing.T) {
+	declared, err := ParseWorkflowDispatchInputs([]byte(testDispatchWorkflow))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ValidateWorkflowDispatchInputs(declared, map[string]interface{}{"environment": "staging", "dry-run": true, "replicas": "3"}); err != nil {
+		t.Errorf("ValidateWorkflowDispatchInputs returned error for valid inputs: %v", err)
+	}
+
+	err = ValidateWorkflowDispatchInputs(declared, map[string]interface{}{
+		"dry-run":  "maybe",
+		"replicas": "many",
+		"tag":      []string{"a"},
+		"extra":    "x",
+	})
+	want := &WorkflowDispatchInputsError{Errors: []string{
+		`input "dry-run" must be a boolean, got "maybe"`,
+		`input "replicas" must be a number, got "many"`,
+		`input "tag" must be a string, boolean or number, got []string`,
+		`missing required input "environment"`,
+		`unexpected input "extra"`,
+	}}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("ValidateWorkflowDispatchInputs returned %v, want %v", err, want)
+	}
+
+	err = ValidateWorkflowDispatchInputs(declared, map[string]interface{}{"environment": "dev"})
+	want = &WorkflowDispatchInputsError{Errors: []string{`input "environment" must be one of ["staging" "production"], got "dev"`}}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("ValidateWorkflowDispatchInputs returned %v, want %v", err, want)
+	}
+}
+
+func TestActionsService_CreateValidatedWorkflowDispatchEvent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	content := base64.StdEncoding.EncodeToString([]byte(testDispatchWorkflow))
+	mux.HandleFunc("/repos/o/r/contents/.github/workflows/deploy.yml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, content)
+	})
+	dispatched := false
+	mux.HandleFunc("/repos/o/r/actions/workflows/deploy.yml/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"main","inputs":{"environment":"production"}}`+"\n")
+		dispatched = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	event := CreateWorkflowDispatchEventRequest{Ref: "main", Inputs: map[string]interface{}{"environment": "production"}}
+	if _, err := client.Actions.CreateValidatedWorkflowDispatchEvent(context.Background(), "o", "r", "deploy.yml", event); err != nil {
+		t.Errorf("Actions.CreateValidatedWorkflowDispatchEvent returned error: %v", err)
+	}
+	if !dispatched {
+		t.Error("Actions.CreateValidatedWorkflowDispatchEvent did not dispatch the workflow")
+	}
+
+	event.Inputs = map[string]interface{}{"environment": "dev"}
+	_, err := client.Actions.CreateValidatedWorkflowDispatchEvent(context.Background(), "o", "r", "deploy.yml", event)
+	if _, ok := err.(*WorkflowDispatchInputsError); !ok {
+		t.Errorf("Actions.CreateValidatedWorkflowDispatchEvent returned %#v, want *WorkflowDispatchInputsError", err)
+	}
+}
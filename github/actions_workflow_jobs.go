@@ -0,0 +1,145 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TaskStep represents a single step of a workflow job.
+type TaskStep struct {
+	Name        *string    `json:"name,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+	Conclusion  *string    `json:"conclusion,omitempty"`
+	Number      *int64     `json:"number,omitempty"`
+	StartedAt   *Timestamp `json:"started_at,omitempty"`
+	CompletedAt *Timestamp `json:"completed_at,omitempty"`
+}
+
+// Duration returns the time the step took to run, or zero if it has not
+// completed.
+func (t *TaskStep) Duration() time.Duration {
+	if t.StartedAt == nil || t.CompletedAt == nil {
+		return 0
+	}
+	return t.CompletedAt.Sub(t.StartedAt.Time)
+}
+
+// WorkflowJob represents a job of a workflow run.
+type WorkflowJob struct {
+	ID              *int64      `json:"id,omitempty"`
+	RunID           *int64      `json:"run_id,omitempty"`
+	RunURL          *string     `json:"run_url,omitempty"`
+	RunAttempt      *int64      `json:"run_attempt,omitempty"`
+	NodeID          *string     `json:"node_id,omitempty"`
+	HeadSHA         *string     `json:"head_sha,omitempty"`
+	URL             *string     `json:"url,omitempty"`
+	HTMLURL         *string     `json:"html_url,omitempty"`
+	Status          *string     `json:"status,omitempty"`
+	Conclusion      *string     `json:"conclusion,omitempty"`
+	CreatedAt       *Timestamp  `json:"created_at,omitempty"`
+	StartedAt       *Timestamp  `json:"started_at,omitempty"`
+	CompletedAt     *Timestamp  `json:"completed_at,omitempty"`
+	Name            *string     `json:"name,omitempty"`
+	Steps           []*TaskStep `json:"steps,omitempty"`
+	CheckRunURL     *string     `json:"check_run_url,omitempty"`
+	Labels          []string    `json:"labels,omitempty"`
+	RunnerID        *int64      `json:"runner_id,omitempty"`
+	RunnerName      *string     `json:"runner_name,omitempty"`
+	RunnerGroupID   *int64      `json:"runner_group_id,omitempty"`
+	RunnerGroupName *string     `json:"runner_group_name,omitempty"`
+}
+
+func (j WorkflowJob) String() string {
+	return Stringify(j)
+}
+
+// WorkflowJobTiming is the breakdown of the time a workflow job took.
+type WorkflowJobTiming struct {
+	// QueueTime is the time the job waited for a runner, from its creation
+	// until it started.
+	QueueTime time.Duration
+
+	// RunTime is the time the job took to run, from its start until it
+	// completed.
+	RunTime time.Duration
+
+	// Steps are the durations of the steps of the job, in the order of the
+	// steps.
+	Steps []*StepTiming
+}
+
+// StepTiming is the time a step of a workflow job took to run.
+type StepTiming struct {
+	Step     *TaskStep
+	Duration time.Duration
+}
+
+// Timing returns the breakdown of the time the job took. Durations whose
+// bounds are not known yet, such as the run time of a job in progress, are
+// zero.
+func (j *WorkflowJob) Timing() *WorkflowJobTiming {
+	timing := new(WorkflowJobTiming)
+	if j.CreatedAt != nil && j.StartedAt != nil {
+		timing.QueueTime = j.StartedAt.Sub(j.CreatedAt.Time)
+	}
+	if j.StartedAt != nil && j.CompletedAt != nil {
+		timing.RunTime = j.CompletedAt.Sub(j.StartedAt.Time)
+	}
+	for _, step := range j.Steps {
+		timing.Steps = append(timing.Steps, &StepTiming{Step: step, Duration: step.Duration()})
+	}
+	return timing
+}
+
+// Slowest returns the n slowest steps, slowest first. n is clamped to the
+// number of steps; a negative n returns no steps.
+func (t *WorkflowJobTiming) Slowest(n int) []*StepTiming {
+	if n < 0 {
+		n = 0
+	}
+	steps := make([]*StepTiming, len(t.Steps))
+	copy(steps, t.Steps)
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Duration > steps[j].Duration })
+	if n < len(steps) {
+		steps = steps[:n]
+	}
+	return steps
+}
+
+// Jobs represents a paginated list of workflow jobs.
+type Jobs struct {
+	TotalCount *int           `json:"total_count,omitempty"`
+	Jobs       []*WorkflowJob `json:"jobs,omitempty"`
+}
+
+// ListJobsForWorkflowRunAttempt lists the jobs of a specific attempt of a
+// workflow run.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run-attempt
+func (s *ActionsService) ListJobsForWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opt *ListOptions) (*Jobs, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v/jobs", owner, repo, runID, attemptNumber)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jobs := new(Jobs)
+	resp, err := s.client.Do(ctx, req, jobs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return jobs, resp, nil
+}
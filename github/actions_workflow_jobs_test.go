@@ -0,0 +1,104 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActionsService_ListJobsForWorkflowRunAttempt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449/attempts/1/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"jobs":[{"id":399444496,"run_id":29679449,"run_attempt":1,"name":"build","steps":[{"name":"Set up job","status":"completed","conclusion":"success","number":1}],"labels":["ubuntu-latest"]}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	jobs, _, err := client.Actions.ListJobsForWorkflowRunAttempt(context.Background(), "o", "r", 29679449, 1, opt)
+	if err != nil {
+		t.Errorf("Actions.ListJobsForWorkflowRunAttempt returned error: %v", err)
+	}
+
+	want := &Jobs{
+		TotalCount: Int(1),
+		Jobs: []*WorkflowJob{{
+			ID:         Int64(399444496),
+			RunID:      Int64(29679449),
+			RunAttempt: Int64(1),
+			Name:       String("build"),
+			Steps: []*TaskStep{{
+				Name:       String("Set up job"),
+				Status:     String("completed"),
+				Conclusion: String("success"),
+				Number:     Int64(1),
+			}},
+			Labels: []string{"ubuntu-latest"},
+		}},
+	}
+	if !reflect.DeepEqual(jobs, want) {
+		t.Errorf("Actions.ListJobsForWorkflowRunAttempt returned %+v, want %+v", jobs, want)
+	}
+}
+
+func TestWorkflowJob_Timing(t *testing.T) {
+	at := func(sec int) *Timestamp {
+		return &Timestamp{time.Date(2019, time.January, 2, 15, 4, sec, 0, time.UTC)}
+	}
+	job := &WorkflowJob{
+		CreatedAt:   at(0),
+		StartedAt:   at(10),
+		CompletedAt: at(50),
+		Steps: []*TaskStep{
+			{Name: String("setup"), StartedAt: at(10), CompletedAt: at(15)},
+			{Name: String("test"), StartedAt: at(15), CompletedAt: at(45)},
+			{Name: String("upload"), StartedAt: at(45), CompletedAt: at(50)},
+			{Name: String("cleanup"), StartedAt: at(50)},
+		},
+	}
+
+	timing := job.Timing()
+	if got, want := timing.QueueTime, 10*time.Second; got != want {
+		t.Errorf("Timing returned QueueTime %v, want %v", got, want)
+	}
+	if got, want := timing.RunTime, 40*time.Second; got != want {
+		t.Errorf("Timing returned RunTime %v, want %v", got, want)
+	}
+	want := []*StepTiming{
+		{job.Steps[0], 5 * time.Second},
+		{job.Steps[1], 30 * time.Second},
+		{job.Steps[2], 5 * time.Second},
+		{job.Steps[3], 0},
+	}
+	if !reflect.DeepEqual(timing.Steps, want) {
+		t.Errorf("Timing returned steps %+v, want %+v", timing.Steps, want)
+	}
+
+	slowest := timing.Slowest(2)
+	if want := []*StepTiming{want[1], want[0]}; !reflect.DeepEqual(slowest, want) {
+		t.Errorf("Slowest returned %+v, want %+v", slowest, want)
+	}
+	if got := len(timing.Slowest(10)); got != 4 {
+		t.Errorf("Slowest(10) returned %v steps, want 4", got)
+	}
+	if got := len(timing.Slowest(-1)); got != 0 {
+		t.Errorf("Slowest(-1) returned %v steps, want 0", got)
+	}
+}
+
+func TestWorkflowJob_Timing_inProgress(t *testing.T) {
+	job := &WorkflowJob{CreatedAt: &Timestamp{time.Now()}}
+	if got, want := job.Timing(), (&WorkflowJobTiming{}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Timing returned %+v, want %+v", got, want)
+	}
+}
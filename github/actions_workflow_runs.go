@@ -0,0 +1,274 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// WorkflowRun represents a single run of a GitHub Actions workflow.
+type WorkflowRun struct {
+	ID                 *int64         `json:"id,omitempty"`
+	Name               *string        `json:"name,omitempty"`
+	NodeID             *string        `json:"node_id,omitempty"`
+	HeadBranch         *string        `json:"head_branch,omitempty"`
+	HeadSHA            *string        `json:"head_sha,omitempty"`
+	RunNumber          *int           `json:"run_number,omitempty"`
+	RunAttempt         *int           `json:"run_attempt,omitempty"`
+	Event              *string        `json:"event,omitempty"`
+	Status             *string        `json:"status,omitempty"`
+	Conclusion         *string        `json:"conclusion,omitempty"`
+	WorkflowID         *int64         `json:"workflow_id,omitempty"`
+	CheckSuiteID       *int64         `json:"check_suite_id,omitempty"`
+	URL                *string        `json:"url,omitempty"`
+	HTMLURL            *string        `json:"html_url,omitempty"`
+	PullRequests       []*PullRequest `json:"pull_requests,omitempty"`
+	CreatedAt          *Timestamp     `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp     `json:"updated_at,omitempty"`
+	RunStartedAt       *Timestamp     `json:"run_started_at,omitempty"`
+	JobsURL            *string        `json:"jobs_url,omitempty"`
+	LogsURL            *string        `json:"logs_url,omitempty"`
+	CheckSuiteURL      *string        `json:"check_suite_url,omitempty"`
+	ArtifactsURL       *string        `json:"artifacts_url,omitempty"`
+	CancelURL          *string        `json:"cancel_url,omitempty"`
+	RerunURL           *string        `json:"rerun_url,omitempty"`
+	PreviousAttemptURL *string        `json:"previous_attempt_url,omitempty"`
+	WorkflowURL        *string        `json:"workflow_url,omitempty"`
+	Actor              *User          `json:"actor,omitempty"`
+	TriggeringActor    *User          `json:"triggering_actor,omitempty"`
+	Repository         *Repository    `json:"repository,omitempty"`
+	HeadRepository     *Repository    `json:"head_repository,omitempty"`
+}
+
+func (r WorkflowRun) String() string {
+	return Stringify(r)
+}
+
+// WorkflowRunAttemptOptions specifies the optional parameters to the
+// ActionsService.GetWorkflowRunAttempt method.
+type WorkflowRunAttemptOptions struct {
+	// ExcludePullRequests omits the pull_requests array from the response.
+	ExcludePullRequests *bool `url:"exclude_pull_requests,omitempty"`
+}
+
+// PendingDeployment represents a deployment of a workflow run that is
+// waiting for approval by one of its required reviewers.
+type PendingDeployment struct {
+	Environment           *PendingDeploymentEnvironment `json:"environment,omitempty"`
+	WaitTimer             *int64                        `json:"wait_timer,omitempty"`
+	WaitTimerStartedAt    *Timestamp                    `json:"wait_timer_started_at,omitempty"`
+	CurrentUserCanApprove *bool                         `json:"current_user_can_approve,omitempty"`
+	Reviewers             []*RequiredReviewer           `json:"reviewers,omitempty"`
+}
+
+func (p PendingDeployment) String() string {
+	return Stringify(p)
+}
+
+// PendingDeploymentEnvironment represents the environment of a pending
+// deployment.
+type PendingDeploymentEnvironment struct {
+	ID      *int64  `json:"id,omitempty"`
+	NodeID  *string `json:"node_id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	HTMLURL *string `json:"html_url,omitempty"`
+}
+
+// RequiredReviewer represents a user or team allowed to approve a pending
+// deployment. Reviewer is a *User if Type is "User" and a *Team if Type is
+// "Team".
+type RequiredReviewer struct {
+	Type     *string     `json:"type,omitempty"`
+	Reviewer interface{} `json:"reviewer,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes Reviewer into a *User or *Team, depending on Type.
+func (r *RequiredReviewer) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type     *string         `json:"type,omitempty"`
+		Reviewer json.RawMessage `json:"reviewer,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Type = raw.Type
+	r.Reviewer = nil
+	if len(raw.Reviewer) == 0 {
+		return nil
+	}
+
+	if raw.Type == nil {
+		return fmt.Errorf("reviewer.type is unset")
+	}
+	switch *raw.Type {
+	case "User":
+		user := new(User)
+		if err := json.Unmarshal(raw.Reviewer, user); err != nil {
+			return err
+		}
+		r.Reviewer = user
+	case "Team":
+		team := new(Team)
+		if err := json.Unmarshal(raw.Reviewer, team); err != nil {
+			return err
+		}
+		r.Reviewer = team
+	default:
+		return fmt.Errorf("reviewer.type %q is not a User or Team", *raw.Type)
+	}
+	return nil
+}
+
+// PendingDeploymentsRequest represents the input to approve or reject the
+// pending deployments of a workflow run.
+type PendingDeploymentsRequest struct {
+	EnvironmentIDs []int64 `json:"environment_ids"`
+	// State can be one of: "approved" or "rejected".
+	State   string `json:"state"`
+	Comment string `json:"comment"`
+}
+
+// GetPendingDeployments lists the deployments of a workflow run that are
+// waiting for approval.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#get-pending-deployments-for-a-workflow-run
+func (s *ActionsService) GetPendingDeployments(ctx context.Context, owner, repo string, runID int64) ([]*PendingDeployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*PendingDeployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
+
+// PendingDeployments approves or rejects the pending deployments of a
+// workflow run to the environments in request, and returns the resulting
+// deployments.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#review-pending-deployments-for-a-workflow-run
+func (s *ActionsService) PendingDeployments(ctx context.Context, owner, repo string, runID int64, request *PendingDeploymentsRequest) ([]*Deployment, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/pending_deployments", owner, repo, runID)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deployments []*Deployment
+	resp, err := s.client.Do(ctx, req, &deployments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return deployments, resp, nil
+}
+
+// ReviewCustomDeploymentProtectionRuleRequest represents the input of a
+// custom deployment protection rule app to approve or reject a deployment.
+type ReviewCustomDeploymentProtectionRuleRequest struct {
+	EnvironmentName string `json:"environment_name"`
+	// State can be one of: "approved" or "rejected".
+	State   string `json:"state"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// ReviewCustomDeploymentProtectionRule approves or rejects the deployment of
+// a workflow run to an environment guarded by a custom deployment protection
+// rule. It is called by the GitHub App of the rule, in response to a
+// deployment_protection_rule event.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#review-custom-deployment-protection-rules-for-a-workflow-run
+func (s *ActionsService) ReviewCustomDeploymentProtectionRule(ctx context.Context, owner, repo string, runID int64, request *ReviewCustomDeploymentProtectionRuleRequest) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/deployment_protection_rule", owner, repo, runID)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ApproveWorkflowRun approves a workflow run for a pull request from a
+// public fork of a first time contributor.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#approve-a-workflow-run-for-a-fork-pull-request
+func (s *ActionsService) ApproveWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/approve", owner, repo, runID)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetWorkflowRunAttempt gets a specific attempt of a workflow run, so that
+// the data of earlier attempts can be told apart from the latest one.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#get-a-workflow-run-attempt
+func (s *ActionsService) GetWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opt *WorkflowRunAttemptOptions) (*WorkflowRun, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v", owner, repo, runID, attemptNumber)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(WorkflowRun)
+	resp, err := s.client.Do(ctx, req, run)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return run, resp, nil
+}
+
+// GetWorkflowRunAttemptLogs gets a redirect URL to download an archive of
+// the logs of a specific attempt of a workflow run. The URL expires after
+// a minute.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflow-runs#download-workflow-run-attempt-logs
+func (s *ActionsService) GetWorkflowRunAttemptLogs(ctx context.Context, owner, repo string, runID int64, attemptNumber int) (*url.URL, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/runs/%v/attempts/%v/logs", owner, repo, runID, attemptNumber)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var resp *http.Response
+	// Use http.DefaultTransport if no custom Transport is configured
+	req = withContext(ctx, req)
+	if s.client.client.Transport == nil {
+		resp, err = http.DefaultTransport.RoundTrip(req)
+	} else {
+		resp, err = s.client.client.Transport.RoundTrip(req)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		return nil, newResponse(resp), fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+	parsedURL, err := url.Parse(resp.Header.Get("Location"))
+	return parsedURL, newResponse(resp), err
+}
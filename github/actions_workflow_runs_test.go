@@ -0,0 +1,175 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetPendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"environment":{"id":1,"name":"production"},"wait_timer":30,"current_user_can_approve":true,"reviewers":[{"type":"User","reviewer":{"login":"octocat"}},{"type":"Team","reviewer":{"id":2,"name":"ops"}}]}]`)
+	})
+
+	deployments, _, err := client.Actions.GetPendingDeployments(context.Background(), "o", "r", 399444496)
+	if err != nil {
+		t.Errorf("Actions.GetPendingDeployments returned error: %v", err)
+	}
+
+	want := []*PendingDeployment{{
+		Environment:           &PendingDeploymentEnvironment{ID: Int64(1), Name: String("production")},
+		WaitTimer:             Int64(30),
+		CurrentUserCanApprove: Bool(true),
+		Reviewers: []*RequiredReviewer{
+			{Type: String("User"), Reviewer: &User{Login: String("octocat")}},
+			{Type: String("Team"), Reviewer: &Team{ID: Int64(2), Name: String("ops")}},
+		},
+	}}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Actions.GetPendingDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
+func TestRequiredReviewer_UnmarshalJSON_invalidType(t *testing.T) {
+	for _, data := range []string{`{"reviewer":{"id":1}}`, `{"type":"Bot","reviewer":{"id":1}}`} {
+		r := new(RequiredReviewer)
+		if err := json.Unmarshal([]byte(data), r); err == nil {
+			t.Errorf("json.Unmarshal(%v) returned nil error, want error", data)
+		}
+	}
+}
+
+func TestActionsService_PendingDeployments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/pending_deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment_ids":[3,4],"state":"approved","comment":"ship it"}`+"\n")
+		fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+	})
+
+	input := &PendingDeploymentsRequest{EnvironmentIDs: []int64{3, 4}, State: "approved", Comment: "ship it"}
+	deployments, _, err := client.Actions.PendingDeployments(context.Background(), "o", "r", 399444496, input)
+	if err != nil {
+		t.Errorf("Actions.PendingDeployments returned error: %v", err)
+	}
+
+	want := []*Deployment{{ID: Int64(1)}, {ID: Int64(2)}}
+	if !reflect.DeepEqual(deployments, want) {
+		t.Errorf("Actions.PendingDeployments returned %+v, want %+v", deployments, want)
+	}
+}
+
+func TestActionsService_ReviewCustomDeploymentProtectionRule(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/1/deployment_protection_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"environment_name":"production","state":"approved","comment":"c"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	request := &ReviewCustomDeploymentProtectionRuleRequest{EnvironmentName: "production", State: "approved", Comment: "c"}
+	if _, err := client.Actions.ReviewCustomDeploymentProtectionRule(context.Background(), "o", "r", 1, request); err != nil {
+		t.Errorf("Actions.ReviewCustomDeploymentProtectionRule returned error: %v", err)
+	}
+}
+
+func TestActionsService_ApproveWorkflowRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/approve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	resp, err := client.Actions.ApproveWorkflowRun(context.Background(), "o", "r", 399444496)
+	if err != nil {
+		t.Errorf("Actions.ApproveWorkflowRun returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Actions.ApproveWorkflowRun returned status code %v, want %v", resp.StatusCode, http.StatusCreated)
+	}
+}
+
+func TestActionsService_GetWorkflowRunAttempt(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/29679449/attempts/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"exclude_pull_requests": "true"})
+		fmt.Fprint(w, `{"id":399444496,"run_number":296,"run_attempt":3,"previous_attempt_url":"https://api.github.com/repos/o/r/actions/runs/29679449/attempts/2"}`)
+	})
+
+	opt := &WorkflowRunAttemptOptions{ExcludePullRequests: Bool(true)}
+	run, _, err := client.Actions.GetWorkflowRunAttempt(context.Background(), "o", "r", 29679449, 3, opt)
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowRunAttempt returned error: %v", err)
+	}
+
+	want := &WorkflowRun{
+		ID:                 Int64(399444496),
+		RunNumber:          Int(296),
+		RunAttempt:         Int(3),
+		PreviousAttemptURL: String("https://api.github.com/repos/o/r/actions/runs/29679449/attempts/2"),
+	}
+	if !reflect.DeepEqual(run, want) {
+		t.Errorf("Actions.GetWorkflowRunAttempt returned %+v, want %+v", run, want)
+	}
+}
+
+func TestActionsService_GetWorkflowRunAttemptLogs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/attempts/2/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, "http://github.com/a", http.StatusFound)
+	})
+
+	url, resp, err := client.Actions.GetWorkflowRunAttemptLogs(context.Background(), "o", "r", 399444496, 2)
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowRunAttemptLogs returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("Actions.GetWorkflowRunAttemptLogs returned status: %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+	want := "http://github.com/a"
+	if url.String() != want {
+		t.Errorf("Actions.GetWorkflowRunAttemptLogs returned %+v, want %+v", url.String(), want)
+	}
+}
+
+func TestActionsService_GetWorkflowRunAttemptLogs_unexpectedCode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/attempts/2/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	url, _, err := client.Actions.GetWorkflowRunAttemptLogs(context.Background(), "o", "r", 399444496, 2)
+	if err == nil {
+		t.Errorf("Actions.GetWorkflowRunAttemptLogs returned nil error, want error")
+	}
+	if url != nil {
+		t.Errorf("Actions.GetWorkflowRunAttemptLogs returned %+v, want nil", url)
+	}
+}
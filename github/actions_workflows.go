@@ -0,0 +1,100 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowUsage represents the billable time used by a workflow in the
+// current billing cycle.
+type WorkflowUsage struct {
+	Billable *WorkflowBillMap `json:"billable,omitempty"`
+}
+
+// WorkflowBillMap maps a runner operating system, such as "UBUNTU", "MACOS"
+// or "WINDOWS", to the billable time used on it.
+type WorkflowBillMap map[string]*WorkflowBill
+
+// WorkflowBill represents the billable time used on one operating system.
+type WorkflowBill struct {
+	TotalMS *int64 `json:"total_ms,omitempty"`
+}
+
+// GetWorkflowUsageByID gets the billable time used by a workflow, in
+// milliseconds per runner operating system, identified by its ID.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflows#get-workflow-usage
+func (s *ActionsService) GetWorkflowUsageByID(ctx context.Context, owner, repo string, workflowID int64) (*WorkflowUsage, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", owner, repo, workflowID)
+	return s.getWorkflowUsage(ctx, u)
+}
+
+// GetWorkflowUsageByFileName gets the billable time used by a workflow, in
+// milliseconds per runner operating system, identified by the file name of
+// the workflow, such as "main.yml".
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflows#get-workflow-usage
+func (s *ActionsService) GetWorkflowUsageByFileName(ctx context.Context, owner, repo, workflowFileName string) (*WorkflowUsage, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/timing", owner, repo, workflowFileName)
+	return s.getWorkflowUsage(ctx, u)
+}
+
+func (s *ActionsService) getWorkflowUsage(ctx context.Context, url string) (*WorkflowUsage, *Response, error) {
+	req, err := s.client.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(WorkflowUsage)
+	resp, err := s.client.Do(ctx, req, usage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return usage, resp, nil
+}
+
+// CreateWorkflowDispatchEventRequest represents a request to create a
+// workflow dispatch event.
+type CreateWorkflowDispatchEventRequest struct {
+	// Ref is the git reference of the workflow, a branch or tag name.
+	// Ref is required.
+	Ref string `json:"ref"`
+
+	// Inputs are the values of the inputs the workflow declares for the
+	// workflow_dispatch event.
+	Inputs map[string]interface{} `json:"inputs,omitempty"`
+}
+
+// CreateWorkflowDispatchEventByID manually triggers a workflow that has a
+// workflow_dispatch trigger, identified by its ID.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflows#create-a-workflow-dispatch-event
+func (s *ActionsService) CreateWorkflowDispatchEventByID(ctx context.Context, owner, repo string, workflowID int64, event CreateWorkflowDispatchEventRequest) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", owner, repo, workflowID)
+	return s.createWorkflowDispatchEvent(ctx, u, &event)
+}
+
+// CreateWorkflowDispatchEventByFileName manually triggers a workflow that
+// has a workflow_dispatch trigger, identified by the file name of the
+// workflow, such as "main.yml".
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/workflows#create-a-workflow-dispatch-event
+func (s *ActionsService) CreateWorkflowDispatchEventByFileName(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/actions/workflows/%v/dispatches", owner, repo, workflowFileName)
+	return s.createWorkflowDispatchEvent(ctx, u, &event)
+}
+
+func (s *ActionsService) createWorkflowDispatchEvent(ctx context.Context, url string, event *CreateWorkflowDispatchEventRequest) (*Response, error) {
+	req, err := s.client.NewRequest("POST", url, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
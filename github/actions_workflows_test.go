@@ -0,0 +1,96 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActionsService_GetWorkflowUsageByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/timing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":180000},"MACOS":{"total_ms":240000},"WINDOWS":{"total_ms":300000}}}`)
+	})
+
+	usage, _, err := client.Actions.GetWorkflowUsageByID(context.Background(), "o", "r", 72844)
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowUsageByID returned error: %v", err)
+	}
+
+	want := &WorkflowUsage{
+		Billable: &WorkflowBillMap{
+			"UBUNTU":  &WorkflowBill{TotalMS: Int64(180000)},
+			"MACOS":   &WorkflowBill{TotalMS: Int64(240000)},
+			"WINDOWS": &WorkflowBill{TotalMS: Int64(300000)},
+		},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("Actions.GetWorkflowUsageByID returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestActionsService_GetWorkflowUsageByFileName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/timing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"billable":{"UBUNTU":{"total_ms":180000}}}`)
+	})
+
+	usage, _, err := client.Actions.GetWorkflowUsageByFileName(context.Background(), "o", "r", "main.yml")
+	if err != nil {
+		t.Errorf("Actions.GetWorkflowUsageByFileName returned error: %v", err)
+	}
+
+	want := &WorkflowUsage{
+		Billable: &WorkflowBillMap{
+			"UBUNTU": &WorkflowBill{TotalMS: Int64(180000)},
+		},
+	}
+	if !reflect.DeepEqual(usage, want) {
+		t.Errorf("Actions.GetWorkflowUsageByFileName returned %+v, want %+v", usage, want)
+	}
+}
+
+func TestActionsService_CreateWorkflowDispatchEventByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"main","inputs":{"key":"value"}}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	event := CreateWorkflowDispatchEventRequest{Ref: "main", Inputs: map[string]interface{}{"key": "value"}}
+	if _, err := client.Actions.CreateWorkflowDispatchEventByID(context.Background(), "o", "r", 72844, event); err != nil {
+		t.Errorf("Actions.CreateWorkflowDispatchEventByID returned error: %v", err)
+	}
+}
+
+func TestActionsService_CreateWorkflowDispatchEventByFileName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"main"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	event := CreateWorkflowDispatchEventRequest{Ref: "main"}
+	if _, err := client.Actions.CreateWorkflowDispatchEventByFileName(context.Background(), "o", "r", "main.yml", event); err != nil {
+		t.Errorf("Actions.CreateWorkflowDispatchEventByFileName returned error: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const headerPollInterval = "X-Poll-Interval"
+
+// defaultPollInterval is used when a response has no X-Poll-Interval header.
+var defaultPollInterval = 60 * time.Second
+
+// PollRepositoryEvents tails the event stream of a repository, sending each
+// new event to events, oldest first. The events on the first page currently
+// listed are sent on the first poll. Later polls follow the pages back to
+// the last event seen, up to the 300 events the API lists.
+//
+// Polls are conditional requests using the ETag of the previous response, so
+// polls that find no new events do not count against the rate limit, and
+// are spaced by the X-Poll-Interval the API asks for.
+//
+// PollRepositoryEvents blocks until ctx is done or a request fails, and
+// returns the corresponding error. It does not close events.
+//
+// GitHub API docs: https://developer.github.com/v3/activity/events/
+func (s *ActivityService) PollRepositoryEvents(ctx context.Context, owner, repo string, events chan<- *Event) error {
+	u := fmt.Sprintf("repos/%v/%v/events", owner, repo)
+	return s.pollEvents(ctx, u, events)
+}
+
+// pollEvents polls the event list at url; see PollRepositoryEvents.
+func (s *ActivityService) pollEvents(ctx context.Context, url string, events chan<- *Event) error {
+	var etag string
+	seen := make(map[string]bool)
+
+	for {
+		req, err := s.client.NewRequest("GET", url, nil)
+		if err != nil {
+			return err
+		}
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		var page []*Event
+		resp, err := s.client.Do(ctx, req, &page)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotModified) {
+			return err
+		}
+
+		if err == nil {
+			etag = resp.Header.Get("ETag")
+
+			// More events than fit on a page may have happened since the
+			// last poll, so page back until one already seen. The API lists
+			// the latest 300 events at most, so older ones are still lost.
+			for next := resp.NextPage; len(seen) > 0 && next != 0 && !anySeen(page, seen); {
+				var more []*Event
+				more, next, err = s.listEventsPage(ctx, url, next)
+				if err != nil {
+					return err
+				}
+				page = append(page, more...)
+			}
+
+			current := make(map[string]bool, len(page))
+			// Events are listed newest first.
+			for i := len(page) - 1; i >= 0; i-- {
+				id := page[i].GetID()
+				if seen[id] || current[id] {
+					continue
+				}
+				current[id] = true
+				select {
+				case events <- page[i]:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			for _, e := range page {
+				current[e.GetID()] = true
+			}
+			seen = current
+		}
+
+		timer := time.NewTimer(pollInterval(resp))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// listEventsPage fetches the given page of the event list at url, and
+// returns it along with the number of the next page.
+func (s *ActivityService) listEventsPage(ctx context.Context, url string, page int) ([]*Event, int, error) {
+	u, err := addOptions(url, &ListOptions{Page: page})
+	if err != nil {
+		return nil, 0, err
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var events []*Event
+	resp, err := s.client.Do(ctx, req, &events)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, resp.NextPage, nil
+}
+
+// anySeen reports whether any of events is in seen.
+func anySeen(events []*Event, seen map[string]bool) bool {
+	for _, e := range events {
+		if seen[e.GetID()] {
+			return true
+		}
+	}
+	return false
+}
+
+// pollInterval returns the delay before the next poll, as requested by the
+// X-Poll-Interval header of resp.
+func pollInterval(resp *Response) time.Duration {
+	if resp != nil && resp.Response != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get(headerPollInterval)); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return defaultPollInterval
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestActivityService_PollRepositoryEvents(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { defaultPollInterval = d }(defaultPollInterval)
+	defaultPollInterval = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		switch calls {
+		case 1:
+			testHeader(t, r, "If-None-Match", "")
+			w.Header().Set("ETag", `"a"`)
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		case 2:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			testHeader(t, r, "If-None-Match", `"a"`)
+			w.Header().Set("ETag", `"b"`)
+			fmt.Fprint(w, `[{"id":"4"},{"id":"3"},{"id":"2"}]`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan *Event)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- client.Activity.PollRepositoryEvents(ctx, "o", "r", events)
+	}()
+
+	for _, want := range []string{"1", "2", "3", "4"} {
+		select {
+		case e := <-events:
+			if got := e.GetID(); got != want {
+				t.Errorf("PollRepositoryEvents sent event %v, want %v", got, want)
+			}
+		case err := <-errc:
+			t.Fatalf("PollRepositoryEvents returned early: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %v", want)
+		}
+	}
+
+	cancel()
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("PollRepositoryEvents returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestActivityService_PollRepositoryEvents_pages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { defaultPollInterval = d }(defaultPollInterval)
+	defaultPollInterval = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch {
+		case calls == 1:
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"2"},{"id":"1"}]`)
+		case r.FormValue("page") == "2":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=3>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"3"},{"id":"2"}]`)
+		case r.FormValue("page") == "3":
+			t.Error("PollRepositoryEvents fetched a page past the last event seen")
+		default:
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":"5"},{"id":"4"}]`)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan *Event)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- client.Activity.PollRepositoryEvents(ctx, "o", "r", events)
+	}()
+
+	for _, want := range []string{"1", "2", "3", "4", "5"} {
+		select {
+		case e := <-events:
+			if got := e.GetID(); got != want {
+				t.Errorf("PollRepositoryEvents sent event %v, want %v", got, want)
+			}
+		case err := <-errc:
+			t.Fatalf("PollRepositoryEvents returned early: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %v", want)
+		}
+	}
+
+	cancel()
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("PollRepositoryEvents returned %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestActivityService_PollRepositoryEvents_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.Activity.PollRepositoryEvents(context.Background(), "o", "r", make(chan *Event))
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("PollRepositoryEvents returned %#v, want *ErrorResponse", err)
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"", defaultPollInterval},
+		{"0", defaultPollInterval},
+		{"x", defaultPollInterval},
+		{"90", 90 * time.Second},
+	}
+
+	for _, tt := range tests {
+		resp := &Response{Response: &http.Response{Header: http.Header{}}}
+		if tt.header != "" {
+			resp.Header.Set(headerPollInterval, tt.header)
+		}
+		if got := pollInterval(resp); got != tt.want {
+			t.Errorf("pollInterval with header %q = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+
+	if got := pollInterval(nil); got != defaultPollInterval {
+		t.Errorf("pollInterval(nil) = %v, want %v", got, defaultPollInterval)
+	}
+}
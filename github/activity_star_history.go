@@ -0,0 +1,200 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// StarHistoryInterval is the width of the buckets of a star history.
+type StarHistoryInterval string
+
+// Possible values of StarHistoryInterval.
+const (
+	StarHistoryDay   StarHistoryInterval = "day"
+	StarHistoryWeek  StarHistoryInterval = "week"
+	StarHistoryMonth StarHistoryInterval = "month"
+)
+
+// StarHistoryBucket is the number of stars a repository received in the
+// interval beginning at Start.
+type StarHistoryBucket struct {
+	Start time.Time
+	Count int
+}
+
+// maxStargazerPages is the number of stargazer pages the REST API serves;
+// requesting later pages fails with 422 Unprocessable Entity.
+var maxStargazerPages = 400
+
+// StargazerHistory returns the number of stars a repository received per
+// interval, oldest first, from the interval of the first star to that of the
+// last. Intervals begin at midnight UTC; weeks begin on Monday. Intervals
+// without stars are included with a Count of zero.
+//
+// Stargazers are listed through the REST API, which serves at most 400
+// pages. For repositories with more stargazers, StargazerHistory lists them
+// again through the GraphQL API, which has no such limit.
+//
+// GitHub API docs: https://developer.github.com/v3/activity/starring/#list-stargazers
+func (s *ActivityService) StargazerHistory(ctx context.Context, owner, repo string, interval StarHistoryInterval) ([]*StarHistoryBucket, error) {
+	start, err := starHistoryTruncater(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := s.listStarTimes(ctx, owner, repo)
+	if err == errStargazerPageCap {
+		times, err = s.listStarTimesGraphQL(ctx, owner, repo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return starHistogram(times, start, interval), nil
+}
+
+// errStargazerPageCap reports that the stargazers of a repository do not fit
+// in the pages served by the REST API.
+var errStargazerPageCap = errors.New("github: stargazer page limit reached")
+
+// listStarTimes returns the starred_at times of all stargazers of a
+// repository using the REST API.
+func (s *ActivityService) listStarTimes(ctx context.Context, owner, repo string) ([]time.Time, error) {
+	var times []time.Time
+	opt := &ListOptions{PerPage: 100}
+	for {
+		if opt.Page > maxStargazerPages {
+			return nil, errStargazerPageCap
+		}
+
+		stargazers, resp, err := s.ListStargazers(ctx, owner, repo, opt)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+				return nil, errStargazerPageCap
+			}
+			return nil, err
+		}
+		for _, sg := range stargazers {
+			times = append(times, sg.GetStarredAt().Time)
+		}
+
+		if resp.NextPage == 0 {
+			return times, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// listStarTimesGraphQL returns the starred_at times of all stargazers of a
+// repository using the GraphQL API.
+func (s *ActivityService) listStarTimesGraphQL(ctx context.Context, owner, repo string) ([]time.Time, error) {
+	query := `query($owner: String!, $name: String!, $first: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		stargazers(first: $first, after: $after) {
+			pageInfo { hasNextPage endCursor }
+			edges { starredAt }
+		}
+	}
+}`
+	var times []time.Time
+	opt := &GraphQLListOptions{First: 100}
+	for {
+		var result struct {
+			Repository *struct {
+				Stargazers struct {
+					PageInfo PageInfo `json:"pageInfo"`
+					Edges    []struct {
+						StarredAt time.Time `json:"starredAt"`
+					} `json:"edges"`
+				} `json:"stargazers"`
+			} `json:"repository"`
+		}
+		vars := map[string]interface{}{"owner": owner, "name": repo, "first": opt.first(), "after": opt.after()}
+		if _, err := s.client.graphQL(ctx, query, vars, &result); err != nil {
+			return nil, err
+		}
+		if result.Repository == nil {
+			return nil, errors.New("github: repository not found")
+		}
+
+		conn := result.Repository.Stargazers
+		for _, e := range conn.Edges {
+			times = append(times, e.StarredAt)
+		}
+
+		if !conn.PageInfo.HasNextPage || conn.PageInfo.EndCursor == nil {
+			return times, nil
+		}
+		opt.After = *conn.PageInfo.EndCursor
+	}
+}
+
+// starHistoryTruncater returns a function that maps a time to the start of
+// its interval.
+func starHistoryTruncater(interval StarHistoryInterval) (func(time.Time) time.Time, error) {
+	day := func(t time.Time) time.Time {
+		t = t.UTC()
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	switch interval {
+	case StarHistoryDay:
+		return day, nil
+	case StarHistoryWeek:
+		return func(t time.Time) time.Time {
+			t = day(t)
+			return t.AddDate(0, 0, -(int(t.Weekday())+6)%7)
+		}, nil
+	case StarHistoryMonth:
+		return func(t time.Time) time.Time {
+			t = t.UTC()
+			return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		}, nil
+	}
+	return nil, errors.New("github: invalid star history interval " + string(interval))
+}
+
+// starHistogram counts times per interval, filling in empty intervals.
+func starHistogram(times []time.Time, start func(time.Time) time.Time, interval StarHistoryInterval) []*StarHistoryBucket {
+	if len(times) == 0 {
+		return nil
+	}
+
+	counts := make(map[time.Time]int)
+	for _, t := range times {
+		counts[start(t)]++
+	}
+
+	keys := make([]time.Time, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Before(keys[j]) })
+
+	var buckets []*StarHistoryBucket
+	last := keys[len(keys)-1]
+	for t := keys[0]; !t.After(last); t = nextStarHistoryInterval(t, interval) {
+		buckets = append(buckets, &StarHistoryBucket{Start: t, Count: counts[t]})
+	}
+	return buckets
+}
+
+// nextStarHistoryInterval returns the start of the interval following the
+// one starting at t.
+func nextStarHistoryInterval(t time.Time, interval StarHistoryInterval) time.Time {
+	switch interval {
+	case StarHistoryWeek:
+		return t.AddDate(0, 0, 7)
+	case StarHistoryMonth:
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
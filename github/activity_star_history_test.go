@@ -0,0 +1,108 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActivityService_StargazerHistory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeStarringPreview)
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/stargazers?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"starred_at":"2019-01-01T10:00:00Z"},{"starred_at":"2019-01-01T23:00:00Z"}]`)
+		default:
+			fmt.Fprint(w, `[{"starred_at":"2019-01-03T00:00:00Z"}]`)
+		}
+	})
+
+	got, err := client.Activity.StargazerHistory(context.Background(), "o", "r", StarHistoryDay)
+	if err != nil {
+		t.Fatalf("Activity.StargazerHistory returned error: %v", err)
+	}
+
+	want := []*StarHistoryBucket{
+		{Start: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), Count: 2},
+		{Start: time.Date(2019, time.January, 2, 0, 0, 0, 0, time.UTC), Count: 0},
+		{Start: time.Date(2019, time.January, 3, 0, 0, 0, 0, time.UTC), Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Activity.StargazerHistory returned %+v, want %+v", got, want)
+	}
+}
+
+func TestActivityService_StargazerHistory_graphQLFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(n int) { maxStargazerPages = n }(maxStargazerPages)
+	maxStargazerPages = 1
+
+	mux.HandleFunc("/repos/o/r/stargazers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/stargazers?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"starred_at":"2019-01-01T10:00:00Z"}]`)
+	})
+	calls := 0
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		calls++
+		if calls == 1 {
+			fmt.Fprint(w, `{"data":{"repository":{"stargazers":{"pageInfo":{"hasNextPage":true,"endCursor":"c"},"edges":[{"starredAt":"2019-01-01T10:00:00Z"}]}}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"stargazers":{"pageInfo":{"hasNextPage":false},"edges":[{"starredAt":"2019-02-20T10:00:00Z"}]}}}}`)
+	})
+
+	got, err := client.Activity.StargazerHistory(context.Background(), "o", "r", StarHistoryMonth)
+	if err != nil {
+		t.Fatalf("Activity.StargazerHistory returned error: %v", err)
+	}
+
+	want := []*StarHistoryBucket{
+		{Start: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+		{Start: time.Date(2019, time.February, 1, 0, 0, 0, 0, time.UTC), Count: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Activity.StargazerHistory returned %+v, want %+v", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("Activity.StargazerHistory made %v GraphQL requests, want 2", calls)
+	}
+}
+
+func TestActivityService_StargazerHistory_invalidInterval(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if _, err := client.Activity.StargazerHistory(context.Background(), "o", "r", "year"); err == nil {
+		t.Error("Activity.StargazerHistory returned nil error for an invalid interval")
+	}
+}
+
+func TestStarHistoryTruncater_week(t *testing.T) {
+	start, err := starHistoryTruncater(StarHistoryWeek)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2019-01-06 is a Sunday; its week begins on Monday 2018-12-31.
+	got := start(time.Date(2019, time.January, 6, 15, 0, 0, 0, time.UTC))
+	if want := time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("week start = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"time"
+)
+
+// StarTrendPoint is the number of stars a repository received in the
+// interval beginning at Start, and its estimated number of stargazers at the
+// end of the interval.
+type StarTrendPoint struct {
+	Start      time.Time
+	New        int
+	Stargazers int
+}
+
+// StarTrend is the recent trend of the stargazers of a repository.
+type StarTrend struct {
+	// Stargazers and Watchers are the current numbers of stargazers and
+	// watchers of the repository.
+	Stargazers int
+	Watchers   int
+
+	// Since is the time of the oldest event of the repository the events API
+	// served, before which the trend is unknown.
+	Since time.Time
+
+	// Points are the stars received per interval since the first star
+	// received after Since, oldest first.
+	Points []*StarTrendPoint
+}
+
+// StarTrend reconstructs the recent trend of the stargazers of a repository
+// from the WatchEvents of the events API, which are emitted when a user stars
+// the repository, and its current number of stargazers. Intervals are
+// defined as for StargazerHistory.
+//
+// The trend is rough: the events API only serves the last 300 events of the
+// last 90 days, and unstarring emits no event, so the estimated numbers of
+// stargazers of past intervals are too low by the number of users who
+// unstarred the repository since. No history of watchers is available; only
+// their current number is reported.
+//
+// GitHub API docs: https://developer.github.com/v3/activity/events/#list-repository-events
+func (s *ActivityService) StarTrend(ctx context.Context, owner, repo string, interval StarHistoryInterval) (*StarTrend, error) {
+	start, err := starHistoryTruncater(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _, err := s.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	trend := &StarTrend{
+		Stargazers: r.GetStargazersCount(),
+		Watchers:   r.GetSubscribersCount(),
+	}
+
+	var times []time.Time
+	opt := &ListOptions{PerPage: 100}
+	for {
+		events, resp, err := s.ListRepositoryEvents(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if e.CreatedAt == nil {
+				continue
+			}
+			if trend.Since.IsZero() || e.CreatedAt.Before(trend.Since) {
+				trend.Since = *e.CreatedAt
+			}
+			if e.GetType() == "WatchEvent" {
+				times = append(times, *e.CreatedAt)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	buckets := starHistogram(times, start, interval)
+	stargazers := trend.Stargazers
+	trend.Points = make([]*StarTrendPoint, len(buckets))
+	for i := len(buckets) - 1; i >= 0; i-- {
+		trend.Points[i] = &StarTrendPoint{
+			Start:      buckets[i].Start,
+			New:        buckets[i].Count,
+			Stargazers: stargazers,
+		}
+		stargazers -= buckets[i].Count
+	}
+
+	return trend, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActivityService_StarTrend(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"stargazers_count":50,"subscribers_count":7}`)
+	})
+	mux.HandleFunc("/repos/o/r/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/events?page=2>; rel="next"`)
+			fmt.Fprint(w, `[
+				{"type":"WatchEvent","created_at":"2019-01-04T10:00:00Z"},
+				{"type":"PushEvent","created_at":"2019-01-03T10:00:00Z"},
+				{"type":"WatchEvent","created_at":"2019-01-02T12:00:00Z"}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"type":"WatchEvent","created_at":"2019-01-02T10:00:00Z"},
+				{"type":"IssuesEvent","created_at":"2019-01-01T10:00:00Z"}
+			]`)
+		}
+	})
+
+	trend, err := client.Activity.StarTrend(context.Background(), "o", "r", StarHistoryDay)
+	if err != nil {
+		t.Fatalf("Activity.StarTrend returned error: %v", err)
+	}
+
+	day := func(d int) time.Time { return time.Date(2019, time.January, d, 0, 0, 0, 0, time.UTC) }
+	want := &StarTrend{
+		Stargazers: 50,
+		Watchers:   7,
+		Since:      time.Date(2019, time.January, 1, 10, 0, 0, 0, time.UTC),
+		Points: []*StarTrendPoint{
+			{Start: day(2), New: 2, Stargazers: 49},
+			{Start: day(3), New: 0, Stargazers: 49},
+			{Start: day(4), New: 1, Stargazers: 50},
+		},
+	}
+	if !reflect.DeepEqual(trend, want) {
+		t.Errorf("Activity.StarTrend returned %+v, want %+v", trend, want)
+	}
+}
+
+func TestActivityService_StarTrend_invalidInterval(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if _, err := client.Activity.StarTrend(context.Background(), "o", "r", "year"); err == nil {
+		t.Error("Activity.StarTrend returned no error for an invalid interval")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// UnwatchRepositories stops the authenticated user from watching the
+// repositories they watch that filter accepts, and returns them.
+//
+// All the watched repositories are listed before any is unwatched. A request
+// that hits a rate limit is retried once the limit resets. If unwatching a
+// repository fails, the repositories unwatched until then are returned with
+// the error.
+func (s *ActivityService) UnwatchRepositories(ctx context.Context, filter RepositoryFilter) ([]*Repository, error) {
+	if filter == nil {
+		return nil, errors.New("github: UnwatchRepositories requires a filter")
+	}
+
+	var matching []*Repository
+	opt := &ListOptions{PerPage: 100}
+	for {
+		var repos []*Repository
+		var resp *Response
+		err := retryOnRateLimit(ctx, func() (err error) {
+			repos, resp, err = s.ListWatched(ctx, "", opt)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range repos {
+			if filter(r) {
+				matching = append(matching, r)
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	var unwatched []*Repository
+	for _, r := range matching {
+		err := retryOnRateLimit(ctx, func() error {
+			_, err := s.DeleteRepositorySubscription(ctx, r.GetOwner().GetLogin(), r.GetName())
+			return err
+		})
+		if err != nil {
+			return unwatched, err
+		}
+		unwatched = append(unwatched, r)
+	}
+
+	return unwatched, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestActivityService_UnwatchRepositories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/user/subscriptions?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"a","owner":{"login":"o"},"archived":true},{"name":"b","owner":{"login":"o"}}]`)
+		case "2":
+			fmt.Fprint(w, `[{"name":"c","owner":{"login":"p"},"archived":true}]`)
+		}
+	})
+	var deleted []string
+	for _, path := range []string{"/repos/o/a/subscription", "/repos/p/c/subscription"} {
+		path := path
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "DELETE")
+			deleted = append(deleted, path)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	archived := func(r *Repository) bool { return r.GetArchived() }
+	repos, err := client.Activity.UnwatchRepositories(context.Background(), archived)
+	if err != nil {
+		t.Fatalf("Activity.UnwatchRepositories returned error: %v", err)
+	}
+
+	var names []string
+	for _, r := range repos {
+		names = append(names, r.GetOwner().GetLogin()+"/"+r.GetName())
+	}
+	if want := []string{"o/a", "p/c"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Activity.UnwatchRepositories returned %v, want %v", names, want)
+	}
+	if want := []string{"/repos/o/a/subscription", "/repos/p/c/subscription"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("Activity.UnwatchRepositories deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestActivityService_UnwatchRepositories_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"a","owner":{"login":"o"}},{"name":"b","owner":{"login":"o"}}]`)
+	})
+	mux.HandleFunc("/repos/o/a/subscription", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/b/subscription", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	all := func(*Repository) bool { return true }
+	repos, err := client.Activity.UnwatchRepositories(context.Background(), all)
+	if err == nil {
+		t.Error("Activity.UnwatchRepositories returned no error")
+	}
+	if len(repos) != 1 || repos[0].GetName() != "a" {
+		t.Errorf("Activity.UnwatchRepositories returned %v, want the repository unwatched before the error", repos)
+	}
+
+	if _, err := client.Activity.UnwatchRepositories(context.Background(), nil); err == nil {
+		t.Error("Activity.UnwatchRepositories returned no error without a filter")
+	}
+}
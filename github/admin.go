@@ -99,3 +99,50 @@ func (s *AdminService) UpdateTeamLDAPMapping(ctx context.Context, team int64, ma
 
 	return m, resp, nil
 }
+
+// LDAPSyncStatus represents the status of a queued LDAP sync job.
+type LDAPSyncStatus struct {
+	Status *string `json:"status,omitempty"`
+}
+
+func (s LDAPSyncStatus) String() string {
+	return Stringify(s)
+}
+
+// SyncUserLDAPMapping queues a job to sync a GitHub user with its LDAP entry.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/ldap/#sync-ldap-mapping-for-a-user
+func (s *AdminService) SyncUserLDAPMapping(ctx context.Context, user string) (*LDAPSyncStatus, *Response, error) {
+	u := fmt.Sprintf("admin/ldap/users/%v/sync", user)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(LDAPSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// SyncTeamLDAPMapping queues a job to sync a GitHub team with its LDAP group.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/ldap/#sync-ldap-mapping-for-a-team
+func (s *AdminService) SyncTeamLDAPMapping(ctx context.Context, team int64) (*LDAPSyncStatus, *Response, error) {
+	u := fmt.Sprintf("admin/ldap/teams/%v/sync", team)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(LDAPSyncStatus)
+	resp, err := s.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
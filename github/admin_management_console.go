@@ -0,0 +1,311 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ManagementConsoleClient manages a GitHub Enterprise Server appliance
+// through its Management Console API. The Management Console is served
+// apart from the REST API, on port 8443 of the appliance, and authenticates
+// with the Management Console password rather than with a user's
+// credentials, hence its own client.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/
+type ManagementConsoleClient struct {
+	client *Client
+}
+
+// NewManagementConsoleClient returns a client for the Management Console
+// served at baseURL, such as "https://github.example.com:8443/", which
+// authenticates with the Management Console password. If a nil httpClient is
+// provided, http.DefaultClient is used; its transport is wrapped to add
+// authentication.
+func NewManagementConsoleClient(baseURL, password string, httpClient *http.Client) (*ManagementConsoleClient, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	authClient := *httpClient
+	authClient.Transport = &BasicAuthTransport{
+		Username:  "api_key",
+		Password:  password,
+		Transport: httpClient.Transport,
+	}
+
+	c, err := NewEnterpriseClient(baseURL, baseURL, &authClient)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementConsoleClient{client: c}, nil
+}
+
+// newFormRequest creates a Management Console request, whose parameters are
+// form encoded.
+func (c *ManagementConsoleClient) newFormRequest(method, urlStr string, form url.Values) (*http.Request, error) {
+	req, err := c.client.NewRequest(method, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body := form.Encode()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// ConfigurationStatus represents the progress of the configuration process
+// of a GitHub Enterprise Server appliance.
+type ConfigurationStatus struct {
+	// Status is "running", "success" or "failed".
+	Status   *string                    `json:"status,omitempty"`
+	Progress []*ConfigurationStepStatus `json:"progress,omitempty"`
+}
+
+func (c ConfigurationStatus) String() string {
+	return Stringify(c)
+}
+
+// ConfigurationStepStatus represents the status of a step of the
+// configuration process.
+type ConfigurationStepStatus struct {
+	// Status is "PENDING", "CONFIGURING", "DONE" or "FAILED".
+	Status *string `json:"status,omitempty"`
+	Key    *string `json:"key,omitempty"`
+}
+
+func (c ConfigurationStepStatus) String() string {
+	return Stringify(c)
+}
+
+// ConfigurationStatus returns the status of the latest configuration
+// process.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#check-configuration-status
+func (c *ManagementConsoleClient) ConfigurationStatus(ctx context.Context) (*ConfigurationStatus, *Response, error) {
+	req, err := c.client.NewRequest("GET", "setup/api/configcheck", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(ConfigurationStatus)
+	resp, err := c.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// StartConfiguration starts a configuration process, which applies the
+// settings of the appliance and restarts its services. It returns as soon as
+// the process is started; follow its progress with ConfigurationStatus.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#start-a-configuration-process
+func (c *ManagementConsoleClient) StartConfiguration(ctx context.Context) (*Response, error) {
+	req, err := c.client.NewRequest("POST", "setup/api/configure", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(ctx, req, nil)
+	// GitHub responds 202 Accepted once the process is started.
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+	return resp, err
+}
+
+// ManagementConsoleSettings represents the settings of a GitHub Enterprise
+// Server appliance.
+type ManagementConsoleSettings struct {
+	// Enterprise holds the settings as JSON, so that they can be edited and
+	// set again without loss, whatever the version of the appliance.
+	Enterprise json.RawMessage `json:"enterprise,omitempty"`
+	RunList    []string        `json:"run_list,omitempty"`
+}
+
+// GetSettings returns the settings of the appliance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#retrieve-settings
+func (c *ManagementConsoleClient) GetSettings(ctx context.Context) (*ManagementConsoleSettings, *Response, error) {
+	req, err := c.client.NewRequest("GET", "setup/api/settings", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(ManagementConsoleSettings)
+	resp, err := c.client.Do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// UpdateSettings updates the settings of the appliance. Settings left out of
+// settings.Enterprise are unchanged. The new settings only take effect once
+// applied by StartConfiguration.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#modify-settings
+func (c *ManagementConsoleClient) UpdateSettings(ctx context.Context, settings *ManagementConsoleSettings) (*Response, error) {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newFormRequest("PUT", "setup/api/settings", url.Values{"settings": {string(b)}})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(ctx, req, nil)
+}
+
+// MaintenanceStatus represents the maintenance mode of a GitHub Enterprise
+// Server appliance.
+type MaintenanceStatus struct {
+	// Status is "on", "off" or "scheduled".
+	Status             *string              `json:"status,omitempty"`
+	ScheduledTime      *string              `json:"scheduled_time,omitempty"`
+	ConnectionServices []*ConnectionService `json:"connection_services,omitempty"`
+}
+
+func (m MaintenanceStatus) String() string {
+	return Stringify(m)
+}
+
+// ConnectionService represents the number of active connections of a
+// service of the appliance, which maintenance mode waits for.
+type ConnectionService struct {
+	Name   *string `json:"name,omitempty"`
+	Number *int    `json:"number,omitempty"`
+}
+
+func (c ConnectionService) String() string {
+	return Stringify(c)
+}
+
+// MaintenanceOptions specifies the maintenance mode to set.
+type MaintenanceOptions struct {
+	Enabled bool `json:"enabled"`
+
+	// When is when to enable or disable maintenance mode: "now", the default,
+	// or a time such as "2019-01-22 15:00" or "in 2 hours".
+	When string `json:"when,omitempty"`
+}
+
+// GetMaintenanceStatus returns the maintenance mode of the appliance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#check-maintenance-status
+func (c *ManagementConsoleClient) GetMaintenanceStatus(ctx context.Context) (*MaintenanceStatus, *Response, error) {
+	req, err := c.client.NewRequest("GET", "setup/api/maintenance", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(MaintenanceStatus)
+	resp, err := c.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// SetMaintenanceStatus enables or disables maintenance mode, now or at a
+// later time.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#enable-or-disable-maintenance-mode
+func (c *ManagementConsoleClient) SetMaintenanceStatus(ctx context.Context, opt *MaintenanceOptions) (*MaintenanceStatus, *Response, error) {
+	b, err := json.Marshal(opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.newFormRequest("POST", "setup/api/maintenance", url.Values{"maintenance": {string(b)}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(MaintenanceStatus)
+	resp, err := c.client.Do(ctx, req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
+
+// SSHKey represents an SSH key authorized to access the administrative shell
+// of the appliance.
+type SSHKey struct {
+	Key         *string `json:"key,omitempty"`
+	PrettyPrint *string `json:"pretty-print,omitempty"`
+}
+
+func (k SSHKey) String() string {
+	return Stringify(k)
+}
+
+// ListSSHKeys lists the SSH keys authorized to access the administrative
+// shell of the appliance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#retrieve-authorized-ssh-keys
+func (c *ManagementConsoleClient) ListSSHKeys(ctx context.Context) ([]*SSHKey, *Response, error) {
+	req, err := c.client.NewRequest("GET", "setup/api/settings/authorized-keys", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*SSHKey
+	resp, err := c.client.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+// AddSSHKey authorizes the public key, such as "ssh-rsa AAAAB3Nza...", to
+// access the administrative shell, and returns the authorized keys.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#add-a-new-authorized-ssh-key
+func (c *ManagementConsoleClient) AddSSHKey(ctx context.Context, key string) ([]*SSHKey, *Response, error) {
+	return c.editSSHKeys(ctx, "POST", key)
+}
+
+// RemoveSSHKey revokes the public key, and returns the authorized keys.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/management_console/#remove-an-authorized-ssh-key
+func (c *ManagementConsoleClient) RemoveSSHKey(ctx context.Context, key string) ([]*SSHKey, *Response, error) {
+	return c.editSSHKeys(ctx, "DELETE", key)
+}
+
+func (c *ManagementConsoleClient) editSSHKeys(ctx context.Context, method, key string) ([]*SSHKey, *Response, error) {
+	req, err := c.newFormRequest(method, "setup/api/settings/authorized-keys", url.Values{"authorized_key": {key}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*SSHKey
+	resp, err := c.client.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func setupManagementConsole(t *testing.T) (*ManagementConsoleClient, *http.ServeMux, func()) {
+	_, mux, serverURL, teardown := setup()
+	c, err := NewManagementConsoleClient(serverURL+baseURLPath, "secret", nil)
+	if err != nil {
+		t.Fatalf("NewManagementConsoleClient returned error: %v", err)
+	}
+	return c, mux, teardown
+}
+
+func testManagementConsoleAuth(t *testing.T, r *http.Request) {
+	if user, password, ok := r.BasicAuth(); !ok || user != "api_key" || password != "secret" {
+		t.Errorf("Request authenticated as %q:%q, want api_key:secret", user, password)
+	}
+}
+
+func TestManagementConsoleClient_ConfigurationStatus(t *testing.T) {
+	c, mux, teardown := setupManagementConsole(t)
+	defer teardown()
+
+	mux.HandleFunc("/setup/api/configcheck", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testManagementConsoleAuth(t, r)
+		fmt.Fprint(w, `{"status":"running","progress":[{"status":"DONE","key":"Appliance core components"}]}`)
+	})
+
+	status, _, err := c.ConfigurationStatus(context.Background())
+	if err != nil {
+		t.Errorf("ConfigurationStatus returned error: %v", err)
+	}
+
+	want := &ConfigurationStatus{
+		Status:   String("running"),
+		Progress: []*ConfigurationStepStatus{{Status: String("DONE"), Key: String("Appliance core components")}},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("ConfigurationStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestManagementConsoleClient_StartConfiguration(t *testing.T) {
+	c, mux, teardown := setupManagementConsole(t)
+	defer teardown()
+
+	mux.HandleFunc("/setup/api/configure", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testManagementConsoleAuth(t, r)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := c.StartConfiguration(context.Background()); err != nil {
+		t.Errorf("StartConfiguration returned error: %v", err)
+	}
+}
+
+func TestManagementConsoleClient_Settings(t *testing.T) {
+	c, mux, teardown := setupManagementConsole(t)
+	defer teardown()
+
+	mux.HandleFunc("/setup/api/settings", func(w http.ResponseWriter, r *http.Request) {
+		testManagementConsoleAuth(t, r)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"enterprise":{"private_mode":false},"run_list":["recipe[enterprise-configure]"]}`)
+		case "PUT":
+			testHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+			testFormValues(t, r, values{"settings": `{"enterprise":{"private_mode":true}}`})
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %v", r.Method)
+		}
+	})
+
+	settings, _, err := c.GetSettings(context.Background())
+	if err != nil {
+		t.Fatalf("GetSettings returned error: %v", err)
+	}
+	want := &ManagementConsoleSettings{
+		Enterprise: json.RawMessage(`{"private_mode":false}`),
+		RunList:    []string{"recipe[enterprise-configure]"},
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("GetSettings returned %+v, want %+v", settings, want)
+	}
+
+	update := &ManagementConsoleSettings{Enterprise: json.RawMessage(`{"private_mode":true}`)}
+	if _, err := c.UpdateSettings(context.Background(), update); err != nil {
+		t.Errorf("UpdateSettings returned error: %v", err)
+	}
+}
+
+func TestManagementConsoleClient_Maintenance(t *testing.T) {
+	c, mux, teardown := setupManagementConsole(t)
+	defer teardown()
+
+	mux.HandleFunc("/setup/api/maintenance", func(w http.ResponseWriter, r *http.Request) {
+		testManagementConsoleAuth(t, r)
+		if r.Method == "POST" {
+			testFormValues(t, r, values{"maintenance": `{"enabled":true,"when":"now"}`})
+		}
+		fmt.Fprint(w, `{"status":"on","connection_services":[{"name":"git operations","number":2}]}`)
+	})
+
+	want := &MaintenanceStatus{
+		Status:             String("on"),
+		ConnectionServices: []*ConnectionService{{Name: String("git operations"), Number: Int(2)}},
+	}
+
+	status, _, err := c.GetMaintenanceStatus(context.Background())
+	if err != nil {
+		t.Errorf("GetMaintenanceStatus returned error: %v", err)
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("GetMaintenanceStatus returned %+v, want %+v", status, want)
+	}
+
+	status, _, err = c.SetMaintenanceStatus(context.Background(), &MaintenanceOptions{Enabled: true, When: "now"})
+	if err != nil {
+		t.Errorf("SetMaintenanceStatus returned error: %v", err)
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("SetMaintenanceStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestManagementConsoleClient_SSHKeys(t *testing.T) {
+	c, mux, teardown := setupManagementConsole(t)
+	defer teardown()
+
+	mux.HandleFunc("/setup/api/settings/authorized-keys", func(w http.ResponseWriter, r *http.Request) {
+		testManagementConsoleAuth(t, r)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `[{"key":"ssh-rsa AAAA","pretty-print":"ssh-rsa 01:14"}]`)
+		case "POST":
+			testFormValues(t, r, values{"authorized_key": "ssh-rsa BBBB"})
+			fmt.Fprint(w, `[{"key":"ssh-rsa AAAA","pretty-print":"ssh-rsa 01:14"},{"key":"ssh-rsa BBBB"}]`)
+		case "DELETE":
+			// ParseForm only reads the body of POST, PUT and PATCH requests.
+			testBody(t, r, "authorized_key=ssh-rsa+AAAA")
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	keys, _, err := c.ListSSHKeys(context.Background())
+	if err != nil {
+		t.Errorf("ListSSHKeys returned error: %v", err)
+	}
+	if want := []*SSHKey{{Key: String("ssh-rsa AAAA"), PrettyPrint: String("ssh-rsa 01:14")}}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("ListSSHKeys returned %+v, want %+v", keys, want)
+	}
+
+	keys, _, err = c.AddSSHKey(context.Background(), "ssh-rsa BBBB")
+	if err != nil {
+		t.Errorf("AddSSHKey returned error: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Errorf("AddSSHKey returned %v keys, want 2", len(keys))
+	}
+
+	keys, _, err = c.RemoveSSHKey(context.Background(), "ssh-rsa AAAA")
+	if err != nil {
+		t.Errorf("RemoveSSHKey returned error: %v", err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("RemoveSSHKey returned %v keys, want 0", len(keys))
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// createOrgRequest is a subset of Organization and is used internally
+// by CreateOrg to pass only the known fields for the endpoint.
+type createOrgRequest struct {
+	Login       *string `json:"login,omitempty"`
+	Admin       *string `json:"admin,omitempty"`
+	ProfileName *string `json:"profile_name,omitempty"`
+}
+
+// CreateOrg creates a new organization in GitHub Enterprise.
+//
+// Note that only org.Login and org.Name are used to create the organization;
+// admin is the login of the user who will manage it.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/orgs/#create-an-organization
+func (s *AdminService) CreateOrg(ctx context.Context, org *Organization, admin string) (*Organization, *Response, error) {
+	u := "admin/organizations"
+
+	orgReq := &createOrgRequest{
+		Login:       org.Login,
+		Admin:       &admin,
+		ProfileName: org.Name,
+	}
+
+	req, err := s.client.NewRequest("POST", u, orgReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o := new(Organization)
+	resp, err := s.client.Do(ctx, req, o)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return o, resp, nil
+}
+
+// renameOrgRequest is used internally by RenameOrg.
+type renameOrgRequest struct {
+	Login string `json:"login"`
+}
+
+// RenameOrg renames an organization in GitHub Enterprise.
+//
+// Like RenameUser, the rename is queued and GitHub responds with 202 Accepted,
+// which is returned as an *AcceptedError along with the RenameResponse.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/orgs/#rename-an-organization
+func (s *AdminService) RenameOrg(ctx context.Context, org, newName string) (*RenameResponse, *Response, error) {
+	u := fmt.Sprintf("admin/organizations/%v", org)
+
+	req, err := s.client.NewRequest("PATCH", u, &renameOrgRequest{Login: newName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rename := new(RenameResponse)
+	resp, err := s.client.Do(ctx, req, rename)
+	if err != nil {
+		// Persist AcceptedError's metadata to the RenameResponse object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, rename); err != nil {
+				return rename, resp, err
+			}
+
+			return rename, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return rename, resp, nil
+}
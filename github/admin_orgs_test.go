@@ -0,0 +1,62 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdminService_CreateOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"login":"github","admin":"ghAdmin","profile_name":"GitHub"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"login":"github","id":1}`)
+	})
+
+	input := &Organization{Login: String("github"), Name: String("GitHub")}
+	org, _, err := client.Admin.CreateOrg(context.Background(), input, "ghAdmin")
+	if err != nil {
+		t.Errorf("Admin.CreateOrg returned error: %v", err)
+	}
+
+	want := &Organization{ID: Int64(1), Login: String("github")}
+	if !reflect.DeepEqual(org, want) {
+		t.Errorf("Admin.CreateOrg returned %+v, want %+v", org, want)
+	}
+}
+
+func TestAdminService_RenameOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/organizations/o", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"login":"the-new-octocats"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"Job queued to rename organization.","url":"https://api.github.com/organizations/1"}`)
+	})
+
+	rename, _, err := client.Admin.RenameOrg(context.Background(), "o", "the-new-octocats")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Admin.RenameOrg returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &RenameResponse{
+		Message: String("Job queued to rename organization."),
+		URL:     String("https://api.github.com/organizations/1"),
+	}
+	if !reflect.DeepEqual(rename, want) {
+		t.Errorf("Admin.RenameOrg returned %+v, want %+v", rename, want)
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// PreReceiveEnvironment represents a pre-receive environment in which
+// pre-receive hooks are run on a GitHub Enterprise instance.
+type PreReceiveEnvironment struct {
+	ID                 *int64                         `json:"id,omitempty"`
+	Name               *string                        `json:"name,omitempty"`
+	ImageURL           *string                        `json:"image_url,omitempty"`
+	URL                *string                        `json:"url,omitempty"`
+	HTMLURL            *string                        `json:"html_url,omitempty"`
+	DefaultEnvironment *bool                          `json:"default_environment,omitempty"`
+	CreatedAt          *Timestamp                     `json:"created_at,omitempty"`
+	HooksCount         *int                           `json:"hooks_count,omitempty"`
+	Download           *PreReceiveEnvironmentDownload `json:"download,omitempty"`
+}
+
+func (p PreReceiveEnvironment) String() string {
+	return Stringify(p)
+}
+
+// PreReceiveEnvironmentDownload represents the state of the most recent
+// download of a pre-receive environment's image.
+type PreReceiveEnvironmentDownload struct {
+	URL          *string    `json:"url,omitempty"`
+	State        *string    `json:"state,omitempty"`
+	DownloadedAt *Timestamp `json:"downloaded_at,omitempty"`
+	Message      *string    `json:"message,omitempty"`
+}
+
+func (p PreReceiveEnvironmentDownload) String() string {
+	return Stringify(p)
+}
+
+// GlobalPreReceiveHook represents a pre-receive hook configured for a
+// whole GitHub Enterprise instance.
+type GlobalPreReceiveHook struct {
+	ID                           *int64                 `json:"id,omitempty"`
+	Name                         *string                `json:"name,omitempty"`
+	Enforcement                  *string                `json:"enforcement,omitempty"`
+	Script                       *string                `json:"script,omitempty"`
+	ScriptRepository             *Repository            `json:"script_repository,omitempty"`
+	Environment                  *PreReceiveEnvironment `json:"environment,omitempty"`
+	AllowDownstreamConfiguration *bool                  `json:"allow_downstream_configuration,omitempty"`
+}
+
+func (p GlobalPreReceiveHook) String() string {
+	return Stringify(p)
+}
+
+// ListPreReceiveEnvironments lists all pre-receive environments.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#list-pre-receive-environments
+func (s *AdminService) ListPreReceiveEnvironments(ctx context.Context, opt *ListOptions) ([]*PreReceiveEnvironment, *Response, error) {
+	u, err := addOptions("admin/pre-receive-environments", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	var environments []*PreReceiveEnvironment
+	resp, err := s.client.Do(ctx, req, &environments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return environments, resp, nil
+}
+
+// GetPreReceiveEnvironment returns a single pre-receive environment.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#get-a-single-pre-receive-environment
+func (s *AdminService) GetPreReceiveEnvironment(ctx context.Context, id int64) (*PreReceiveEnvironment, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-environments/%d", id)
+	return s.preReceiveEnvironment(ctx, "GET", u, nil)
+}
+
+// CreatePreReceiveEnvironment creates a pre-receive environment. Only
+// environment.Name and environment.ImageURL are used.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#create-a-pre-receive-environment
+func (s *AdminService) CreatePreReceiveEnvironment(ctx context.Context, environment *PreReceiveEnvironment) (*PreReceiveEnvironment, *Response, error) {
+	return s.preReceiveEnvironment(ctx, "POST", "admin/pre-receive-environments", environment)
+}
+
+// EditPreReceiveEnvironment updates a pre-receive environment. The default
+// environment cannot be modified.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#edit-a-pre-receive-environment
+func (s *AdminService) EditPreReceiveEnvironment(ctx context.Context, id int64, environment *PreReceiveEnvironment) (*PreReceiveEnvironment, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-environments/%d", id)
+	return s.preReceiveEnvironment(ctx, "PATCH", u, environment)
+}
+
+// DeletePreReceiveEnvironment deletes a pre-receive environment. An
+// environment cannot be deleted while hooks are using it.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#delete-a-pre-receive-environment
+func (s *AdminService) DeletePreReceiveEnvironment(ctx context.Context, id int64) (*Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-environments/%d", id)
+	return s.deletePreReceive(ctx, u)
+}
+
+func (s *AdminService) preReceiveEnvironment(ctx context.Context, method, u string, body *PreReceiveEnvironment) (*PreReceiveEnvironment, *Response, error) {
+	var reqBody interface{}
+	if body != nil {
+		reqBody = body
+	}
+	req, err := s.client.NewRequest(method, u, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	environment := new(PreReceiveEnvironment)
+	resp, err := s.client.Do(ctx, req, environment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return environment, resp, nil
+}
+
+// StartPreReceiveEnvironmentDownload triggers a new download of the
+// environment's tarball from its ImageURL.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#trigger-a-pre-receive-environment-download
+func (s *AdminService) StartPreReceiveEnvironmentDownload(ctx context.Context, id int64) (*PreReceiveEnvironmentDownload, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-environments/%d/downloads", id)
+	return s.preReceiveEnvironmentDownload(ctx, "POST", u)
+}
+
+// GetPreReceiveEnvironmentDownloadStatus returns the status of the latest
+// download of a pre-receive environment.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_environments/#get-a-pre-receive-environments-download-status
+func (s *AdminService) GetPreReceiveEnvironmentDownloadStatus(ctx context.Context, id int64) (*PreReceiveEnvironmentDownload, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-environments/%d/downloads/latest", id)
+	return s.preReceiveEnvironmentDownload(ctx, "GET", u)
+}
+
+func (s *AdminService) preReceiveEnvironmentDownload(ctx context.Context, method, u string) (*PreReceiveEnvironmentDownload, *Response, error) {
+	req, err := s.client.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	download := new(PreReceiveEnvironmentDownload)
+	resp, err := s.client.Do(ctx, req, download)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return download, resp, nil
+}
+
+// ListPreReceiveHooks lists all pre-receive hooks of the instance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_hooks/#list-pre-receive-hooks
+func (s *AdminService) ListPreReceiveHooks(ctx context.Context, opt *ListOptions) ([]*GlobalPreReceiveHook, *Response, error) {
+	u, err := addOptions("admin/pre-receive-hooks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	var hooks []*GlobalPreReceiveHook
+	resp, err := s.client.Do(ctx, req, &hooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hooks, resp, nil
+}
+
+// GetPreReceiveHook returns a single pre-receive hook of the instance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_hooks/#get-a-single-pre-receive-hook
+func (s *AdminService) GetPreReceiveHook(ctx context.Context, id int64) (*GlobalPreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	return s.preReceiveHook(ctx, "GET", u, nil)
+}
+
+// CreatePreReceiveHook creates a pre-receive hook. Name, Script,
+// ScriptRepository and Environment are required.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_hooks/#create-a-pre-receive-hook
+func (s *AdminService) CreatePreReceiveHook(ctx context.Context, hook *GlobalPreReceiveHook) (*GlobalPreReceiveHook, *Response, error) {
+	return s.preReceiveHook(ctx, "POST", "admin/pre-receive-hooks", hook)
+}
+
+// EditPreReceiveHook updates a pre-receive hook of the instance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_hooks/#edit-a-pre-receive-hook
+func (s *AdminService) EditPreReceiveHook(ctx context.Context, id int64, hook *GlobalPreReceiveHook) (*GlobalPreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	return s.preReceiveHook(ctx, "PATCH", u, hook)
+}
+
+// DeletePreReceiveHook deletes a pre-receive hook of the instance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/pre_receive_hooks/#delete-a-pre-receive-hook
+func (s *AdminService) DeletePreReceiveHook(ctx context.Context, id int64) (*Response, error) {
+	u := fmt.Sprintf("admin/pre-receive-hooks/%d", id)
+	return s.deletePreReceive(ctx, u)
+}
+
+func (s *AdminService) preReceiveHook(ctx context.Context, method, u string, body *GlobalPreReceiveHook) (*GlobalPreReceiveHook, *Response, error) {
+	var reqBody interface{}
+	if body != nil {
+		reqBody = body
+	}
+	req, err := s.client.NewRequest(method, u, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	hook := new(GlobalPreReceiveHook)
+	resp, err := s.client.Do(ctx, req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hook, resp, nil
+}
+
+func (s *AdminService) deletePreReceive(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,229 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdminService_ListPreReceiveEnvironments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"name":"Default","default_environment":true,"download":{"state":"success"}},{"id":2}]`)
+	})
+
+	environments, _, err := client.Admin.ListPreReceiveEnvironments(context.Background(), &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Admin.ListPreReceiveEnvironments returned error: %v", err)
+	}
+
+	want := []*PreReceiveEnvironment{
+		{
+			ID:                 Int64(1),
+			Name:               String("Default"),
+			DefaultEnvironment: Bool(true),
+			Download:           &PreReceiveEnvironmentDownload{State: String("success")},
+		},
+		{ID: Int64(2)},
+	}
+	if !reflect.DeepEqual(environments, want) {
+		t.Errorf("Admin.ListPreReceiveEnvironments returned %+v, want %+v", environments, want)
+	}
+}
+
+func TestAdminService_PreReceiveEnvironmentCRUD(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testBody(t, r, `{"name":"DevTools Hook Env","image_url":"https://example.com/image.tar.gz"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":2,"name":"DevTools Hook Env"}`)
+	})
+	mux.HandleFunc("/admin/pre-receive-environments/2", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":2,"name":"DevTools Hook Env"}`)
+		case "PATCH":
+			testBody(t, r, `{"name":"Renamed"}`+"\n")
+			fmt.Fprint(w, `{"id":2,"name":"Renamed"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	input := &PreReceiveEnvironment{Name: String("DevTools Hook Env"), ImageURL: String("https://example.com/image.tar.gz")}
+	env, _, err := client.Admin.CreatePreReceiveEnvironment(ctx, input)
+	if err != nil {
+		t.Errorf("Admin.CreatePreReceiveEnvironment returned error: %v", err)
+	}
+	want := &PreReceiveEnvironment{ID: Int64(2), Name: String("DevTools Hook Env")}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Admin.CreatePreReceiveEnvironment returned %+v, want %+v", env, want)
+	}
+
+	env, _, err = client.Admin.GetPreReceiveEnvironment(ctx, 2)
+	if err != nil {
+		t.Errorf("Admin.GetPreReceiveEnvironment returned error: %v", err)
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Admin.GetPreReceiveEnvironment returned %+v, want %+v", env, want)
+	}
+
+	env, _, err = client.Admin.EditPreReceiveEnvironment(ctx, 2, &PreReceiveEnvironment{Name: String("Renamed")})
+	if err != nil {
+		t.Errorf("Admin.EditPreReceiveEnvironment returned error: %v", err)
+	}
+	want = &PreReceiveEnvironment{ID: Int64(2), Name: String("Renamed")}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("Admin.EditPreReceiveEnvironment returned %+v, want %+v", env, want)
+	}
+
+	if _, err := client.Admin.DeletePreReceiveEnvironment(ctx, 2); err != nil {
+		t.Errorf("Admin.DeletePreReceiveEnvironment returned error: %v", err)
+	}
+}
+
+func TestAdminService_PreReceiveEnvironmentDownloads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-environments/2/downloads", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"state":"not_started"}`)
+	})
+	mux.HandleFunc("/admin/pre-receive-environments/2/downloads/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		fmt.Fprint(w, `{"state":"success"}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Admin.StartPreReceiveEnvironmentDownload(ctx, 2); err != nil {
+		if _, ok := err.(*AcceptedError); !ok {
+			t.Errorf("Admin.StartPreReceiveEnvironmentDownload returned error: %v", err)
+		}
+	}
+
+	download, _, err := client.Admin.GetPreReceiveEnvironmentDownloadStatus(ctx, 2)
+	if err != nil {
+		t.Errorf("Admin.GetPreReceiveEnvironmentDownloadStatus returned error: %v", err)
+	}
+	want := &PreReceiveEnvironmentDownload{State: String("success")}
+	if !reflect.DeepEqual(download, want) {
+		t.Errorf("Admin.GetPreReceiveEnvironmentDownloadStatus returned %+v, want %+v", download, want)
+	}
+}
+
+func TestAdminService_ListPreReceiveHooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		fmt.Fprint(w, `[{"id":1,"name":"Check Commits","enforcement":"disabled","script":"scripts/commit_check.sh","script_repository":{"id":595,"full_name":"DevIT/hooks"},"environment":{"id":2},"allow_downstream_configuration":false}]`)
+	})
+
+	hooks, _, err := client.Admin.ListPreReceiveHooks(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Admin.ListPreReceiveHooks returned error: %v", err)
+	}
+
+	want := []*GlobalPreReceiveHook{{
+		ID:                           Int64(1),
+		Name:                         String("Check Commits"),
+		Enforcement:                  String("disabled"),
+		Script:                       String("scripts/commit_check.sh"),
+		ScriptRepository:             &Repository{ID: Int64(595), FullName: String("DevIT/hooks")},
+		Environment:                  &PreReceiveEnvironment{ID: Int64(2)},
+		AllowDownstreamConfiguration: Bool(false),
+	}}
+	if !reflect.DeepEqual(hooks, want) {
+		t.Errorf("Admin.ListPreReceiveHooks returned %+v, want %+v", hooks, want)
+	}
+}
+
+func TestAdminService_PreReceiveHookCRUD(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testBody(t, r, `{"name":"Check Commits","script":"scripts/commit_check.sh","script_repository":{"full_name":"DevIT/hooks"},"environment":{"id":2}}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"Check Commits"}`)
+	})
+	mux.HandleFunc("/admin/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"name":"Check Commits"}`)
+		case "PATCH":
+			testBody(t, r, `{"enforcement":"enabled"}`+"\n")
+			fmt.Fprint(w, `{"id":1,"name":"Check Commits","enforcement":"enabled"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	input := &GlobalPreReceiveHook{
+		Name:             String("Check Commits"),
+		Script:           String("scripts/commit_check.sh"),
+		ScriptRepository: &Repository{FullName: String("DevIT/hooks")},
+		Environment:      &PreReceiveEnvironment{ID: Int64(2)},
+	}
+	hook, _, err := client.Admin.CreatePreReceiveHook(ctx, input)
+	if err != nil {
+		t.Errorf("Admin.CreatePreReceiveHook returned error: %v", err)
+	}
+	want := &GlobalPreReceiveHook{ID: Int64(1), Name: String("Check Commits")}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Admin.CreatePreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	hook, _, err = client.Admin.GetPreReceiveHook(ctx, 1)
+	if err != nil {
+		t.Errorf("Admin.GetPreReceiveHook returned error: %v", err)
+	}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Admin.GetPreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	hook, _, err = client.Admin.EditPreReceiveHook(ctx, 1, &GlobalPreReceiveHook{Enforcement: String("enabled")})
+	if err != nil {
+		t.Errorf("Admin.EditPreReceiveHook returned error: %v", err)
+	}
+	want = &GlobalPreReceiveHook{ID: Int64(1), Name: String("Check Commits"), Enforcement: String("enabled")}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Admin.EditPreReceiveHook returned %+v, want %+v", hook, want)
+	}
+
+	if _, err := client.Admin.DeletePreReceiveHook(ctx, 1); err != nil {
+		t.Errorf("Admin.DeletePreReceiveHook returned error: %v", err)
+	}
+}
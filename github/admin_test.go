@@ -79,3 +79,45 @@ func TestAdminService_UpdateTeamLDAPMapping(t *testing.T) {
 		t.Errorf("Admin.UpdateTeamLDAPMapping returned %+v, want %+v", mapping, want)
 	}
 }
+
+func TestAdminService_SyncUserLDAPMapping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ldap/users/u/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+
+	status, _, err := client.Admin.SyncUserLDAPMapping(context.Background(), "u")
+	if err != nil {
+		t.Errorf("Admin.SyncUserLDAPMapping returned error: %v", err)
+	}
+
+	want := &LDAPSyncStatus{Status: String("queued")}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Admin.SyncUserLDAPMapping returned %+v, want %+v", status, want)
+	}
+}
+
+func TestAdminService_SyncTeamLDAPMapping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/ldap/teams/1/sync", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"status":"queued"}`)
+	})
+
+	status, _, err := client.Admin.SyncTeamLDAPMapping(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Admin.SyncTeamLDAPMapping returned error: %v", err)
+	}
+
+	want := &LDAPSyncStatus{Status: String("queued")}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("Admin.SyncTeamLDAPMapping returned %+v, want %+v", status, want)
+	}
+}
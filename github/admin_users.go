@@ -0,0 +1,110 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// createUserRequest is a subset of User and is used internally
+// by CreateUser to pass only the known fields for the endpoint.
+type createUserRequest struct {
+	Login *string `json:"login,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+// CreateUser creates a new user in GitHub Enterprise. If email is empty, an
+// email address is not set for the user, which is only valid when the
+// instance uses built-in authentication.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#create-a-new-user
+func (s *AdminService) CreateUser(ctx context.Context, login, email string) (*User, *Response, error) {
+	u := "admin/users"
+
+	userReq := &createUserRequest{Login: &login}
+	if email != "" {
+		userReq.Email = &email
+	}
+
+	req, err := s.client.NewRequest("POST", u, userReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// DeleteUser deletes a user in GitHub Enterprise, including all of their
+// repositories, gists, applications and personal settings. Suspending a
+// user with UsersService.Suspend is often a better choice.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#delete-a-user
+func (s *AdminService) DeleteUser(ctx context.Context, username string) (*Response, error) {
+	u := fmt.Sprintf("admin/users/%v", username)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RenameResponse represents the response of a queued rename job for a user
+// or an organization.
+type RenameResponse struct {
+	Message *string `json:"message,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+func (r RenameResponse) String() string {
+	return Stringify(r)
+}
+
+// renameUserRequest is used internally by RenameUser.
+type renameUserRequest struct {
+	Login string `json:"login"`
+}
+
+// RenameUser renames a user in GitHub Enterprise.
+//
+// The rename is performed by a background job, so GitHub responds with
+// 202 Accepted. This method returns an *AcceptedError in that case, along
+// with a RenameResponse describing the queued job.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/enterprise-admin/users/#rename-an-existing-user
+func (s *AdminService) RenameUser(ctx context.Context, username, newLogin string) (*RenameResponse, *Response, error) {
+	u := fmt.Sprintf("admin/users/%v", username)
+
+	req, err := s.client.NewRequest("PATCH", u, &renameUserRequest{Login: newLogin})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rename := new(RenameResponse)
+	resp, err := s.client.Do(ctx, req, rename)
+	if err != nil {
+		// Persist AcceptedError's metadata to the RenameResponse object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, rename); err != nil {
+				return rename, resp, err
+			}
+
+			return rename, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return rename, resp, nil
+}
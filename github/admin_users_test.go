@@ -0,0 +1,90 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAdminService_CreateUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"login":"github","email":"email@domain.com"}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"login":"github","id":1}`)
+	})
+
+	user, _, err := client.Admin.CreateUser(context.Background(), "github", "email@domain.com")
+	if err != nil {
+		t.Errorf("Admin.CreateUser returned error: %v", err)
+	}
+
+	want := &User{ID: Int64(1), Login: String("github")}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("Admin.CreateUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestAdminService_CreateUser_noEmail(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"login":"github"}`+"\n")
+		fmt.Fprint(w, `{"login":"github"}`)
+	})
+
+	if _, _, err := client.Admin.CreateUser(context.Background(), "github", ""); err != nil {
+		t.Errorf("Admin.CreateUser returned error: %v", err)
+	}
+}
+
+func TestAdminService_DeleteUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/users/github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Admin.DeleteUser(context.Background(), "github"); err != nil {
+		t.Errorf("Admin.DeleteUser returned error: %v", err)
+	}
+}
+
+func TestAdminService_RenameUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/admin/users/github", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"login":"octocat"}`+"\n")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"Job queued to rename user.","url":"https://api.github.com/user/1"}`)
+	})
+
+	rename, _, err := client.Admin.RenameUser(context.Background(), "github", "octocat")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Admin.RenameUser returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &RenameResponse{
+		Message: String("Job queued to rename user."),
+		URL:     String("https://api.github.com/user/1"),
+	}
+	if !reflect.DeepEqual(rename, want) {
+		t.Errorf("Admin.RenameUser returned %+v, want %+v", rename, want)
+	}
+}
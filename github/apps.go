@@ -36,12 +36,34 @@ type InstallationToken struct {
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
-// InstallationPermissions lists the permissions for metadata, contents, issues and single file for an installation.
+// InstallationPermissions lists the permissions granted to an installation.
+// Each permission is one of "read" or "write", or nil if not granted.
 type InstallationPermissions struct {
-	Metadata   *string `json:"metadata,omitempty"`
-	Contents   *string `json:"contents,omitempty"`
-	Issues     *string `json:"issues,omitempty"`
-	SingleFile *string `json:"single_file,omitempty"`
+	Administration                *string `json:"administration,omitempty"`
+	Checks                        *string `json:"checks,omitempty"`
+	Contents                      *string `json:"contents,omitempty"`
+	Deployments                   *string `json:"deployments,omitempty"`
+	Issues                        *string `json:"issues,omitempty"`
+	Members                       *string `json:"members,omitempty"`
+	Metadata                      *string `json:"metadata,omitempty"`
+	OrganizationAdministration    *string `json:"organization_administration,omitempty"`
+	OrganizationHooks             *string `json:"organization_hooks,omitempty"`
+	OrganizationPlan              *string `json:"organization_plan,omitempty"`
+	OrganizationProjects          *string `json:"organization_projects,omitempty"`
+	OrganizationUserBlocking      *string `json:"organization_user_blocking,omitempty"`
+	Pages                         *string `json:"pages,omitempty"`
+	PullRequests                  *string `json:"pull_requests,omitempty"`
+	RepositoryHooks               *string `json:"repository_hooks,omitempty"`
+	RepositoryProjects            *string `json:"repository_projects,omitempty"`
+	SingleFile                    *string `json:"single_file,omitempty"`
+	Statuses                      *string `json:"statuses,omitempty"`
+	TeamDiscussions               *string `json:"team_discussions,omitempty"`
+	VulnerabilityAlerts           *string `json:"vulnerability_alerts,omitempty"`
+	Workflows                     *string `json:"workflows,omitempty"`
+	OrganizationSecrets           *string `json:"organization_secrets,omitempty"`
+	Secrets                       *string `json:"secrets,omitempty"`
+	SecurityEvents                *string `json:"security_events,omitempty"`
+	OrganizationSelfHostedRunners *string `json:"organization_self_hosted_runners,omitempty"`
 }
 
 // Installation represents a GitHub Apps installation.
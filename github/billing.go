@@ -0,0 +1,219 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// BillingService handles communication with the billing related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing
+type BillingService service
+
+// ActionsBilling represents a GitHub Actions billing summary.
+type ActionsBilling struct {
+	TotalMinutesUsed     float64 `json:"total_minutes_used"`
+	TotalPaidMinutesUsed float64 `json:"total_paid_minutes_used"`
+	IncludedMinutes      float64 `json:"included_minutes"`
+	// MinutesUsedBreakdown maps a runner type, such as "UBUNTU" or
+	// "MACOS", to the minutes used on it.
+	MinutesUsedBreakdown map[string]int `json:"minutes_used_breakdown"`
+}
+
+// PackagesBilling represents a GitHub Packages billing summary.
+type PackagesBilling struct {
+	TotalGigabytesBandwidthUsed     int     `json:"total_gigabytes_bandwidth_used"`
+	TotalPaidGigabytesBandwidthUsed int     `json:"total_paid_gigabytes_bandwidth_used"`
+	IncludedGigabytesBandwidth      float64 `json:"included_gigabytes_bandwidth"`
+}
+
+// StorageBilling represents a shared storage billing summary, covering both
+// GitHub Actions artifacts and GitHub Packages.
+type StorageBilling struct {
+	DaysLeftInBillingCycle       int     `json:"days_left_in_billing_cycle"`
+	EstimatedPaidStorageForMonth float64 `json:"estimated_paid_storage_for_month"`
+	EstimatedStorageForMonth     float64 `json:"estimated_storage_for_month"`
+}
+
+// UsageReportOptions specifies the optional parameters to the
+// BillingService.GetOrgUsageReport and BillingService.GetUserUsageReport
+// methods. If no option is set, the report covers the current year.
+type UsageReportOptions struct {
+	// Year of the usage report. Default is the current year.
+	Year *int `url:"year,omitempty"`
+
+	// Month of the usage report, from 1 to 12. Default is the current month
+	// if Year is not set either.
+	Month *int `url:"month,omitempty"`
+
+	// Day of the usage report, from 1 to 31.
+	Day *int `url:"day,omitempty"`
+
+	// Hour of the usage report, from 0 to 23.
+	Hour *int `url:"hour,omitempty"`
+}
+
+// UsageItem represents a single line of an enhanced billing usage report.
+type UsageItem struct {
+	Date             *string  `json:"date,omitempty"`
+	Product          *string  `json:"product,omitempty"`
+	SKU              *string  `json:"sku,omitempty"`
+	Quantity         *float64 `json:"quantity,omitempty"`
+	UnitType         *string  `json:"unitType,omitempty"`
+	PricePerUnit     *float64 `json:"pricePerUnit,omitempty"`
+	GrossAmount      *float64 `json:"grossAmount,omitempty"`
+	DiscountAmount   *float64 `json:"discountAmount,omitempty"`
+	NetAmount        *float64 `json:"netAmount,omitempty"`
+	OrganizationName *string  `json:"organizationName,omitempty"`
+	RepositoryName   *string  `json:"repositoryName,omitempty"`
+}
+
+// UsageReport represents an enhanced billing usage report.
+type UsageReport struct {
+	UsageItems []*UsageItem `json:"usageItems,omitempty"`
+}
+
+func (s *BillingService) get(ctx context.Context, u string, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, v)
+}
+
+// GetOrgActionsBillingUsage returns the summary of the free and paid GitHub
+// Actions minutes used by an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-github-actions-billing-for-an-organization
+func (s *BillingService) GetOrgActionsBillingUsage(ctx context.Context, org string) (*ActionsBilling, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/billing/actions", org)
+	billing := new(ActionsBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetUserActionsBillingUsage returns the summary of the free and paid GitHub
+// Actions minutes used by a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-github-actions-billing-for-a-user
+func (s *BillingService) GetUserActionsBillingUsage(ctx context.Context, user string) (*ActionsBilling, *Response, error) {
+	u := fmt.Sprintf("users/%v/settings/billing/actions", user)
+	billing := new(ActionsBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetOrgPackagesBillingUsage returns the free and paid storage used for
+// GitHub Packages by an organization, in gigabytes.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-github-packages-billing-for-an-organization
+func (s *BillingService) GetOrgPackagesBillingUsage(ctx context.Context, org string) (*PackagesBilling, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/billing/packages", org)
+	billing := new(PackagesBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetUserPackagesBillingUsage returns the free and paid storage used for
+// GitHub Packages by a user, in gigabytes.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-github-packages-billing-for-a-user
+func (s *BillingService) GetUserPackagesBillingUsage(ctx context.Context, user string) (*PackagesBilling, *Response, error) {
+	u := fmt.Sprintf("users/%v/settings/billing/packages", user)
+	billing := new(PackagesBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetOrgStorageBillingUsage returns the estimated paid and estimated total
+// storage used for GitHub Actions and GitHub Packages by an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-shared-storage-billing-for-an-organization
+func (s *BillingService) GetOrgStorageBillingUsage(ctx context.Context, org string) (*StorageBilling, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/billing/shared-storage", org)
+	billing := new(StorageBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetUserStorageBillingUsage returns the estimated paid and estimated total
+// storage used for GitHub Actions and GitHub Packages by a user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/billing#get-shared-storage-billing-for-a-user
+func (s *BillingService) GetUserStorageBillingUsage(ctx context.Context, user string) (*StorageBilling, *Response, error) {
+	u := fmt.Sprintf("users/%v/settings/billing/shared-storage", user)
+	billing := new(StorageBilling)
+	resp, err := s.get(ctx, u, billing)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return billing, resp, nil
+}
+
+// GetOrgUsageReport returns the enhanced billing usage report of an
+// organization, itemized by product, SKU and repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/enhanced-billing#get-billing-usage-report-for-an-organization
+func (s *BillingService) GetOrgUsageReport(ctx context.Context, org string, opt *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("organizations/%v/settings/billing/usage", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(UsageReport)
+	resp, err := s.get(ctx, u, report)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
+
+// GetUserUsageReport returns the enhanced billing usage report of a user,
+// itemized by product, SKU and repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/billing/enhanced-billing#get-billing-usage-report-for-a-user
+func (s *BillingService) GetUserUsageReport(ctx context.Context, user string, opt *UsageReportOptions) (*UsageReport, *Response, error) {
+	u := fmt.Sprintf("users/%v/settings/billing/usage", user)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(UsageReport)
+	resp, err := s.get(ctx, u, report)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
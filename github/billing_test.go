@@ -0,0 +1,208 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestBillingService_GetOrgActionsBillingUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/billing/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"total_minutes_used": 305.0,
+			"total_paid_minutes_used": 0.0,
+			"included_minutes": 3000.0,
+			"minutes_used_breakdown": {"UBUNTU": 205, "MACOS": 10, "WINDOWS": 90}
+		}`)
+	})
+
+	billing, _, err := client.Billing.GetOrgActionsBillingUsage(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Billing.GetOrgActionsBillingUsage returned error: %v", err)
+	}
+
+	want := &ActionsBilling{
+		TotalMinutesUsed:     305,
+		TotalPaidMinutesUsed: 0,
+		IncludedMinutes:      3000,
+		MinutesUsedBreakdown: map[string]int{"UBUNTU": 205, "MACOS": 10, "WINDOWS": 90},
+	}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetOrgActionsBillingUsage returned %+v, want %+v", billing, want)
+	}
+}
+
+func TestBillingService_GetUserActionsBillingUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/settings/billing/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_minutes_used":10,"included_minutes":2000}`)
+	})
+
+	billing, _, err := client.Billing.GetUserActionsBillingUsage(context.Background(), "u")
+	if err != nil {
+		t.Errorf("Billing.GetUserActionsBillingUsage returned error: %v", err)
+	}
+
+	want := &ActionsBilling{TotalMinutesUsed: 10, IncludedMinutes: 2000}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetUserActionsBillingUsage returned %+v, want %+v", billing, want)
+	}
+}
+
+func TestBillingService_GetPackagesBillingUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"total_gigabytes_bandwidth_used":50,"total_paid_gigabytes_bandwidth_used":40,"included_gigabytes_bandwidth":10}`
+	mux.HandleFunc("/orgs/o/settings/billing/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+	mux.HandleFunc("/users/u/settings/billing/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	want := &PackagesBilling{
+		TotalGigabytesBandwidthUsed:     50,
+		TotalPaidGigabytesBandwidthUsed: 40,
+		IncludedGigabytesBandwidth:      10,
+	}
+
+	billing, _, err := client.Billing.GetOrgPackagesBillingUsage(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Billing.GetOrgPackagesBillingUsage returned error: %v", err)
+	}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetOrgPackagesBillingUsage returned %+v, want %+v", billing, want)
+	}
+
+	billing, _, err = client.Billing.GetUserPackagesBillingUsage(context.Background(), "u")
+	if err != nil {
+		t.Errorf("Billing.GetUserPackagesBillingUsage returned error: %v", err)
+	}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetUserPackagesBillingUsage returned %+v, want %+v", billing, want)
+	}
+}
+
+func TestBillingService_GetStorageBillingUsage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"days_left_in_billing_cycle":20,"estimated_paid_storage_for_month":15.25,"estimated_storage_for_month":40}`
+	mux.HandleFunc("/orgs/o/settings/billing/shared-storage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+	mux.HandleFunc("/users/u/settings/billing/shared-storage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	want := &StorageBilling{
+		DaysLeftInBillingCycle:       20,
+		EstimatedPaidStorageForMonth: 15.25,
+		EstimatedStorageForMonth:     40,
+	}
+
+	billing, _, err := client.Billing.GetOrgStorageBillingUsage(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Billing.GetOrgStorageBillingUsage returned error: %v", err)
+	}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetOrgStorageBillingUsage returned %+v, want %+v", billing, want)
+	}
+
+	billing, _, err = client.Billing.GetUserStorageBillingUsage(context.Background(), "u")
+	if err != nil {
+		t.Errorf("Billing.GetUserStorageBillingUsage returned error: %v", err)
+	}
+	if !reflect.DeepEqual(billing, want) {
+		t.Errorf("Billing.GetUserStorageBillingUsage returned %+v, want %+v", billing, want)
+	}
+}
+
+func TestBillingService_GetOrgUsageReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/organizations/o/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"year": "2023", "month": "8"})
+		fmt.Fprint(w, `{"usageItems":[{
+			"date": "2023-08-01",
+			"product": "Actions",
+			"sku": "Actions Linux",
+			"quantity": 100,
+			"unitType": "minutes",
+			"pricePerUnit": 0.008,
+			"grossAmount": 0.8,
+			"discountAmount": 0,
+			"netAmount": 0.8,
+			"organizationName": "o",
+			"repositoryName": "r"
+		}]}`)
+	})
+
+	opt := &UsageReportOptions{Year: Int(2023), Month: Int(8)}
+	report, _, err := client.Billing.GetOrgUsageReport(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Billing.GetOrgUsageReport returned error: %v", err)
+	}
+
+	quantity, price, gross, discount, net := 100.0, 0.008, 0.8, 0.0, 0.8
+	want := &UsageReport{
+		UsageItems: []*UsageItem{{
+			Date:             String("2023-08-01"),
+			Product:          String("Actions"),
+			SKU:              String("Actions Linux"),
+			Quantity:         &quantity,
+			UnitType:         String("minutes"),
+			PricePerUnit:     &price,
+			GrossAmount:      &gross,
+			DiscountAmount:   &discount,
+			NetAmount:        &net,
+			OrganizationName: String("o"),
+			RepositoryName:   String("r"),
+		}},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("Billing.GetOrgUsageReport returned %+v, want %+v", report, want)
+	}
+}
+
+func TestBillingService_GetUserUsageReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/settings/billing/usage", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"day": "1"})
+		fmt.Fprint(w, `{"usageItems":[]}`)
+	})
+
+	report, _, err := client.Billing.GetUserUsageReport(context.Background(), "u", &UsageReportOptions{Day: Int(1)})
+	if err != nil {
+		t.Errorf("Billing.GetUserUsageReport returned error: %v", err)
+	}
+
+	want := &UsageReport{UsageItems: []*UsageItem{}}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("Billing.GetUserUsageReport returned %+v, want %+v", report, want)
+	}
+}
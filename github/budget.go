@@ -0,0 +1,189 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// rateLimitWindow is the period after which the core rate limit resets.
+const rateLimitWindow = time.Hour
+
+// Budget shares the core rate limit of a client between tasks, such as the
+// jobs of a scheduler that all use the same token. Tasks reserve the
+// requests they need before starting, and report the requests they make
+// against their reservation, so that a task is not started unless the quota
+// left covers it.
+//
+// The quota left is the last rate limit the client observed, less the
+// requests reserved but not yet made. A Budget is safe for concurrent use.
+type Budget struct {
+	client *Client
+
+	// OnExceeded, if set, is called when a task asks for more requests than
+	// are available: when a reservation is refused, and when a task makes
+	// more requests than it reserved.
+	OnExceeded func(task string, requested, available int)
+
+	mu          sync.Mutex
+	outstanding int // Requests reserved but not yet made.
+}
+
+// NewBudget returns a Budget sharing the core rate limit of client.
+func NewBudget(client *Client) *Budget {
+	return &Budget{client: client}
+}
+
+// BudgetExceededError occurs when a reservation asks for more requests than
+// a Budget has available.
+type BudgetExceededError struct {
+	Task      string
+	Requested int
+	Available int
+
+	// Completion is when the requests could all be made, as projected by
+	// Budget.Completion.
+	Completion time.Time
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("github: task %v needs %v requests, but only %v are available; they could be made by %v", e.Task, e.Requested, e.Available, e.Completion)
+}
+
+// Reservation is a number of requests reserved for a task by Budget.Reserve.
+type Reservation struct {
+	budget *Budget
+	task   string
+
+	mu   sync.Mutex
+	left int
+}
+
+// rate returns the core rate limit last observed by the client, assuming it
+// was reset if its reset time has passed. It reports false if the client has
+// not observed it yet.
+func (b *Budget) rate() (Rate, bool) {
+	b.client.rateMu.Lock()
+	rate := b.client.rateLimits[coreCategory]
+	b.client.rateMu.Unlock()
+	if rate.Limit == 0 {
+		return rate, false
+	}
+	if now := time.Now(); !now.Before(rate.Reset.Time) {
+		rate.Remaining = rate.Limit
+		rate.Reset = Timestamp{rate.Reset.Add(rateLimitWindow * (now.Sub(rate.Reset.Time)/rateLimitWindow + 1))}
+	}
+	return rate, true
+}
+
+// Available returns the number of requests left to reserve.
+func (b *Budget) Available() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	rate, _ := b.rate()
+	return rate.Remaining - b.outstanding
+}
+
+// Completion returns when n more requests could all be made, on top of
+// those reserved: now if they are available, or else when enough rate limit
+// windows will have reset.
+func (b *Budget) Completion(n int) time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.completion(n)
+}
+
+// completion is Completion with b.mu held.
+func (b *Budget) completion(n int) time.Time {
+	rate, ok := b.rate()
+	missing := n - (rate.Remaining - b.outstanding)
+	if missing <= 0 {
+		return time.Now()
+	}
+	if !ok {
+		return time.Time{}
+	}
+	windows := (missing + rate.Limit - 1) / rate.Limit
+	return rate.Reset.Add(rateLimitWindow * time.Duration(windows-1))
+}
+
+// Reserve reserves n requests for task. The rate limit is fetched first if
+// the client has not made a request yet. If fewer than n requests are
+// available, OnExceeded is called and the error is a *BudgetExceededError.
+func (b *Budget) Reserve(ctx context.Context, task string, n int) (*Reservation, error) {
+	b.mu.Lock()
+	_, ok := b.rate()
+	b.mu.Unlock()
+	if !ok {
+		if _, _, err := b.client.RateLimits(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	b.mu.Lock()
+	rate, _ := b.rate()
+	available := rate.Remaining - b.outstanding
+	if n > available {
+		err := &BudgetExceededError{Task: task, Requested: n, Available: available, Completion: b.completion(n)}
+		b.mu.Unlock()
+		if b.OnExceeded != nil {
+			b.OnExceeded(task, n, available)
+		}
+		return nil, err
+	}
+	b.outstanding += n
+	b.mu.Unlock()
+
+	return &Reservation{budget: b, task: task, left: n}, nil
+}
+
+// Use records that n requests of the reservation were made. If the task
+// makes more requests than it reserved, OnExceeded is called.
+func (r *Reservation) Use(n int) {
+	r.mu.Lock()
+	used := n
+	if used > r.left {
+		used = r.left
+	}
+	r.left -= used
+	r.mu.Unlock()
+
+	b := r.budget
+	b.mu.Lock()
+	b.outstanding -= used
+	var available int
+	if used < n {
+		rate, _ := b.rate()
+		available = rate.Remaining - b.outstanding
+	}
+	b.mu.Unlock()
+	if used < n && b.OnExceeded != nil {
+		b.OnExceeded(r.task, n-used, available)
+	}
+}
+
+// Left returns the number of requests of the reservation not yet made.
+func (r *Reservation) Left() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.left
+}
+
+// Release returns the requests of the reservation not yet made to the
+// budget, once the task is done.
+func (r *Reservation) Release() {
+	r.mu.Lock()
+	left := r.left
+	r.left = 0
+	r.mu.Unlock()
+
+	r.budget.mu.Lock()
+	r.budget.outstanding -= left
+	r.budget.mu.Unlock()
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBudget_Reserve(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	client.rateLimits[coreCategory] = Rate{Limit: 100, Remaining: 50, Reset: Timestamp{reset}}
+
+	var exceeded []string
+	budget := NewBudget(client)
+	budget.OnExceeded = func(task string, requested, available int) {
+		exceeded = append(exceeded, fmt.Sprintf("%v %v/%v", task, requested, available))
+	}
+
+	a, err := budget.Reserve(context.Background(), "a", 30)
+	if err != nil {
+		t.Fatalf("Reserve returned error: %v", err)
+	}
+	if got := budget.Available(); got != 20 {
+		t.Errorf("Available returned %v, want 20", got)
+	}
+
+	_, err = budget.Reserve(context.Background(), "b", 25)
+	exceededErr, ok := err.(*BudgetExceededError)
+	if !ok {
+		t.Fatalf("Reserve returned %v, want *BudgetExceededError", err)
+	}
+	if !exceededErr.Completion.Equal(reset) {
+		t.Errorf("BudgetExceededError.Completion = %v, want %v", exceededErr.Completion, reset)
+	}
+
+	a.Use(10)
+	client.rateLimits[coreCategory].Remaining = 40
+	if got := a.Left(); got != 20 {
+		t.Errorf("Left returned %v, want 20", got)
+	}
+	a.Use(25)
+	a.Release()
+	client.rateLimits[coreCategory].Remaining = 15
+	if got := budget.Available(); got != 15 {
+		t.Errorf("Available returned %v after Release, want 15", got)
+	}
+
+	if want := []string{"b 25/20", "a 5/40"}; fmt.Sprint(exceeded) != fmt.Sprint(want) {
+		t.Errorf("OnExceeded was called with %v, want %v", exceeded, want)
+	}
+}
+
+func TestBudget_Completion(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().Add(30 * time.Minute)
+	client.rateLimits[coreCategory] = Rate{Limit: 100, Remaining: 10, Reset: Timestamp{reset}}
+	budget := NewBudget(client)
+
+	tests := []struct {
+		n    int
+		want time.Time
+	}{
+		{110, reset},
+		{111, reset.Add(time.Hour)},
+		{310, reset.Add(2 * time.Hour)},
+	}
+	for _, tt := range tests {
+		if got := budget.Completion(tt.n); !got.Equal(tt.want) {
+			t.Errorf("Completion(%v) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if got := budget.Completion(10); time.Since(got) > time.Minute {
+		t.Errorf("Completion(10) = %v, want now", got)
+	}
+
+	// A rate limit whose reset time has passed is assumed to have reset.
+	client.rateLimits[coreCategory] = Rate{Limit: 100, Remaining: 0, Reset: Timestamp{reset.Add(-time.Hour)}}
+	if got := budget.Available(); got != 100 {
+		t.Errorf("Available returned %v after the reset, want 100", got)
+	}
+	if got := budget.Completion(101); !got.Equal(reset) {
+		t.Errorf("Completion(101) after the reset = %v, want %v", got, reset)
+	}
+}
+
+func TestBudget_Reserve_fetchesRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"resources":{"core":{"limit":60,"remaining":5,"reset":%v}}}`, time.Now().Add(time.Hour).Unix())
+	})
+
+	budget := NewBudget(client)
+	if _, err := budget.Reserve(context.Background(), "a", 5); err != nil {
+		t.Errorf("Reserve returned error: %v", err)
+	}
+	if _, err := budget.Reserve(context.Background(), "b", 1); err == nil {
+		t.Error("Reserve returned no error beyond the fetched rate limit")
+	}
+}
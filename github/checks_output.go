@@ -0,0 +1,167 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxCheckRunOutputLength is the maximum length GitHub accepts for the
+	// summary and the text of a check run output, and for the message and
+	// the raw details of an annotation.
+	maxCheckRunOutputLength = 65535
+
+	// maxCheckRunAnnotations is the maximum number of annotations GitHub
+	// accepts in a single request.
+	maxCheckRunAnnotations = 50
+
+	// checkRunOutputTruncated is appended to the fields truncated by
+	// CheckRunOutputBuilder.
+	checkRunOutputTruncated = "\n\n_Output truncated._"
+)
+
+// CheckRunOutputBuilder assembles a CheckRunOutput from Markdown paragraphs,
+// tables and annotations, keeping it within the limits GitHub enforces, which
+// otherwise fail the request with status 422.
+//
+// The zero value is not usable; use NewCheckRunOutputBuilder.
+type CheckRunOutputBuilder struct {
+	title       string
+	summary     strings.Builder
+	text        strings.Builder
+	annotations []*CheckRunAnnotation
+	images      []*CheckRunImage
+}
+
+// NewCheckRunOutputBuilder returns a builder for a check run output with the
+// given title.
+func NewCheckRunOutputBuilder(title string) *CheckRunOutputBuilder {
+	return &CheckRunOutputBuilder{title: title}
+}
+
+// Summary appends a paragraph to the summary of the output.
+func (b *CheckRunOutputBuilder) Summary(format string, a ...interface{}) *CheckRunOutputBuilder {
+	appendParagraph(&b.summary, fmt.Sprintf(format, a...))
+	return b
+}
+
+// Text appends a paragraph to the text of the output.
+func (b *CheckRunOutputBuilder) Text(format string, a ...interface{}) *CheckRunOutputBuilder {
+	appendParagraph(&b.text, fmt.Sprintf(format, a...))
+	return b
+}
+
+// Table appends a Markdown table to the text of the output. Pipes and
+// newlines in the cells are escaped.
+func (b *CheckRunOutputBuilder) Table(header []string, rows [][]string) *CheckRunOutputBuilder {
+	var t strings.Builder
+	writeTableRow(&t, header)
+	t.WriteString("\n|")
+	for range header {
+		t.WriteString(" --- |")
+	}
+	for _, row := range rows {
+		t.WriteString("\n")
+		writeTableRow(&t, row)
+	}
+	appendParagraph(&b.text, t.String())
+	return b
+}
+
+// Annotate adds annotations to the output.
+func (b *CheckRunOutputBuilder) Annotate(annotations ...*CheckRunAnnotation) *CheckRunOutputBuilder {
+	b.annotations = append(b.annotations, annotations...)
+	return b
+}
+
+// Image adds images to the output.
+func (b *CheckRunOutputBuilder) Image(images ...*CheckRunImage) *CheckRunOutputBuilder {
+	b.images = append(b.images, images...)
+	return b
+}
+
+// Build returns the output in as many parts as needed to send all the
+// annotations, as GitHub accepts at most 50 per request. Pass the first part
+// to ChecksService.CreateCheckRun or UpdateCheckRun, and each of the others
+// to UpdateCheckRun: GitHub appends their annotations to those of the check
+// run. Every part has the title and the summary, and only the first has the
+// text and the images.
+//
+// The summary, the text, and the message and raw details of the annotations
+// are truncated to 65535 characters. The annotations are copied.
+func (b *CheckRunOutputBuilder) Build() []*CheckRunOutput {
+	title := String(b.title)
+	summary := String(truncateCheckRunOutput(b.summary.String()))
+
+	first := &CheckRunOutput{
+		Title:   title,
+		Summary: summary,
+		Images:  b.images,
+	}
+	if b.text.Len() > 0 {
+		first.Text = String(truncateCheckRunOutput(b.text.String()))
+	}
+	outputs := []*CheckRunOutput{first}
+
+	for i := 0; i < len(b.annotations); i += maxCheckRunAnnotations {
+		out := first
+		if i > 0 {
+			out = &CheckRunOutput{Title: title, Summary: summary}
+			outputs = append(outputs, out)
+		}
+		end := i + maxCheckRunAnnotations
+		if end > len(b.annotations) {
+			end = len(b.annotations)
+		}
+		for _, a := range b.annotations[i:end] {
+			a := *a
+			if a.Message != nil {
+				a.Message = String(truncateCheckRunOutput(*a.Message))
+			}
+			if a.RawDetails != nil {
+				a.RawDetails = String(truncateCheckRunOutput(*a.RawDetails))
+			}
+			out.Annotations = append(out.Annotations, &a)
+		}
+	}
+	return outputs
+}
+
+// appendParagraph appends p to b, separated from the previous paragraph by
+// a blank line.
+func appendParagraph(b *strings.Builder, p string) {
+	if b.Len() > 0 {
+		b.WriteString("\n\n")
+	}
+	b.WriteString(p)
+}
+
+// writeTableRow writes the cells of a Markdown table row to b.
+func writeTableRow(b *strings.Builder, cells []string) {
+	b.WriteString("|")
+	for _, c := range cells {
+		c = strings.Replace(c, "|", `\|`, -1)
+		c = strings.Replace(c, "\n", "<br>", -1)
+		b.WriteString(" " + c + " |")
+	}
+}
+
+// truncateCheckRunOutput truncates s to maxCheckRunOutputLength bytes, which
+// is at most as many characters, without splitting a character, and marks
+// it as truncated.
+func truncateCheckRunOutput(s string) string {
+	if len(s) <= maxCheckRunOutputLength {
+		return s
+	}
+	n := maxCheckRunOutputLength - len(checkRunOutputTruncated)
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + checkRunOutputTruncated
+}
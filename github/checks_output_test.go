@@ -0,0 +1,104 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCheckRunOutputBuilder(t *testing.T) {
+	outputs := NewCheckRunOutputBuilder("t").
+		Summary("%v tests failed", 2).
+		Summary("See below.").
+		Text("Failures:").
+		Table([]string{"Test", "Error"}, [][]string{
+			{"TestA", "a | b"},
+			{"TestB", "line 1\nline 2"},
+		}).
+		Image(&CheckRunImage{Alt: String("a"), ImageURL: String("u")}).
+		Annotate(&CheckRunAnnotation{Path: String("a.go"), Message: String("m")}).
+		Build()
+
+	want := []*CheckRunOutput{{
+		Title:   String("t"),
+		Summary: String("2 tests failed\n\nSee below."),
+		Text: String("Failures:\n\n" +
+			"| Test | Error |\n" +
+			"| --- | --- |\n" +
+			"| TestA | a \\| b |\n" +
+			"| TestB | line 1<br>line 2 |"),
+		Annotations: []*CheckRunAnnotation{{Path: String("a.go"), Message: String("m")}},
+		Images:      []*CheckRunImage{{Alt: String("a"), ImageURL: String("u")}},
+	}}
+	if !reflect.DeepEqual(outputs, want) {
+		t.Errorf("Build returned %+v, want %+v", outputs, want)
+	}
+}
+
+func TestCheckRunOutputBuilder_annotationBatches(t *testing.T) {
+	b := NewCheckRunOutputBuilder("t").Summary("s").Text("x")
+	for i := 0; i < 120; i++ {
+		b.Annotate(&CheckRunAnnotation{StartLine: Int(i)})
+	}
+	outputs := b.Build()
+
+	if len(outputs) != 3 {
+		t.Fatalf("Build returned %v outputs, want 3", len(outputs))
+	}
+	line := 0
+	for i, out := range outputs {
+		if out.GetTitle() != "t" || out.GetSummary() != "s" {
+			t.Errorf("Build returned output %v with title %q and summary %q, want %q and %q", i, out.GetTitle(), out.GetSummary(), "t", "s")
+		}
+		if (i == 0) != (out.Text != nil) {
+			t.Errorf("Build returned output %v with text %v", i, out.Text)
+		}
+		for _, a := range out.Annotations {
+			if a.GetStartLine() != line {
+				t.Fatalf("Build returned annotation for line %v, want %v", a.GetStartLine(), line)
+			}
+			line++
+		}
+	}
+	if line != 120 {
+		t.Errorf("Build returned %v annotations, want 120", line)
+	}
+	if n := len(outputs[2].Annotations); n != 20 {
+		t.Errorf("Build returned %v annotations in the last output, want 20", n)
+	}
+}
+
+func TestCheckRunOutputBuilder_truncation(t *testing.T) {
+	long := strings.Repeat("é", maxCheckRunOutputLength)
+	annotation := &CheckRunAnnotation{Message: String(long), RawDetails: String("d")}
+	outputs := NewCheckRunOutputBuilder("t").Summary("%v", long).Text("%v", long).Annotate(annotation).Build()
+	out := outputs[0]
+
+	for name, s := range map[string]string{
+		"summary": out.GetSummary(),
+		"text":    out.GetText(),
+		"message": out.Annotations[0].GetMessage(),
+	} {
+		if len(s) > maxCheckRunOutputLength {
+			t.Errorf("Build returned %v of %v bytes, want at most %v", name, len(s), maxCheckRunOutputLength)
+		}
+		if !utf8.ValidString(s) {
+			t.Errorf("Build returned invalid UTF-8 %v", name)
+		}
+		if !strings.HasSuffix(s, checkRunOutputTruncated) {
+			t.Errorf("Build returned %v not marked as truncated", name)
+		}
+	}
+	if got := out.Annotations[0].GetRawDetails(); got != "d" {
+		t.Errorf("Build returned raw details %q, want %q", got, "d")
+	}
+	if annotation.GetMessage() != long {
+		t.Error("Build modified the annotation")
+	}
+}
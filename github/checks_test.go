@@ -364,7 +364,7 @@ func TestChecksService_ListCheckSuiteForRef(t *testing.T) {
 
 	opt := &ListCheckSuiteOptions{
 		CheckName:   String("testing"),
-		AppID:       Int(2),
+		AppID:       Int64(2),
 		ListOptions: ListOptions{Page: 1},
 	}
 	checkSuites, _, err := client.Checks.ListCheckSuitesForRef(context.Background(), "o", "r", "master", opt)
@@ -0,0 +1,223 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ClassroomService handles communication with the GitHub Classroom related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom
+type ClassroomService service
+
+// Classroom represents a GitHub Classroom classroom.
+type Classroom struct {
+	ID           *int64        `json:"id,omitempty"`
+	Name         *string       `json:"name,omitempty"`
+	Archived     *bool         `json:"archived,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	URL          *string       `json:"url,omitempty"`
+}
+
+func (c Classroom) String() string {
+	return Stringify(c)
+}
+
+// ClassroomAssignment represents an assignment of a classroom.
+type ClassroomAssignment struct {
+	ID                          *int64      `json:"id,omitempty"`
+	PublicRepo                  *bool       `json:"public_repo,omitempty"`
+	Title                       *string     `json:"title,omitempty"`
+	Type                        *string     `json:"type,omitempty"`
+	InviteLink                  *string     `json:"invite_link,omitempty"`
+	InvitationsEnabled          *bool       `json:"invitations_enabled,omitempty"`
+	Slug                        *string     `json:"slug,omitempty"`
+	StudentsAreRepoAdmins       *bool       `json:"students_are_repo_admins,omitempty"`
+	FeedbackPullRequestsEnabled *bool       `json:"feedback_pull_requests_enabled,omitempty"`
+	MaxTeams                    *int        `json:"max_teams,omitempty"`
+	MaxMembers                  *int        `json:"max_members,omitempty"`
+	Editor                      *string     `json:"editor,omitempty"`
+	Accepted                    *int        `json:"accepted,omitempty"`
+	Submitted                   *int        `json:"submitted,omitempty"`
+	Passing                     *int        `json:"passing,omitempty"`
+	Language                    *string     `json:"language,omitempty"`
+	Deadline                    *Timestamp  `json:"deadline,omitempty"`
+	StarterCodeRepository       *Repository `json:"starter_code_repository,omitempty"`
+	Classroom                   *Classroom  `json:"classroom,omitempty"`
+}
+
+func (a ClassroomAssignment) String() string {
+	return Stringify(a)
+}
+
+// ClassroomAcceptedAssignment represents an assignment accepted by a
+// student or a group of students.
+type ClassroomAcceptedAssignment struct {
+	ID          *int64               `json:"id,omitempty"`
+	Submitted   *bool                `json:"submitted,omitempty"`
+	Passing     *bool                `json:"passing,omitempty"`
+	CommitCount *int                 `json:"commit_count,omitempty"`
+	Grade       *string              `json:"grade,omitempty"`
+	Students    []*User              `json:"students,omitempty"`
+	Repository  *Repository          `json:"repository,omitempty"`
+	Assignment  *ClassroomAssignment `json:"assignment,omitempty"`
+}
+
+func (a ClassroomAcceptedAssignment) String() string {
+	return Stringify(a)
+}
+
+// ClassroomAssignmentGrade represents the grade of a student or a group of
+// students for an assignment.
+type ClassroomAssignmentGrade struct {
+	AssignmentName        *string `json:"assignment_name,omitempty"`
+	AssignmentURL         *string `json:"assignment_url,omitempty"`
+	StarterCodeURL        *string `json:"starter_code_url,omitempty"`
+	GithubUsername        *string `json:"github_username,omitempty"`
+	RosterIdentifier      *string `json:"roster_identifier,omitempty"`
+	StudentRepositoryName *string `json:"student_repository_name,omitempty"`
+	StudentRepositoryURL  *string `json:"student_repository_url,omitempty"`
+	SubmissionTimestamp   *string `json:"submission_timestamp,omitempty"`
+	PointsAwarded         *int    `json:"points_awarded,omitempty"`
+	PointsAvailable       *int    `json:"points_available,omitempty"`
+	GroupName             *string `json:"group_name,omitempty"`
+}
+
+func (g ClassroomAssignmentGrade) String() string {
+	return Stringify(g)
+}
+
+// ListClassrooms lists the classrooms the authenticated user is an
+// administrator of.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#list-classrooms
+func (s *ClassroomService) ListClassrooms(ctx context.Context, opt *ListOptions) ([]*Classroom, *Response, error) {
+	u, err := addOptions("classrooms", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var classrooms []*Classroom
+	resp, err := s.client.Do(ctx, req, &classrooms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return classrooms, resp, nil
+}
+
+// GetClassroom gets a classroom.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#get-a-classroom
+func (s *ClassroomService) GetClassroom(ctx context.Context, classroomID int64) (*Classroom, *Response, error) {
+	u := fmt.Sprintf("classrooms/%v", classroomID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	classroom := new(Classroom)
+	resp, err := s.client.Do(ctx, req, classroom)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return classroom, resp, nil
+}
+
+// ListClassroomAssignments lists the assignments of a classroom.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#list-assignments-for-a-classroom
+func (s *ClassroomService) ListClassroomAssignments(ctx context.Context, classroomID int64, opt *ListOptions) ([]*ClassroomAssignment, *Response, error) {
+	u := fmt.Sprintf("classrooms/%v/assignments", classroomID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assignments []*ClassroomAssignment
+	resp, err := s.client.Do(ctx, req, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return assignments, resp, nil
+}
+
+// GetAssignment gets an assignment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#get-an-assignment
+func (s *ClassroomService) GetAssignment(ctx context.Context, assignmentID int64) (*ClassroomAssignment, *Response, error) {
+	u := fmt.Sprintf("assignments/%v", assignmentID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assignment := new(ClassroomAssignment)
+	resp, err := s.client.Do(ctx, req, assignment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return assignment, resp, nil
+}
+
+// ListAcceptedAssignments lists the accepted assignments of an assignment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#list-accepted-assignments-for-an-assignment
+func (s *ClassroomService) ListAcceptedAssignments(ctx context.Context, assignmentID int64, opt *ListOptions) ([]*ClassroomAcceptedAssignment, *Response, error) {
+	u := fmt.Sprintf("assignments/%v/accepted_assignments", assignmentID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accepted []*ClassroomAcceptedAssignment
+	resp, err := s.client.Do(ctx, req, &accepted)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return accepted, resp, nil
+}
+
+// GetAssignmentGrades gets the grades of an assignment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/classroom/classroom#get-assignment-grades
+func (s *ClassroomService) GetAssignmentGrades(ctx context.Context, assignmentID int64) ([]*ClassroomAssignmentGrade, *Response, error) {
+	u := fmt.Sprintf("assignments/%v/grades", assignmentID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var grades []*ClassroomAssignmentGrade
+	resp, err := s.client.Do(ctx, req, &grades)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return grades, resp, nil
+}
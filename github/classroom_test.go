@@ -0,0 +1,146 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClassroomService_ListClassrooms(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/classrooms", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"name":"c","archived":false}]`)
+	})
+
+	classrooms, _, err := client.Classroom.ListClassrooms(context.Background(), &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Classroom.ListClassrooms returned error: %v", err)
+	}
+
+	want := []*Classroom{{ID: Int64(1), Name: String("c"), Archived: Bool(false)}}
+	if !reflect.DeepEqual(classrooms, want) {
+		t.Errorf("Classroom.ListClassrooms returned %+v, want %+v", classrooms, want)
+	}
+}
+
+func TestClassroomService_GetClassroom(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/classrooms/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"organization":{"login":"o"}}`)
+	})
+
+	classroom, _, err := client.Classroom.GetClassroom(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Classroom.GetClassroom returned error: %v", err)
+	}
+
+	want := &Classroom{ID: Int64(1), Organization: &Organization{Login: String("o")}}
+	if !reflect.DeepEqual(classroom, want) {
+		t.Errorf("Classroom.GetClassroom returned %+v, want %+v", classroom, want)
+	}
+}
+
+func TestClassroomService_ListClassroomAssignments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/classrooms/1/assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "10"})
+		fmt.Fprint(w, `[{"id":2,"title":"t","type":"individual"}]`)
+	})
+
+	assignments, _, err := client.Classroom.ListClassroomAssignments(context.Background(), 1, &ListOptions{PerPage: 10})
+	if err != nil {
+		t.Errorf("Classroom.ListClassroomAssignments returned error: %v", err)
+	}
+
+	want := []*ClassroomAssignment{{ID: Int64(2), Title: String("t"), Type: String("individual")}}
+	if !reflect.DeepEqual(assignments, want) {
+		t.Errorf("Classroom.ListClassroomAssignments returned %+v, want %+v", assignments, want)
+	}
+}
+
+func TestClassroomService_GetAssignment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/assignments/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"starter_code_repository":{"id":3},"classroom":{"id":1}}`)
+	})
+
+	assignment, _, err := client.Classroom.GetAssignment(context.Background(), 2)
+	if err != nil {
+		t.Errorf("Classroom.GetAssignment returned error: %v", err)
+	}
+
+	want := &ClassroomAssignment{
+		ID:                    Int64(2),
+		StarterCodeRepository: &Repository{ID: Int64(3)},
+		Classroom:             &Classroom{ID: Int64(1)},
+	}
+	if !reflect.DeepEqual(assignment, want) {
+		t.Errorf("Classroom.GetAssignment returned %+v, want %+v", assignment, want)
+	}
+}
+
+func TestClassroomService_ListAcceptedAssignments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/assignments/2/accepted_assignments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "3"})
+		fmt.Fprint(w, `[{"id":4,"passing":true,"commit_count":5,"students":[{"login":"s"}]}]`)
+	})
+
+	accepted, _, err := client.Classroom.ListAcceptedAssignments(context.Background(), 2, &ListOptions{Page: 3})
+	if err != nil {
+		t.Errorf("Classroom.ListAcceptedAssignments returned error: %v", err)
+	}
+
+	want := []*ClassroomAcceptedAssignment{{
+		ID:          Int64(4),
+		Passing:     Bool(true),
+		CommitCount: Int(5),
+		Students:    []*User{{Login: String("s")}},
+	}}
+	if !reflect.DeepEqual(accepted, want) {
+		t.Errorf("Classroom.ListAcceptedAssignments returned %+v, want %+v", accepted, want)
+	}
+}
+
+func TestClassroomService_GetAssignmentGrades(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/assignments/2/grades", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"github_username":"s","points_awarded":8,"points_available":10}]`)
+	})
+
+	grades, _, err := client.Classroom.GetAssignmentGrades(context.Background(), 2)
+	if err != nil {
+		t.Errorf("Classroom.GetAssignmentGrades returned error: %v", err)
+	}
+
+	want := []*ClassroomAssignmentGrade{{GithubUsername: String("s"), PointsAwarded: Int(8), PointsAvailable: Int(10)}}
+	if !reflect.DeepEqual(grades, want) {
+		t.Errorf("Classroom.GetAssignmentGrades returned %+v, want %+v", grades, want)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CodeScanningService handles communication with the code scanning related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning
+type CodeScanningService service
+
+// DefaultSetupConfiguration represents a code scanning default setup configuration.
+type DefaultSetupConfiguration struct {
+	// State is the code scanning default setup state.
+	// Possible values are: "configured" and "not-configured".
+	State *string `json:"state,omitempty"`
+	// Languages is the list of CodeQL languages that are analyzed.
+	Languages []string `json:"languages,omitempty"`
+	// QuerySuite is the CodeQL query suite to use.
+	// Possible values are: "default" and "extended".
+	QuerySuite *string `json:"query_suite,omitempty"`
+	// Schedule is the frequency of the periodic analysis.
+	// Possible values are: "weekly".
+	Schedule  *string    `json:"schedule,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (d DefaultSetupConfiguration) String() string {
+	return Stringify(d)
+}
+
+// UpdateDefaultSetupConfigurationOptions specifies the parameters to
+// CodeScanningService.UpdateDefaultSetupConfiguration.
+type UpdateDefaultSetupConfigurationOptions struct {
+	// State is the desired code scanning default setup state.
+	// Possible values are: "configured" and "not-configured". (Required.)
+	State string `json:"state"`
+	// Languages is the list of CodeQL languages to analyze. If omitted,
+	// all supported languages present in the repository are analyzed.
+	Languages []string `json:"languages,omitempty"`
+	// QuerySuite is the CodeQL query suite to use.
+	// Possible values are: "default" and "extended".
+	QuerySuite *string `json:"query_suite,omitempty"`
+}
+
+// UpdateDefaultSetupConfigurationResponse represents the response of an
+// UpdateDefaultSetupConfiguration call. RunID and RunURL identify the
+// workflow run that applies the new configuration.
+type UpdateDefaultSetupConfigurationResponse struct {
+	RunID  *int64  `json:"run_id,omitempty"`
+	RunURL *string `json:"run_url,omitempty"`
+}
+
+func (d UpdateDefaultSetupConfigurationResponse) String() string {
+	return Stringify(d)
+}
+
+// GetDefaultSetupConfiguration gets the code scanning default setup
+// configuration for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning/code-scanning#get-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*DefaultSetupConfiguration, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cfg := new(DefaultSetupConfiguration)
+	resp, err := s.client.Do(ctx, req, cfg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cfg, resp, nil
+}
+
+// UpdateDefaultSetupConfiguration updates the code scanning default setup
+// configuration for a repository.
+//
+// This method might return an *AcceptedError and a status code of
+// 202. This is because this is the status that GitHub returns to signify that
+// it has scheduled a workflow run to apply the configuration. In this event,
+// the UpdateDefaultSetupConfigurationResponse value will be returned, which
+// identifies that run.
+//
+// GitHub API docs: https://docs.github.com/en/rest/code-scanning/code-scanning#update-a-code-scanning-default-setup-configuration
+func (s *CodeScanningService) UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, opt *UpdateDefaultSetupConfigurationOptions) (*UpdateDefaultSetupConfigurationResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/code-scanning/default-setup", owner, repo)
+
+	req, err := s.client.NewRequest("PATCH", u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(UpdateDefaultSetupConfigurationResponse)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		// Persist AcceptedError's metadata to the response object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, a); err != nil {
+				return a, resp, err
+			}
+
+			return a, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCodeScanningService_GetDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"state": "configured",
+			"languages": ["javascript", "python"],
+			"query_suite": "default",
+			"updated_at": "2023-01-19T11:21:34Z",
+			"schedule": "weekly"
+		}`)
+	})
+
+	cfg, _, err := client.CodeScanning.GetDefaultSetupConfiguration(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned error: %v", err)
+	}
+
+	want := &DefaultSetupConfiguration{
+		State:      String("configured"),
+		Languages:  []string{"javascript", "python"},
+		QuerySuite: String("default"),
+		Schedule:   String("weekly"),
+		UpdatedAt:  &Timestamp{time.Date(2023, time.January, 19, 11, 21, 34, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("CodeScanning.GetDefaultSetupConfiguration returned %+v, want %+v", cfg, want)
+	}
+}
+
+func TestCodeScanningService_UpdateDefaultSetupConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &UpdateDefaultSetupConfigurationOptions{
+		State:      "configured",
+		Languages:  []string{"go"},
+		QuerySuite: String("extended"),
+	}
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UpdateDefaultSetupConfigurationOptions)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"run_id":5301214200,"run_url":"https://api.github.com/repos/o/r/actions/runs/5301214200"}`)
+	})
+
+	got, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned error: %v", err)
+	}
+
+	want := &UpdateDefaultSetupConfigurationResponse{
+		RunID:  Int64(5301214200),
+		RunURL: String("https://api.github.com/repos/o/r/actions/runs/5301214200"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned %+v, want %+v", got, want)
+	}
+}
+
+func TestCodeScanningService_UpdateDefaultSetupConfiguration_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"run_id":1,"run_url":"u"}`)
+	})
+
+	opt := &UpdateDefaultSetupConfigurationOptions{State: "configured"}
+	got, _, err := client.CodeScanning.UpdateDefaultSetupConfiguration(context.Background(), "o", "r", opt)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &UpdateDefaultSetupConfigurationResponse{RunID: Int64(1), RunURL: String("u")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CodeScanning.UpdateDefaultSetupConfiguration returned %+v, want %+v", got, want)
+	}
+}
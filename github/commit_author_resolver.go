@@ -0,0 +1,126 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// AuthorResolver maps the email addresses of git commit authors to GitHub
+// accounts, so that commits made with any of the addresses of a user are
+// attributed to them.
+//
+// Addresses are resolved first from the commits the resolver is given, which
+// GitHub links to the account of their author and committer, then by
+// searching for a commit authored with the address, and finally by searching
+// for a user with the address as public email. Results, including addresses
+// that cannot be resolved, are cached. An AuthorResolver is safe for
+// concurrent use.
+type AuthorResolver struct {
+	client *Client
+
+	mu    sync.Mutex
+	users map[string]*User // keyed by lowercase email; nil if unresolved
+}
+
+// NewAuthorResolver returns an AuthorResolver using client.
+func NewAuthorResolver(client *Client) *AuthorResolver {
+	return &AuthorResolver{client: client, users: make(map[string]*User)}
+}
+
+// Observe records the accounts GitHub linked to the author and committer
+// emails of commits, such as those returned by RepositoriesService.ListCommits.
+func (r *AuthorResolver) Observe(commits ...*RepositoryCommit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range commits {
+		r.observe(c.GetCommit().GetAuthor().GetEmail(), c.Author)
+		r.observe(c.GetCommit().GetCommitter().GetEmail(), c.Committer)
+	}
+}
+
+// observe records that email belongs to user. r.mu must be held.
+func (r *AuthorResolver) observe(email string, user *User) {
+	if email == "" || user.GetLogin() == "" {
+		return
+	}
+	r.users[strings.ToLower(email)] = user
+}
+
+// ResolveCommit returns the account of the author of commit: the account
+// GitHub linked to it if any, or else the account resolved from the author
+// email. It returns nil if the author has no account.
+func (r *AuthorResolver) ResolveCommit(ctx context.Context, commit *RepositoryCommit) (*User, error) {
+	if commit.Author.GetLogin() != "" {
+		r.Observe(commit)
+		return commit.Author, nil
+	}
+	return r.Resolve(ctx, commit.GetCommit().GetAuthor().GetEmail())
+}
+
+// Resolve returns the account of the author with the given email, or nil if
+// none is found.
+func (r *AuthorResolver) Resolve(ctx context.Context, email string) (*User, error) {
+	if email == "" {
+		return nil, nil
+	}
+	key := strings.ToLower(email)
+
+	r.mu.Lock()
+	user, ok := r.users[key]
+	r.mu.Unlock()
+	if ok {
+		return user, nil
+	}
+
+	user, err := r.search(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Keep what was observed during the search, which is authoritative.
+	if observed := r.users[key]; observed != nil {
+		return observed, nil
+	}
+	r.users[key] = user
+	return user, nil
+}
+
+// search looks for the account of email with the search API.
+func (r *AuthorResolver) search(ctx context.Context, email string) (*User, error) {
+	var commits *CommitsSearchResult
+	err := retryOnRateLimit(ctx, func() (err error) {
+		query := fmt.Sprintf("author-email:%v", email)
+		commits, _, err = r.client.Search.Commits(ctx, query, &SearchOptions{ListOptions: ListOptions{PerPage: 1}})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(commits.Commits) > 0 && commits.Commits[0].Author.GetLogin() != "" {
+		return commits.Commits[0].Author, nil
+	}
+
+	var users *UsersSearchResult
+	err = retryOnRateLimit(ctx, func() (err error) {
+		query := fmt.Sprintf("%v in:email", email)
+		users, _, err = r.client.Search.Users(ctx, query, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// An address shared by several accounts cannot be attributed.
+	if len(users.Users) == 1 {
+		return &users.Users[0], nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAuthorResolver_Observe(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected search %v", r.URL)
+	})
+
+	resolver := NewAuthorResolver(client)
+	resolver.Observe(&RepositoryCommit{
+		Commit: &Commit{
+			Author:    &CommitAuthor{Email: String("A@example.com")},
+			Committer: &CommitAuthor{Email: String("c@example.com")},
+		},
+		Author:    &User{Login: String("a")},
+		Committer: &User{Login: String("c")},
+	})
+
+	for email, login := range map[string]string{"a@example.com": "a", "C@example.com": "c"} {
+		user, err := resolver.Resolve(context.Background(), email)
+		if err != nil {
+			t.Fatalf("Resolve returned error: %v", err)
+		}
+		if want := (&User{Login: String(login)}); !reflect.DeepEqual(user, want) {
+			t.Errorf("Resolve(%q) returned %+v, want %+v", email, user, want)
+		}
+	}
+}
+
+func TestAuthorResolver_Resolve(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	commitSearches, userSearches := 0, 0
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		commitSearches++
+		switch r.FormValue("q") {
+		case "author-email:linked@example.com":
+			fmt.Fprint(w, `{"total_count":1,"items":[{"sha":"s","author":{"login":"linked"}}]}`)
+		default:
+			fmt.Fprint(w, `{"total_count":0,"items":[]}`)
+		}
+	})
+	mux.HandleFunc("/search/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		userSearches++
+		switch r.FormValue("q") {
+		case "public@example.com in:email":
+			fmt.Fprint(w, `{"total_count":1,"items":[{"login":"public"}]}`)
+		case "shared@example.com in:email":
+			fmt.Fprint(w, `{"total_count":2,"items":[{"login":"x"},{"login":"y"}]}`)
+		default:
+			fmt.Fprint(w, `{"total_count":0,"items":[]}`)
+		}
+	})
+
+	resolver := NewAuthorResolver(client)
+	tests := []struct {
+		email string
+		want  *User
+	}{
+		{"linked@example.com", &User{Login: String("linked")}},
+		{"public@example.com", &User{Login: String("public")}},
+		{"shared@example.com", nil},
+		{"unknown@example.com", nil},
+	}
+	for i := 0; i < 2; i++ {
+		for _, tt := range tests {
+			user, err := resolver.Resolve(context.Background(), tt.email)
+			if err != nil {
+				t.Fatalf("Resolve returned error: %v", err)
+			}
+			if !reflect.DeepEqual(user, tt.want) {
+				t.Errorf("Resolve(%q) returned %+v, want %+v", tt.email, user, tt.want)
+			}
+		}
+	}
+
+	// The second round is served from the cache.
+	if commitSearches != 4 || userSearches != 3 {
+		t.Errorf("Resolve made %v commit and %v user searches, want 4 and 3", commitSearches, userSearches)
+	}
+}
+
+func TestAuthorResolver_ResolveCommit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"q": "author-email:b@example.com", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":1,"items":[{"author":{"login":"b"}}]}`)
+	})
+
+	resolver := NewAuthorResolver(client)
+	linked := &RepositoryCommit{
+		Commit: &Commit{Author: &CommitAuthor{Email: String("a@example.com")}},
+		Author: &User{Login: String("a")},
+	}
+	unlinked := &RepositoryCommit{
+		Commit: &Commit{Author: &CommitAuthor{Email: String("b@example.com")}},
+	}
+
+	for _, tt := range []struct {
+		commit *RepositoryCommit
+		want   string
+	}{{linked, "a"}, {unlinked, "b"}} {
+		user, err := resolver.ResolveCommit(context.Background(), tt.commit)
+		if err != nil {
+			t.Fatalf("ResolveCommit returned error: %v", err)
+		}
+		if got := user.GetLogin(); got != tt.want {
+			t.Errorf("ResolveCommit returned %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// Dependency represents the vulnerable dependency of a Dependabot alert.
+type Dependency struct {
+	Package      *VulnerabilityPackage `json:"package,omitempty"`
+	ManifestPath *string               `json:"manifest_path,omitempty"`
+	// Scope is the execution scope of the dependency.
+	// Possible values are: "development" and "runtime".
+	Scope *string `json:"scope,omitempty"`
+}
+
+// VulnerabilityPackage represents the package object of a security
+// vulnerability.
+type VulnerabilityPackage struct {
+	Ecosystem *string `json:"ecosystem,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// FirstPatchedVersion identifies the first version of a package that is not
+// affected by a vulnerability.
+type FirstPatchedVersion struct {
+	Identifier *string `json:"identifier,omitempty"`
+}
+
+// AdvisoryVulnerability represents a vulnerability of a package described by
+// a security advisory.
+type AdvisoryVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	Severity               *string               `json:"severity,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *FirstPatchedVersion  `json:"first_patched_version,omitempty"`
+
+	// PatchedVersions and VulnerableFunctions are only populated for
+	// repository security advisories.
+	PatchedVersions     *string  `json:"patched_versions,omitempty"`
+	VulnerableFunctions []string `json:"vulnerable_functions,omitempty"`
+}
+
+// AdvisoryCVSS represents the Common Vulnerability Scoring System details of
+// a security advisory.
+type AdvisoryCVSS struct {
+	Score        *float64 `json:"score,omitempty"`
+	VectorString *string  `json:"vector_string,omitempty"`
+}
+
+// AdvisoryCWE represents a Common Weakness Enumeration entry of a security
+// advisory.
+type AdvisoryCWE struct {
+	CWEID *string `json:"cwe_id,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// AdvisoryIdentifier represents an identifier of a security advisory.
+type AdvisoryIdentifier struct {
+	// Type is the type of the identifier.
+	// Possible values are: "GHSA" and "CVE".
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// AdvisoryReference represents a reference URL of a security advisory.
+type AdvisoryReference struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// DependabotSecurityAdvisory represents the GitHub security advisory that
+// triggered a Dependabot alert.
+type DependabotSecurityAdvisory struct {
+	GHSAID          *string                  `json:"ghsa_id,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	Severity        *string                  `json:"severity,omitempty"`
+	CVSS            *AdvisoryCVSS            `json:"cvss,omitempty"`
+	CWEs            []*AdvisoryCWE           `json:"cwes,omitempty"`
+	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
+	References      []*AdvisoryReference     `json:"references,omitempty"`
+	PublishedAt     *Timestamp               `json:"published_at,omitempty"`
+	UpdatedAt       *Timestamp               `json:"updated_at,omitempty"`
+	WithdrawnAt     *Timestamp               `json:"withdrawn_at,omitempty"`
+}
+
+// DependabotAlert represents a Dependabot alert.
+type DependabotAlert struct {
+	Number *int `json:"number,omitempty"`
+	// State is the state of the alert.
+	// Possible values are: "auto_dismissed", "dismissed", "fixed" and "open".
+	State                 *string                     `json:"state,omitempty"`
+	Dependency            *Dependency                 `json:"dependency,omitempty"`
+	SecurityAdvisory      *DependabotSecurityAdvisory `json:"security_advisory,omitempty"`
+	SecurityVulnerability *AdvisoryVulnerability      `json:"security_vulnerability,omitempty"`
+	URL                   *string                     `json:"url,omitempty"`
+	HTMLURL               *string                     `json:"html_url,omitempty"`
+	CreatedAt             *Timestamp                  `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp                  `json:"updated_at,omitempty"`
+	DismissedAt           *Timestamp                  `json:"dismissed_at,omitempty"`
+	DismissedBy           *User                       `json:"dismissed_by,omitempty"`
+	DismissedReason       *string                     `json:"dismissed_reason,omitempty"`
+	DismissedComment      *string                     `json:"dismissed_comment,omitempty"`
+	FixedAt               *Timestamp                  `json:"fixed_at,omitempty"`
+	AutoDismissedAt       *Timestamp                  `json:"auto_dismissed_at,omitempty"`
+	// Repository is only populated by ListOrgAlerts.
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+func (d DependabotAlert) String() string {
+	return Stringify(d)
+}
+
+// DependabotAlertListOptions specifies the optional parameters to the
+// DependabotService.ListRepoAlerts and DependabotService.ListOrgAlerts methods.
+type DependabotAlertListOptions struct {
+	// State filters alerts by a comma-separated list of states.
+	// Possible values are: "auto_dismissed", "dismissed", "fixed" and "open".
+	State string `url:"state,omitempty"`
+
+	// Severity filters alerts by a comma-separated list of severities.
+	// Possible values are: "low", "medium", "high" and "critical".
+	Severity string `url:"severity,omitempty"`
+
+	// Ecosystem filters alerts by a comma-separated list of ecosystems,
+	// such as "npm", "pip" or "go".
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Package filters alerts by a comma-separated list of package names.
+	Package string `url:"package,omitempty"`
+
+	// Scope filters alerts by the scope of the vulnerable dependency.
+	// Possible values are: "development" and "runtime".
+	Scope string `url:"scope,omitempty"`
+
+	// Sort specifies how to sort alerts.
+	// Possible values are: "created" and "updated". Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort alerts.
+	// Possible values are: "asc" and "desc". Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+// DependabotAlertState represents the request body to update a Dependabot alert.
+type DependabotAlertState struct {
+	// State is the state of the alert.
+	// Possible values are: "dismissed" and "open". (Required.)
+	State string `json:"state"`
+
+	// DismissedReason is required when State is "dismissed".
+	// Possible values are: "fix_started", "inaccurate", "no_bandwidth",
+	// "not_used" and "tolerable_risk".
+	DismissedReason *string `json:"dismissed_reason,omitempty"`
+
+	// DismissedComment is an optional comment associated with dismissing the alert.
+	DismissedComment *string `json:"dismissed_comment,omitempty"`
+}
+
+func (s *DependabotService) listAlerts(ctx context.Context, u string, opt *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []*DependabotAlert
+	resp, err := s.client.Do(ctx, req, &alerts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alerts, resp, nil
+}
+
+// ListRepoAlerts lists all Dependabot alerts of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#list-dependabot-alerts-for-a-repository
+func (s *DependabotService) ListRepoAlerts(ctx context.Context, owner, repo string, opt *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts", owner, repo)
+	return s.listAlerts(ctx, u, opt)
+}
+
+// ListOrgAlerts lists all Dependabot alerts of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#list-dependabot-alerts-for-an-organization
+func (s *DependabotService) ListOrgAlerts(ctx context.Context, org string, opt *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/dependabot/alerts", org)
+	return s.listAlerts(ctx, u, opt)
+}
+
+// GetRepoAlert gets a single repository Dependabot alert.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#get-a-dependabot-alert
+func (s *DependabotService) GetRepoAlert(ctx context.Context, owner, repo string, number int) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
+
+// UpdateAlert updates a Dependabot alert, for example to dismiss it with a reason.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependabot/alerts#update-a-dependabot-alert
+func (s *DependabotService) UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *DependabotAlertState) (*DependabotAlert, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependabot/alerts/%v", owner, repo, number)
+	req, err := s.client.NewRequest("PATCH", u, stateInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alert := new(DependabotAlert)
+	resp, err := s.client.Do(ctx, req, alert)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return alert, resp, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependabotService_ListRepoAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "open", "severity": "high,critical", "ecosystem": "npm", "per_page": "2", "after": "xyz"})
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/dependabot/alerts?after=abc&per_page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"number":1,"state":"open"},{"number":42,"state":"fixed"}]`)
+	})
+
+	opt := &DependabotAlertListOptions{
+		State:             "open",
+		Severity:          "high,critical",
+		Ecosystem:         "npm",
+		ListCursorOptions: ListCursorOptions{PerPage: 2, After: "xyz"},
+	}
+	alerts, resp, err := client.Dependabot.ListRepoAlerts(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{Number: Int(1), State: String("open")},
+		{Number: Int(42), State: String("fixed")},
+	}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListRepoAlerts returned %+v, want %+v", alerts, want)
+	}
+	if got, want := resp.After, "abc"; got != want {
+		t.Errorf("Dependabot.ListRepoAlerts returned After %q, want %q", got, want)
+	}
+}
+
+func TestDependabotService_ListOrgAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package": "lodash", "scope": "runtime"})
+		fmt.Fprint(w, `[{"number":1,"repository":{"id":1,"name":"r"}}]`)
+	})
+
+	opt := &DependabotAlertListOptions{Package: "lodash", Scope: "runtime"}
+	alerts, _, err := client.Dependabot.ListOrgAlerts(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Dependabot.ListOrgAlerts returned error: %v", err)
+	}
+
+	want := []*DependabotAlert{
+		{Number: Int(1), Repository: &Repository{ID: Int64(1), Name: String("r")}},
+	}
+	if !reflect.DeepEqual(alerts, want) {
+		t.Errorf("Dependabot.ListOrgAlerts returned %+v, want %+v", alerts, want)
+	}
+}
+
+func TestDependabotService_GetRepoAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"number": 42,
+			"state": "open",
+			"dependency": {
+				"package": {"ecosystem": "pip", "name": "django"},
+				"manifest_path": "path/to/requirements.txt",
+				"scope": "runtime"
+			},
+			"security_advisory": {
+				"ghsa_id": "GHSA-rf4j-j272-fj86",
+				"cve_id": "CVE-2018-6188",
+				"severity": "high",
+				"cvss": {"score": 7.5, "vector_string": "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"},
+				"cwes": [{"cwe_id": "CWE-200", "name": "Exposure of Sensitive Information"}],
+				"identifiers": [{"type": "GHSA", "value": "GHSA-rf4j-j272-fj86"}],
+				"references": [{"url": "https://nvd.nist.gov/vuln/detail/CVE-2018-6188"}],
+				"published_at": "2018-10-03T21:13:54Z"
+			},
+			"security_vulnerability": {
+				"package": {"ecosystem": "pip", "name": "django"},
+				"severity": "high",
+				"vulnerable_version_range": ">= 2.0.0, < 2.0.2",
+				"first_patched_version": {"identifier": "2.0.2"}
+			},
+			"created_at": "2022-06-15T07:43:03Z"
+		}`)
+	})
+
+	alert, _, err := client.Dependabot.GetRepoAlert(context.Background(), "o", "r", 42)
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoAlert returned error: %v", err)
+	}
+
+	score := 7.5
+	pkg := &VulnerabilityPackage{Ecosystem: String("pip"), Name: String("django")}
+	want := &DependabotAlert{
+		Number: Int(42),
+		State:  String("open"),
+		Dependency: &Dependency{
+			Package:      pkg,
+			ManifestPath: String("path/to/requirements.txt"),
+			Scope:        String("runtime"),
+		},
+		SecurityAdvisory: &DependabotSecurityAdvisory{
+			GHSAID:   String("GHSA-rf4j-j272-fj86"),
+			CVEID:    String("CVE-2018-6188"),
+			Severity: String("high"),
+			CVSS: &AdvisoryCVSS{
+				Score:        &score,
+				VectorString: String("CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"),
+			},
+			CWEs:        []*AdvisoryCWE{{CWEID: String("CWE-200"), Name: String("Exposure of Sensitive Information")}},
+			Identifiers: []*AdvisoryIdentifier{{Type: String("GHSA"), Value: String("GHSA-rf4j-j272-fj86")}},
+			References:  []*AdvisoryReference{{URL: String("https://nvd.nist.gov/vuln/detail/CVE-2018-6188")}},
+			PublishedAt: &Timestamp{time.Date(2018, time.October, 3, 21, 13, 54, 0, time.UTC)},
+		},
+		SecurityVulnerability: &AdvisoryVulnerability{
+			Package:                pkg,
+			Severity:               String("high"),
+			VulnerableVersionRange: String(">= 2.0.0, < 2.0.2"),
+			FirstPatchedVersion:    &FirstPatchedVersion{Identifier: String("2.0.2")},
+		},
+		CreatedAt: &Timestamp{time.Date(2022, time.June, 15, 7, 43, 3, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.GetRepoAlert returned %+v, want %+v", alert, want)
+	}
+}
+
+func TestDependabotService_UpdateAlert(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &DependabotAlertState{
+		State:            "dismissed",
+		DismissedReason:  String("no_bandwidth"),
+		DismissedComment: String("no time to fix this"),
+	}
+
+	mux.HandleFunc("/repos/o/r/dependabot/alerts/42", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DependabotAlertState)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		fmt.Fprint(w, `{"number":42,"state":"dismissed","dismissed_reason":"no_bandwidth"}`)
+	})
+
+	alert, _, err := client.Dependabot.UpdateAlert(context.Background(), "o", "r", 42, input)
+	if err != nil {
+		t.Errorf("Dependabot.UpdateAlert returned error: %v", err)
+	}
+
+	want := &DependabotAlert{
+		Number:          Int(42),
+		State:           String("dismissed"),
+		DismissedReason: String("no_bandwidth"),
+	}
+	if !reflect.DeepEqual(alert, want) {
+		t.Errorf("Dependabot.UpdateAlert returned %+v, want %+v", alert, want)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// PrivateRegistry represents a private registry configuration of an
+// organization, which Dependabot uses to reach registries that are not
+// public. Its credentials are never returned by the GitHub API.
+type PrivateRegistry struct {
+	Name *string `json:"name,omitempty"`
+	// RegistryType can be one of: "maven_repository", "nuget_feed",
+	// "goproxy_server", "npm_registry", "rubygems_server", "cargo_registry",
+	// "composer_repository", "docker_registry", "git_source",
+	// "helm_registry", "hex_organization", "hex_repository",
+	// "pub_repository", "python_index" and "terraform_registry".
+	RegistryType *string `json:"registry_type,omitempty"`
+	Username     *string `json:"username,omitempty"`
+	// Visibility can be one of: "all", "private" and "selected".
+	Visibility            *string    `json:"visibility,omitempty"`
+	SelectedRepositoryIDs []int64    `json:"selected_repository_ids,omitempty"`
+	CreatedAt             *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt             *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (r PrivateRegistry) String() string {
+	return Stringify(r)
+}
+
+// PrivateRegistries represents a paginated list of private registry
+// configurations.
+type PrivateRegistries struct {
+	TotalCount     int                `json:"total_count"`
+	Configurations []*PrivateRegistry `json:"configurations"`
+}
+
+// CreatePrivateRegistryRequest represents a request to create a private
+// registry configuration.
+//
+// EncryptedValue must be the password or token of the registry, encrypted
+// with the key returned by GetOrgPrivateRegistriesPublicKey. EncryptSecret
+// computes both EncryptedValue and KeyID.
+type CreatePrivateRegistryRequest struct {
+	RegistryType   string  `json:"registry_type"`
+	URL            string  `json:"url"`
+	Username       *string `json:"username,omitempty"`
+	EncryptedValue string  `json:"encrypted_value"`
+	KeyID          string  `json:"key_id"`
+	// Visibility can be one of: "all", "private" and "selected".
+	Visibility string `json:"visibility"`
+	// SelectedRepositoryIDs are the repositories that can use the registry
+	// when Visibility is "selected".
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+}
+
+// UpdatePrivateRegistryRequest represents a request to update a private
+// registry configuration. Unset fields are left unchanged; EncryptedValue and
+// KeyID must be set together.
+type UpdatePrivateRegistryRequest struct {
+	RegistryType          *string `json:"registry_type,omitempty"`
+	URL                   *string `json:"url,omitempty"`
+	Username              *string `json:"username,omitempty"`
+	EncryptedValue        *string `json:"encrypted_value,omitempty"`
+	KeyID                 *string `json:"key_id,omitempty"`
+	Visibility            *string `json:"visibility,omitempty"`
+	SelectedRepositoryIDs []int64 `json:"selected_repository_ids,omitempty"`
+}
+
+// GetOrgPrivateRegistriesPublicKey gets the public key that should be used to
+// encrypt the credentials of the private registries of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#get-private-registries-public-key-for-an-organization
+func (s *DependabotService) GetOrgPrivateRegistriesPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries/public-key", org)
+	return s.getPublicKey(ctx, u)
+}
+
+// ListOrgPrivateRegistries lists the private registry configurations of an
+// organization without revealing their credentials.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#list-private-registries-for-an-organization
+func (s *DependabotService) ListOrgPrivateRegistries(ctx context.Context, org string, opt *ListOptions) (*PrivateRegistries, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registries := new(PrivateRegistries)
+	resp, err := s.client.Do(ctx, req, registries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return registries, resp, nil
+}
+
+// GetOrgPrivateRegistry gets a private registry configuration of an
+// organization without revealing its credentials.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#get-a-private-registry-for-an-organization
+func (s *DependabotService) GetOrgPrivateRegistry(ctx context.Context, org, name string) (*PrivateRegistry, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries/%v", org, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registry := new(PrivateRegistry)
+	resp, err := s.client.Do(ctx, req, registry)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return registry, resp, nil
+}
+
+// CreateOrgPrivateRegistry creates a private registry configuration for an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#create-a-private-registry-for-an-organization
+func (s *DependabotService) CreateOrgPrivateRegistry(ctx context.Context, org string, registry *CreatePrivateRegistryRequest) (*PrivateRegistry, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries", org)
+	req, err := s.client.NewRequest("POST", u, registry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(PrivateRegistry)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// UpdateOrgPrivateRegistry updates a private registry configuration of an
+// organization, including the repositories that can use it.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#update-a-private-registry-for-an-organization
+func (s *DependabotService) UpdateOrgPrivateRegistry(ctx context.Context, org, name string, registry *UpdatePrivateRegistryRequest) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries/%v", org, name)
+	req, err := s.client.NewRequest("PATCH", u, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteOrgPrivateRegistry deletes a private registry configuration of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/private-registries/organization-configurations#delete-a-private-registry-for-an-organization
+func (s *DependabotService) DeleteOrgPrivateRegistry(ctx context.Context, org, name string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/private-registries/%v", org, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
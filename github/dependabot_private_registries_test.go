@@ -0,0 +1,143 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDependabotService_GetOrgPrivateRegistriesPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"k"}`)
+	})
+
+	key, _, err := client.Dependabot.GetOrgPrivateRegistriesPublicKey(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgPrivateRegistriesPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("k")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Dependabot.GetOrgPrivateRegistriesPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDependabotService_ListOrgPrivateRegistries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"configurations":[{"name":"MAVEN_REPOSITORY_SECRET","registry_type":"maven_repository","username":"monalisa","visibility":"selected"}]}`)
+	})
+
+	registries, _, err := client.Dependabot.ListOrgPrivateRegistries(context.Background(), "o", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Dependabot.ListOrgPrivateRegistries returned error: %v", err)
+	}
+
+	want := &PrivateRegistries{
+		TotalCount: 1,
+		Configurations: []*PrivateRegistry{{
+			Name:         String("MAVEN_REPOSITORY_SECRET"),
+			RegistryType: String("maven_repository"),
+			Username:     String("monalisa"),
+			Visibility:   String("selected"),
+		}},
+	}
+	if !reflect.DeepEqual(registries, want) {
+		t.Errorf("Dependabot.ListOrgPrivateRegistries returned %+v, want %+v", registries, want)
+	}
+}
+
+func TestDependabotService_GetOrgPrivateRegistry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","visibility":"selected","selected_repository_ids":[1,2]}`)
+	})
+
+	registry, _, err := client.Dependabot.GetOrgPrivateRegistry(context.Background(), "o", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgPrivateRegistry returned error: %v", err)
+	}
+
+	want := &PrivateRegistry{Name: String("NAME"), Visibility: String("selected"), SelectedRepositoryIDs: []int64{1, 2}}
+	if !reflect.DeepEqual(registry, want) {
+		t.Errorf("Dependabot.GetOrgPrivateRegistry returned %+v, want %+v", registry, want)
+	}
+}
+
+func TestDependabotService_CreateOrgPrivateRegistry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"registry_type":"npm_registry","url":"https://npm.example.com","encrypted_value":"v","key_id":"1234","visibility":"selected","selected_repository_ids":[1]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"NPM_REGISTRY_SECRET"}`)
+	})
+
+	input := &CreatePrivateRegistryRequest{
+		RegistryType:          "npm_registry",
+		URL:                   "https://npm.example.com",
+		EncryptedValue:        "v",
+		KeyID:                 "1234",
+		Visibility:            "selected",
+		SelectedRepositoryIDs: []int64{1},
+	}
+	registry, _, err := client.Dependabot.CreateOrgPrivateRegistry(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Dependabot.CreateOrgPrivateRegistry returned error: %v", err)
+	}
+
+	want := &PrivateRegistry{Name: String("NPM_REGISTRY_SECRET")}
+	if !reflect.DeepEqual(registry, want) {
+		t.Errorf("Dependabot.CreateOrgPrivateRegistry returned %+v, want %+v", registry, want)
+	}
+}
+
+func TestDependabotService_UpdateOrgPrivateRegistry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"visibility":"selected","selected_repository_ids":[1,2]}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	input := &UpdatePrivateRegistryRequest{Visibility: String("selected"), SelectedRepositoryIDs: []int64{1, 2}}
+	if _, err := client.Dependabot.UpdateOrgPrivateRegistry(context.Background(), "o", "NAME", input); err != nil {
+		t.Errorf("Dependabot.UpdateOrgPrivateRegistry returned error: %v", err)
+	}
+}
+
+func TestDependabotService_DeleteOrgPrivateRegistry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/private-registries/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Dependabot.DeleteOrgPrivateRegistry(context.Background(), "o", "NAME"); err != nil {
+		t.Errorf("Dependabot.DeleteOrgPrivateRegistry returned error: %v", err)
+	}
+}
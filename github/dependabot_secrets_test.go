@@ -0,0 +1,286 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependabotService_GetRepoPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"1234","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	key, _, err := client.Dependabot.GetRepoPublicKey(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("1234"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Dependabot.GetRepoPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDependabotService_GetOrgPublicKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/public-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"012345678","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	key, _, err := client.Dependabot.GetOrgPublicKey(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgPublicKey returned error: %v", err)
+	}
+
+	want := &PublicKey{KeyID: String("012345678"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Dependabot.GetOrgPublicKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDependabotService_ListRepoSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2"})
+		fmt.Fprint(w, `{"total_count":4,"secrets":[{"name":"A","created_at":"2019-01-02T15:04:05Z","updated_at":"2020-01-02T15:04:05Z"}]}`)
+	})
+
+	opt := &ListOptions{Page: 2, PerPage: 2}
+	secrets, _, err := client.Dependabot.ListRepoSecrets(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Dependabot.ListRepoSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 4,
+		Secrets: []*Secret{
+			{
+				Name:      String("A"),
+				CreatedAt: &Timestamp{time.Date(2019, time.January, 02, 15, 04, 05, 0, time.UTC)},
+				UpdatedAt: &Timestamp{time.Date(2020, time.January, 02, 15, 04, 05, 0, time.UTC)},
+			},
+		},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Dependabot.ListRepoSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestDependabotService_ListOrgSecrets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"secrets":[{"name":"A","visibility":"selected","selected_repositories_url":"u"}]}`)
+	})
+
+	secrets, _, err := client.Dependabot.ListOrgSecrets(context.Background(), "o", nil)
+	if err != nil {
+		t.Errorf("Dependabot.ListOrgSecrets returned error: %v", err)
+	}
+
+	want := &Secrets{
+		TotalCount: 1,
+		Secrets:    []*Secret{{Name: String("A"), Visibility: String("selected"), SelectedRepositoriesURL: String("u")}},
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Errorf("Dependabot.ListOrgSecrets returned %+v, want %+v", secrets, want)
+	}
+}
+
+func TestDependabotService_GetRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME"}`)
+	})
+
+	secret, _, err := client.Dependabot.GetRepoSecret(context.Background(), "o", "r", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.GetRepoSecret returned error: %v", err)
+	}
+
+	want := &Secret{Name: String("NAME")}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Dependabot.GetRepoSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestDependabotService_GetOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"NAME","visibility":"all"}`)
+	})
+
+	secret, _, err := client.Dependabot.GetOrgSecret(context.Background(), "o", "NAME")
+	if err != nil {
+		t.Errorf("Dependabot.GetOrgSecret returned error: %v", err)
+	}
+
+	want := &Secret{Name: String("NAME"), Visibility: String("all")}
+	if !reflect.DeepEqual(secret, want) {
+		t.Errorf("Dependabot.GetOrgSecret returned %+v, want %+v", secret, want)
+	}
+}
+
+func TestDependabotService_CreateOrUpdateRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv="}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:           "NAME",
+		EncryptedValue: "QIv=",
+		KeyID:          "1234",
+	}
+	if _, err := client.Dependabot.CreateOrUpdateRepoSecret(context.Background(), "o", "r", input); err != nil {
+		t.Errorf("Dependabot.CreateOrUpdateRepoSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_CreateOrUpdateOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"key_id":"1234","encrypted_value":"QIv=","visibility":"selected","selected_repository_ids":[1296269,1269280]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	input := &EncryptedSecret{
+		Name:                  "NAME",
+		EncryptedValue:        "QIv=",
+		KeyID:                 "1234",
+		Visibility:            "selected",
+		SelectedRepositoryIDs: SelectedRepoIDs{1296269, 1269280},
+	}
+	if _, err := client.Dependabot.CreateOrUpdateOrgSecret(context.Background(), "o", input); err != nil {
+		t.Errorf("Dependabot.CreateOrUpdateOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_DeleteRepoSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	if _, err := client.Dependabot.DeleteRepoSecret(context.Background(), "o", "r", "NAME"); err != nil {
+		t.Errorf("Dependabot.DeleteRepoSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_DeleteOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	if _, err := client.Dependabot.DeleteOrgSecret(context.Background(), "o", "NAME"); err != nil {
+		t.Errorf("Dependabot.DeleteOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_ListSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"repositories":[{"id":1}]}`)
+	})
+
+	repos, _, err := client.Dependabot.ListSelectedReposForOrgSecret(context.Background(), "o", "NAME", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Dependabot.ListSelectedReposForOrgSecret returned error: %v", err)
+	}
+
+	want := &SelectedReposList{
+		TotalCount:   Int(1),
+		Repositories: []*Repository{{ID: Int64(1)}},
+	}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Dependabot.ListSelectedReposForOrgSecret returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestDependabotService_SetSelectedReposForOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"selected_repository_ids":[64780797]}`+"\n")
+	})
+
+	if _, err := client.Dependabot.SetSelectedReposForOrgSecret(context.Background(), "o", "NAME", SelectedRepoIDs{64780797}); err != nil {
+		t.Errorf("Dependabot.SetSelectedReposForOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_AddSelectedRepoToOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	if _, err := client.Dependabot.AddSelectedRepoToOrgSecret(context.Background(), "o", "NAME", repo); err != nil {
+		t.Errorf("Dependabot.AddSelectedRepoToOrgSecret returned error: %v", err)
+	}
+}
+
+func TestDependabotService_RemoveSelectedRepoFromOrgSecret(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/dependabot/secrets/NAME/repositories/1234", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	repo := &Repository{ID: Int64(1234)}
+	if _, err := client.Dependabot.RemoveSelectedRepoFromOrgSecret(context.Background(), "o", "NAME", repo); err != nil {
+		t.Errorf("Dependabot.RemoveSelectedRepoFromOrgSecret returned error: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphService handles communication with the dependency graph
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph
+type DependencyGraphService service
+
+// SBOM represents a software bill of materials, which describes the
+// packages/libraries that a repository depends on.
+type SBOM struct {
+	SBOM *SBOMInfo `json:"sbom,omitempty"`
+}
+
+func (s SBOM) String() string {
+	return Stringify(s)
+}
+
+// SBOMInfo represents a software bill of materials (SBOM) using SPDX.
+// SPDX is an open standard for SBOMs that
+// identifies and catalogs components, licenses, copyrights, security
+// references, and other metadata relating to software.
+type SBOMInfo struct {
+	SPDXID       *string       `json:"SPDXID,omitempty"`
+	SPDXVersion  *string       `json:"spdxVersion,omitempty"`
+	CreationInfo *CreationInfo `json:"creationInfo,omitempty"`
+
+	// Repo name
+	Name              *string  `json:"name,omitempty"`
+	DataLicense       *string  `json:"dataLicense,omitempty"`
+	DocumentDescribes []string `json:"documentDescribes,omitempty"`
+	DocumentNamespace *string  `json:"documentNamespace,omitempty"`
+
+	// List of packages dependencies
+	Packages []*RepoDependencies `json:"packages,omitempty"`
+
+	// List of relationships between packages
+	Relationships []*SBOMRelationship `json:"relationships,omitempty"`
+}
+
+// CreationInfo represents when the SBOM was created and who created it.
+type CreationInfo struct {
+	Created  *Timestamp `json:"created,omitempty"`
+	Creators []string   `json:"creators,omitempty"`
+}
+
+// RepoDependencies represents a package that a repository depends on.
+type RepoDependencies struct {
+	SPDXID *string `json:"SPDXID,omitempty"`
+	// Package name
+	Name             *string `json:"name,omitempty"`
+	VersionInfo      *string `json:"versionInfo,omitempty"`
+	DownloadLocation *string `json:"downloadLocation,omitempty"`
+	FilesAnalyzed    *bool   `json:"filesAnalyzed,omitempty"`
+	LicenseConcluded *string `json:"licenseConcluded,omitempty"`
+	LicenseDeclared  *string `json:"licenseDeclared,omitempty"`
+	CopyrightText    *string `json:"copyrightText,omitempty"`
+	// ExternalRefs usually contain the package URL (purl) of the package.
+	ExternalRefs []*PackageExternalRef `json:"externalRefs,omitempty"`
+}
+
+// PackageExternalRef allows an SBOM package to reference an external source
+// of additional information, metadata, enumerations, asset identifiers, or
+// downloadable content believed to be relevant to the package.
+type PackageExternalRef struct {
+	// ReferenceCategory is the category of the external reference.
+	// Possible values are: "SECURITY", "PACKAGE-MANAGER", "PERSISTENT-ID" and "OTHER".
+	ReferenceCategory *string `json:"referenceCategory,omitempty"`
+	ReferenceType     *string `json:"referenceType,omitempty"`
+	ReferenceLocator  *string `json:"referenceLocator,omitempty"`
+}
+
+// SBOMRelationship provides information about the relationship between two SPDX elements.
+type SBOMRelationship struct {
+	SPDXElementID      *string `json:"spdxElementId,omitempty"`
+	RelatedSPDXElement *string `json:"relatedSpdxElement,omitempty"`
+	// RelationshipType is the type of the relationship, such as "DEPENDS_ON".
+	RelationshipType *string `json:"relationshipType,omitempty"`
+}
+
+// GetSBOM fetches the software bill of materials for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/sboms#export-a-software-bill-of-materials-sbom-for-a-repository
+func (s *DependencyGraphService) GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/sbom", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sbom := new(SBOM)
+	resp, err := s.client.Do(ctx, req, sbom)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return sbom, resp, nil
+}
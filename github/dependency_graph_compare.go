@@ -0,0 +1,77 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyChangeVulnerability represents a known vulnerability of a
+// dependency that is added or removed between two revisions.
+type DependencyChangeVulnerability struct {
+	Severity        *string `json:"severity,omitempty"`
+	AdvisoryGHSAID  *string `json:"advisory_ghsa_id,omitempty"`
+	AdvisorySummary *string `json:"advisory_summary,omitempty"`
+	AdvisoryURL     *string `json:"advisory_url,omitempty"`
+}
+
+// DependencyChange represents a dependency that is added or removed between
+// two revisions of a repository.
+type DependencyChange struct {
+	// ChangeType is the kind of change.
+	// Possible values are: "added" and "removed".
+	ChangeType          *string `json:"change_type,omitempty"`
+	Manifest            *string `json:"manifest,omitempty"`
+	Ecosystem           *string `json:"ecosystem,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Version             *string `json:"version,omitempty"`
+	PackageURL          *string `json:"package_url,omitempty"`
+	License             *string `json:"license,omitempty"`
+	SourceRepositoryURL *string `json:"source_repository_url,omitempty"`
+	// Scope is the execution scope of the dependency.
+	// Possible values are: "unknown", "development" and "runtime".
+	Scope           *string                          `json:"scope,omitempty"`
+	Vulnerabilities []*DependencyChangeVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+func (d DependencyChange) String() string {
+	return Stringify(d)
+}
+
+// DependencyDiffOptions specifies the optional parameters to the
+// DependencyGraphService.GetDependencyDiff method.
+type DependencyDiffOptions struct {
+	// Name restricts the comparison to the manifest at this path.
+	Name string `url:"name,omitempty"`
+}
+
+// GetDependencyDiff gets the diff of the dependencies between two commits of
+// a repository, based on the changes to the dependency manifests made in
+// those commits. base and head may be any valid Git revision, such as a
+// branch name or SHA.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/dependency-review#get-a-diff-of-the-dependencies-between-commits
+func (s *DependencyGraphService) GetDependencyDiff(ctx context.Context, owner, repo, base, head string, opt *DependencyDiffOptions) ([]*DependencyChange, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/compare/%v...%v", owner, repo, base, head)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*DependencyChange
+	resp, err := s.client.Do(ctx, req, &changes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
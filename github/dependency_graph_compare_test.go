@@ -0,0 +1,72 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDependencyGraphService_GetDependencyDiff(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/compare/main...feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"name": "package.json"})
+		fmt.Fprint(w, `[{
+			"change_type": "added",
+			"manifest": "package.json",
+			"ecosystem": "npm",
+			"name": "helmet",
+			"version": "4.6.0",
+			"package_url": "pkg:npm/helmet@4.6.0",
+			"license": "MIT",
+			"source_repository_url": "https://github.com/helmetjs/helmet",
+			"scope": "runtime",
+			"vulnerabilities": [{
+				"severity": "critical",
+				"advisory_ghsa_id": "GHSA-rf4j-j272-fj86",
+				"advisory_summary": "A summary",
+				"advisory_url": "https://github.com/advisories/GHSA-rf4j-j272-fj86"
+			}]
+		}]`)
+	})
+
+	opt := &DependencyDiffOptions{Name: "package.json"}
+	changes, _, err := client.DependencyGraph.GetDependencyDiff(context.Background(), "o", "r", "main", "feature", opt)
+	if err != nil {
+		t.Errorf("DependencyGraph.GetDependencyDiff returned error: %v", err)
+	}
+
+	want := []*DependencyChange{
+		{
+			ChangeType:          String("added"),
+			Manifest:            String("package.json"),
+			Ecosystem:           String("npm"),
+			Name:                String("helmet"),
+			Version:             String("4.6.0"),
+			PackageURL:          String("pkg:npm/helmet@4.6.0"),
+			License:             String("MIT"),
+			SourceRepositoryURL: String("https://github.com/helmetjs/helmet"),
+			Scope:               String("runtime"),
+			Vulnerabilities: []*DependencyChangeVulnerability{
+				{
+					Severity:        String("critical"),
+					AdvisoryGHSAID:  String("GHSA-rf4j-j272-fj86"),
+					AdvisorySummary: String("A summary"),
+					AdvisoryURL:     String("https://github.com/advisories/GHSA-rf4j-j272-fj86"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("DependencyGraph.GetDependencyDiff returned %+v, want %+v", changes, want)
+	}
+}
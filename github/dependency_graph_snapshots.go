@@ -0,0 +1,112 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// DependencyGraphSnapshotResolvedDependency represents a resolved dependency
+// in a dependency graph snapshot.
+type DependencyGraphSnapshotResolvedDependency struct {
+	// PackageURL is the package URL (purl) of the dependency,
+	// for example "pkg:golang/github.com/google/go-github@v25.0.0".
+	PackageURL *string `json:"package_url,omitempty"`
+	// Represents whether the dependency is requested directly by the manifest or is a dependency of another dependency.
+	// Possible values are: "direct", "indirect".
+	Relationship *string `json:"relationship,omitempty"`
+	// Represents whether the dependency is required for the primary build artifact or is only used for development.
+	// Possible values are: "runtime", "development".
+	Scope *string `json:"scope,omitempty"`
+	// A collection of user-defined metadata to associate with the dependency.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Array of package-url (PURLs) of direct child dependencies.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// DependencyGraphSnapshotJob represents the job that created a dependency
+// graph snapshot.
+type DependencyGraphSnapshotJob struct {
+	// Correlator is the external ID of the job, which combined with the
+	// detector name groups snapshots of the same workflow over time.
+	Correlator *string `json:"correlator,omitempty"`
+	ID         *string `json:"id,omitempty"`
+	HTMLURL    *string `json:"html_url,omitempty"`
+}
+
+// DependencyGraphSnapshotDetector represents the tool that detected the
+// dependencies of a snapshot.
+type DependencyGraphSnapshotDetector struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+// DependencyGraphSnapshotManifestFile represents the file declaring the
+// dependencies of a manifest.
+type DependencyGraphSnapshotManifestFile struct {
+	SourceLocation *string `json:"source_location,omitempty"`
+}
+
+// DependencyGraphSnapshotManifest represents a collection of related
+// dependencies declared in a file or representing a logical group of
+// dependencies.
+type DependencyGraphSnapshotManifest struct {
+	Name     *string                                               `json:"name,omitempty"`
+	File     *DependencyGraphSnapshotManifestFile                  `json:"file,omitempty"`
+	Metadata map[string]interface{}                                `json:"metadata,omitempty"`
+	Resolved map[string]*DependencyGraphSnapshotResolvedDependency `json:"resolved,omitempty"`
+}
+
+// DependencyGraphSnapshot represents a snapshot of a repository's
+// dependencies, as submitted by a build tool.
+type DependencyGraphSnapshot struct {
+	Version   int                                         `json:"version"`
+	Sha       *string                                     `json:"sha,omitempty"`
+	Ref       *string                                     `json:"ref,omitempty"`
+	Job       *DependencyGraphSnapshotJob                 `json:"job,omitempty"`
+	Detector  *DependencyGraphSnapshotDetector            `json:"detector,omitempty"`
+	Scanned   *Timestamp                                  `json:"scanned,omitempty"`
+	Metadata  map[string]interface{}                      `json:"metadata,omitempty"`
+	Manifests map[string]*DependencyGraphSnapshotManifest `json:"manifests,omitempty"`
+}
+
+// DependencyGraphSnapshotCreationData represents the dependency snapshot's
+// creation result.
+type DependencyGraphSnapshotCreationData struct {
+	ID        *int64     `json:"id,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	Message   *string    `json:"message,omitempty"`
+	// Represents the snapshot creation result.
+	// Possible values are: "SUCCESS", "ACCEPTED", "INVALID".
+	Result *string `json:"result,omitempty"`
+}
+
+func (d DependencyGraphSnapshotCreationData) String() string {
+	return Stringify(d)
+}
+
+// CreateSnapshot submits a dependency snapshot for a repository, adding the
+// dependencies it reports to the repository's dependency graph.
+//
+// GitHub API docs: https://docs.github.com/en/rest/dependency-graph/dependency-submission#create-a-snapshot-of-dependencies-for-a-repository
+func (s *DependencyGraphService) CreateSnapshot(ctx context.Context, owner, repo string, snapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/dependency-graph/snapshots", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(DependencyGraphSnapshotCreationData)
+	resp, err := s.client.Do(ctx, req, data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data, resp, nil
+}
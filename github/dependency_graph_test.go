@@ -0,0 +1,105 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDependencyGraphService_GetSBOM(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/dependency-graph/sbom", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sbom": {
+				"SPDXID": "SPDXRef-DOCUMENT",
+				"spdxVersion": "SPDX-2.3",
+				"creationInfo": {
+					"created": "2021-09-02T15:22:43Z",
+					"creators": ["Tool: GitHub.com-Dependency-Graph"]
+				},
+				"name": "github/github",
+				"dataLicense": "CC0-1.0",
+				"documentDescribes": ["github/github"],
+				"documentNamespace": "https://github.com/github/github/dependency_graph/sbom-abcdef123456",
+				"packages": [{
+					"SPDXID": "SPDXRef-Repository",
+					"name": "rubygems:rails",
+					"versionInfo": "1.0.0",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "MIT",
+					"licenseDeclared": "MIT",
+					"externalRefs": [{
+						"referenceCategory": "PACKAGE-MANAGER",
+						"referenceType": "purl",
+						"referenceLocator": "pkg:gem/rails@1.0.0"
+					}]
+				}],
+				"relationships": [{
+					"spdxElementId": "SPDXRef-DOCUMENT",
+					"relatedSpdxElement": "SPDXRef-Repository",
+					"relationshipType": "DESCRIBES"
+				}]
+			}
+		}`)
+	})
+
+	sbom, _, err := client.DependencyGraph.GetSBOM(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("DependencyGraph.GetSBOM returned error: %v", err)
+	}
+
+	want := &SBOM{
+		SBOM: &SBOMInfo{
+			SPDXID:      String("SPDXRef-DOCUMENT"),
+			SPDXVersion: String("SPDX-2.3"),
+			CreationInfo: &CreationInfo{
+				Created:  &Timestamp{time.Date(2021, time.September, 2, 15, 22, 43, 0, time.UTC)},
+				Creators: []string{"Tool: GitHub.com-Dependency-Graph"},
+			},
+			Name:              String("github/github"),
+			DataLicense:       String("CC0-1.0"),
+			DocumentDescribes: []string{"github/github"},
+			DocumentNamespace: String("https://github.com/github/github/dependency_graph/sbom-abcdef123456"),
+			Packages: []*RepoDependencies{
+				{
+					SPDXID:           String("SPDXRef-Repository"),
+					Name:             String("rubygems:rails"),
+					VersionInfo:      String("1.0.0"),
+					DownloadLocation: String("NOASSERTION"),
+					FilesAnalyzed:    Bool(false),
+					LicenseConcluded: String("MIT"),
+					LicenseDeclared:  String("MIT"),
+					ExternalRefs: []*PackageExternalRef{
+						{
+							ReferenceCategory: String("PACKAGE-MANAGER"),
+							ReferenceType:     String("purl"),
+							ReferenceLocator:  String("pkg:gem/rails@1.0.0"),
+						},
+					},
+				},
+			},
+			Relationships: []*SBOMRelationship{
+				{
+					SPDXElementID:      String("SPDXRef-DOCUMENT"),
+					RelatedSPDXElement: String("SPDXRef-Repository"),
+					RelationshipType:   String("DESCRIBES"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(sbom, want) {
+		t.Errorf("DependencyGraph.GetSBOM returned %+v, want %+v", sbom, want)
+	}
+}
@@ -0,0 +1,411 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// DiscussionsService handles communication with the repository discussion
+// related methods of the GitHub API. Discussions are only available
+// through the GraphQL API; objects are identified by their GraphQL node ID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/using-the-graphql-api-for-discussions
+type DiscussionsService service
+
+// DiscussionAuthor represents the author of a discussion or comment.
+type DiscussionAuthor struct {
+	Login *string `json:"login,omitempty"`
+}
+
+// DiscussionCategory represents a category of discussions in a repository.
+type DiscussionCategory struct {
+	ID           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Slug         *string `json:"slug,omitempty"`
+	Emoji        *string `json:"emoji,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	IsAnswerable *bool   `json:"isAnswerable,omitempty"`
+}
+
+func (c DiscussionCategory) String() string {
+	return Stringify(c)
+}
+
+// DiscussionPollOption represents an option of a discussion poll.
+type DiscussionPollOption struct {
+	ID             *string `json:"id,omitempty"`
+	Option         *string `json:"option,omitempty"`
+	TotalVoteCount *int    `json:"totalVoteCount,omitempty"`
+}
+
+// DiscussionPoll represents the poll attached to a discussion in a
+// "Polls" category.
+type DiscussionPoll struct {
+	ID             *string `json:"id,omitempty"`
+	Question       *string `json:"question,omitempty"`
+	TotalVoteCount *int    `json:"totalVoteCount,omitempty"`
+	Options        *struct {
+		Nodes []*DiscussionPollOption `json:"nodes"`
+	} `json:"options,omitempty"`
+}
+
+// Discussion represents a repository discussion.
+type Discussion struct {
+	ID             *string             `json:"id,omitempty"`
+	Number         *int                `json:"number,omitempty"`
+	Title          *string             `json:"title,omitempty"`
+	Body           *string             `json:"body,omitempty"`
+	URL            *string             `json:"url,omitempty"`
+	Author         *DiscussionAuthor   `json:"author,omitempty"`
+	Category       *DiscussionCategory `json:"category,omitempty"`
+	Closed         *bool               `json:"closed,omitempty"`
+	Locked         *bool               `json:"locked,omitempty"`
+	IsAnswered     *bool               `json:"isAnswered,omitempty"`
+	AnswerChosenAt *Timestamp          `json:"answerChosenAt,omitempty"`
+	CreatedAt      *Timestamp          `json:"createdAt,omitempty"`
+	UpdatedAt      *Timestamp          `json:"updatedAt,omitempty"`
+	Poll           *DiscussionPoll     `json:"poll,omitempty"`
+}
+
+func (d Discussion) String() string {
+	return Stringify(d)
+}
+
+// DiscussionConnection represents a page of discussions.
+type DiscussionConnection struct {
+	TotalCount *int          `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo     `json:"pageInfo,omitempty"`
+	Nodes      []*Discussion `json:"nodes"`
+}
+
+// DiscussionCategoryConnection represents a page of discussion categories.
+type DiscussionCategoryConnection struct {
+	TotalCount *int                  `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo             `json:"pageInfo,omitempty"`
+	Nodes      []*DiscussionCategory `json:"nodes"`
+}
+
+// RepoDiscussionComment represents a comment on a discussion, or a reply to
+// such a comment.
+type RepoDiscussionComment struct {
+	ID        *string           `json:"id,omitempty"`
+	Body      *string           `json:"body,omitempty"`
+	URL       *string           `json:"url,omitempty"`
+	Author    *DiscussionAuthor `json:"author,omitempty"`
+	IsAnswer  *bool             `json:"isAnswer,omitempty"`
+	CreatedAt *Timestamp        `json:"createdAt,omitempty"`
+	UpdatedAt *Timestamp        `json:"updatedAt,omitempty"`
+}
+
+func (c RepoDiscussionComment) String() string {
+	return Stringify(c)
+}
+
+// RepoDiscussionCommentConnection represents a page of discussion comments.
+type RepoDiscussionCommentConnection struct {
+	TotalCount *int                     `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo                `json:"pageInfo,omitempty"`
+	Nodes      []*RepoDiscussionComment `json:"nodes"`
+}
+
+// RepoDiscussionListOptions specifies the optional parameters to the
+// DiscussionsService.ListDiscussions method.
+type RepoDiscussionListOptions struct {
+	// CategoryID only returns discussions of the given category.
+	CategoryID string
+
+	GraphQLListOptions
+}
+
+// CreateDiscussionInput represents a new discussion.
+type CreateDiscussionInput struct {
+	RepositoryID string `json:"repositoryId"`
+	CategoryID   string `json:"categoryId"`
+	Title        string `json:"title"`
+	Body         string `json:"body"`
+}
+
+const discussionFields = `id number title body url closed locked isAnswered answerChosenAt createdAt updatedAt
+author { login }
+category { id name slug emoji description isAnswerable }
+poll { id question totalVoteCount options(first: 20) { nodes { id option totalVoteCount } } }`
+
+const discussionCommentFields = `id body url isAnswer createdAt updatedAt author { login }`
+
+// ListDiscussions lists the discussions of a repository, most recently
+// updated first.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/using-the-graphql-api-for-discussions#repositorydiscussions
+func (s *DiscussionsService) ListDiscussions(ctx context.Context, owner, repo string, opt *RepoDiscussionListOptions) (*DiscussionConnection, *Response, error) {
+	query := `query($owner: String!, $name: String!, $first: Int!, $after: String, $categoryId: ID) {
+	repository(owner: $owner, name: $name) {
+		discussions(first: $first, after: $after, categoryId: $categoryId, orderBy: {field: UPDATED_AT, direction: DESC}) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes { ` + discussionFields + ` }
+		}
+	}
+}`
+	var gopt *GraphQLListOptions
+	var categoryID *string
+	if opt != nil {
+		gopt = &opt.GraphQLListOptions
+		if opt.CategoryID != "" {
+			categoryID = &opt.CategoryID
+		}
+	}
+
+	var result struct {
+		Repository *struct {
+			Discussions *DiscussionConnection `json:"discussions"`
+		} `json:"repository"`
+	}
+	vars := map[string]interface{}{
+		"owner":      owner,
+		"name":       repo,
+		"first":      gopt.first(),
+		"after":      gopt.after(),
+		"categoryId": categoryID,
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Repository == nil {
+		return nil, resp, errors.New("github: repository not found")
+	}
+
+	return result.Repository.Discussions, resp, nil
+}
+
+// GetDiscussion fetches a single discussion by its number.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/using-the-graphql-api-for-discussions#repositorydiscussion
+func (s *DiscussionsService) GetDiscussion(ctx context.Context, owner, repo string, number int) (*Discussion, *Response, error) {
+	query := `query($owner: String!, $name: String!, $number: Int!) {
+	repository(owner: $owner, name: $name) {
+		discussion(number: $number) { ` + discussionFields + ` }
+	}
+}`
+	var result struct {
+		Repository *struct {
+			Discussion *Discussion `json:"discussion"`
+		} `json:"repository"`
+	}
+	vars := map[string]interface{}{"owner": owner, "name": repo, "number": number}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Repository == nil || result.Repository.Discussion == nil {
+		return nil, resp, errors.New("github: discussion not found")
+	}
+
+	return result.Repository.Discussion, resp, nil
+}
+
+// ListDiscussionCategories lists the discussion categories of a repository.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/using-the-graphql-api-for-discussions#repositorydiscussioncategories
+func (s *DiscussionsService) ListDiscussionCategories(ctx context.Context, owner, repo string, opt *GraphQLListOptions) (*DiscussionCategoryConnection, *Response, error) {
+	query := `query($owner: String!, $name: String!, $first: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		discussionCategories(first: $first, after: $after) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes { id name slug emoji description isAnswerable }
+		}
+	}
+}`
+	var result struct {
+		Repository *struct {
+			DiscussionCategories *DiscussionCategoryConnection `json:"discussionCategories"`
+		} `json:"repository"`
+	}
+	vars := map[string]interface{}{"owner": owner, "name": repo, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Repository == nil {
+		return nil, resp, errors.New("github: repository not found")
+	}
+
+	return result.Repository.DiscussionCategories, resp, nil
+}
+
+// CreateDiscussion creates a discussion. The repository and category are
+// identified by their node IDs.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#creatediscussion
+func (s *DiscussionsService) CreateDiscussion(ctx context.Context, input *CreateDiscussionInput) (*Discussion, *Response, error) {
+	query := `mutation($input: CreateDiscussionInput!) {
+	createDiscussion(input: $input) {
+		discussion { ` + discussionFields + ` }
+	}
+}`
+	var result struct {
+		CreateDiscussion struct {
+			Discussion *Discussion `json:"discussion"`
+		} `json:"createDiscussion"`
+	}
+	resp, err := s.client.graphQL(ctx, query, map[string]interface{}{"input": input}, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.CreateDiscussion.Discussion, resp, nil
+}
+
+// CloseDiscussion closes a discussion. reason is one of "RESOLVED",
+// "OUTDATED" or "DUPLICATE"; if empty, "RESOLVED" is used.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#closediscussion
+func (s *DiscussionsService) CloseDiscussion(ctx context.Context, discussionID, reason string) (*Response, error) {
+	query := `mutation($id: ID!, $reason: DiscussionCloseReason) {
+	closeDiscussion(input: {discussionId: $id, reason: $reason}) { discussion { id } }
+}`
+	vars := map[string]interface{}{"id": discussionID}
+	if reason != "" {
+		vars["reason"] = reason
+	}
+	return s.client.graphQL(ctx, query, vars, nil)
+}
+
+// ReopenDiscussion reopens a closed discussion.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#reopendiscussion
+func (s *DiscussionsService) ReopenDiscussion(ctx context.Context, discussionID string) (*Response, error) {
+	query := `mutation($id: ID!) {
+	reopenDiscussion(input: {discussionId: $id}) { discussion { id } }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": discussionID}, nil)
+}
+
+// DeleteDiscussion deletes a discussion and all of its replies.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#deletediscussion
+func (s *DiscussionsService) DeleteDiscussion(ctx context.Context, discussionID string) (*Response, error) {
+	query := `mutation($id: ID!) {
+	deleteDiscussion(input: {id: $id}) { discussion { id } }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": discussionID}, nil)
+}
+
+// ListDiscussionComments lists the top-level comments of a discussion.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#discussion
+func (s *DiscussionsService) ListDiscussionComments(ctx context.Context, discussionID string, opt *GraphQLListOptions) (*RepoDiscussionCommentConnection, *Response, error) {
+	query := `query($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		... on Discussion {
+			comments(first: $first, after: $after) {
+				totalCount
+				pageInfo { hasNextPage endCursor }
+				nodes { ` + discussionCommentFields + ` }
+			}
+		}
+	}
+}`
+	var result struct {
+		Node *struct {
+			Comments *RepoDiscussionCommentConnection `json:"comments"`
+		} `json:"node"`
+	}
+	vars := map[string]interface{}{"id": discussionID, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.Comments == nil {
+		return nil, resp, errors.New("github: discussion not found")
+	}
+
+	return result.Node.Comments, resp, nil
+}
+
+// AddDiscussionComment adds a comment to a discussion. If replyToID is not
+// empty, the comment is posted as a reply to that comment.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#adddiscussioncomment
+func (s *DiscussionsService) AddDiscussionComment(ctx context.Context, discussionID, body, replyToID string) (*RepoDiscussionComment, *Response, error) {
+	query := `mutation($id: ID!, $body: String!, $replyToId: ID) {
+	addDiscussionComment(input: {discussionId: $id, body: $body, replyToId: $replyToId}) {
+		comment { ` + discussionCommentFields + ` }
+	}
+}`
+	var result struct {
+		AddDiscussionComment struct {
+			Comment *RepoDiscussionComment `json:"comment"`
+		} `json:"addDiscussionComment"`
+	}
+	vars := map[string]interface{}{"id": discussionID, "body": body}
+	if replyToID != "" {
+		vars["replyToId"] = replyToID
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AddDiscussionComment.Comment, resp, nil
+}
+
+// DeleteDiscussionComment deletes a discussion comment.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#deletediscussioncomment
+func (s *DiscussionsService) DeleteDiscussionComment(ctx context.Context, commentID string) (*Response, error) {
+	query := `mutation($id: ID!) {
+	deleteDiscussionComment(input: {id: $id}) { comment { id } }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": commentID}, nil)
+}
+
+// MarkDiscussionCommentAsAnswer marks a comment as the answer of its
+// discussion. The discussion must be in an answerable category.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#markdiscussioncommentasanswer
+func (s *DiscussionsService) MarkDiscussionCommentAsAnswer(ctx context.Context, commentID string) (*Response, error) {
+	query := `mutation($id: ID!) {
+	markDiscussionCommentAsAnswer(input: {id: $id}) { discussion { id } }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": commentID}, nil)
+}
+
+// UnmarkDiscussionCommentAsAnswer unmarks a comment as the answer of its
+// discussion.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#unmarkdiscussioncommentasanswer
+func (s *DiscussionsService) UnmarkDiscussionCommentAsAnswer(ctx context.Context, commentID string) (*Response, error) {
+	query := `mutation($id: ID!) {
+	unmarkDiscussionCommentAsAnswer(input: {id: $id}) { discussion { id } }
+}`
+	return s.client.graphQL(ctx, query, map[string]interface{}{"id": commentID}, nil)
+}
+
+// AddDiscussionPollVote votes for an option of a discussion poll.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#adddiscussionpollvote
+func (s *DiscussionsService) AddDiscussionPollVote(ctx context.Context, pollOptionID string) (*DiscussionPollOption, *Response, error) {
+	query := `mutation($id: ID!) {
+	addDiscussionPollVote(input: {pollOptionId: $id}) {
+		pollOption { id option totalVoteCount }
+	}
+}`
+	var result struct {
+		AddDiscussionPollVote struct {
+			PollOption *DiscussionPollOption `json:"pollOption"`
+		} `json:"addDiscussionPollVote"`
+	}
+	resp, err := s.client.graphQL(ctx, query, map[string]interface{}{"id": pollOptionID}, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AddDiscussionPollVote.PollOption, resp, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiscussionsService_ListDiscussions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "discussions(first: $first", map[string]interface{}{
+			"owner": "o", "name": "r", "first": 5.0, "after": nil, "categoryId": "DIC_1",
+		})
+		fmt.Fprint(w, `{"data":{"repository":{"discussions":{
+			"totalCount": 1,
+			"pageInfo": {"hasNextPage": true, "endCursor": "c"},
+			"nodes": [{
+				"id": "D_1", "number": 3, "title": "Q", "closed": false, "isAnswered": true,
+				"createdAt": "2022-01-02T03:04:05Z",
+				"author": {"login": "octocat"},
+				"category": {"id": "DIC_1", "name": "Q&A", "isAnswerable": true}
+			}]
+		}}}}`)
+	})
+
+	opt := &RepoDiscussionListOptions{CategoryID: "DIC_1", GraphQLListOptions: GraphQLListOptions{First: 5}}
+	discussions, _, err := client.Discussions.ListDiscussions(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("Discussions.ListDiscussions returned error: %v", err)
+	}
+
+	want := &DiscussionConnection{
+		TotalCount: Int(1),
+		PageInfo:   &PageInfo{HasNextPage: true, EndCursor: String("c")},
+		Nodes: []*Discussion{{
+			ID:         String("D_1"),
+			Number:     Int(3),
+			Title:      String("Q"),
+			Closed:     Bool(false),
+			IsAnswered: Bool(true),
+			CreatedAt:  &Timestamp{time.Date(2022, time.January, 2, 3, 4, 5, 0, time.UTC)},
+			Author:     &DiscussionAuthor{Login: String("octocat")},
+			Category:   &DiscussionCategory{ID: String("DIC_1"), Name: String("Q&A"), IsAnswerable: Bool(true)},
+		}},
+	}
+	if !reflect.DeepEqual(discussions, want) {
+		t.Errorf("Discussions.ListDiscussions returned %+v, want %+v", discussions, want)
+	}
+}
+
+func TestDiscussionsService_GetDiscussion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "discussion(number: $number)", map[string]interface{}{"owner": "o", "name": "r", "number": 3.0})
+		fmt.Fprint(w, `{"data":{"repository":{"discussion":{
+			"id": "D_1", "number": 3,
+			"poll": {"id": "P_1", "question": "Tabs?", "totalVoteCount": 2, "options": {"nodes": [{"id": "PO_1", "option": "Yes", "totalVoteCount": 2}]}}
+		}}}}`)
+	})
+
+	discussion, _, err := client.Discussions.GetDiscussion(context.Background(), "o", "r", 3)
+	if err != nil {
+		t.Fatalf("Discussions.GetDiscussion returned error: %v", err)
+	}
+
+	if got, want := discussion.GetPoll().GetQuestion(), "Tabs?"; got != want {
+		t.Errorf("Discussions.GetDiscussion poll question = %q, want %q", got, want)
+	}
+	wantOptions := []*DiscussionPollOption{{ID: String("PO_1"), Option: String("Yes"), TotalVoteCount: Int(2)}}
+	if !reflect.DeepEqual(discussion.Poll.Options.Nodes, wantOptions) {
+		t.Errorf("Discussions.GetDiscussion poll options = %+v, want %+v", discussion.Poll.Options.Nodes, wantOptions)
+	}
+}
+
+func TestDiscussionsService_GetDiscussion_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"discussion":null}}}`)
+	})
+
+	if _, _, err := client.Discussions.GetDiscussion(context.Background(), "o", "r", 3); err == nil {
+		t.Error("Discussions.GetDiscussion returned no error for a missing discussion")
+	}
+}
+
+func TestDiscussionsService_ListDiscussionCategories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "discussionCategories", map[string]interface{}{"owner": "o", "name": "r", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"repository":{"discussionCategories":{"totalCount":1,"nodes":[{"id":"DIC_1","name":"Ideas","slug":"ideas","emoji":":bulb:","isAnswerable":false}]}}}}`)
+	})
+
+	categories, _, err := client.Discussions.ListDiscussionCategories(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Discussions.ListDiscussionCategories returned error: %v", err)
+	}
+
+	want := &DiscussionCategoryConnection{
+		TotalCount: Int(1),
+		Nodes: []*DiscussionCategory{{
+			ID:           String("DIC_1"),
+			Name:         String("Ideas"),
+			Slug:         String("ideas"),
+			Emoji:        String(":bulb:"),
+			IsAnswerable: Bool(false),
+		}},
+	}
+	if !reflect.DeepEqual(categories, want) {
+		t.Errorf("Discussions.ListDiscussionCategories returned %+v, want %+v", categories, want)
+	}
+}
+
+func TestDiscussionsService_CreateDiscussion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "createDiscussion(input: $input)", map[string]interface{}{
+			"input": map[string]interface{}{"repositoryId": "R_1", "categoryId": "DIC_1", "title": "t", "body": "b"},
+		})
+		fmt.Fprint(w, `{"data":{"createDiscussion":{"discussion":{"id":"D_2","number":4}}}}`)
+	})
+
+	input := &CreateDiscussionInput{RepositoryID: "R_1", CategoryID: "DIC_1", Title: "t", Body: "b"}
+	discussion, _, err := client.Discussions.CreateDiscussion(context.Background(), input)
+	if err != nil {
+		t.Errorf("Discussions.CreateDiscussion returned error: %v", err)
+	}
+
+	want := &Discussion{ID: String("D_2"), Number: Int(4)}
+	if !reflect.DeepEqual(discussion, want) {
+		t.Errorf("Discussions.CreateDiscussion returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestDiscussionsService_moderation(t *testing.T) {
+	tests := []struct {
+		operation string
+		vars      map[string]interface{}
+		call      func(*Client) (*Response, error)
+	}{
+		{
+			"closeDiscussion", map[string]interface{}{"id": "D_1", "reason": "OUTDATED"},
+			func(c *Client) (*Response, error) {
+				return c.Discussions.CloseDiscussion(context.Background(), "D_1", "OUTDATED")
+			},
+		},
+		{
+			"closeDiscussion", map[string]interface{}{"id": "D_1"},
+			func(c *Client) (*Response, error) {
+				return c.Discussions.CloseDiscussion(context.Background(), "D_1", "")
+			},
+		},
+		{
+			"reopenDiscussion", map[string]interface{}{"id": "D_1"},
+			func(c *Client) (*Response, error) { return c.Discussions.ReopenDiscussion(context.Background(), "D_1") },
+		},
+		{
+			"deleteDiscussion", map[string]interface{}{"id": "D_1"},
+			func(c *Client) (*Response, error) { return c.Discussions.DeleteDiscussion(context.Background(), "D_1") },
+		},
+		{
+			"deleteDiscussionComment", map[string]interface{}{"id": "DC_1"},
+			func(c *Client) (*Response, error) {
+				return c.Discussions.DeleteDiscussionComment(context.Background(), "DC_1")
+			},
+		},
+		{
+			"markDiscussionCommentAsAnswer", map[string]interface{}{"id": "DC_1"},
+			func(c *Client) (*Response, error) {
+				return c.Discussions.MarkDiscussionCommentAsAnswer(context.Background(), "DC_1")
+			},
+		},
+		{
+			"unmarkDiscussionCommentAsAnswer", map[string]interface{}{"id": "DC_1"},
+			func(c *Client) (*Response, error) {
+				return c.Discussions.UnmarkDiscussionCommentAsAnswer(context.Background(), "DC_1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		client, mux, _, teardown := setup()
+
+		mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+			testGraphQLRequest(t, r, tt.operation, tt.vars)
+			fmt.Fprint(w, `{"data":{}}`)
+		})
+
+		if _, err := tt.call(client); err != nil {
+			t.Errorf("Discussions %v returned error: %v", tt.operation, err)
+		}
+
+		teardown()
+	}
+}
+
+func TestDiscussionsService_ListDiscussionComments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "comments(first: $first, after: $after)", map[string]interface{}{"id": "D_1", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"node":{"comments":{"totalCount":1,"nodes":[{"id":"DC_1","body":"b","isAnswer":true,"author":{"login":"u"}}]}}}}`)
+	})
+
+	comments, _, err := client.Discussions.ListDiscussionComments(context.Background(), "D_1", nil)
+	if err != nil {
+		t.Errorf("Discussions.ListDiscussionComments returned error: %v", err)
+	}
+
+	want := &RepoDiscussionCommentConnection{
+		TotalCount: Int(1),
+		Nodes: []*RepoDiscussionComment{{
+			ID:       String("DC_1"),
+			Body:     String("b"),
+			IsAnswer: Bool(true),
+			Author:   &DiscussionAuthor{Login: String("u")},
+		}},
+	}
+	if !reflect.DeepEqual(comments, want) {
+		t.Errorf("Discussions.ListDiscussionComments returned %+v, want %+v", comments, want)
+	}
+}
+
+func TestDiscussionsService_AddDiscussionComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "addDiscussionComment", map[string]interface{}{"id": "D_1", "body": "thanks", "replyToId": "DC_1"})
+		fmt.Fprint(w, `{"data":{"addDiscussionComment":{"comment":{"id":"DC_2","body":"thanks"}}}}`)
+	})
+
+	comment, _, err := client.Discussions.AddDiscussionComment(context.Background(), "D_1", "thanks", "DC_1")
+	if err != nil {
+		t.Errorf("Discussions.AddDiscussionComment returned error: %v", err)
+	}
+
+	want := &RepoDiscussionComment{ID: String("DC_2"), Body: String("thanks")}
+	if !reflect.DeepEqual(comment, want) {
+		t.Errorf("Discussions.AddDiscussionComment returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestDiscussionsService_AddDiscussionPollVote(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "addDiscussionPollVote", map[string]interface{}{"id": "PO_1"})
+		fmt.Fprint(w, `{"data":{"addDiscussionPollVote":{"pollOption":{"id":"PO_1","option":"Yes","totalVoteCount":3}}}}`)
+	})
+
+	option, _, err := client.Discussions.AddDiscussionPollVote(context.Background(), "PO_1")
+	if err != nil {
+		t.Errorf("Discussions.AddDiscussionPollVote returned error: %v", err)
+	}
+
+	want := &DiscussionPollOption{ID: String("PO_1"), Option: String("Yes"), TotalVoteCount: Int(3)}
+	if !reflect.DeepEqual(option, want) {
+		t.Errorf("Discussions.AddDiscussionPollVote returned %+v, want %+v", option, want)
+	}
+}
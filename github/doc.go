@@ -114,6 +114,17 @@ To detect an API rate limit error, you can check if its type is *github.RateLimi
 Learn more about GitHub rate limiting at
 https://developer.github.com/v3/#rate-limiting.
 
+Batch Methods
+
+The batch methods, RepositoriesService.GetContentsBatch,
+IssuesService.SyncLabels, GitService.CreateRefs and
+ActionsService.RolloutSecrets, make many requests concurrently. When GitHub
+signals an abuse rate limit, all of their requests wait for the time it asks
+for, and the limited request is retried up to 3 times; Client.RetryCoordinator,
+if set, coordinates them with the other requests of the client. Once the
+primary rate limit is exhausted, the remaining requests fail with a
+*RateLimitError without reaching GitHub.
+
 Accepted Status
 
 Some endpoints may return a 202 Accepted status code, meaning that the
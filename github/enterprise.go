@@ -0,0 +1,13 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// EnterpriseService provides access to the enterprise related functions
+// in the GitHub API. These endpoints operate on an enterprise account of
+// GitHub Enterprise Cloud and are addressed by the enterprise slug.
+//
+// GitHub API docs: https://docs.github.com/en/rest/enterprise-admin
+type EnterpriseService service
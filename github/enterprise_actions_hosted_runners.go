@@ -0,0 +1,145 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListEnterpriseHostedRunners lists the GitHub-hosted runners of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#list-github-hosted-runners-for-an-enterprise
+func (s *EnterpriseService) ListEnterpriseHostedRunners(ctx context.Context, enterprise string, opt *ListOptions) (*HostedRunners, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners", enterprise)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runners := new(HostedRunners)
+	resp, err := s.client.Do(ctx, req, runners)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runners, resp, nil
+}
+
+// GetEnterpriseHostedRunner gets a GitHub-hosted runner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-a-github-hosted-runner-for-an-enterprise
+func (s *EnterpriseService) GetEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners/%v", enterprise, runnerID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// CreateEnterpriseHostedRunner creates a GitHub-hosted runner for an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#create-a-github-hosted-runner-for-an-enterprise
+func (s *EnterpriseService) CreateEnterpriseHostedRunner(ctx context.Context, enterprise string, createReq CreateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners", enterprise)
+	req, err := s.client.NewRequest("POST", u, createReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// UpdateEnterpriseHostedRunner updates the machine size, image, networking or
+// other settings of a GitHub-hosted runner of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#update-a-github-hosted-runner-for-an-enterprise
+func (s *EnterpriseService) UpdateEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64, updateReq UpdateHostedRunnerRequest) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners/%v", enterprise, runnerID)
+	req, err := s.client.NewRequest("PATCH", u, updateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// DeleteEnterpriseHostedRunner deletes a GitHub-hosted runner of an enterprise.
+//
+// GitHub shuts the runner down in a background task, so this method returns
+// an *AcceptedError and a status code of 202 on success, along with the
+// runner being shut down.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#delete-a-github-hosted-runner-for-an-enterprise
+func (s *EnterpriseService) DeleteEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64) (*HostedRunner, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners/%v", enterprise, runnerID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(HostedRunner)
+	resp, err := s.client.Do(ctx, req, runner)
+	if err != nil {
+		// Persist AcceptedError's metadata to the HostedRunner object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, runner); err != nil {
+				return runner, resp, err
+			}
+
+			return runner, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return runner, resp, nil
+}
+
+// ListEnterpriseHostedRunnerMachineSpecs lists the machine sizes available for the
+// GitHub-hosted runners of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/rest/actions/hosted-runners#get-github-hosted-runners-machine-specs-for-an-enterprise
+func (s *EnterpriseService) ListEnterpriseHostedRunnerMachineSpecs(ctx context.Context, enterprise string) (*HostedRunnerMachineSpecs, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/actions/hosted-runners/machine-sizes", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specs := new(HostedRunnerMachineSpecs)
+	resp, err := s.client.Do(ctx, req, specs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return specs, resp, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListEnterpriseHostedRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"runners":[{"id":5}]}`)
+	})
+
+	runners, _, err := client.Enterprise.ListEnterpriseHostedRunners(context.Background(), "e", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Enterprise.ListEnterpriseHostedRunners returned error: %v", err)
+	}
+
+	want := &HostedRunners{TotalCount: 1, Runners: []*HostedRunner{{ID: Int64(5)}}}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Enterprise.ListEnterpriseHostedRunners returned %+v, want %+v", runners, want)
+	}
+}
+
+func TestEnterpriseService_CreateEnterpriseHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/hosted-runners", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"r","size":"4-core"}`+"\n")
+		fmt.Fprint(w, `{"id":5}`)
+	})
+
+	createReq := CreateHostedRunnerRequest{Name: String("r"), Size: String("4-core")}
+	runner, _, err := client.Enterprise.CreateEnterpriseHostedRunner(context.Background(), "e", createReq)
+	if err != nil {
+		t.Errorf("Enterprise.CreateEnterpriseHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5)}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Enterprise.CreateEnterpriseHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestEnterpriseService_UpdateEnterpriseHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"maximum_runners":20}`+"\n")
+		fmt.Fprint(w, `{"id":5,"maximum_runners":20}`)
+	})
+
+	runner, _, err := client.Enterprise.UpdateEnterpriseHostedRunner(context.Background(), "e", 5, UpdateHostedRunnerRequest{MaximumRunners: Int64(20)})
+	if err != nil {
+		t.Errorf("Enterprise.UpdateEnterpriseHostedRunner returned error: %v", err)
+	}
+
+	want := &HostedRunner{ID: Int64(5), MaximumRunners: Int64(20)}
+	if !reflect.DeepEqual(runner, want) {
+		t.Errorf("Enterprise.UpdateEnterpriseHostedRunner returned %+v, want %+v", runner, want)
+	}
+}
+
+func TestEnterpriseService_DeleteEnterpriseHostedRunner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/hosted-runners/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":5}`)
+	})
+
+	runner, _, err := client.Enterprise.DeleteEnterpriseHostedRunner(context.Background(), "e", 5)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Enterprise.DeleteEnterpriseHostedRunner returned error: %v (want AcceptedError)", err)
+	}
+	if got, want := runner.GetID(), int64(5); got != want {
+		t.Errorf("Enterprise.DeleteEnterpriseHostedRunner returned runner %v, want %v", got, want)
+	}
+}
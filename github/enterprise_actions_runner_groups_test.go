@@ -0,0 +1,239 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListRunnerGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "2", "page": "2", "visible_to_organization": "github"})
+		fmt.Fprint(w, `{"total_count":1,"runner_groups":[{"id":1,"name":"Default","visibility":"all","default":true,"runners_url":"https://api.github.com/enterprises/e/actions/runner-groups/1/runners","inherited":false,"allows_public_repositories":true,"restricted_to_workflows":false,"selected_workflows":[]}]}`)
+	})
+
+	opt := &ListEnterpriseRunnerGroupOptions{ListOptions: ListOptions{Page: 2, PerPage: 2}, VisibleToOrganization: "github"}
+	groups, _, err := client.Enterprise.ListRunnerGroups(context.Background(), "e", opt)
+	if err != nil {
+		t.Errorf("Enterprise.ListRunnerGroups returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroups{
+		TotalCount: Int(1),
+		RunnerGroups: []*EnterpriseRunnerGroup{{
+			ID:                       Int64(1),
+			Name:                     String("Default"),
+			Visibility:               String("all"),
+			Default:                  Bool(true),
+			RunnersURL:               String("https://api.github.com/enterprises/e/actions/runner-groups/1/runners"),
+			Inherited:                Bool(false),
+			AllowsPublicRepositories: Bool(true),
+			RestrictedToWorkflows:    Bool(false),
+			SelectedWorkflows:        []string{},
+		}},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Enterprise.ListRunnerGroups returned %+v, want %+v", groups, want)
+	}
+}
+
+func TestEnterpriseService_GetEnterpriseRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected"}`)
+	})
+
+	group, _, err := client.Enterprise.GetEnterpriseRunnerGroup(context.Background(), "e", 2)
+	if err != nil {
+		t.Errorf("Enterprise.GetEnterpriseRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.GetEnterpriseRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_CreateEnterpriseRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"octo-runner-group","visibility":"selected","selected_organization_ids":[1],"runners":[9,2]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":2,"name":"octo-runner-group","visibility":"selected"}`)
+	})
+
+	createReq := CreateEnterpriseRunnerGroupRequest{
+		Name:                    String("octo-runner-group"),
+		Visibility:              String("selected"),
+		SelectedOrganizationIDs: []int64{1},
+		Runners:                 []int64{9, 2},
+	}
+	group, _, err := client.Enterprise.CreateEnterpriseRunnerGroup(context.Background(), "e", createReq)
+	if err != nil {
+		t.Errorf("Enterprise.CreateEnterpriseRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{ID: Int64(2), Name: String("octo-runner-group"), Visibility: String("selected")}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.CreateEnterpriseRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_UpdateEnterpriseRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"name":"renamed","restricted_to_workflows":true,"selected_workflows":["a/b/.github/workflows/ci.yml@main"]}`+"\n")
+		fmt.Fprint(w, `{"id":2,"name":"renamed","restricted_to_workflows":true}`)
+	})
+
+	updateReq := UpdateEnterpriseRunnerGroupRequest{
+		Name:                  String("renamed"),
+		RestrictedToWorkflows: Bool(true),
+		SelectedWorkflows:     []string{"a/b/.github/workflows/ci.yml@main"},
+	}
+	group, _, err := client.Enterprise.UpdateEnterpriseRunnerGroup(context.Background(), "e", 2, updateReq)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateEnterpriseRunnerGroup returned error: %v", err)
+	}
+
+	want := &EnterpriseRunnerGroup{ID: Int64(2), Name: String("renamed"), RestrictedToWorkflows: Bool(true)}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Enterprise.UpdateEnterpriseRunnerGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestEnterpriseService_DeleteEnterpriseRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Enterprise.DeleteEnterpriseRunnerGroup(context.Background(), "e", 2); err != nil {
+		t.Errorf("Enterprise.DeleteEnterpriseRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_OrganizationAccessRunnerGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"page": "1"})
+			fmt.Fprint(w, `{"total_count":1,"organizations":[{"id":1,"login":"octo-org"}]}`)
+		case "PUT":
+			testBody(t, r, `{"selected_organization_ids":[1,2]}`+"\n")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+	})
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/organizations/3", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" && r.Method != "DELETE" {
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	orgs, _, err := client.Enterprise.ListOrganizationAccessRunnerGroup(ctx, "e", 2, &ListOptions{Page: 1})
+	if err != nil {
+		t.Errorf("Enterprise.ListOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+	want := &ListOrganizations{
+		TotalCount:    Int(1),
+		Organizations: []*Organization{{ID: Int64(1), Login: String("octo-org")}},
+	}
+	if !reflect.DeepEqual(orgs, want) {
+		t.Errorf("Enterprise.ListOrganizationAccessRunnerGroup returned %+v, want %+v", orgs, want)
+	}
+
+	ids := SetOrgAccessRunnerGroupRequest{SelectedOrganizationIDs: []int64{1, 2}}
+	if _, err := client.Enterprise.SetOrganizationAccessRunnerGroup(ctx, "e", 2, ids); err != nil {
+		t.Errorf("Enterprise.SetOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+	if _, err := client.Enterprise.AddOrganizationAccessRunnerGroup(ctx, "e", 2, 3); err != nil {
+		t.Errorf("Enterprise.AddOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+	if _, err := client.Enterprise.RemoveOrganizationAccessRunnerGroup(ctx, "e", 2, 3); err != nil {
+		t.Errorf("Enterprise.RemoveOrganizationAccessRunnerGroup returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_RunnerGroupRunners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			testFormValues(t, r, values{"per_page": "10"})
+			fmt.Fprint(w, `{"total_count":1,"runners":[{"id":23,"name":"MBP","os":"macos","status":"online","busy":false,"labels":[{"id":5,"name":"self-hosted","type":"read-only"}]}]}`)
+		case "PUT":
+			testBody(t, r, `{"runners":[23,24]}`+"\n")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+	})
+	mux.HandleFunc("/enterprises/e/actions/runner-groups/2/runners/23", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" && r.Method != "DELETE" {
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	runners, _, err := client.Enterprise.ListRunnerGroupRunners(ctx, "e", 2, &ListOptions{PerPage: 10})
+	if err != nil {
+		t.Errorf("Enterprise.ListRunnerGroupRunners returned error: %v", err)
+	}
+	want := &Runners{
+		TotalCount: 1,
+		Runners: []*Runner{{
+			ID:     Int64(23),
+			Name:   String("MBP"),
+			OS:     String("macos"),
+			Status: String("online"),
+			Busy:   Bool(false),
+			Labels: []*RunnerLabels{{ID: Int64(5), Name: String("self-hosted"), Type: String("read-only")}},
+		}},
+	}
+	if !reflect.DeepEqual(runners, want) {
+		t.Errorf("Enterprise.ListRunnerGroupRunners returned %+v, want %+v", runners, want)
+	}
+
+	if _, err := client.Enterprise.SetRunnerGroupRunners(ctx, "e", 2, SetRunnerGroupRunnersRequest{Runners: []int64{23, 24}}); err != nil {
+		t.Errorf("Enterprise.SetRunnerGroupRunners returned error: %v", err)
+	}
+	if _, err := client.Enterprise.AddRunnerGroupRunners(ctx, "e", 2, 23); err != nil {
+		t.Errorf("Enterprise.AddRunnerGroupRunners returned error: %v", err)
+	}
+	if _, err := client.Enterprise.RemoveRunnerGroupRunners(ctx, "e", 2, 23); err != nil {
+		t.Errorf("Enterprise.RemoveRunnerGroupRunners returned error: %v", err)
+	}
+}
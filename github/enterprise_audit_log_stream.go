@@ -0,0 +1,156 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// AuditLogStreamKey represents the public key used to encrypt secrets,
+// such as tokens, sent in an audit log stream configuration.
+type AuditLogStreamKey struct {
+	KeyID *string `json:"key_id,omitempty"`
+	Key   *string `json:"key,omitempty"`
+}
+
+// AuditLogStream represents an audit log streaming configuration of an
+// enterprise.
+type AuditLogStream struct {
+	ID            *int64     `json:"id,omitempty"`
+	StreamType    *string    `json:"stream_type,omitempty"`
+	StreamDetails *string    `json:"stream_details,omitempty"`
+	Enabled       *bool      `json:"enabled,omitempty"`
+	CreatedAt     *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
+	PausedAt      *Timestamp `json:"paused_at,omitempty"`
+}
+
+func (a AuditLogStream) String() string {
+	return Stringify(a)
+}
+
+// AuditLogStreamConfig represents the configuration used to create or
+// update an audit log stream.
+type AuditLogStreamConfig struct {
+	Enabled bool `json:"enabled"`
+	// StreamType is the name of the streaming provider, such as
+	// "Azure Blob Storage", "Amazon S3", "Splunk" or "Datadog".
+	StreamType string `json:"stream_type"`
+	// VendorSpecific holds the provider-specific configuration. Secrets in
+	// it must be encrypted with the key returned by GetAuditLogStreamKey.
+	VendorSpecific json.RawMessage `json:"vendor_specific"`
+}
+
+// GetAuditLogStreamKey returns the public key used to encrypt secrets in
+// audit log stream configurations of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#get-the-audit-log-stream-key-for-encrypting-secrets
+func (s *EnterpriseService) GetAuditLogStreamKey(ctx context.Context, enterprise string) (*AuditLogStreamKey, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/stream-key", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(AuditLogStreamKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, nil
+}
+
+// ListAuditLogStreams lists the audit log streaming configurations of an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#list-audit-log-stream-configurations-for-an-enterprise
+func (s *EnterpriseService) ListAuditLogStreams(ctx context.Context, enterprise string) ([]*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams", enterprise)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var streams []*AuditLogStream
+	resp, err := s.client.Do(ctx, req, &streams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return streams, resp, nil
+}
+
+// GetAuditLogStream gets a single audit log streaming configuration.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#list-one-audit-log-streaming-configuration-via-a-stream-id
+func (s *EnterpriseService) GetAuditLogStream(ctx context.Context, enterprise string, id int64) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// CreateAuditLogStream creates an audit log streaming configuration.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#create-an-audit-log-streaming-configuration-for-an-enterprise
+func (s *EnterpriseService) CreateAuditLogStream(ctx context.Context, enterprise string, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams", enterprise)
+	req, err := s.client.NewRequest("POST", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// UpdateAuditLogStream updates an existing audit log streaming configuration.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#update-an-existing-audit-log-stream-configuration
+func (s *EnterpriseService) UpdateAuditLogStream(ctx context.Context, enterprise string, id int64, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, id)
+	req, err := s.client.NewRequest("PUT", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(AuditLogStream)
+	resp, err := s.client.Do(ctx, req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, nil
+}
+
+// DeleteAuditLogStream deletes an audit log streaming configuration.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/audit-log#delete-an-audit-log-streaming-configuration-for-an-enterprise
+func (s *EnterpriseService) DeleteAuditLogStream(ctx context.Context, enterprise string, id int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/audit-log/streams/%v", enterprise, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
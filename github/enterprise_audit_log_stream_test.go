@@ -0,0 +1,127 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnterpriseService_GetAuditLogStreamKey(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/stream-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key_id":"123","key":"2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234"}`)
+	})
+
+	key, _, err := client.Enterprise.GetAuditLogStreamKey(context.Background(), "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLogStreamKey returned error: %v", err)
+	}
+
+	want := &AuditLogStreamKey{KeyID: String("123"), Key: String("2Sg8iYjAxxmI2LvUXpJjkYrMxURPc8r+dB7TJyvv1234")}
+	if !reflect.DeepEqual(key, want) {
+		t.Errorf("Enterprise.GetAuditLogStreamKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestEnterpriseService_ListAuditLogStreams(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"stream_type":"Splunk","stream_details":"US","enabled":true,"created_at":"2024-06-06T08:00:00Z","updated_at":"2024-06-06T08:00:00Z"}]`)
+	})
+
+	streams, _, err := client.Enterprise.ListAuditLogStreams(context.Background(), "e")
+	if err != nil {
+		t.Errorf("Enterprise.ListAuditLogStreams returned error: %v", err)
+	}
+
+	ts := &Timestamp{time.Date(2024, time.June, 6, 8, 0, 0, 0, time.UTC)}
+	want := []*AuditLogStream{{
+		ID:            Int64(1),
+		StreamType:    String("Splunk"),
+		StreamDetails: String("US"),
+		Enabled:       Bool(true),
+		CreatedAt:     ts,
+		UpdatedAt:     ts,
+	}}
+	if !reflect.DeepEqual(streams, want) {
+		t.Errorf("Enterprise.ListAuditLogStreams returned %+v, want %+v", streams, want)
+	}
+}
+
+func TestEnterpriseService_AuditLogStreamCRUD(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	config := &AuditLogStreamConfig{
+		Enabled:        true,
+		StreamType:     "Splunk",
+		VendorSpecific: json.RawMessage(`{"domain":"splunk.example.com","port":8088,"key_id":"123","encrypted_token":"abc","ssl_verify":true}`),
+	}
+	wantBody := `{"enabled":true,"stream_type":"Splunk","vendor_specific":{"domain":"splunk.example.com","port":8088,"key_id":"123","encrypted_token":"abc","ssl_verify":true}}` + "\n"
+
+	mux.HandleFunc("/enterprises/e/audit-log/streams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, wantBody)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"stream_type":"Splunk","enabled":true}`)
+	})
+	mux.HandleFunc("/enterprises/e/audit-log/streams/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"id":1,"stream_type":"Splunk","enabled":true}`)
+		case "PUT":
+			testBody(t, r, wantBody)
+			fmt.Fprint(w, `{"id":1,"stream_type":"Splunk","enabled":true}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %v, unexpected", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	want := &AuditLogStream{ID: Int64(1), StreamType: String("Splunk"), Enabled: Bool(true)}
+
+	stream, _, err := client.Enterprise.CreateAuditLogStream(ctx, "e", config)
+	if err != nil {
+		t.Errorf("Enterprise.CreateAuditLogStream returned error: %v", err)
+	}
+	if !reflect.DeepEqual(stream, want) {
+		t.Errorf("Enterprise.CreateAuditLogStream returned %+v, want %+v", stream, want)
+	}
+
+	stream, _, err = client.Enterprise.GetAuditLogStream(ctx, "e", 1)
+	if err != nil {
+		t.Errorf("Enterprise.GetAuditLogStream returned error: %v", err)
+	}
+	if !reflect.DeepEqual(stream, want) {
+		t.Errorf("Enterprise.GetAuditLogStream returned %+v, want %+v", stream, want)
+	}
+
+	stream, _, err = client.Enterprise.UpdateAuditLogStream(ctx, "e", 1, config)
+	if err != nil {
+		t.Errorf("Enterprise.UpdateAuditLogStream returned error: %v", err)
+	}
+	if !reflect.DeepEqual(stream, want) {
+		t.Errorf("Enterprise.UpdateAuditLogStream returned %+v, want %+v", stream, want)
+	}
+
+	if _, err := client.Enterprise.DeleteAuditLogStream(ctx, "e", 1); err != nil {
+		t.Errorf("Enterprise.DeleteAuditLogStream returned error: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetCodeSecurityAndAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/code_security_and_analysis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"advanced_security_enabled_for_new_repositories": true,
+			"secret_scanning_enabled_for_new_repositories": true,
+			"secret_scanning_push_protection_enabled_for_new_repositories": true,
+			"secret_scanning_push_protection_custom_link": "https://github.com/test-org/test-repo/blob/main/README.md"
+		}`)
+	})
+
+	settings, _, err := client.Enterprise.GetCodeSecurityAndAnalysis(context.Background(), "e")
+	if err != nil {
+		t.Errorf("Enterprise.GetCodeSecurityAndAnalysis returned error: %v", err)
+	}
+
+	want := &EnterpriseSecurityAnalysisSettings{
+		AdvancedSecurityEnabledForNewRepositories:             Bool(true),
+		SecretScanningEnabledForNewRepositories:               Bool(true),
+		SecretScanningPushProtectionEnabledForNewRepositories: Bool(true),
+		SecretScanningPushProtectionCustomLink:                String("https://github.com/test-org/test-repo/blob/main/README.md"),
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Enterprise.GetCodeSecurityAndAnalysis returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestEnterpriseService_UpdateCodeSecurityAndAnalysis(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/code_security_and_analysis", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"advanced_security_enabled_for_new_repositories":false,"secret_scanning_enabled_for_new_repositories":true}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	settings := &EnterpriseSecurityAnalysisSettings{
+		AdvancedSecurityEnabledForNewRepositories: Bool(false),
+		SecretScanningEnabledForNewRepositories:   Bool(true),
+	}
+	if _, err := client.Enterprise.UpdateCodeSecurityAndAnalysis(context.Background(), "e", settings); err != nil {
+		t.Errorf("Enterprise.UpdateCodeSecurityAndAnalysis returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_EnableDisableSecurityFeature(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/advanced_security/enable_all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Enterprise.EnableDisableSecurityFeature(context.Background(), "e", "advanced_security", "enable_all"); err != nil {
+		t.Errorf("Enterprise.EnableDisableSecurityFeature returned error: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnterpriseConsumedLicenses represents the license consumption report of
+// an enterprise.
+type EnterpriseConsumedLicenses struct {
+	TotalSeatsConsumed  int                        `json:"total_seats_consumed"`
+	TotalSeatsPurchased int                        `json:"total_seats_purchased"`
+	Users               []*EnterpriseLicensedUsers `json:"users,omitempty"`
+}
+
+// EnterpriseLicensedUsers represents a user consuming a license seat of an
+// enterprise, on GitHub.com, GitHub Enterprise Server or both.
+type EnterpriseLicensedUsers struct {
+	GithubComLogin                  string   `json:"github_com_login"`
+	GithubComName                   *string  `json:"github_com_name"`
+	EnterpriseServerUserIDs         []string `json:"enterprise_server_user_ids"`
+	GithubComUser                   bool     `json:"github_com_user"`
+	EnterpriseServerUser            *bool    `json:"enterprise_server_user"`
+	VisualStudioSubscriptionUser    bool     `json:"visual_studio_subscription_user"`
+	LicenseType                     string   `json:"license_type"`
+	GithubComProfile                *string  `json:"github_com_profile"`
+	GithubComMemberRoles            []string `json:"github_com_member_roles"`
+	GithubComEnterpriseRoles        []string `json:"github_com_enterprise_roles"`
+	GithubComVerifiedDomainEmails   []string `json:"github_com_verified_domain_emails"`
+	GithubComSamlNameID             *string  `json:"github_com_saml_name_id"`
+	GithubComOrgsWithPendingInvites []string `json:"github_com_orgs_with_pending_invites"`
+	GithubComTwoFactorAuth          *bool    `json:"github_com_two_factor_auth"`
+	EnterpriseServerEmails          []string `json:"enterprise_server_emails"`
+	VisualStudioLicenseStatus       *string  `json:"visual_studio_license_status"`
+	VisualStudioSubscriptionEmail   *string  `json:"visual_studio_subscription_email"`
+	TotalUserAccounts               int      `json:"total_user_accounts"`
+}
+
+// GetConsumedLicenses returns the license consumption of an enterprise,
+// including the users that consume a seat.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/enterprise-admin/license#list-enterprise-consumed-licenses
+func (s *EnterpriseService) GetConsumedLicenses(ctx context.Context, enterprise string, opt *ListOptions) (*EnterpriseConsumedLicenses, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/consumed-licenses", enterprise)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	licenses := new(EnterpriseConsumedLicenses)
+	resp, err := s.client.Do(ctx, req, licenses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return licenses, resp, nil
+}
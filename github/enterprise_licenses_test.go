@@ -0,0 +1,63 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_GetConsumedLicenses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/consumed-licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{
+			"total_seats_consumed": 20,
+			"total_seats_purchased": 25,
+			"users": [{
+				"github_com_login": "user1",
+				"github_com_name": null,
+				"enterprise_server_user_ids": ["123"],
+				"github_com_user": true,
+				"enterprise_server_user": false,
+				"visual_studio_subscription_user": false,
+				"license_type": "enterprise",
+				"github_com_member_roles": ["org1:Owner"],
+				"github_com_two_factor_auth": true,
+				"total_user_accounts": 1
+			}]
+		}`)
+	})
+
+	licenses, _, err := client.Enterprise.GetConsumedLicenses(context.Background(), "e", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Enterprise.GetConsumedLicenses returned error: %v", err)
+	}
+
+	want := &EnterpriseConsumedLicenses{
+		TotalSeatsConsumed:  20,
+		TotalSeatsPurchased: 25,
+		Users: []*EnterpriseLicensedUsers{{
+			GithubComLogin:          "user1",
+			EnterpriseServerUserIDs: []string{"123"},
+			GithubComUser:           true,
+			EnterpriseServerUser:    Bool(false),
+			LicenseType:             "enterprise",
+			GithubComMemberRoles:    []string{"org1:Owner"},
+			GithubComTwoFactorAuth:  Bool(true),
+			TotalUserAccounts:       1,
+		}},
+	}
+	if !reflect.DeepEqual(licenses, want) {
+		t.Errorf("Enterprise.GetConsumedLicenses returned %+v, want %+v", licenses, want)
+	}
+}
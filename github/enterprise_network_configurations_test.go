@@ -0,0 +1,90 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListEnterpriseNetworkConfigurations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"network_configurations":[{"id":"123"}]}`)
+	})
+
+	configurations, _, err := client.Enterprise.ListEnterpriseNetworkConfigurations(context.Background(), "e", nil)
+	if err != nil {
+		t.Errorf("Enterprise.ListEnterpriseNetworkConfigurations returned error: %v", err)
+	}
+
+	want := &NetworkConfigurations{TotalCount: Int(1), NetworkConfigurations: []*NetworkConfiguration{{ID: String("123")}}}
+	if !reflect.DeepEqual(configurations, want) {
+		t.Errorf("Enterprise.ListEnterpriseNetworkConfigurations returned %+v, want %+v", configurations, want)
+	}
+}
+
+func TestEnterpriseService_CreateEnterpriseNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"c","network_settings_ids":["456"]}`+"\n")
+		fmt.Fprint(w, `{"id":"123"}`)
+	})
+
+	createReq := NetworkConfigurationRequest{Name: String("c"), NetworkSettingsIDs: []string{"456"}}
+	configuration, _, err := client.Enterprise.CreateEnterpriseNetworkConfiguration(context.Background(), "e", createReq)
+	if err != nil {
+		t.Errorf("Enterprise.CreateEnterpriseNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("123")}
+	if !reflect.DeepEqual(configuration, want) {
+		t.Errorf("Enterprise.CreateEnterpriseNetworkConfiguration returned %+v, want %+v", configuration, want)
+	}
+}
+
+func TestEnterpriseService_DeleteEnterpriseNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-configurations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Enterprise.DeleteEnterpriseNetworkConfiguration(context.Background(), "e", "123"); err != nil {
+		t.Errorf("Enterprise.DeleteEnterpriseNetworkConfiguration returned error: %v", err)
+	}
+}
+
+func TestEnterpriseService_GetEnterpriseNetworkSettingsResource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/network-settings/456", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"456","region":"eastus"}`)
+	})
+
+	resource, _, err := client.Enterprise.GetEnterpriseNetworkSettingsResource(context.Background(), "e", "456")
+	if err != nil {
+		t.Errorf("Enterprise.GetEnterpriseNetworkSettingsResource returned error: %v", err)
+	}
+
+	want := &NetworkSettingsResource{ID: String("456"), Region: String("eastus")}
+	if !reflect.DeepEqual(resource, want) {
+		t.Errorf("Enterprise.GetEnterpriseNetworkSettingsResource returned %+v, want %+v", resource, want)
+	}
+}
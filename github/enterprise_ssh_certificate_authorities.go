@@ -0,0 +1,70 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListSSHCertificateAuthorities lists the SSH certificate authorities of an
+// enterprise, which apply to all of its organizations.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/admin/policies/enforcing-policies-for-your-enterprise/enforcing-policies-for-security-settings-in-your-enterprise#managing-ssh-certificate-authorities-for-your-enterprise
+func (s *EnterpriseService) ListSSHCertificateAuthorities(ctx context.Context, enterprise string, opt *ListOptions) ([]*SSHCertificateAuthority, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/ssh-certificate-authorities", enterprise)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var authorities []*SSHCertificateAuthority
+	resp, err := s.client.Do(ctx, req, &authorities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authorities, resp, nil
+}
+
+// CreateSSHCertificateAuthority adds the SSH certificate authority with the
+// given public key to an enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/admin/policies/enforcing-policies-for-your-enterprise/enforcing-policies-for-security-settings-in-your-enterprise#managing-ssh-certificate-authorities-for-your-enterprise
+func (s *EnterpriseService) CreateSSHCertificateAuthority(ctx context.Context, enterprise, key string) (*SSHCertificateAuthority, *Response, error) {
+	u := fmt.Sprintf("enterprises/%v/ssh-certificate-authorities", enterprise)
+	req, err := s.client.NewRequest("POST", u, &sshCertificateAuthorityRequest{Key: key})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authority := new(SSHCertificateAuthority)
+	resp, err := s.client.Do(ctx, req, authority)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authority, resp, nil
+}
+
+// DeleteSSHCertificateAuthority deletes an SSH certificate authority of an
+// enterprise.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/admin/policies/enforcing-policies-for-your-enterprise/enforcing-policies-for-security-settings-in-your-enterprise#managing-ssh-certificate-authorities-for-your-enterprise
+func (s *EnterpriseService) DeleteSSHCertificateAuthority(ctx context.Context, enterprise string, id int64) (*Response, error) {
+	u := fmt.Sprintf("enterprises/%v/ssh-certificate-authorities/%v", enterprise, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,69 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestEnterpriseService_ListSSHCertificateAuthorities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/ssh-certificate-authorities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	authorities, _, err := client.Enterprise.ListSSHCertificateAuthorities(context.Background(), "e", nil)
+	if err != nil {
+		t.Errorf("Enterprise.ListSSHCertificateAuthorities returned error: %v", err)
+	}
+
+	want := []*SSHCertificateAuthority{{ID: Int64(1)}}
+	if !reflect.DeepEqual(authorities, want) {
+		t.Errorf("Enterprise.ListSSHCertificateAuthorities returned %+v, want %+v", authorities, want)
+	}
+}
+
+func TestEnterpriseService_CreateSSHCertificateAuthority(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/ssh-certificate-authorities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"ssh-ed25519 AAAA"}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	authority, _, err := client.Enterprise.CreateSSHCertificateAuthority(context.Background(), "e", "ssh-ed25519 AAAA")
+	if err != nil {
+		t.Errorf("Enterprise.CreateSSHCertificateAuthority returned error: %v", err)
+	}
+
+	if want := (&SSHCertificateAuthority{ID: Int64(1)}); !reflect.DeepEqual(authority, want) {
+		t.Errorf("Enterprise.CreateSSHCertificateAuthority returned %+v, want %+v", authority, want)
+	}
+}
+
+func TestEnterpriseService_DeleteSSHCertificateAuthority(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/enterprises/e/ssh-certificate-authorities/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Enterprise.DeleteSSHCertificateAuthority(context.Background(), "e", 1)
+	if err != nil {
+		t.Errorf("Enterprise.DeleteSSHCertificateAuthority returned error: %v", err)
+	}
+}
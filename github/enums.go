@@ -0,0 +1,62 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// The constants below name the values accepted by free-form string option
+// fields such as RepositoryListOptions.Sort or PullRequestOptions.MergeMethod.
+// They are untyped so that they can be assigned to the existing string
+// fields, while a misspelled name is caught at compile time:
+//
+//	opt := &github.RepositoryListOptions{
+//		Type:      github.RepoTypeOwner,
+//		Sort:      github.SortUpdated,
+//		Direction: github.DirectionDesc,
+//	}
+
+// Sort directions, used by the Direction field of list options.
+const (
+	DirectionAsc  = "asc"
+	DirectionDesc = "desc"
+)
+
+// Sort orders, used by the Sort field of list options. Not every endpoint
+// supports every order; see the documentation of the options struct.
+const (
+	SortCreated  = "created"
+	SortUpdated  = "updated"
+	SortPushed   = "pushed"
+	SortFullName = "full_name"
+	SortComments = "comments"
+)
+
+// Repository types, used by RepositoryListOptions.Type and
+// RepositoryListByOrgOptions.Type.
+const (
+	RepoTypeAll      = "all"
+	RepoTypeOwner    = "owner"
+	RepoTypePublic   = "public"
+	RepoTypePrivate  = "private"
+	RepoTypeMember   = "member"
+	RepoTypeForks    = "forks"
+	RepoTypeSources  = "sources"
+	RepoTypeInternal = "internal"
+)
+
+// Issue and pull request states, used by the State field of
+// IssueListOptions, IssueListByRepoOptions and PullRequestListOptions, and
+// by IssueRequest.State.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+	StateAll    = "all"
+)
+
+// Pull request merge methods, used by PullRequestOptions.MergeMethod.
+const (
+	MergeMethodMerge  = "merge"
+	MergeMethodSquash = "squash"
+	MergeMethodRebase = "rebase"
+)
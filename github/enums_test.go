@@ -0,0 +1,44 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestEnums_listOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"type": "owner", "sort": "full_name", "direction": "asc"})
+		w.Write([]byte(`[]`))
+	})
+
+	opt := &RepositoryListOptions{Type: RepoTypeOwner, Sort: SortFullName, Direction: DirectionAsc}
+	if _, _, err := client.Repositories.List(context.Background(), "", opt); err != nil {
+		t.Errorf("Repositories.List returned error: %v", err)
+	}
+}
+
+func TestEnums_mergeMethod(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"commit_message":"m","merge_method":"squash"}`+"\n")
+		w.Write([]byte(`{"merged":true}`))
+	})
+
+	opt := &PullRequestOptions{MergeMethod: MergeMethodSquash}
+	if _, _, err := client.PullRequests.Merge(context.Background(), "o", "r", 1, "m", opt); err != nil {
+		t.Errorf("PullRequests.Merge returned error: %v", err)
+	}
+}
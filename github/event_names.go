@@ -0,0 +1,154 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+// Webhook event names, as sent in the X-GitHub-Event header and accepted by
+// ParseWebHook.
+const (
+	EventCheckRun                     = "check_run"
+	EventCheckSuite                   = "check_suite"
+	EventCommitComment                = "commit_comment"
+	EventCreate                       = "create"
+	EventDelete                       = "delete"
+	EventDeployKey                    = "deploy_key"
+	EventDeployment                   = "deployment"
+	EventDeploymentStatus             = "deployment_status"
+	EventFork                         = "fork"
+	EventGollum                       = "gollum"
+	EventInstallation                 = "installation"
+	EventInstallationRepositories     = "installation_repositories"
+	EventIssueComment                 = "issue_comment"
+	EventIssues                       = "issues"
+	EventLabel                        = "label"
+	EventMarketplacePurchase          = "marketplace_purchase"
+	EventMember                       = "member"
+	EventMembership                   = "membership"
+	EventMeta                         = "meta"
+	EventMilestone                    = "milestone"
+	EventOrgBlock                     = "org_block"
+	EventOrganization                 = "organization"
+	EventPageBuild                    = "page_build"
+	EventPing                         = "ping"
+	EventProject                      = "project"
+	EventProjectCard                  = "project_card"
+	EventProjectColumn                = "project_column"
+	EventPublic                       = "public"
+	EventPullRequest                  = "pull_request"
+	EventPullRequestReview            = "pull_request_review"
+	EventPullRequestReviewComment     = "pull_request_review_comment"
+	EventPush                         = "push"
+	EventRelease                      = "release"
+	EventRepository                   = "repository"
+	EventRepositoryVulnerabilityAlert = "repository_vulnerability_alert"
+	EventStar                         = "star"
+	EventStatus                       = "status"
+	EventTeam                         = "team"
+	EventTeamAdd                      = "team_add"
+	EventWatch                        = "watch"
+)
+
+// Values of the action field of webhook event payloads. EventActions lists
+// the actions sent with each event.
+const (
+	ActionAdded                  = "added"
+	ActionAddedToRepository      = "added_to_repository"
+	ActionArchived               = "archived"
+	ActionAssigned               = "assigned"
+	ActionBlocked                = "blocked"
+	ActionCancelled              = "cancelled"
+	ActionChanged                = "changed"
+	ActionClosed                 = "closed"
+	ActionCompleted              = "completed"
+	ActionConverted              = "converted"
+	ActionCreate                 = "create"
+	ActionCreated                = "created"
+	ActionDeleted                = "deleted"
+	ActionDemilestoned           = "demilestoned"
+	ActionDismiss                = "dismiss"
+	ActionDismissed              = "dismissed"
+	ActionEdited                 = "edited"
+	ActionLabeled                = "labeled"
+	ActionLocked                 = "locked"
+	ActionMemberAdded            = "member_added"
+	ActionMemberInvited          = "member_invited"
+	ActionMemberRemoved          = "member_removed"
+	ActionMilestoned             = "milestoned"
+	ActionMoved                  = "moved"
+	ActionNewPermissionsAccepted = "new_permissions_accepted"
+	ActionOpened                 = "opened"
+	ActionPendingChange          = "pending_change"
+	ActionPendingChangeCancelled = "pending_change_cancelled"
+	ActionPinned                 = "pinned"
+	ActionPrereleased            = "prereleased"
+	ActionPrivatized             = "privatized"
+	ActionPublicized             = "publicized"
+	ActionPublished              = "published"
+	ActionPurchased              = "purchased"
+	ActionReadyForReview         = "ready_for_review"
+	ActionRemoved                = "removed"
+	ActionRemovedFromRepository  = "removed_from_repository"
+	ActionRenamed                = "renamed"
+	ActionReopened               = "reopened"
+	ActionRequested              = "requested"
+	ActionRequestedAction        = "requested_action"
+	ActionRerequested            = "rerequested"
+	ActionResolve                = "resolve"
+	ActionReviewRequestRemoved   = "review_request_removed"
+	ActionReviewRequested        = "review_requested"
+	ActionStarted                = "started"
+	ActionSubmitted              = "submitted"
+	ActionSynchronize            = "synchronize"
+	ActionTransferred            = "transferred"
+	ActionUnarchived             = "unarchived"
+	ActionUnassigned             = "unassigned"
+	ActionUnblocked              = "unblocked"
+	ActionUnlabeled              = "unlabeled"
+	ActionUnlocked               = "unlocked"
+	ActionUnpinned               = "unpinned"
+	ActionUnpublished            = "unpublished"
+)
+
+// eventActions maps event names to the actions sent with them. Events
+// without actions are not listed.
+var eventActions = map[string][]string{
+	EventCheckRun:                     {ActionCreated, ActionCompleted, ActionRerequested, ActionRequestedAction},
+	EventCheckSuite:                   {ActionCompleted, ActionRequested, ActionRerequested},
+	EventDeployKey:                    {ActionCreated, ActionDeleted},
+	EventInstallation:                 {ActionCreated, ActionDeleted, ActionNewPermissionsAccepted},
+	EventInstallationRepositories:     {ActionAdded, ActionRemoved},
+	EventIssueComment:                 {ActionCreated, ActionEdited, ActionDeleted},
+	EventIssues:                       {ActionOpened, ActionEdited, ActionDeleted, ActionPinned, ActionUnpinned, ActionClosed, ActionReopened, ActionAssigned, ActionUnassigned, ActionLabeled, ActionUnlabeled, ActionLocked, ActionUnlocked, ActionTransferred, ActionMilestoned, ActionDemilestoned},
+	EventLabel:                        {ActionCreated, ActionEdited, ActionDeleted},
+	EventMarketplacePurchase:          {ActionPurchased, ActionPendingChange, ActionPendingChangeCancelled, ActionChanged, ActionCancelled},
+	EventMember:                       {ActionAdded, ActionRemoved, ActionEdited},
+	EventMembership:                   {ActionAdded, ActionRemoved},
+	EventMeta:                         {ActionDeleted},
+	EventMilestone:                    {ActionCreated, ActionClosed, ActionOpened, ActionEdited, ActionDeleted},
+	EventOrgBlock:                     {ActionBlocked, ActionUnblocked},
+	EventOrganization:                 {ActionDeleted, ActionRenamed, ActionMemberAdded, ActionMemberRemoved, ActionMemberInvited},
+	EventProject:                      {ActionCreated, ActionEdited, ActionClosed, ActionReopened, ActionDeleted},
+	EventProjectCard:                  {ActionCreated, ActionEdited, ActionMoved, ActionConverted, ActionDeleted},
+	EventProjectColumn:                {ActionCreated, ActionEdited, ActionMoved, ActionDeleted},
+	EventPullRequest:                  {ActionAssigned, ActionUnassigned, ActionReviewRequested, ActionReviewRequestRemoved, ActionLabeled, ActionUnlabeled, ActionOpened, ActionEdited, ActionClosed, ActionReadyForReview, ActionLocked, ActionUnlocked, ActionReopened, ActionSynchronize},
+	EventPullRequestReview:            {ActionSubmitted, ActionEdited, ActionDismissed},
+	EventPullRequestReviewComment:     {ActionCreated, ActionEdited, ActionDeleted},
+	EventRelease:                      {ActionPublished, ActionUnpublished, ActionCreated, ActionEdited, ActionDeleted, ActionPrereleased},
+	EventRepository:                   {ActionCreated, ActionDeleted, ActionArchived, ActionUnarchived, ActionEdited, ActionRenamed, ActionTransferred, ActionPublicized, ActionPrivatized},
+	EventRepositoryVulnerabilityAlert: {ActionCreate, ActionDismiss, ActionResolve},
+	EventStar:                         {ActionCreated, ActionDeleted},
+	EventTeam:                         {ActionCreated, ActionDeleted, ActionEdited, ActionAddedToRepository, ActionRemovedFromRepository},
+	EventWatch:                        {ActionStarted},
+}
+
+// EventActions returns the values of the action field sent with the webhook
+// event named eventType, or nil if the event has no action field.
+func EventActions(eventType string) []string {
+	actions := eventActions[eventType]
+	if actions == nil {
+		return nil
+	}
+	return append([]string(nil), actions...)
+}
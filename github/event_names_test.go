@@ -0,0 +1,53 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEventActions(t *testing.T) {
+	for event := range eventActions {
+		if _, ok := eventTypeMapping[event]; !ok {
+			t.Errorf("eventActions lists unknown event %q", event)
+		}
+	}
+
+	got := EventActions(EventPullRequestReview)
+	want := []string{ActionSubmitted, ActionEdited, ActionDismissed}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EventActions(%q) = %v, want %v", EventPullRequestReview, got, want)
+	}
+
+	// The returned slice must not alias the table.
+	got[0] = "x"
+	if EventActions(EventPullRequestReview)[0] != ActionSubmitted {
+		t.Error("EventActions returned a slice aliasing its table")
+	}
+
+	if got := EventActions(EventPush); got != nil {
+		t.Errorf("EventActions(%q) = %v, want nil", EventPush, got)
+	}
+}
+
+func TestEventNames(t *testing.T) {
+	for _, event := range []string{
+		EventCheckRun, EventCheckSuite, EventCommitComment, EventCreate, EventDelete,
+		EventDeployKey, EventDeployment, EventDeploymentStatus, EventFork, EventGollum,
+		EventInstallation, EventInstallationRepositories, EventIssueComment, EventIssues,
+		EventLabel, EventMarketplacePurchase, EventMember, EventMembership, EventMeta,
+		EventMilestone, EventOrganization, EventOrgBlock, EventPageBuild, EventPing,
+		EventProject, EventProjectCard, EventProjectColumn, EventPublic, EventPullRequest,
+		EventPullRequestReview, EventPullRequestReviewComment, EventPush, EventRelease,
+		EventRepository, EventRepositoryVulnerabilityAlert, EventStar, EventStatus,
+		EventTeam, EventTeamAdd, EventWatch,
+	} {
+		if _, ok := eventTypeMapping[event]; !ok {
+			t.Errorf("event name %q is not known to ParseWebHook", event)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// gen-interfaces generates an interface for each service, listing all of
+// its exported methods, so that users can substitute fakes for services in
+// their own tests.
+//
+// It is meant to be used by the go-github authors in conjunction with the
+// go generate tool before sending a commit to GitHub.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileSuffix = "-interfaces.go"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			filename: pkgName + fileSuffix,
+			Year:     2019,
+			Package:  pkgName,
+			Imports:  map[string]string{},
+			services: map[string]*service{},
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.processServices(f)
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing methods of %v...", filename)
+			if err := t.processMethods(fset, f); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+// processServices records every "type XService service" declaration.
+func (t *templateData) processServices(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			if id, ok := ts.Type.(*ast.Ident); ok && id.Name == "service" {
+				t.services[ts.Name.Name] = &service{Name: ts.Name.Name}
+			}
+		}
+	}
+}
+
+// processMethods adds the exported methods declared in f to their service.
+func (t *templateData) processMethods(fset *token.FileSet, f *ast.File) error {
+	imports := map[string]string{}
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return err
+		}
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+			continue
+		}
+		recv := fd.Recv.List[0].Type
+		if se, ok := recv.(*ast.StarExpr); ok {
+			recv = se.X
+		}
+		id, ok := recv.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		svc, ok := t.services[id.Name]
+		if !ok {
+			continue
+		}
+
+		ast.Inspect(fd.Type, func(n ast.Node) bool {
+			if se, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := se.X.(*ast.Ident); ok {
+					if path, ok := imports[x.Name]; ok {
+						t.Imports[path] = path
+					}
+				}
+			}
+			return true
+		})
+
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, fd.Type); err != nil {
+			return err
+		}
+		sig := strings.TrimPrefix(buf.String(), "func")
+		svc.Methods = append(svc.Methods, &method{Name: fd.Name.Name, Signature: sig})
+	}
+	return nil
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix)
+}
+
+func (t *templateData) dump() error {
+	for _, svc := range t.services {
+		if len(svc.Methods) == 0 {
+			logf("Service %v has no methods; skipping.", svc.Name)
+			continue
+		}
+		sort.Slice(svc.Methods, func(i, j int) bool { return svc.Methods[i].Name < svc.Methods[j].Name })
+		t.Services = append(t.Services, svc)
+	}
+	if len(t.Services) == 0 {
+		logf("No services for %v; skipping.", t.filename)
+		return nil
+	}
+	sort.Slice(t.Services, func(i, j int) bool { return t.Services[i].Name < t.Services[j].Name })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", t.filename)
+	return ioutil.WriteFile(t.filename, clean, 0644)
+}
+
+type templateData struct {
+	filename string
+	services map[string]*service
+	Year     int
+	Package  string
+	Imports  map[string]string
+	Services []*service
+}
+
+type service struct {
+	Name    string
+	Methods []*method
+}
+
+type method struct {
+	Name      string
+	Signature string
+}
+
+const source = `// Copyright {{.Year}} The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-interfaces; DO NOT EDIT.
+
+package {{.Package}}
+{{with .Imports}}
+import (
+  {{- range . -}}
+  "{{.}}"
+  {{end -}}
+)
+{{end}}
+{{range .Services}}
+// {{.Name}}Interface lists the methods of {{.Name}}, so that it can be
+// replaced by a fake in tests. See {{.Name}} for documentation.
+type {{.Name}}Interface interface {
+  {{- range .Methods}}
+  {{.Name}}{{.Signature}}
+  {{- end}}
+}
+
+var _ {{.Name}}Interface = (*{{.Name}})(nil)
+{{end}}
+`
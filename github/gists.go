@@ -31,6 +31,10 @@ type Gist struct {
 	CreatedAt   *time.Time                `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time                `json:"updated_at,omitempty"`
 	NodeID      *string                   `json:"node_id,omitempty"`
+
+	// Truncated is set when Files holds only the first 300 files of the
+	// gist. Clone the gist from GitPullURL to get all of them.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g Gist) String() string {
@@ -48,6 +52,10 @@ type GistFile struct {
 	Type     *string `json:"type,omitempty"`
 	RawURL   *string `json:"raw_url,omitempty"`
 	Content  *string `json:"content,omitempty"`
+
+	// Truncated is set when Content holds only the first megabyte of the
+	// file. Use GistsService.DownloadFile to get all of it.
+	Truncated *bool `json:"truncated,omitempty"`
 }
 
 func (g GistFile) String() string {
@@ -0,0 +1,47 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// DownloadFile returns an io.ReadCloser that reads the whole content of a
+// file of a gist. It is the caller's responsibility to close the ReadCloser.
+//
+// Gists returned by the API hold at most one megabyte of the content of each
+// file. If file holds all of it, it is read from file; otherwise, it is
+// streamed from the raw URL of the file, which serves files of up to ten
+// megabytes. Larger files can only be retrieved by cloning the gist from its
+// GitPullURL.
+func (s *GistsService) DownloadFile(ctx context.Context, file *GistFile) (io.ReadCloser, error) {
+	if file.Content != nil && !file.GetTruncated() {
+		return ioutil.NopCloser(strings.NewReader(*file.Content)), nil
+	}
+	if file.GetRawURL() == "" {
+		return nil, errors.New("github: gist file has no raw URL")
+	}
+
+	req, err := http.NewRequest("GET", file.GetRawURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.client.Do(withContext(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
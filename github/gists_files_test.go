@@ -0,0 +1,65 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestGistsService_DownloadFile(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/raw/f.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "full content")
+	})
+
+	tests := []struct {
+		name string
+		file *GistFile
+		want string
+	}{
+		{"complete", &GistFile{Content: String("c"), RawURL: String(serverURL + baseURLPath + "/raw/x")}, "c"},
+		{"truncated", &GistFile{Content: String("full"), Truncated: Bool(true), RawURL: String(serverURL + baseURLPath + "/raw/f.txt")}, "full content"},
+		{"no content", &GistFile{RawURL: String(serverURL + baseURLPath + "/raw/f.txt")}, "full content"},
+	}
+	for _, tt := range tests {
+		rc, err := client.Gists.DownloadFile(context.Background(), tt.file)
+		if err != nil {
+			t.Fatalf("Gists.DownloadFile(%v) returned error: %v", tt.name, err)
+		}
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("Gists.DownloadFile(%v) read error: %v", tt.name, err)
+		}
+		if got := string(b); got != tt.want {
+			t.Errorf("Gists.DownloadFile(%v) returned %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGistsService_DownloadFile_error(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/raw/f.txt", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	file := &GistFile{Truncated: Bool(true), RawURL: String(serverURL + baseURLPath + "/raw/f.txt")}
+	if _, err := client.Gists.DownloadFile(context.Background(), file); err == nil {
+		t.Error("Gists.DownloadFile returned no error for a missing file")
+	}
+	if _, err := client.Gists.DownloadFile(context.Background(), &GistFile{Truncated: Bool(true)}); err == nil {
+		t.Error("Gists.DownloadFile returned no error for a file without raw URL")
+	}
+}
@@ -46,7 +46,7 @@ func (s *GitService) GetBlobRaw(ctx context.Context, owner, repo, sha string) ([
 	if err != nil {
 		return nil, nil, err
 	}
-	req.Header.Set("Accept", "application/vnd.github.v3.raw")
+	req.Header.Set("Accept", MediaTypeRaw)
 
 	var buf bytes.Buffer
 	resp, err := s.client.Do(ctx, req, &buf)
@@ -19,6 +19,23 @@ type SignatureVerification struct {
 	Payload   *string `json:"payload,omitempty"`
 }
 
+// Possible values of SignatureVerification.Reason.
+const (
+	SignatureVerificationReasonExpiredKey           = "expired_key"
+	SignatureVerificationReasonNotSigningKey        = "not_signing_key"
+	SignatureVerificationReasonGPGVerifyError       = "gpgverify_error"
+	SignatureVerificationReasonGPGVerifyUnavailable = "gpgverify_unavailable"
+	SignatureVerificationReasonUnsigned             = "unsigned"
+	SignatureVerificationReasonUnknownSignatureType = "unknown_signature_type"
+	SignatureVerificationReasonNoUser               = "no_user"
+	SignatureVerificationReasonUnverifiedEmail      = "unverified_email"
+	SignatureVerificationReasonBadEmail             = "bad_email"
+	SignatureVerificationReasonUnknownKey           = "unknown_key"
+	SignatureVerificationReasonMalformedSignature   = "malformed_signature"
+	SignatureVerificationReasonInvalid              = "invalid"
+	SignatureVerificationReasonValid                = "valid"
+)
+
 // Commit represents a GitHub commit.
 type Commit struct {
 	SHA          *string                `json:"sha,omitempty"`
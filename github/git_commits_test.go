@@ -34,6 +34,34 @@ func TestGitService_GetCommit(t *testing.T) {
 	}
 }
 
+func TestGitService_GetCommit_verification(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"s","verification":{"verified":false,"reason":"unknown_key","signature":"sig","payload":"p"}}`)
+	})
+
+	commit, _, err := client.Git.GetCommit(context.Background(), "o", "r", "s")
+	if err != nil {
+		t.Errorf("Git.GetCommit returned error: %v", err)
+	}
+
+	want := &Commit{
+		SHA: String("s"),
+		Verification: &SignatureVerification{
+			Verified:  Bool(false),
+			Reason:    String(SignatureVerificationReasonUnknownKey),
+			Signature: String("sig"),
+			Payload:   String("p"),
+		},
+	}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("Git.GetCommit returned %+v, want %+v", commit, want)
+	}
+}
+
 func TestGitService_GetCommit_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,163 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CreateRefsOptions specifies the optional parameters to the
+// GitService.CreateRefs method.
+type CreateRefsOptions struct {
+	// From is the ref the new ref points to the head of, such as
+	// "heads/main". Default is the default branch of each repository.
+	From string
+
+	// SHAs maps repositories, in the "owner/name" form, to the commit the
+	// new ref points to in them, overriding From.
+	SHAs map[string]string
+
+	// Concurrency is the number of repositories processed at the same
+	// time. Default is 4.
+	Concurrency int
+}
+
+// CreateRefsResult is the result of CreateRefs for one repository.
+type CreateRefsResult struct {
+	Repo string // In the "owner/name" form.
+	SHA  string // The commit the ref points to, once resolved.
+
+	// Ref is the ref created. It is nil if the ref was not created, or was
+	// deleted by the rollback.
+	Ref *Reference
+	Err error
+
+	// RolledBack reports whether the ref was created and then deleted by the
+	// rollback. RollbackErr is the error deleting it, in which case the
+	// ref is left behind.
+	RolledBack  bool
+	RollbackErr error
+}
+
+// CreateRefsError reports the repositories in which CreateRefs failed.
+type CreateRefsError struct {
+	Ref     string
+	Results []*CreateRefsResult // The results of all repositories.
+}
+
+func (e *CreateRefsError) Error() string {
+	var failed, leftBehind []string
+	for _, r := range e.Results {
+		if r.Err != nil {
+			failed = append(failed, fmt.Sprintf("%v: %v", r.Repo, r.Err))
+		}
+		if r.RollbackErr != nil {
+			leftBehind = append(leftBehind, r.Repo)
+		}
+	}
+	msg := fmt.Sprintf("github: creating %v failed in %v of %v repositories (%v)", e.Ref, len(failed), len(e.Results), strings.Join(failed, "; "))
+	if len(leftBehind) > 0 {
+		msg += fmt.Sprintf("; the rollback left it in %v", strings.Join(leftBehind, ", "))
+	}
+	return msg
+}
+
+// CreateRefs creates the same branch or tag ref, such as
+// "heads/release-1.2" or "tags/v1.2.0", in each of repos, which are in the
+// "owner/name" form. It is meant for cutting a release across the
+// repositories of a product. Results are returned in the order of repos.
+//
+// The commits the ref points to are resolved first, and nothing is created
+// unless they all are. If creating the ref fails in some repository, it is
+// deleted from those it was created in, so that the release is cut either
+// everywhere or nowhere. Refs that already existed are left untouched. The
+// error is then a *CreateRefsError, which reports the failures and any ref
+// the rollback could not delete.
+//
+// Repositories are processed concurrently, and rate limits are handled as
+// described under Batch Methods in the package documentation.
+func (s *GitService) CreateRefs(ctx context.Context, repos []string, ref string, opt *CreateRefsOptions) ([]*CreateRefsResult, error) {
+	if opt == nil {
+		opt = &CreateRefsOptions{}
+	}
+	ref = "refs/" + strings.TrimPrefix(ref, "refs/")
+
+	results := make([]*CreateRefsResult, len(repos))
+	for i, repo := range repos {
+		results[i] = &CreateRefsResult{Repo: repo, SHA: opt.SHAs[repo]}
+	}
+
+	// forEach calls fn for the results of all repositories, concurrently,
+	// and reports whether it returned no error.
+	forEach := func(fn func(ctx context.Context, r *CreateRefsResult, owner, name string) error) bool {
+		var mu sync.Mutex
+		ok := true
+		s.client.forEachConcurrently(ctx, len(results), opt.Concurrency, func(ctx context.Context, i int) {
+			r := results[i]
+			parts := strings.SplitN(r.Repo, "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				r.Err = fmt.Errorf("github: invalid repository %q, want owner/name", r.Repo)
+			} else if err := fn(ctx, r, parts[0], parts[1]); err != nil {
+				r.Err = err
+			}
+			if r.Err != nil {
+				mu.Lock()
+				ok = false
+				mu.Unlock()
+			}
+		})
+		return ok
+	}
+
+	resolved := forEach(func(ctx context.Context, r *CreateRefsResult, owner, name string) error {
+		if r.SHA != "" {
+			return nil
+		}
+		from := opt.From
+		if from == "" {
+			repo, _, err := s.client.Repositories.Get(ctx, owner, name)
+			if err != nil {
+				return err
+			}
+			from = "heads/" + repo.GetDefaultBranch()
+		}
+		head, _, err := s.GetRef(ctx, owner, name, from)
+		if err != nil {
+			return err
+		}
+		r.SHA = head.GetObject().GetSHA()
+		return nil
+	})
+	if !resolved {
+		return results, &CreateRefsError{Ref: ref, Results: results}
+	}
+
+	created := forEach(func(ctx context.Context, r *CreateRefsResult, owner, name string) (err error) {
+		r.Ref, _, err = s.CreateRef(ctx, owner, name, &Reference{
+			Ref:    String(ref),
+			Object: &GitObject{SHA: String(r.SHA)},
+		})
+		return err
+	})
+	if created {
+		return results, nil
+	}
+
+	s.client.forEachConcurrently(ctx, len(results), opt.Concurrency, func(ctx context.Context, i int) {
+		r := results[i]
+		if r.Ref == nil {
+			return
+		}
+		parts := strings.SplitN(r.Repo, "/", 2)
+		if _, r.RollbackErr = s.DeleteRef(ctx, parts[0], parts[1], ref); r.RollbackErr == nil {
+			r.Ref, r.RolledBack = nil, true
+		}
+	})
+	return results, &CreateRefsError{Ref: ref, Results: results}
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestGitService_CreateRefs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/a/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"aaa"}}`)
+	})
+	var mu sync.Mutex
+	created := make(map[string]string)
+	for _, repo := range []string{"a", "b"} {
+		repo := repo
+		mux.HandleFunc("/repos/o/"+repo+"/git/refs", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			var req struct {
+				Ref string `json:"ref"`
+				SHA string `json:"sha"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Ref != "refs/heads/release-1" {
+				t.Errorf("Request ref = %v, want refs/heads/release-1", req.Ref)
+			}
+			mu.Lock()
+			created[repo] = req.SHA
+			mu.Unlock()
+			fmt.Fprintf(w, `{"ref":"refs/heads/release-1","object":{"sha":%q}}`, req.SHA)
+		})
+	}
+
+	results, err := client.Git.CreateRefs(context.Background(), []string{"o/a", "o/b"}, "heads/release-1", &CreateRefsOptions{SHAs: map[string]string{"o/b": "bbb"}})
+	if err != nil {
+		t.Fatalf("Git.CreateRefs returned error: %v", err)
+	}
+	if created["a"] != "aaa" || created["b"] != "bbb" {
+		t.Errorf("Git.CreateRefs created refs at %v, want a at aaa and b at bbb", created)
+	}
+	for _, r := range results {
+		if r.Ref.GetRef() != "refs/heads/release-1" {
+			t.Errorf("Git.CreateRefs returned ref %v for %v, want refs/heads/release-1", r.Ref, r.Repo)
+		}
+	}
+}
+
+func TestGitService_CreateRefs_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"ref":"refs/tags/v1","object":{"sha":"s"}}`)
+	})
+	deleted := false
+	mux.HandleFunc("/repos/o/a/git/refs/tags/v1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/b/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reference already exists"}`)
+	})
+
+	opt := &CreateRefsOptions{SHAs: map[string]string{"o/a": "s", "o/b": "s"}}
+	results, err := client.Git.CreateRefs(context.Background(), []string{"o/a", "o/b"}, "refs/tags/v1", opt)
+	refsErr, ok := err.(*CreateRefsError)
+	if !ok {
+		t.Fatalf("Git.CreateRefs returned %v, want *CreateRefsError", err)
+	}
+	if !strings.Contains(refsErr.Error(), "failed in 1 of 2 repositories") {
+		t.Errorf("CreateRefsError.Error() = %q", refsErr.Error())
+	}
+	if !deleted {
+		t.Error("Git.CreateRefs did not delete the ref it created in o/a")
+	}
+	if a := results[0]; !a.RolledBack || a.Ref != nil || a.Err != nil {
+		t.Errorf("Git.CreateRefs returned %+v for o/a, want it rolled back", a)
+	}
+	if b := results[1]; b.RolledBack || b.Err == nil {
+		t.Errorf("Git.CreateRefs returned %+v for o/b, want it failed", b)
+	}
+}
+
+func TestGitService_CreateRefs_unresolved(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a/git/refs/heads/dev", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/b/git/refs/heads/dev", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ref":"refs/heads/dev","object":{"sha":"s"}}`)
+	})
+	mux.HandleFunc("/repos/o/b/git/refs", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Git.CreateRefs created a ref although a commit could not be resolved")
+	})
+
+	results, err := client.Git.CreateRefs(context.Background(), []string{"o/a", "o/b", "bad"}, "heads/release", &CreateRefsOptions{From: "heads/dev"})
+	if _, ok := err.(*CreateRefsError); !ok {
+		t.Fatalf("Git.CreateRefs returned %v, want *CreateRefsError", err)
+	}
+	if results[0].Err == nil || results[1].Err != nil || results[2].Err == nil {
+		t.Errorf("Git.CreateRefs returned errors %v, %v, %v; want errors for o/a and bad", results[0].Err, results[1].Err, results[2].Err)
+	}
+}
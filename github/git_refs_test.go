@@ -409,6 +409,30 @@ func TestGitService_UpdateRef(t *testing.T) {
 	}
 }
 
+func TestGitService_refEscaping(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/refs/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.EscapedPath(), "/repos/o/r/git/refs/heads/fix/50%25%3F%231"; got != want {
+			t.Errorf("Request path = %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `{"ref":"refs/heads/fix/50%?#1","object":{"sha":"s"}}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Git.GetRef(ctx, "o", "r", "heads/fix/50%?#1"); err != nil {
+		t.Errorf("Git.GetRef returned error: %v", err)
+	}
+	ref := &Reference{Ref: String("refs/heads/fix/50%?#1"), Object: &GitObject{SHA: String("s")}}
+	if _, _, err := client.Git.UpdateRef(ctx, "o", "r", ref, false); err != nil {
+		t.Errorf("Git.UpdateRef returned error: %v", err)
+	}
+	if _, err := client.Git.DeleteRef(ctx, "o", "r", "refs/heads/fix/50%?#1"); err != nil {
+		t.Errorf("Git.DeleteRef returned error: %v", err)
+	}
+}
+
 func TestGitService_DeleteRef(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
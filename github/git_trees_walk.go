@@ -0,0 +1,99 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// SkipTree is used as a return value from a WalkFunc to indicate that the
+// tree named in the call is to be skipped. It is not returned as an error
+// by Walk.
+var SkipTree = errors.New("github: skip this tree")
+
+// WalkFunc is the type of the function called by Walk for each entry of a
+// tree. The Path of entry is relative to the root of the walked tree.
+//
+// If the function returns SkipTree for an entry of type "tree", Walk skips
+// its contents. SkipTree returned for any other entry is ignored. Any other
+// non-nil error stops the walk and is returned by Walk.
+type WalkFunc func(entry TreeEntry) error
+
+// Walk calls fn for every entry of the tree at ref, which may be a tree SHA,
+// a commit SHA or a branch or tag name, descending into subtrees. An entry
+// of type "tree" is visited before its contents.
+//
+// Walk first fetches the tree recursively. If the API truncates the result
+// because the tree is too large, Walk falls back to fetching each directory
+// separately, which takes one request per directory.
+//
+// GitHub API docs: https://developer.github.com/v3/git/trees/#get-a-tree-recursively
+func (s *GitService) Walk(ctx context.Context, owner, repo, ref string, fn WalkFunc) error {
+	tree, _, err := s.GetTree(ctx, owner, repo, ref, true)
+	if err != nil {
+		return err
+	}
+
+	if tree.GetTruncated() {
+		return s.walkTree(ctx, owner, repo, ref, "", fn)
+	}
+
+	var skipped []string
+	for _, entry := range tree.Entries {
+		if hasAnyPrefix(entry.GetPath(), skipped) {
+			continue
+		}
+		if err := fn(entry); err != nil {
+			if err == SkipTree {
+				if entry.GetType() == "tree" {
+					skipped = append(skipped, entry.GetPath()+"/")
+				}
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// walkTree walks the tree with the given sha one directory at a time,
+// prefixing entry paths with dir.
+func (s *GitService) walkTree(ctx context.Context, owner, repo, sha, dir string, fn WalkFunc) error {
+	tree, _, err := s.GetTree(ctx, owner, repo, sha, false)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range tree.Entries {
+		if dir != "" {
+			entry.Path = String(dir + "/" + entry.GetPath())
+		}
+		if err := fn(entry); err != nil {
+			if err == SkipTree {
+				continue
+			}
+			return err
+		}
+		if entry.GetType() == "tree" {
+			if err := s.walkTree(ctx, owner, repo, entry.GetSHA(), entry.GetPath(), fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hasAnyPrefix reports whether s begins with any of prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
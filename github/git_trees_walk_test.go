@@ -0,0 +1,136 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGitService_Walk(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/master", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		fmt.Fprint(w, `{"sha":"s","truncated":false,"tree":[
+			{"path":"a","type":"tree"},
+			{"path":"a/b","type":"blob"},
+			{"path":"c","type":"tree"},
+			{"path":"c/d","type":"blob"},
+			{"path":"e","type":"blob"}]}`)
+	})
+
+	var got []string
+	err := client.Git.Walk(context.Background(), "o", "r", "master", func(entry TreeEntry) error {
+		got = append(got, entry.GetPath())
+		if entry.GetPath() == "c" {
+			return SkipTree
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Git.Walk returned error: %v", err)
+	}
+
+	want := []string{"a", "a/b", "c", "e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Git.Walk visited %v, want %v", got, want)
+	}
+}
+
+func TestGitService_Walk_truncated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/master", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("recursive") != "" {
+			fmt.Fprint(w, `{"sha":"s","truncated":true,"tree":[{"path":"a","type":"tree"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"s","tree":[
+			{"path":"a","type":"tree","sha":"sa"},
+			{"path":"c","type":"tree","sha":"sc"},
+			{"path":"e","type":"blob"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/sa", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{})
+		fmt.Fprint(w, `{"sha":"sa","tree":[{"path":"b","type":"tree","sha":"sb"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/sb", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"sb","tree":[{"path":"f","type":"blob"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/sc", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Git.Walk fetched a skipped tree")
+	})
+
+	var got []string
+	err := client.Git.Walk(context.Background(), "o", "r", "master", func(entry TreeEntry) error {
+		got = append(got, entry.GetPath())
+		if entry.GetPath() == "c" {
+			return SkipTree
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Git.Walk returned error: %v", err)
+	}
+
+	want := []string{"a", "a/b", "a/b/f", "c", "e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Git.Walk visited %v, want %v", got, want)
+	}
+}
+
+func TestGitService_Walk_skipBlob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/master", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","tree":[{"path":"a","type":"blob"},{"path":"b","type":"blob"}]}`)
+	})
+
+	var got []string
+	err := client.Git.Walk(context.Background(), "o", "r", "master", func(entry TreeEntry) error {
+		got = append(got, entry.GetPath())
+		return SkipTree
+	})
+	if err != nil {
+		t.Errorf("Git.Walk returned error: %v", err)
+	}
+
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Git.Walk visited %v, want %v", got, want)
+	}
+}
+
+func TestGitService_Walk_stop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/trees/master", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","tree":[{"path":"a","type":"blob"},{"path":"b","type":"blob"}]}`)
+	})
+
+	stop := errors.New("stop")
+	calls := 0
+	err := client.Git.Walk(context.Background(), "o", "r", "master", func(entry TreeEntry) error {
+		calls++
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Git.Walk returned %v, want %v", err, stop)
+	}
+	if calls != 1 {
+		t.Errorf("Git.Walk called fn %v times, want 1", calls)
+	}
+}
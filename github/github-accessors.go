@@ -20,6 +20,46 @@ func (a *AbuseRateLimitError) GetRetryAfter() time.Duration {
 	return *a.RetryAfter
 }
 
+// GetGithubOwnedAllowed returns the GithubOwnedAllowed field if it's non-nil, zero value otherwise.
+func (a *ActionsAllowed) GetGithubOwnedAllowed() bool {
+	if a == nil || a.GithubOwnedAllowed == nil {
+		return false
+	}
+	return *a.GithubOwnedAllowed
+}
+
+// GetVerifiedAllowed returns the VerifiedAllowed field if it's non-nil, zero value otherwise.
+func (a *ActionsAllowed) GetVerifiedAllowed() bool {
+	if a == nil || a.VerifiedAllowed == nil {
+		return false
+	}
+	return *a.VerifiedAllowed
+}
+
+// GetAllowedActions returns the AllowedActions field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetAllowedActions() string {
+	if a == nil || a.AllowedActions == nil {
+		return ""
+	}
+	return *a.AllowedActions
+}
+
+// GetEnabledRepositories returns the EnabledRepositories field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetEnabledRepositories() string {
+	if a == nil || a.EnabledRepositories == nil {
+		return ""
+	}
+	return *a.EnabledRepositories
+}
+
+// GetSelectedActionsURL returns the SelectedActionsURL field if it's non-nil, zero value otherwise.
+func (a *ActionsPermissions) GetSelectedActionsURL() string {
+	if a == nil || a.SelectedActionsURL == nil {
+		return ""
+	}
+	return *a.SelectedActionsURL
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (a *AdminEnforcement) GetURL() string {
 	if a == nil || a.URL == nil {
@@ -108,6 +148,102 @@ func (a *AdminStats) GetUsers() *UserStats {
 	return a.Users
 }
 
+// GetScore returns the Score field.
+func (a *AdvisoryCVSS) GetScore() *float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Score
+}
+
+// GetVectorString returns the VectorString field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCVSS) GetVectorString() string {
+	if a == nil || a.VectorString == nil {
+		return ""
+	}
+	return *a.VectorString
+}
+
+// GetCWEID returns the CWEID field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetCWEID() string {
+	if a == nil || a.CWEID == nil {
+		return ""
+	}
+	return *a.CWEID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (a *AdvisoryCWE) GetName() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetType() string {
+	if a == nil || a.Type == nil {
+		return ""
+	}
+	return *a.Type
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (a *AdvisoryIdentifier) GetValue() string {
+	if a == nil || a.Value == nil {
+		return ""
+	}
+	return *a.Value
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (a *AdvisoryReference) GetURL() string {
+	if a == nil || a.URL == nil {
+		return ""
+	}
+	return *a.URL
+}
+
+// GetFirstPatchedVersion returns the FirstPatchedVersion field.
+func (a *AdvisoryVulnerability) GetFirstPatchedVersion() *FirstPatchedVersion {
+	if a == nil {
+		return nil
+	}
+	return a.FirstPatchedVersion
+}
+
+// GetPackage returns the Package field.
+func (a *AdvisoryVulnerability) GetPackage() *VulnerabilityPackage {
+	if a == nil {
+		return nil
+	}
+	return a.Package
+}
+
+// GetPatchedVersions returns the PatchedVersions field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetPatchedVersions() string {
+	if a == nil || a.PatchedVersions == nil {
+		return ""
+	}
+	return *a.PatchedVersions
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetSeverity() string {
+	if a == nil || a.Severity == nil {
+		return ""
+	}
+	return *a.Severity
+}
+
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (a *AdvisoryVulnerability) GetVulnerableVersionRange() string {
+	if a == nil || a.VulnerableVersionRange == nil {
+		return ""
+	}
+	return *a.VulnerableVersionRange
+}
+
 // GetVerifiablePasswordAuthentication returns the VerifiablePasswordAuthentication field if it's non-nil, zero value otherwise.
 func (a *APIMeta) GetVerifiablePasswordAuthentication() bool {
 	if a == nil || a.VerifiablePasswordAuthentication == nil {
@@ -212,6 +348,174 @@ func (a *Attachment) GetTitle() string {
 	return *a.Title
 }
 
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetAction() string {
+	if a == nil || a.Action == nil {
+		return ""
+	}
+	return *a.Action
+}
+
+// GetActor returns the Actor field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActor() string {
+	if a == nil || a.Actor == nil {
+		return ""
+	}
+	return *a.Actor
+}
+
+// GetActorID returns the ActorID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetActorID() int64 {
+	if a == nil || a.ActorID == nil {
+		return 0
+	}
+	return *a.ActorID
+}
+
+// GetBlockedUser returns the BlockedUser field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetBlockedUser() string {
+	if a == nil || a.BlockedUser == nil {
+		return ""
+	}
+	return *a.BlockedUser
+}
+
+// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetDocumentID() string {
+	if a == nil || a.DocumentID == nil {
+		return ""
+	}
+	return *a.DocumentID
+}
+
+// GetOrg returns the Org field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrg() string {
+	if a == nil || a.Org == nil {
+		return ""
+	}
+	return *a.Org
+}
+
+// GetOrgID returns the OrgID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetOrgID() int64 {
+	if a == nil || a.OrgID == nil {
+		return 0
+	}
+	return *a.OrgID
+}
+
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetRepo() string {
+	if a == nil || a.Repo == nil {
+		return ""
+	}
+	return *a.Repo
+}
+
+// GetTeam returns the Team field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTeam() string {
+	if a == nil || a.Team == nil {
+		return ""
+	}
+	return *a.Team
+}
+
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetTimestamp() int64 {
+	if a == nil || a.Timestamp == nil {
+		return 0
+	}
+	return *a.Timestamp
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUser() string {
+	if a == nil || a.User == nil {
+		return ""
+	}
+	return *a.User
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (a *AuditEntry) GetUserID() int64 {
+	if a == nil || a.UserID == nil {
+		return 0
+	}
+	return *a.UserID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetCreatedAt() Timestamp {
+	if a == nil || a.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.CreatedAt
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetEnabled() bool {
+	if a == nil || a.Enabled == nil {
+		return false
+	}
+	return *a.Enabled
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetID() int64 {
+	if a == nil || a.ID == nil {
+		return 0
+	}
+	return *a.ID
+}
+
+// GetPausedAt returns the PausedAt field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetPausedAt() Timestamp {
+	if a == nil || a.PausedAt == nil {
+		return Timestamp{}
+	}
+	return *a.PausedAt
+}
+
+// GetStreamDetails returns the StreamDetails field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetStreamDetails() string {
+	if a == nil || a.StreamDetails == nil {
+		return ""
+	}
+	return *a.StreamDetails
+}
+
+// GetStreamType returns the StreamType field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetStreamType() string {
+	if a == nil || a.StreamType == nil {
+		return ""
+	}
+	return *a.StreamType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (a *AuditLogStream) GetUpdatedAt() Timestamp {
+	if a == nil || a.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *a.UpdatedAt
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (a *AuditLogStreamKey) GetKey() string {
+	if a == nil || a.Key == nil {
+		return ""
+	}
+	return *a.Key
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (a *AuditLogStreamKey) GetKeyID() string {
+	if a == nil || a.KeyID == nil {
+		return ""
+	}
+	return *a.KeyID
+}
+
 // GetApp returns the App field.
 func (a *Authorization) GetApp() *AuthorizationApp {
 	if a == nil {
@@ -412,6 +716,14 @@ func (a *AutoTriggerCheck) GetSetting() bool {
 	return *a.Setting
 }
 
+// GetContent returns the Content field.
+func (b *BatchContentsResult) GetContent() *RepositoryContent {
+	if b == nil {
+		return nil
+	}
+	return b.Content
+}
+
 // GetContent returns the Content field if it's non-nil, zero value otherwise.
 func (b *Blob) GetContent() string {
 	if b == nil || b.Content == nil {
@@ -460,6 +772,14 @@ func (b *Blob) GetURL() string {
 	return *b.URL
 }
 
+// GetUser returns the User field.
+func (b *BlockedUser) GetUser() *User {
+	if b == nil {
+		return nil
+	}
+	return b.User
+}
+
 // GetCommit returns the Commit field.
 func (b *Branch) GetCommit() *RepositoryCommit {
 	if b == nil {
@@ -948,87 +1268,479 @@ func (c *CheckSuitePreferenceResults) GetRepository() *Repository {
 	return c.Repository
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetBody() string {
-	if c == nil || c.Body == nil {
-		return ""
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (c *Classroom) GetArchived() bool {
+	if c == nil || c.Archived == nil {
+		return false
 	}
-	return *c.Body
+	return *c.Archived
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetKey() string {
-	if c == nil || c.Key == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Classroom) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *c.Key
+	return *c.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetName() string {
+func (c *Classroom) GetName() string {
 	if c == nil || c.Name == nil {
 		return ""
 	}
 	return *c.Name
 }
 
+// GetOrganization returns the Organization field.
+func (c *Classroom) GetOrganization() *Organization {
+	if c == nil {
+		return nil
+	}
+	return c.Organization
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (c *CodeOfConduct) GetURL() string {
+func (c *Classroom) GetURL() string {
 	if c == nil || c.URL == nil {
 		return ""
 	}
 	return *c.URL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetHTMLURL() string {
-	if c == nil || c.HTMLURL == nil {
-		return ""
+// GetAssignment returns the Assignment field.
+func (c *ClassroomAcceptedAssignment) GetAssignment() *ClassroomAssignment {
+	if c == nil {
+		return nil
 	}
-	return *c.HTMLURL
+	return c.Assignment
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (c *ClassroomAcceptedAssignment) GetCommitCount() int {
+	if c == nil || c.CommitCount == nil {
+		return 0
 	}
-	return *c.Name
+	return *c.CommitCount
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetPath() string {
-	if c == nil || c.Path == nil {
+// GetGrade returns the Grade field if it's non-nil, zero value otherwise.
+func (c *ClassroomAcceptedAssignment) GetGrade() string {
+	if c == nil || c.Grade == nil {
 		return ""
 	}
-	return *c.Path
+	return *c.Grade
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClassroomAcceptedAssignment) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetPassing returns the Passing field if it's non-nil, zero value otherwise.
+func (c *ClassroomAcceptedAssignment) GetPassing() bool {
+	if c == nil || c.Passing == nil {
+		return false
+	}
+	return *c.Passing
 }
 
 // GetRepository returns the Repository field.
-func (c *CodeResult) GetRepository() *Repository {
+func (c *ClassroomAcceptedAssignment) GetRepository() *Repository {
 	if c == nil {
 		return nil
 	}
 	return c.Repository
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (c *CodeResult) GetSHA() string {
-	if c == nil || c.SHA == nil {
-		return ""
+// GetSubmitted returns the Submitted field if it's non-nil, zero value otherwise.
+func (c *ClassroomAcceptedAssignment) GetSubmitted() bool {
+	if c == nil || c.Submitted == nil {
+		return false
 	}
-	return *c.SHA
+	return *c.Submitted
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (c *CodeSearchResult) GetIncompleteResults() bool {
-	if c == nil || c.IncompleteResults == nil {
-		return false
+// GetAccepted returns the Accepted field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetAccepted() int {
+	if c == nil || c.Accepted == nil {
+		return 0
 	}
-	return *c.IncompleteResults
+	return *c.Accepted
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+// GetClassroom returns the Classroom field.
+func (c *ClassroomAssignment) GetClassroom() *Classroom {
+	if c == nil {
+		return nil
+	}
+	return c.Classroom
+}
+
+// GetDeadline returns the Deadline field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetDeadline() Timestamp {
+	if c == nil || c.Deadline == nil {
+		return Timestamp{}
+	}
+	return *c.Deadline
+}
+
+// GetEditor returns the Editor field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetEditor() string {
+	if c == nil || c.Editor == nil {
+		return ""
+	}
+	return *c.Editor
+}
+
+// GetFeedbackPullRequestsEnabled returns the FeedbackPullRequestsEnabled field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetFeedbackPullRequestsEnabled() bool {
+	if c == nil || c.FeedbackPullRequestsEnabled == nil {
+		return false
+	}
+	return *c.FeedbackPullRequestsEnabled
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+	return *c.ID
+}
+
+// GetInvitationsEnabled returns the InvitationsEnabled field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetInvitationsEnabled() bool {
+	if c == nil || c.InvitationsEnabled == nil {
+		return false
+	}
+	return *c.InvitationsEnabled
+}
+
+// GetInviteLink returns the InviteLink field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetInviteLink() string {
+	if c == nil || c.InviteLink == nil {
+		return ""
+	}
+	return *c.InviteLink
+}
+
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetLanguage() string {
+	if c == nil || c.Language == nil {
+		return ""
+	}
+	return *c.Language
+}
+
+// GetMaxMembers returns the MaxMembers field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetMaxMembers() int {
+	if c == nil || c.MaxMembers == nil {
+		return 0
+	}
+	return *c.MaxMembers
+}
+
+// GetMaxTeams returns the MaxTeams field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetMaxTeams() int {
+	if c == nil || c.MaxTeams == nil {
+		return 0
+	}
+	return *c.MaxTeams
+}
+
+// GetPassing returns the Passing field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetPassing() int {
+	if c == nil || c.Passing == nil {
+		return 0
+	}
+	return *c.Passing
+}
+
+// GetPublicRepo returns the PublicRepo field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetPublicRepo() bool {
+	if c == nil || c.PublicRepo == nil {
+		return false
+	}
+	return *c.PublicRepo
+}
+
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetSlug() string {
+	if c == nil || c.Slug == nil {
+		return ""
+	}
+	return *c.Slug
+}
+
+// GetStarterCodeRepository returns the StarterCodeRepository field.
+func (c *ClassroomAssignment) GetStarterCodeRepository() *Repository {
+	if c == nil {
+		return nil
+	}
+	return c.StarterCodeRepository
+}
+
+// GetStudentsAreRepoAdmins returns the StudentsAreRepoAdmins field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetStudentsAreRepoAdmins() bool {
+	if c == nil || c.StudentsAreRepoAdmins == nil {
+		return false
+	}
+	return *c.StudentsAreRepoAdmins
+}
+
+// GetSubmitted returns the Submitted field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetSubmitted() int {
+	if c == nil || c.Submitted == nil {
+		return 0
+	}
+	return *c.Submitted
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetTitle() string {
+	if c == nil || c.Title == nil {
+		return ""
+	}
+	return *c.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignment) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetAssignmentName returns the AssignmentName field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetAssignmentName() string {
+	if c == nil || c.AssignmentName == nil {
+		return ""
+	}
+	return *c.AssignmentName
+}
+
+// GetAssignmentURL returns the AssignmentURL field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetAssignmentURL() string {
+	if c == nil || c.AssignmentURL == nil {
+		return ""
+	}
+	return *c.AssignmentURL
+}
+
+// GetGithubUsername returns the GithubUsername field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetGithubUsername() string {
+	if c == nil || c.GithubUsername == nil {
+		return ""
+	}
+	return *c.GithubUsername
+}
+
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetGroupName() string {
+	if c == nil || c.GroupName == nil {
+		return ""
+	}
+	return *c.GroupName
+}
+
+// GetPointsAvailable returns the PointsAvailable field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetPointsAvailable() int {
+	if c == nil || c.PointsAvailable == nil {
+		return 0
+	}
+	return *c.PointsAvailable
+}
+
+// GetPointsAwarded returns the PointsAwarded field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetPointsAwarded() int {
+	if c == nil || c.PointsAwarded == nil {
+		return 0
+	}
+	return *c.PointsAwarded
+}
+
+// GetRosterIdentifier returns the RosterIdentifier field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetRosterIdentifier() string {
+	if c == nil || c.RosterIdentifier == nil {
+		return ""
+	}
+	return *c.RosterIdentifier
+}
+
+// GetStarterCodeURL returns the StarterCodeURL field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetStarterCodeURL() string {
+	if c == nil || c.StarterCodeURL == nil {
+		return ""
+	}
+	return *c.StarterCodeURL
+}
+
+// GetStudentRepositoryName returns the StudentRepositoryName field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetStudentRepositoryName() string {
+	if c == nil || c.StudentRepositoryName == nil {
+		return ""
+	}
+	return *c.StudentRepositoryName
+}
+
+// GetStudentRepositoryURL returns the StudentRepositoryURL field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetStudentRepositoryURL() string {
+	if c == nil || c.StudentRepositoryURL == nil {
+		return ""
+	}
+	return *c.StudentRepositoryURL
+}
+
+// GetSubmissionTimestamp returns the SubmissionTimestamp field if it's non-nil, zero value otherwise.
+func (c *ClassroomAssignmentGrade) GetSubmissionTimestamp() string {
+	if c == nil || c.SubmissionTimestamp == nil {
+		return ""
+	}
+	return *c.SubmissionTimestamp
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetBody() string {
+	if c == nil || c.Body == nil {
+		return ""
+	}
+	return *c.Body
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetKey() string {
+	if c == nil || c.Key == nil {
+		return ""
+	}
+	return *c.Key
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (c *CodeOfConduct) GetURL() string {
+	if c == nil || c.URL == nil {
+		return ""
+	}
+	return *c.URL
+}
+
+// GetColumn returns the Column field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetColumn() int {
+	if c == nil || c.Column == nil {
+		return 0
+	}
+	return *c.Column
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetKind() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetLine returns the Line field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetLine() int {
+	if c == nil || c.Line == nil {
+		return 0
+	}
+	return *c.Line
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetMessage() string {
+	if c == nil || c.Message == nil {
+		return ""
+	}
+	return *c.Message
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
+	}
+	return *c.Path
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetSource() string {
+	if c == nil || c.Source == nil {
+		return ""
+	}
+	return *c.Source
+}
+
+// GetSuggestion returns the Suggestion field if it's non-nil, zero value otherwise.
+func (c *CodeOwnersError) GetSuggestion() string {
+	if c == nil || c.Suggestion == nil {
+		return ""
+	}
+	return *c.Suggestion
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetHTMLURL() string {
+	if c == nil || c.HTMLURL == nil {
+		return ""
+	}
+	return *c.HTMLURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetPath() string {
+	if c == nil || c.Path == nil {
+		return ""
+	}
+	return *c.Path
+}
+
+// GetRepository returns the Repository field.
+func (c *CodeResult) GetRepository() *Repository {
+	if c == nil {
+		return nil
+	}
+	return c.Repository
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (c *CodeResult) GetSHA() string {
+	if c == nil || c.SHA == nil {
+		return ""
+	}
+	return *c.SHA
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (c *CodeSearchResult) GetIncompleteResults() bool {
+	if c == nil || c.IncompleteResults == nil {
+		return false
+	}
+	return *c.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
 func (c *CodeSearchResult) GetTotal() int {
 	if c == nil || c.Total == nil {
 		return 0
@@ -1636,18 +2348,106 @@ func (c *CommunityHealthMetrics) GetUpdatedAt() time.Time {
 	return *c.UpdatedAt
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetAvatarURL() string {
-	if c == nil || c.AvatarURL == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *ConfigurationStatus) GetStatus() string {
+	if c == nil || c.Status == nil {
 		return ""
 	}
-	return *c.AvatarURL
+	return *c.Status
 }
 
-// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
-func (c *Contributor) GetContributions() int {
-	if c == nil || c.Contributions == nil {
-		return 0
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (c *ConfigurationStepStatus) GetKey() string {
+	if c == nil || c.Key == nil {
+		return ""
+	}
+	return *c.Key
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *ConfigurationStepStatus) GetStatus() string {
+	if c == nil || c.Status == nil {
+		return ""
+	}
+	return *c.Status
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ConnectionService) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (c *ConnectionService) GetNumber() int {
+	if c == nil || c.Number == nil {
+		return 0
+	}
+	return *c.Number
+}
+
+// GetTotalContributions returns the TotalContributions field if it's non-nil, zero value otherwise.
+func (c *ContributionCalendar) GetTotalContributions() int {
+	if c == nil || c.TotalContributions == nil {
+		return 0
+	}
+	return *c.TotalContributions
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (c *ContributionDay) GetColor() string {
+	if c == nil || c.Color == nil {
+		return ""
+	}
+	return *c.Color
+}
+
+// GetContributionCount returns the ContributionCount field if it's non-nil, zero value otherwise.
+func (c *ContributionDay) GetContributionCount() int {
+	if c == nil || c.ContributionCount == nil {
+		return 0
+	}
+	return *c.ContributionCount
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (c *ContributionDay) GetDate() string {
+	if c == nil || c.Date == nil {
+		return ""
+	}
+	return *c.Date
+}
+
+// GetWeekday returns the Weekday field if it's non-nil, zero value otherwise.
+func (c *ContributionDay) GetWeekday() int {
+	if c == nil || c.Weekday == nil {
+		return 0
+	}
+	return *c.Weekday
+}
+
+// GetFirstDay returns the FirstDay field if it's non-nil, zero value otherwise.
+func (c *ContributionWeek) GetFirstDay() string {
+	if c == nil || c.FirstDay == nil {
+		return ""
+	}
+	return *c.FirstDay
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetAvatarURL() string {
+	if c == nil || c.AvatarURL == nil {
+		return ""
+	}
+	return *c.AvatarURL
+}
+
+// GetContributions returns the Contributions field if it's non-nil, zero value otherwise.
+func (c *Contributor) GetContributions() int {
+	if c == nil || c.Contributions == nil {
+		return 0
 	}
 	return *c.Contributions
 }
@@ -1860,6 +2660,38 @@ func (c *CreateCheckSuiteOptions) GetHeadBranch() string {
 	return *c.HeadBranch
 }
 
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if c == nil || c.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *c.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetRestrictedToWorkflows() bool {
+	if c == nil || c.RestrictedToWorkflows == nil {
+		return false
+	}
+	return *c.RestrictedToWorkflows
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (c *CreateEnterpriseRunnerGroupRequest) GetVisibility() string {
+	if c == nil || c.Visibility == nil {
+		return ""
+	}
+	return *c.Visibility
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (c *CreateEvent) GetDescription() string {
 	if c == nil || c.Description == nil {
@@ -1924,6 +2756,54 @@ func (c *CreateEvent) GetSender() *User {
 	return c.Sender
 }
 
+// GetEnableStaticIP returns the EnableStaticIP field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetEnableStaticIP() bool {
+	if c == nil || c.EnableStaticIP == nil {
+		return false
+	}
+	return *c.EnableStaticIP
+}
+
+// GetImage returns the Image field.
+func (c *CreateHostedRunnerRequest) GetImage() *HostedRunnerImage {
+	if c == nil {
+		return nil
+	}
+	return c.Image
+}
+
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetMaximumRunners() int64 {
+	if c == nil || c.MaximumRunners == nil {
+		return 0
+	}
+	return *c.MaximumRunners
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetRunnerGroupID() int64 {
+	if c == nil || c.RunnerGroupID == nil {
+		return 0
+	}
+	return *c.RunnerGroupID
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (c *CreateHostedRunnerRequest) GetSize() string {
+	if c == nil || c.Size == nil {
+		return ""
+	}
+	return *c.Size
+}
+
 // GetEmail returns the Email field if it's non-nil, zero value otherwise.
 func (c *CreateOrgInvitationOptions) GetEmail() string {
 	if c == nil || c.Email == nil {
@@ -1948,4688 +2828,9000 @@ func (c *CreateOrgInvitationOptions) GetRole() string {
 	return *c.Role
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeleteEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
-	}
-	return d.Installation
-}
-
-// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetPusherType() string {
-	if d == nil || d.PusherType == nil {
-		return ""
-	}
-	return *d.PusherType
-}
-
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (c *CreatePrivateRegistryRequest) GetUsername() string {
+	if c == nil || c.Username == nil {
 		return ""
 	}
-	return *d.Ref
+	return *c.Username
 }
 
-// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
-func (d *DeleteEvent) GetRefType() string {
-	if d == nil || d.RefType == nil {
-		return ""
+// GetRef returns the Ref field.
+func (c *CreateRefsResult) GetRef() *Reference {
+	if c == nil {
+		return nil
 	}
-	return *d.RefType
+	return c.Ref
 }
 
-// GetRepo returns the Repo field.
-func (d *DeleteEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetRepositoryID returns the RepositoryID field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetRepositoryID() int64 {
+	if c == nil || c.RepositoryID == nil {
+		return 0
 	}
-	return d.Repo
+	return *c.RepositoryID
 }
 
-// GetSender returns the Sender field.
-func (d *DeleteEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.Scope
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (d *DeployKeyEvent) GetAction() string {
-	if d == nil || d.Action == nil {
+// GetWorkflowFilePath returns the WorkflowFilePath field if it's non-nil, zero value otherwise.
+func (c *CreateUpdateRequiredWorkflowOptions) GetWorkflowFilePath() string {
+	if c == nil || c.WorkflowFilePath == nil {
 		return ""
 	}
-	return *d.Action
+	return *c.WorkflowFilePath
 }
 
-// GetKey returns the Key field.
-func (d *DeployKeyEvent) GetKey() *Key {
-	if d == nil {
-		return nil
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (c *CreationInfo) GetCreated() Timestamp {
+	if c == nil || c.Created == nil {
+		return Timestamp{}
 	}
-	return d.Key
+	return *c.Created
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
+// GetAuthorizedCredentialExpiresAt returns the AuthorizedCredentialExpiresAt field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetAuthorizedCredentialExpiresAt() Timestamp {
+	if c == nil || c.AuthorizedCredentialExpiresAt == nil {
 		return Timestamp{}
 	}
-	return *d.CreatedAt
+	return *c.AuthorizedCredentialExpiresAt
 }
 
-// GetCreator returns the Creator field.
-func (d *Deployment) GetCreator() *User {
-	if d == nil {
-		return nil
+// GetAuthorizedCredentialID returns the AuthorizedCredentialID field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetAuthorizedCredentialID() int64 {
+	if c == nil || c.AuthorizedCredentialID == nil {
+		return 0
 	}
-	return d.Creator
+	return *c.AuthorizedCredentialID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetAuthorizedCredentialNote returns the AuthorizedCredentialNote field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetAuthorizedCredentialNote() string {
+	if c == nil || c.AuthorizedCredentialNote == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.AuthorizedCredentialNote
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetAuthorizedCredentialTitle returns the AuthorizedCredentialTitle field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetAuthorizedCredentialTitle() string {
+	if c == nil || c.AuthorizedCredentialTitle == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.AuthorizedCredentialTitle
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetCredentialAccessedAt returns the CredentialAccessedAt field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetCredentialAccessedAt() Timestamp {
+	if c == nil || c.CredentialAccessedAt == nil {
+		return Timestamp{}
 	}
-	return *d.ID
+	return *c.CredentialAccessedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
-		return ""
+// GetCredentialAuthorizedAt returns the CredentialAuthorizedAt field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetCredentialAuthorizedAt() Timestamp {
+	if c == nil || c.CredentialAuthorizedAt == nil {
+		return Timestamp{}
 	}
-	return *d.NodeID
+	return *c.CredentialAuthorizedAt
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRef() string {
-	if d == nil || d.Ref == nil {
-		return ""
+// GetCredentialID returns the CredentialID field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetCredentialID() int64 {
+	if c == nil || c.CredentialID == nil {
+		return 0
 	}
-	return *d.Ref
+	return *c.CredentialID
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
+// GetCredentialType returns the CredentialType field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetCredentialType() string {
+	if c == nil || c.CredentialType == nil {
 		return ""
 	}
-	return *d.RepositoryURL
+	return *c.CredentialType
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetSHA() string {
-	if d == nil || d.SHA == nil {
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetFingerprint() string {
+	if c == nil || c.Fingerprint == nil {
 		return ""
 	}
-	return *d.SHA
+	return *c.Fingerprint
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetStatusesURL() string {
-	if d == nil || d.StatusesURL == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetLogin() string {
+	if c == nil || c.Login == nil {
 		return ""
 	}
-	return *d.StatusesURL
+	return *c.Login
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetTokenLastEight returns the TokenLastEight field if it's non-nil, zero value otherwise.
+func (c *CredentialAuthorization) GetTokenLastEight() string {
+	if c == nil || c.TokenLastEight == nil {
 		return ""
 	}
-	return *d.Task
+	return *c.TokenLastEight
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *Credit) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
 	}
-	return *d.UpdatedAt
+	return *c.Type
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *Deployment) GetURL() string {
-	if d == nil || d.URL == nil {
-		return ""
+// GetUser returns the User field.
+func (c *Credit) GetUser() *User {
+	if c == nil {
+		return nil
 	}
-	return *d.URL
+	return c.User
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentEvent) GetDeployment() *Deployment {
-	if d == nil {
+// GetApp returns the App field.
+func (c *CustomDeploymentProtectionRule) GetApp() *CustomDeploymentProtectionRuleApp {
+	if c == nil {
 		return nil
 	}
-	return d.Deployment
+	return c.App
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentEvent) GetInstallation() *Installation {
-	if d == nil {
-		return nil
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return false
 	}
-	return d.Installation
+	return *c.Enabled
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentEvent) GetRepo() *Repository {
-	if d == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return d.Repo
+	return *c.ID
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentEvent) GetSender() *User {
-	if d == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRule) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
+		return ""
 	}
-	return d.Sender
+	return *c.NodeID
 }
 
-// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetAutoMerge() bool {
-	if d == nil || d.AutoMerge == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
 	}
-	return *d.AutoMerge
+	return *c.ID
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
+// GetIntegrationURL returns the IntegrationURL field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetIntegrationURL() string {
+	if c == nil || c.IntegrationURL == nil {
 		return ""
 	}
-	return *d.Description
+	return *c.IntegrationURL
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetNodeID() string {
+	if c == nil || c.NodeID == nil {
 		return ""
 	}
-	return *d.Environment
+	return *c.NodeID
 }
 
-// GetPayload returns the Payload field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetPayload() string {
-	if d == nil || d.Payload == nil {
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleApp) GetSlug() string {
+	if c == nil || c.Slug == nil {
 		return ""
 	}
-	return *d.Payload
+	return *c.Slug
 }
 
-// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetProductionEnvironment() bool {
-	if d == nil || d.ProductionEnvironment == nil {
-		return false
+// GetIntegrationID returns the IntegrationID field if it's non-nil, zero value otherwise.
+func (c *CustomDeploymentProtectionRuleRequest) GetIntegrationID() int64 {
+	if c == nil || c.IntegrationID == nil {
+		return 0
 	}
-	return *d.ProductionEnvironment
+	return *c.IntegrationID
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRef() string {
-	if d == nil || d.Ref == nil {
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetQuerySuite() string {
+	if d == nil || d.QuerySuite == nil {
 		return ""
 	}
-	return *d.Ref
+	return *d.QuerySuite
 }
 
-// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetRequiredContexts() []string {
-	if d == nil || d.RequiredContexts == nil {
+// GetSchedule returns the Schedule field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetSchedule() string {
+	if d == nil || d.Schedule == nil {
+		return ""
+	}
+	return *d.Schedule
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
+	}
+	return *d.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DefaultSetupConfiguration) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *d.UpdatedAt
+}
+
+// GetInstallation returns the Installation field.
+func (d *DeleteEvent) GetInstallation() *Installation {
+	if d == nil {
 		return nil
 	}
-	return *d.RequiredContexts
+	return d.Installation
 }
 
-// GetTask returns the Task field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTask() string {
-	if d == nil || d.Task == nil {
+// GetPusherType returns the PusherType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetPusherType() string {
+	if d == nil || d.PusherType == nil {
 		return ""
 	}
-	return *d.Task
+	return *d.PusherType
 }
 
-// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
-func (d *DeploymentRequest) GetTransientEnvironment() bool {
-	if d == nil || d.TransientEnvironment == nil {
-		return false
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return *d.TransientEnvironment
+	return *d.Ref
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
+// GetRefType returns the RefType field if it's non-nil, zero value otherwise.
+func (d *DeleteEvent) GetRefType() string {
+	if d == nil || d.RefType == nil {
+		return ""
 	}
-	return *d.CreatedAt
+	return *d.RefType
 }
 
-// GetCreator returns the Creator field.
-func (d *DeploymentStatus) GetCreator() *User {
+// GetRepo returns the Repo field.
+func (d *DeleteEvent) GetRepo() *Repository {
 	if d == nil {
 		return nil
 	}
-	return d.Creator
+	return d.Repo
 }
 
-// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDeploymentURL() string {
-	if d == nil || d.DeploymentURL == nil {
-		return ""
+// GetSender returns the Sender field.
+func (d *DeleteEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *d.DeploymentURL
+	return d.Sender
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetAutoDismissedAt returns the AutoDismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetAutoDismissedAt() Timestamp {
+	if d == nil || d.AutoDismissedAt == nil {
+		return Timestamp{}
 	}
-	return *d.Description
+	return *d.AutoDismissedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetID() int64 {
-	if d == nil || d.ID == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.ID
+	return *d.CreatedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
-		return ""
+// GetDependency returns the Dependency field.
+func (d *DependabotAlert) GetDependency() *Dependency {
+	if d == nil {
+		return nil
 	}
-	return *d.NodeID
+	return d.Dependency
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetRepositoryURL() string {
-	if d == nil || d.RepositoryURL == nil {
-		return ""
+// GetDismissedAt returns the DismissedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedAt() Timestamp {
+	if d == nil || d.DismissedAt == nil {
+		return Timestamp{}
 	}
-	return *d.RepositoryURL
+	return *d.DismissedAt
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetState() string {
-	if d == nil || d.State == nil {
+// GetDismissedBy returns the DismissedBy field.
+func (d *DependabotAlert) GetDismissedBy() *User {
+	if d == nil {
+		return nil
+	}
+	return d.DismissedBy
+}
+
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *d.State
+	return *d.DismissedComment
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetTargetURL() string {
-	if d == nil || d.TargetURL == nil {
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
 		return ""
 	}
-	return *d.TargetURL
+	return *d.DismissedReason
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
+// GetFixedAt returns the FixedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetFixedAt() Timestamp {
+	if d == nil || d.FixedAt == nil {
 		return Timestamp{}
 	}
-	return *d.UpdatedAt
+	return *d.FixedAt
 }
 
-// GetDeployment returns the Deployment field.
-func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
-	if d == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return d.Deployment
+	return *d.HTMLURL
 }
 
-// GetDeploymentStatus returns the DeploymentStatus field.
-func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
-	if d == nil {
-		return nil
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return d.DeploymentStatus
+	return *d.Number
 }
 
-// GetInstallation returns the Installation field.
-func (d *DeploymentStatusEvent) GetInstallation() *Installation {
+// GetRepository returns the Repository field.
+func (d *DependabotAlert) GetRepository() *Repository {
 	if d == nil {
 		return nil
 	}
-	return d.Installation
+	return d.Repository
 }
 
-// GetRepo returns the Repo field.
-func (d *DeploymentStatusEvent) GetRepo() *Repository {
+// GetSecurityAdvisory returns the SecurityAdvisory field.
+func (d *DependabotAlert) GetSecurityAdvisory() *DependabotSecurityAdvisory {
 	if d == nil {
 		return nil
 	}
-	return d.Repo
+	return d.SecurityAdvisory
 }
 
-// GetSender returns the Sender field.
-func (d *DeploymentStatusEvent) GetSender() *User {
+// GetSecurityVulnerability returns the SecurityVulnerability field.
+func (d *DependabotAlert) GetSecurityVulnerability() *AdvisoryVulnerability {
 	if d == nil {
 		return nil
 	}
-	return d.Sender
+	return d.SecurityVulnerability
 }
 
-// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetAutoInactive() bool {
-	if d == nil || d.AutoInactive == nil {
-		return false
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
 	}
-	return *d.AutoInactive
+	return *d.State
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetDescription() string {
-	if d == nil || d.Description == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.Description
+	return *d.UpdatedAt
 }
 
-// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironment() string {
-	if d == nil || d.Environment == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependabotAlert) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *d.Environment
+	return *d.URL
 }
 
-// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
-	if d == nil || d.EnvironmentURL == nil {
+// GetDismissedComment returns the DismissedComment field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedComment() string {
+	if d == nil || d.DismissedComment == nil {
 		return ""
 	}
-	return *d.EnvironmentURL
+	return *d.DismissedComment
 }
 
-// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetLogURL() string {
-	if d == nil || d.LogURL == nil {
+// GetDismissedReason returns the DismissedReason field if it's non-nil, zero value otherwise.
+func (d *DependabotAlertState) GetDismissedReason() string {
+	if d == nil || d.DismissedReason == nil {
 		return ""
 	}
-	return *d.LogURL
+	return *d.DismissedReason
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DeploymentStatusRequest) GetState() string {
-	if d == nil || d.State == nil {
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetCVEID() string {
+	if d == nil || d.CVEID == nil {
 		return ""
 	}
-	return *d.State
+	return *d.CVEID
 }
 
-// GetAuthor returns the Author field.
-func (d *DiscussionComment) GetAuthor() *User {
+// GetCVSS returns the CVSS field.
+func (d *DependabotSecurityAdvisory) GetCVSS() *AdvisoryCVSS {
 	if d == nil {
 		return nil
 	}
-	return d.Author
+	return d.CVSS
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *d.Body
+	return *d.Description
 }
 
-// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyHTML() string {
-	if d == nil || d.BodyHTML == nil {
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetGHSAID() string {
+	if d == nil || d.GHSAID == nil {
 		return ""
 	}
-	return *d.BodyHTML
+	return *d.GHSAID
 }
 
-// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetBodyVersion() string {
-	if d == nil || d.BodyVersion == nil {
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetPublishedAt() Timestamp {
+	if d == nil || d.PublishedAt == nil {
+		return Timestamp{}
+	}
+	return *d.PublishedAt
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSeverity() string {
+	if d == nil || d.Severity == nil {
 		return ""
 	}
-	return *d.BodyVersion
+	return *d.Severity
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetCreatedAt() Timestamp {
-	if d == nil || d.CreatedAt == nil {
-		return Timestamp{}
-	}
-	return *d.CreatedAt
-}
-
-// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetDiscussionURL() string {
-	if d == nil || d.DiscussionURL == nil {
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetSummary() string {
+	if d == nil || d.Summary == nil {
 		return ""
 	}
-	return *d.DiscussionURL
+	return *d.Summary
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetHTMLURL() string {
-	if d == nil || d.HTMLURL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *d.HTMLURL
+	return *d.UpdatedAt
 }
 
-// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetLastEditedAt() Timestamp {
-	if d == nil || d.LastEditedAt == nil {
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (d *DependabotSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if d == nil || d.WithdrawnAt == nil {
 		return Timestamp{}
 	}
-	return *d.LastEditedAt
+	return *d.WithdrawnAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNodeID() string {
-	if d == nil || d.NodeID == nil {
+// GetManifestPath returns the ManifestPath field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetManifestPath() string {
+	if d == nil || d.ManifestPath == nil {
 		return ""
 	}
-	return *d.NodeID
-}
-
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetNumber() int {
-	if d == nil || d.Number == nil {
-		return 0
-	}
-	return *d.Number
+	return *d.ManifestPath
 }
 
-// GetReactions returns the Reactions field.
-func (d *DiscussionComment) GetReactions() *Reactions {
+// GetPackage returns the Package field.
+func (d *Dependency) GetPackage() *VulnerabilityPackage {
 	if d == nil {
 		return nil
 	}
-	return d.Reactions
+	return d.Package
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetUpdatedAt() Timestamp {
-	if d == nil || d.UpdatedAt == nil {
-		return Timestamp{}
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *Dependency) GetScope() string {
+	if d == nil || d.Scope == nil {
+		return ""
 	}
-	return *d.UpdatedAt
+	return *d.Scope
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (d *DiscussionComment) GetURL() string {
-	if d == nil || d.URL == nil {
+// GetChangeType returns the ChangeType field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetChangeType() string {
+	if d == nil || d.ChangeType == nil {
 		return ""
 	}
-	return *d.URL
+	return *d.ChangeType
 }
 
-// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetTeams() []string {
-	if d == nil || d.Teams == nil {
-		return nil
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetEcosystem() string {
+	if d == nil || d.Ecosystem == nil {
+		return ""
 	}
-	return *d.Teams
+	return *d.Ecosystem
 }
 
-// GetUsers returns the Users field if it's non-nil, zero value otherwise.
-func (d *DismissalRestrictionsRequest) GetUsers() []string {
-	if d == nil || d.Users == nil {
-		return nil
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetLicense() string {
+	if d == nil || d.License == nil {
+		return ""
 	}
-	return *d.Users
+	return *d.License
 }
 
-// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalCommitID() string {
-	if d == nil || d.DismissalCommitID == nil {
+// GetManifest returns the Manifest field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetManifest() string {
+	if d == nil || d.Manifest == nil {
 		return ""
 	}
-	return *d.DismissalCommitID
+	return *d.Manifest
 }
 
-// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetDismissalMessage() string {
-	if d == nil || d.DismissalMessage == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *d.DismissalMessage
+	return *d.Name
 }
 
-// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetReviewID() int64 {
-	if d == nil || d.ReviewID == nil {
-		return 0
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
+		return ""
 	}
-	return *d.ReviewID
+	return *d.PackageURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (d *DismissedReview) GetState() string {
-	if d == nil || d.State == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *d.State
+	return *d.Scope
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetBody() string {
-	if d == nil || d.Body == nil {
+// GetSourceRepositoryURL returns the SourceRepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetSourceRepositoryURL() string {
+	if d == nil || d.SourceRepositoryURL == nil {
 		return ""
 	}
-	return *d.Body
+	return *d.SourceRepositoryURL
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPath() string {
-	if d == nil || d.Path == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyChange) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *d.Path
+	return *d.Version
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (d *DraftReviewComment) GetPosition() int {
-	if d == nil || d.Position == nil {
-		return 0
+// GetAdvisoryGHSAID returns the AdvisoryGHSAID field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryGHSAID() string {
+	if d == nil || d.AdvisoryGHSAID == nil {
+		return ""
 	}
-	return *d.Position
+	return *d.AdvisoryGHSAID
 }
 
-// GetActor returns the Actor field.
-func (e *Event) GetActor() *User {
-	if e == nil {
-		return nil
+// GetAdvisorySummary returns the AdvisorySummary field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisorySummary() string {
+	if d == nil || d.AdvisorySummary == nil {
+		return ""
 	}
-	return e.Actor
+	return *d.AdvisorySummary
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (e *Event) GetCreatedAt() time.Time {
-	if e == nil || e.CreatedAt == nil {
-		return time.Time{}
+// GetAdvisoryURL returns the AdvisoryURL field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetAdvisoryURL() string {
+	if d == nil || d.AdvisoryURL == nil {
+		return ""
 	}
-	return *e.CreatedAt
+	return *d.AdvisoryURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Event) GetID() string {
-	if e == nil || e.ID == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (d *DependencyChangeVulnerability) GetSeverity() string {
+	if d == nil || d.Severity == nil {
 		return ""
 	}
-	return *e.ID
+	return *d.Severity
 }
 
-// GetOrg returns the Org field.
-func (e *Event) GetOrg() *Organization {
-	if e == nil {
+// GetDetector returns the Detector field.
+func (d *DependencyGraphSnapshot) GetDetector() *DependencyGraphSnapshotDetector {
+	if d == nil {
 		return nil
 	}
-	return e.Org
+	return d.Detector
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (e *Event) GetPublic() bool {
-	if e == nil || e.Public == nil {
-		return false
+// GetJob returns the Job field.
+func (d *DependencyGraphSnapshot) GetJob() *DependencyGraphSnapshotJob {
+	if d == nil {
+		return nil
 	}
-	return *e.Public
+	return d.Job
 }
 
-// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
-func (e *Event) GetRawPayload() json.RawMessage {
-	if e == nil || e.RawPayload == nil {
-		return json.RawMessage{}
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return *e.RawPayload
+	return *d.Ref
 }
 
-// GetRepo returns the Repo field.
-func (e *Event) GetRepo() *Repository {
-	if e == nil {
-		return nil
+// GetScanned returns the Scanned field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetScanned() Timestamp {
+	if d == nil || d.Scanned == nil {
+		return Timestamp{}
 	}
-	return e.Repo
+	return *d.Scanned
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (e *Event) GetType() string {
-	if e == nil || e.Type == nil {
+// GetSha returns the Sha field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshot) GetSha() string {
+	if d == nil || d.Sha == nil {
 		return ""
 	}
-	return *e.Type
+	return *d.Sha
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetHRef() string {
-	if f == nil || f.HRef == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *f.HRef
+	return *d.CreatedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (f *FeedLink) GetType() string {
-	if f == nil || f.Type == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *f.Type
+	return *d.ID
 }
 
-// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserActorURL() string {
-	if f == nil || f.CurrentUserActorURL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetMessage() string {
+	if d == nil || d.Message == nil {
 		return ""
 	}
-	return *f.CurrentUserActorURL
+	return *d.Message
 }
 
-// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserOrganizationURL() string {
-	if f == nil || f.CurrentUserOrganizationURL == nil {
+// GetResult returns the Result field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotCreationData) GetResult() string {
+	if d == nil || d.Result == nil {
 		return ""
 	}
-	return *f.CurrentUserOrganizationURL
+	return *d.Result
 }
 
-// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserPublicURL() string {
-	if f == nil || f.CurrentUserPublicURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *f.CurrentUserPublicURL
+	return *d.Name
 }
 
-// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetCurrentUserURL() string {
-	if f == nil || f.CurrentUserURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *f.CurrentUserURL
+	return *d.URL
 }
 
-// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetTimelineURL() string {
-	if f == nil || f.TimelineURL == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotDetector) GetVersion() string {
+	if d == nil || d.Version == nil {
 		return ""
 	}
-	return *f.TimelineURL
+	return *d.Version
 }
 
-// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
-func (f *Feeds) GetUserURL() string {
-	if f == nil || f.UserURL == nil {
+// GetCorrelator returns the Correlator field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetCorrelator() string {
+	if d == nil || d.Correlator == nil {
 		return ""
 	}
-	return *f.UserURL
+	return *d.Correlator
 }
 
-// GetForkee returns the Forkee field.
-func (f *ForkEvent) GetForkee() *Repository {
-	if f == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
+		return ""
 	}
-	return f.Forkee
+	return *d.HTMLURL
 }
 
-// GetInstallation returns the Installation field.
-func (f *ForkEvent) GetInstallation() *Installation {
-	if f == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotJob) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
 	}
-	return f.Installation
+	return *d.ID
 }
 
-// GetRepo returns the Repo field.
-func (f *ForkEvent) GetRepo() *Repository {
-	if f == nil {
+// GetFile returns the File field.
+func (d *DependencyGraphSnapshotManifest) GetFile() *DependencyGraphSnapshotManifestFile {
+	if d == nil {
 		return nil
 	}
-	return f.Repo
+	return d.File
 }
 
-// GetSender returns the Sender field.
-func (f *ForkEvent) GetSender() *User {
-	if f == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifest) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
 	}
-	return f.Sender
+	return *d.Name
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (g *Gist) GetComments() int {
-	if g == nil || g.Comments == nil {
-		return 0
+// GetSourceLocation returns the SourceLocation field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotManifestFile) GetSourceLocation() string {
+	if d == nil || d.SourceLocation == nil {
+		return ""
 	}
-	return *g.Comments
+	return *d.SourceLocation
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetPackageURL returns the PackageURL field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetPackageURL() string {
+	if d == nil || d.PackageURL == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.PackageURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (g *Gist) GetDescription() string {
-	if g == nil || g.Description == nil {
+// GetRelationship returns the Relationship field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetRelationship() string {
+	if d == nil || d.Relationship == nil {
 		return ""
 	}
-	return *g.Description
+	return *d.Relationship
 }
 
-// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPullURL() string {
-	if g == nil || g.GitPullURL == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (d *DependencyGraphSnapshotResolvedDependency) GetScope() string {
+	if d == nil || d.Scope == nil {
 		return ""
 	}
-	return *g.GitPullURL
+	return *d.Scope
 }
 
-// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetGitPushURL() string {
-	if g == nil || g.GitPushURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (d *DeployKeyEvent) GetAction() string {
+	if d == nil || d.Action == nil {
 		return ""
 	}
-	return *g.GitPushURL
+	return *d.Action
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (g *Gist) GetHTMLURL() string {
-	if g == nil || g.HTMLURL == nil {
-		return ""
-	}
-	return *g.HTMLURL
+// GetKey returns the Key field.
+func (d *DeployKeyEvent) GetKey() *Key {
+	if d == nil {
+		return nil
+	}
+	return d.Key
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetID() string {
-	if g == nil || g.ID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.ID
+	return *d.CreatedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *Gist) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
-		return ""
+// GetCreator returns the Creator field.
+func (d *Deployment) GetCreator() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.NodeID
+	return d.Creator
 }
 
-// GetOwner returns the Owner field.
-func (g *Gist) GetOwner() *User {
-	if g == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return g.Owner
+	return *d.Description
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (g *Gist) GetPublic() bool {
-	if g == nil || g.Public == nil {
-		return false
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
+		return ""
 	}
-	return *g.Public
+	return *d.Environment
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Gist) GetUpdatedAt() time.Time {
-	if g == nil || g.UpdatedAt == nil {
-		return time.Time{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetID() int64 {
+	if d == nil || d.ID == nil {
+		return 0
 	}
-	return *g.UpdatedAt
+	return *d.ID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetBody() string {
-	if g == nil || g.Body == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.Body
+	return *d.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRef() string {
+	if d == nil || d.Ref == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.Ref
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
+		return ""
 	}
-	return *g.ID
+	return *d.RepositoryURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistComment) GetURL() string {
-	if g == nil || g.URL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetSHA() string {
+	if d == nil || d.SHA == nil {
 		return ""
 	}
-	return *g.URL
+	return *d.SHA
 }
 
-// GetUser returns the User field.
-func (g *GistComment) GetUser() *User {
-	if g == nil {
-		return nil
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetStatusesURL() string {
+	if d == nil || d.StatusesURL == nil {
+		return ""
 	}
-	return g.User
+	return *d.StatusesURL
 }
 
-// GetChangeStatus returns the ChangeStatus field.
-func (g *GistCommit) GetChangeStatus() *CommitStats {
-	if g == nil {
-		return nil
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetTask() string {
+	if d == nil || d.Task == nil {
+		return ""
 	}
-	return g.ChangeStatus
+	return *d.Task
 }
 
-// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetCommittedAt() Timestamp {
-	if g == nil || g.CommittedAt == nil {
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.CommittedAt
+	return *d.UpdatedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *Deployment) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *g.NodeID
+	return *d.URL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetDeployment returns the Deployment field.
+func (d *DeploymentEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *g.URL
+	return d.Deployment
 }
 
-// GetUser returns the User field.
-func (g *GistCommit) GetUser() *User {
-	if g == nil {
+// GetInstallation returns the Installation field.
+func (d *DeploymentEvent) GetInstallation() *Installation {
+	if d == nil {
 		return nil
 	}
-	return g.User
+	return d.Installation
 }
 
-// GetVersion returns the Version field if it's non-nil, zero value otherwise.
-func (g *GistCommit) GetVersion() string {
-	if g == nil || g.Version == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (d *DeploymentEvent) GetRepo() *Repository {
+	if d == nil {
+		return nil
 	}
-	return *g.Version
+	return d.Repo
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetContent() string {
-	if g == nil || g.Content == nil {
-		return ""
+// GetSender returns the Sender field.
+func (d *DeploymentEvent) GetSender() *User {
+	if d == nil {
+		return nil
 	}
-	return *g.Content
+	return d.Sender
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetFilename() string {
-	if g == nil || g.Filename == nil {
+// GetAutoMerge returns the AutoMerge field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetAutoMerge() bool {
+	if d == nil || d.AutoMerge == nil {
+		return false
+	}
+	return *d.AutoMerge
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
 		return ""
 	}
-	return *g.Filename
+	return *d.Description
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetLanguage() string {
-	if g == nil || g.Language == nil {
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
 		return ""
 	}
-	return *g.Language
+	return *d.Environment
 }
 
-// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetRawURL() string {
-	if g == nil || g.RawURL == nil {
+// GetPayload returns the Payload field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetPayload() string {
+	if d == nil || d.Payload == nil {
 		return ""
 	}
-	return *g.RawURL
+	return *d.Payload
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetSize() int {
-	if g == nil || g.Size == nil {
-		return 0
+// GetProductionEnvironment returns the ProductionEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetProductionEnvironment() bool {
+	if d == nil || d.ProductionEnvironment == nil {
+		return false
 	}
-	return *g.Size
+	return *d.ProductionEnvironment
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GistFile) GetType() string {
-	if g == nil || g.Type == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRef() string {
+	if d == nil || d.Ref == nil {
 		return ""
 	}
-	return *g.Type
+	return *d.Ref
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
-		return Timestamp{}
+// GetRequiredContexts returns the RequiredContexts field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetRequiredContexts() []string {
+	if d == nil || d.RequiredContexts == nil {
+		return nil
 	}
-	return *g.CreatedAt
+	return *d.RequiredContexts
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetID() string {
-	if g == nil || g.ID == nil {
+// GetTask returns the Task field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTask() string {
+	if d == nil || d.Task == nil {
 		return ""
 	}
-	return *g.ID
+	return *d.Task
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetNodeID() string {
-	if g == nil || g.NodeID == nil {
-		return ""
+// GetTransientEnvironment returns the TransientEnvironment field if it's non-nil, zero value otherwise.
+func (d *DeploymentRequest) GetTransientEnvironment() bool {
+	if d == nil || d.TransientEnvironment == nil {
+		return false
 	}
-	return *g.NodeID
+	return *d.TransientEnvironment
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.UpdatedAt
+	return *d.CreatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GistFork) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
-	}
-	return *g.URL
-}
-
-// GetUser returns the User field.
-func (g *GistFork) GetUser() *User {
-	if g == nil {
+// GetCreator returns the Creator field.
+func (d *DeploymentStatus) GetCreator() *User {
+	if d == nil {
 		return nil
 	}
-	return g.User
+	return d.Creator
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPrivateGists() int {
-	if g == nil || g.PrivateGists == nil {
-		return 0
+// GetDeploymentURL returns the DeploymentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDeploymentURL() string {
+	if d == nil || d.DeploymentURL == nil {
+		return ""
 	}
-	return *g.PrivateGists
+	return *d.DeploymentURL
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetPublicGists() int {
-	if g == nil || g.PublicGists == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return *g.PublicGists
+	return *d.Description
 }
 
-// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
-func (g *GistStats) GetTotalGists() int {
-	if g == nil || g.TotalGists == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetID() int64 {
+	if d == nil || d.ID == nil {
 		return 0
 	}
-	return *g.TotalGists
+	return *d.ID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (g *GitHubAppAuthorizationEvent) GetAction() string {
-	if g == nil || g.Action == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *g.Action
+	return *d.NodeID
 }
 
-// GetSender returns the Sender field.
-func (g *GitHubAppAuthorizationEvent) GetSender() *User {
-	if g == nil {
-		return nil
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetRepositoryURL() string {
+	if d == nil || d.RepositoryURL == nil {
+		return ""
 	}
-	return g.Sender
+	return *d.RepositoryURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetName() string {
-	if g == nil || g.Name == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *g.Name
+	return *d.State
 }
 
-// GetSource returns the Source field if it's non-nil, zero value otherwise.
-func (g *Gitignore) GetSource() string {
-	if g == nil || g.Source == nil {
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetTargetURL() string {
+	if d == nil || d.TargetURL == nil {
 		return ""
 	}
-	return *g.Source
+	return *d.TargetURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetSHA() string {
-	if g == nil || g.SHA == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatus) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *g.SHA
+	return *d.UpdatedAt
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetType() string {
-	if g == nil || g.Type == nil {
-		return ""
+// GetDeployment returns the Deployment field.
+func (d *DeploymentStatusEvent) GetDeployment() *Deployment {
+	if d == nil {
+		return nil
 	}
-	return *g.Type
+	return d.Deployment
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *GitObject) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetDeploymentStatus returns the DeploymentStatus field.
+func (d *DeploymentStatusEvent) GetDeploymentStatus() *DeploymentStatus {
+	if d == nil {
+		return nil
 	}
-	return *g.URL
+	return d.DeploymentStatus
 }
 
 // GetInstallation returns the Installation field.
-func (g *GollumEvent) GetInstallation() *Installation {
-	if g == nil {
+func (d *DeploymentStatusEvent) GetInstallation() *Installation {
+	if d == nil {
 		return nil
 	}
-	return g.Installation
+	return d.Installation
 }
 
 // GetRepo returns the Repo field.
-func (g *GollumEvent) GetRepo() *Repository {
-	if g == nil {
+func (d *DeploymentStatusEvent) GetRepo() *Repository {
+	if d == nil {
 		return nil
 	}
-	return g.Repo
+	return d.Repo
 }
 
 // GetSender returns the Sender field.
-func (g *GollumEvent) GetSender() *User {
-	if g == nil {
+func (d *DeploymentStatusEvent) GetSender() *User {
+	if d == nil {
 		return nil
 	}
-	return g.Sender
-}
-
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetEmail() string {
-	if g == nil || g.Email == nil {
-		return ""
-	}
-	return *g.Email
+	return d.Sender
 }
 
-// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
-func (g *GPGEmail) GetVerified() bool {
-	if g == nil || g.Verified == nil {
+// GetAutoInactive returns the AutoInactive field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetAutoInactive() bool {
+	if d == nil || d.AutoInactive == nil {
 		return false
 	}
-	return *g.Verified
+	return *d.AutoInactive
 }
 
-// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanCertify() bool {
-	if g == nil || g.CanCertify == nil {
-		return false
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return *g.CanCertify
+	return *d.Description
 }
 
-// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptComms() bool {
-	if g == nil || g.CanEncryptComms == nil {
-		return false
+// GetEnvironment returns the Environment field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironment() string {
+	if d == nil || d.Environment == nil {
+		return ""
 	}
-	return *g.CanEncryptComms
+	return *d.Environment
 }
 
-// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanEncryptStorage() bool {
-	if g == nil || g.CanEncryptStorage == nil {
-		return false
+// GetEnvironmentURL returns the EnvironmentURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetEnvironmentURL() string {
+	if d == nil || d.EnvironmentURL == nil {
+		return ""
 	}
-	return *g.CanEncryptStorage
+	return *d.EnvironmentURL
 }
 
-// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCanSign() bool {
-	if g == nil || g.CanSign == nil {
-		return false
+// GetLogURL returns the LogURL field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetLogURL() string {
+	if d == nil || d.LogURL == nil {
+		return ""
 	}
-	return *g.CanSign
+	return *d.LogURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetCreatedAt() time.Time {
-	if g == nil || g.CreatedAt == nil {
-		return time.Time{}
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DeploymentStatusRequest) GetState() string {
+	if d == nil || d.State == nil {
+		return ""
 	}
-	return *g.CreatedAt
+	return *d.State
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetExpiresAt() time.Time {
-	if g == nil || g.ExpiresAt == nil {
-		return time.Time{}
+// GetAnswerChosenAt returns the AnswerChosenAt field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetAnswerChosenAt() Timestamp {
+	if d == nil || d.AnswerChosenAt == nil {
+		return Timestamp{}
 	}
-	return *g.ExpiresAt
+	return *d.AnswerChosenAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (d *Discussion) GetAuthor() *DiscussionAuthor {
+	if d == nil {
+		return nil
 	}
-	return *g.ID
+	return d.Author
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetKeyID() string {
-	if g == nil || g.KeyID == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetBody() string {
+	if d == nil || d.Body == nil {
 		return ""
 	}
-	return *g.KeyID
-}
-
-// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPrimaryKeyID() int64 {
-	if g == nil || g.PrimaryKeyID == nil {
-		return 0
-	}
-	return *g.PrimaryKeyID
+	return *d.Body
 }
 
-// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
-func (g *GPGKey) GetPublicKey() string {
-	if g == nil || g.PublicKey == nil {
-		return ""
+// GetCategory returns the Category field.
+func (d *Discussion) GetCategory() *DiscussionCategory {
+	if d == nil {
+		return nil
 	}
-	return *g.PublicKey
+	return d.Category
 }
 
-// GetApp returns the App field.
-func (g *Grant) GetApp() *AuthorizationApp {
-	if g == nil {
-		return nil
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetClosed() bool {
+	if d == nil || d.Closed == nil {
+		return false
 	}
-	return g.App
+	return *d.Closed
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetCreatedAt() Timestamp {
-	if g == nil || g.CreatedAt == nil {
+func (d *Discussion) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
 		return Timestamp{}
 	}
-	return *g.CreatedAt
+	return *d.CreatedAt
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetID() int64 {
-	if g == nil || g.ID == nil {
-		return 0
+func (d *Discussion) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
 	}
-	return *g.ID
+	return *d.ID
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (g *Grant) GetUpdatedAt() Timestamp {
-	if g == nil || g.UpdatedAt == nil {
-		return Timestamp{}
+// GetIsAnswered returns the IsAnswered field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetIsAnswered() bool {
+	if d == nil || d.IsAnswered == nil {
+		return false
 	}
-	return *g.UpdatedAt
+	return *d.IsAnswered
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (g *Grant) GetURL() string {
-	if g == nil || g.URL == nil {
-		return ""
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetLocked() bool {
+	if d == nil || d.Locked == nil {
+		return false
 	}
-	return *g.URL
+	return *d.Locked
 }
 
-// GetActive returns the Active field if it's non-nil, zero value otherwise.
-func (h *Hook) GetActive() bool {
-	if h == nil || h.Active == nil {
-		return false
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *h.Active
+	return *d.Number
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetCreatedAt() time.Time {
-	if h == nil || h.CreatedAt == nil {
-		return time.Time{}
+// GetPoll returns the Poll field.
+func (d *Discussion) GetPoll() *DiscussionPoll {
+	if d == nil {
+		return nil
 	}
-	return *h.CreatedAt
+	return d.Poll
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetID() int64 {
-	if h == nil || h.ID == nil {
-		return 0
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *Discussion) GetTitle() string {
+	if d == nil || d.Title == nil {
+		return ""
 	}
-	return *h.ID
+	return *d.Title
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (h *Hook) GetUpdatedAt() time.Time {
-	if h == nil || h.UpdatedAt == nil {
-		return time.Time{}
+func (d *Discussion) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *h.UpdatedAt
+	return *d.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (h *Hook) GetURL() string {
-	if h == nil || h.URL == nil {
+func (d *Discussion) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *h.URL
-}
-
-// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetActiveHooks() int {
-	if h == nil || h.ActiveHooks == nil {
-		return 0
-	}
-	return *h.ActiveHooks
+	return *d.URL
 }
 
-// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetInactiveHooks() int {
-	if h == nil || h.InactiveHooks == nil {
-		return 0
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (d *DiscussionAuthor) GetLogin() string {
+	if d == nil || d.Login == nil {
+		return ""
 	}
-	return *h.InactiveHooks
+	return *d.Login
 }
 
-// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
-func (h *HookStats) GetTotalHooks() int {
-	if h == nil || h.TotalHooks == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
 	}
-	return *h.TotalHooks
+	return *d.Description
 }
 
-// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsCount() int {
-	if i == nil || i.AuthorsCount == nil {
-		return 0
+// GetEmoji returns the Emoji field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetEmoji() string {
+	if d == nil || d.Emoji == nil {
+		return ""
 	}
-	return *i.AuthorsCount
+	return *d.Emoji
 }
 
-// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetAuthorsURL() string {
-	if i == nil || i.AuthorsURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetID() string {
+	if d == nil || d.ID == nil {
 		return ""
 	}
-	return *i.AuthorsURL
+	return *d.ID
 }
 
-// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetCommitCount() int {
-	if i == nil || i.CommitCount == nil {
-		return 0
+// GetIsAnswerable returns the IsAnswerable field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetIsAnswerable() bool {
+	if d == nil || d.IsAnswerable == nil {
+		return false
 	}
-	return *i.CommitCount
+	return *d.IsAnswerable
 }
 
-// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
-func (i *Import) GetFailedStep() string {
-	if i == nil || i.FailedStep == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetName() string {
+	if d == nil || d.Name == nil {
 		return ""
 	}
-	return *i.FailedStep
+	return *d.Name
 }
 
-// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
-func (i *Import) GetHasLargeFiles() bool {
-	if i == nil || i.HasLargeFiles == nil {
-		return false
+// GetSlug returns the Slug field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategory) GetSlug() string {
+	if d == nil || d.Slug == nil {
+		return ""
 	}
-	return *i.HasLargeFiles
+	return *d.Slug
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetPageInfo returns the PageInfo field.
+func (d *DiscussionCategoryConnection) GetPageInfo() *PageInfo {
+	if d == nil {
+		return nil
 	}
-	return *i.HTMLURL
+	return d.PageInfo
 }
 
-// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
-func (i *Import) GetHumanName() string {
-	if i == nil || i.HumanName == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionCategoryConnection) GetTotalCount() int {
+	if d == nil || d.TotalCount == nil {
+		return 0
 	}
-	return *i.HumanName
+	return *d.TotalCount
 }
 
-// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesCount() int {
-	if i == nil || i.LargeFilesCount == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (d *DiscussionComment) GetAuthor() *User {
+	if d == nil {
+		return nil
 	}
-	return *i.LargeFilesCount
+	return d.Author
 }
 
-// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
-func (i *Import) GetLargeFilesSize() int {
-	if i == nil || i.LargeFilesSize == nil {
-		return 0
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *i.LargeFilesSize
+	return *d.Body
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (i *Import) GetMessage() string {
-	if i == nil || i.Message == nil {
+// GetBodyHTML returns the BodyHTML field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyHTML() string {
+	if d == nil || d.BodyHTML == nil {
 		return ""
 	}
-	return *i.Message
+	return *d.BodyHTML
 }
 
-// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPercent() int {
-	if i == nil || i.Percent == nil {
-		return 0
+// GetBodyVersion returns the BodyVersion field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetBodyVersion() string {
+	if d == nil || d.BodyVersion == nil {
+		return ""
 	}
-	return *i.Percent
+	return *d.BodyVersion
 }
 
-// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
-func (i *Import) GetPushPercent() int {
-	if i == nil || i.PushPercent == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetCreatedAt() Timestamp {
+	if d == nil || d.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.PushPercent
+	return *d.CreatedAt
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetDiscussionURL returns the DiscussionURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetDiscussionURL() string {
+	if d == nil || d.DiscussionURL == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *d.DiscussionURL
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatus() string {
-	if i == nil || i.Status == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetHTMLURL() string {
+	if d == nil || d.HTMLURL == nil {
 		return ""
 	}
-	return *i.Status
+	return *d.HTMLURL
 }
 
-// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
-func (i *Import) GetStatusText() string {
-	if i == nil || i.StatusText == nil {
-		return ""
+// GetLastEditedAt returns the LastEditedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetLastEditedAt() Timestamp {
+	if d == nil || d.LastEditedAt == nil {
+		return Timestamp{}
 	}
-	return *i.StatusText
+	return *d.LastEditedAt
 }
 
-// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
-func (i *Import) GetTFVCProject() string {
-	if i == nil || i.TFVCProject == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
 		return ""
 	}
-	return *i.TFVCProject
+	return *d.NodeID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Import) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetNumber() int {
+	if d == nil || d.Number == nil {
+		return 0
 	}
-	return *i.URL
+	return *d.Number
 }
 
-// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
-func (i *Import) GetUseLFS() string {
-	if i == nil || i.UseLFS == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (d *DiscussionComment) GetReactions() *Reactions {
+	if d == nil {
+		return nil
 	}
-	return *i.UseLFS
+	return d.Reactions
 }
 
-// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCS() string {
-	if i == nil || i.VCS == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetUpdatedAt() Timestamp {
+	if d == nil || d.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.VCS
+	return *d.UpdatedAt
 }
 
-// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSPassword() string {
-	if i == nil || i.VCSPassword == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (d *DiscussionComment) GetURL() string {
+	if d == nil || d.URL == nil {
 		return ""
 	}
-	return *i.VCSPassword
+	return *d.URL
 }
 
-// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSURL() string {
-	if i == nil || i.VCSURL == nil {
-		return ""
+// GetPageInfo returns the PageInfo field.
+func (d *DiscussionConnection) GetPageInfo() *PageInfo {
+	if d == nil {
+		return nil
 	}
-	return *i.VCSURL
+	return d.PageInfo
 }
 
-// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
-func (i *Import) GetVCSUsername() string {
-	if i == nil || i.VCSUsername == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionConnection) GetTotalCount() int {
+	if d == nil || d.TotalCount == nil {
+		return 0
 	}
-	return *i.VCSUsername
+	return *d.TotalCount
 }
 
-// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAccessTokensURL() string {
-	if i == nil || i.AccessTokensURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetID() string {
+	if d == nil || d.ID == nil {
 		return ""
 	}
-	return *i.AccessTokensURL
+	return *d.ID
 }
 
-// GetAccount returns the Account field.
-func (i *Installation) GetAccount() *User {
-	if i == nil {
-		return nil
+// GetQuestion returns the Question field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetQuestion() string {
+	if d == nil || d.Question == nil {
+		return ""
 	}
-	return i.Account
+	return *d.Question
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetAppID() int64 {
-	if i == nil || i.AppID == nil {
+// GetTotalVoteCount returns the TotalVoteCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetTotalVoteCount() int {
+	if d == nil || d.TotalVoteCount == nil {
 		return 0
 	}
-	return *i.AppID
+	return *d.TotalVoteCount
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetCreatedAt() Timestamp {
-	if i == nil || i.CreatedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetID() string {
+	if d == nil || d.ID == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *d.ID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetOption returns the Option field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetOption() string {
+	if d == nil || d.Option == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *d.Option
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetTotalVoteCount returns the TotalVoteCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetTotalVoteCount() int {
+	if d == nil || d.TotalVoteCount == nil {
 		return 0
 	}
-	return *i.ID
+	return *d.TotalVoteCount
 }
 
-// GetPermissions returns the Permissions field.
-func (i *Installation) GetPermissions() *InstallationPermissions {
-	if i == nil {
+// GetTeams returns the Teams field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetTeams() []string {
+	if d == nil || d.Teams == nil {
 		return nil
 	}
-	return i.Permissions
+	return *d.Teams
 }
 
-// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositoriesURL() string {
-	if i == nil || i.RepositoriesURL == nil {
-		return ""
+// GetUsers returns the Users field if it's non-nil, zero value otherwise.
+func (d *DismissalRestrictionsRequest) GetUsers() []string {
+	if d == nil || d.Users == nil {
+		return nil
 	}
-	return *i.RepositoriesURL
+	return *d.Users
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *Installation) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
+// GetDismissalCommitID returns the DismissalCommitID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalCommitID() string {
+	if d == nil || d.DismissalCommitID == nil {
 		return ""
 	}
-	return *i.RepositorySelection
+	return *d.DismissalCommitID
 }
 
-// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
-func (i *Installation) GetSingleFileName() string {
-	if i == nil || i.SingleFileName == nil {
+// GetDismissalMessage returns the DismissalMessage field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetDismissalMessage() string {
+	if d == nil || d.DismissalMessage == nil {
 		return ""
 	}
-	return *i.SingleFileName
+	return *d.DismissalMessage
 }
 
-// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetID() int64 {
-	if i == nil || i.TargetID == nil {
+// GetReviewID returns the ReviewID field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetReviewID() int64 {
+	if d == nil || d.ReviewID == nil {
 		return 0
 	}
-	return *i.TargetID
+	return *d.ReviewID
 }
 
-// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
-func (i *Installation) GetTargetType() string {
-	if i == nil || i.TargetType == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (d *DismissedReview) GetState() string {
+	if d == nil || d.State == nil {
 		return ""
 	}
-	return *i.TargetType
+	return *d.State
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Installation) GetUpdatedAt() Timestamp {
-	if i == nil || i.UpdatedAt == nil {
-		return Timestamp{}
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *d.Body
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPath() string {
+	if d == nil || d.Path == nil {
 		return ""
 	}
-	return *i.Action
+	return *d.Path
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (d *DraftReviewComment) GetPosition() int {
+	if d == nil || d.Position == nil {
+		return 0
 	}
-	return i.Installation
+	return *d.Position
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetEnterpriseServerUser returns the EnterpriseServerUser field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetEnterpriseServerUser() bool {
+	if e == nil || e.EnterpriseServerUser == nil {
+		return false
 	}
-	return i.Sender
+	return *e.EnterpriseServerUser
 }
 
-// GetContents returns the Contents field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetContents() string {
-	if i == nil || i.Contents == nil {
+// GetGithubComName returns the GithubComName field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetGithubComName() string {
+	if e == nil || e.GithubComName == nil {
 		return ""
 	}
-	return *i.Contents
+	return *e.GithubComName
 }
 
-// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetIssues() string {
-	if i == nil || i.Issues == nil {
+// GetGithubComProfile returns the GithubComProfile field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetGithubComProfile() string {
+	if e == nil || e.GithubComProfile == nil {
 		return ""
 	}
-	return *i.Issues
+	return *e.GithubComProfile
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetMetadata() string {
-	if i == nil || i.Metadata == nil {
+// GetGithubComSamlNameID returns the GithubComSamlNameID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetGithubComSamlNameID() string {
+	if e == nil || e.GithubComSamlNameID == nil {
 		return ""
 	}
-	return *i.Metadata
+	return *e.GithubComSamlNameID
 }
 
-// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
-func (i *InstallationPermissions) GetSingleFile() string {
-	if i == nil || i.SingleFile == nil {
-		return ""
+// GetGithubComTwoFactorAuth returns the GithubComTwoFactorAuth field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetGithubComTwoFactorAuth() bool {
+	if e == nil || e.GithubComTwoFactorAuth == nil {
+		return false
 	}
-	return *i.SingleFile
+	return *e.GithubComTwoFactorAuth
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+// GetVisualStudioLicenseStatus returns the VisualStudioLicenseStatus field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetVisualStudioLicenseStatus() string {
+	if e == nil || e.VisualStudioLicenseStatus == nil {
 		return ""
 	}
-	return *i.Action
+	return *e.VisualStudioLicenseStatus
 }
 
-// GetInstallation returns the Installation field.
-func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetVisualStudioSubscriptionEmail returns the VisualStudioSubscriptionEmail field if it's non-nil, zero value otherwise.
+func (e *EnterpriseLicensedUsers) GetVisualStudioSubscriptionEmail() string {
+	if e == nil || e.VisualStudioSubscriptionEmail == nil {
+		return ""
 	}
-	return i.Installation
+	return *e.VisualStudioSubscriptionEmail
 }
 
-// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
-func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
-	if i == nil || i.RepositorySelection == nil {
-		return ""
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetAllowsPublicRepositories() bool {
+	if e == nil || e.AllowsPublicRepositories == nil {
+		return false
 	}
-	return *i.RepositorySelection
+	return *e.AllowsPublicRepositories
 }
 
-// GetSender returns the Sender field.
-func (i *InstallationRepositoriesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetDefault() bool {
+	if e == nil || e.Default == nil {
+		return false
 	}
-	return i.Sender
+	return *e.Default
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetExpiresAt() time.Time {
-	if i == nil || i.ExpiresAt == nil {
-		return time.Time{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
 	}
-	return *i.ExpiresAt
+	return *e.ID
 }
 
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (i *InstallationToken) GetToken() string {
-	if i == nil || i.Token == nil {
-		return ""
+// GetInherited returns the Inherited field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetInherited() bool {
+	if e == nil || e.Inherited == nil {
+		return false
 	}
-	return *i.Token
+	return *e.Inherited
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetExpiresAt() Timestamp {
-	if i == nil || i.ExpiresAt == nil {
-		return Timestamp{}
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
 	}
-	return *i.ExpiresAt
+	return *e.Name
 }
 
-// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetLimit() string {
-	if i == nil || i.Limit == nil {
-		return ""
+// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetRestrictedToWorkflows() bool {
+	if e == nil || e.RestrictedToWorkflows == nil {
+		return false
 	}
-	return *i.Limit
+	return *e.RestrictedToWorkflows
 }
 
-// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
-func (i *InteractionRestriction) GetOrigin() string {
-	if i == nil || i.Origin == nil {
+// GetRunnersURL returns the RunnersURL field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetRunnersURL() string {
+	if e == nil || e.RunnersURL == nil {
 		return ""
 	}
-	return *i.Origin
+	return *e.RunnersURL
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetSelectedOrganizationsURL returns the SelectedOrganizationsURL field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetSelectedOrganizationsURL() string {
+	if e == nil || e.SelectedOrganizationsURL == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *e.SelectedOrganizationsURL
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetEmail() string {
-	if i == nil || i.Email == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroup) GetVisibility() string {
+	if e == nil || e.Visibility == nil {
 		return ""
 	}
-	return *i.Email
+	return *e.Visibility
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (e *EnterpriseRunnerGroups) GetTotalCount() int {
+	if e == nil || e.TotalCount == nil {
 		return 0
 	}
-	return *i.ID
+	return *e.TotalCount
 }
 
-// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetInvitationTeamURL() string {
-	if i == nil || i.InvitationTeamURL == nil {
-		return ""
+// GetAdvancedSecurityEnabledForNewRepositories returns the AdvancedSecurityEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetAdvancedSecurityEnabledForNewRepositories() bool {
+	if e == nil || e.AdvancedSecurityEnabledForNewRepositories == nil {
+		return false
 	}
-	return *i.InvitationTeamURL
+	return *e.AdvancedSecurityEnabledForNewRepositories
 }
 
-// GetInviter returns the Inviter field.
-func (i *Invitation) GetInviter() *User {
-	if i == nil {
-		return nil
+// GetSecretScanningEnabledForNewRepositories returns the SecretScanningEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningEnabledForNewRepositories() bool {
+	if e == nil || e.SecretScanningEnabledForNewRepositories == nil {
+		return false
 	}
-	return i.Inviter
+	return *e.SecretScanningEnabledForNewRepositories
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetLogin() string {
-	if i == nil || i.Login == nil {
+// GetSecretScanningPushProtectionCustomLink returns the SecretScanningPushProtectionCustomLink field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionCustomLink() string {
+	if e == nil || e.SecretScanningPushProtectionCustomLink == nil {
 		return ""
 	}
-	return *i.Login
+	return *e.SecretScanningPushProtectionCustomLink
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
-		return ""
+// GetSecretScanningPushProtectionEnabledForNewRepositories returns the SecretScanningPushProtectionEnabledForNewRepositories field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningPushProtectionEnabledForNewRepositories() bool {
+	if e == nil || e.SecretScanningPushProtectionEnabledForNewRepositories == nil {
+		return false
 	}
-	return *i.NodeID
+	return *e.SecretScanningPushProtectionEnabledForNewRepositories
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetRole() string {
-	if i == nil || i.Role == nil {
-		return ""
+// GetSecretScanningValidityChecksEnabled returns the SecretScanningValidityChecksEnabled field if it's non-nil, zero value otherwise.
+func (e *EnterpriseSecurityAnalysisSettings) GetSecretScanningValidityChecksEnabled() bool {
+	if e == nil || e.SecretScanningValidityChecksEnabled == nil {
+		return false
 	}
-	return *i.Role
+	return *e.SecretScanningValidityChecksEnabled
 }
 
-// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
-func (i *Invitation) GetTeamCount() int {
-	if i == nil || i.TeamCount == nil {
-		return 0
+// GetActor returns the Actor field.
+func (e *Event) GetActor() *User {
+	if e == nil {
+		return nil
 	}
-	return *i.TeamCount
+	return e.Actor
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (i *Issue) GetActiveLockReason() string {
-	if i == nil || i.ActiveLockReason == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Event) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Event) GetID() string {
+	if e == nil || e.ID == nil {
 		return ""
 	}
-	return *i.ActiveLockReason
+	return *e.ID
 }
 
-// GetAssignee returns the Assignee field.
-func (i *Issue) GetAssignee() *User {
-	if i == nil {
+// GetOrg returns the Org field.
+func (e *Event) GetOrg() *Organization {
+	if e == nil {
 		return nil
 	}
-	return i.Assignee
+	return e.Org
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *Issue) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
-	}
-	return *i.Body
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (e *Event) GetPublic() bool {
+	if e == nil || e.Public == nil {
+		return false
+	}
+	return *e.Public
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetClosedAt() time.Time {
-	if i == nil || i.ClosedAt == nil {
-		return time.Time{}
+// GetRawPayload returns the RawPayload field if it's non-nil, zero value otherwise.
+func (e *Event) GetRawPayload() json.RawMessage {
+	if e == nil || e.RawPayload == nil {
+		return json.RawMessage{}
 	}
-	return *i.ClosedAt
+	return *e.RawPayload
 }
 
-// GetClosedBy returns the ClosedBy field.
-func (i *Issue) GetClosedBy() *User {
-	if i == nil {
+// GetRepo returns the Repo field.
+func (e *Event) GetRepo() *Repository {
+	if e == nil {
 		return nil
 	}
-	return i.ClosedBy
+	return e.Repo
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (i *Issue) GetComments() int {
-	if i == nil || i.Comments == nil {
-		return 0
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (e *Event) GetType() string {
+	if e == nil || e.Type == nil {
+		return ""
 	}
-	return *i.Comments
+	return *e.Type
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCommentsURL() string {
-	if i == nil || i.CommentsURL == nil {
-		return ""
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetGroupID() int64 {
+	if e == nil || e.GroupID == nil {
+		return 0
 	}
-	return *i.CommentsURL
+	return *e.GroupID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetGroupName returns the GroupName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetGroupName() string {
+	if e == nil || e.GroupName == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *e.GroupName
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetEventsURL() string {
-	if i == nil || i.EventsURL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *ExternalGroup) GetUpdatedAt() Timestamp {
+	if e == nil || e.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.EventsURL
+	return *e.UpdatedAt
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
+// GetMemberEmail returns the MemberEmail field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberEmail() string {
+	if e == nil || e.MemberEmail == nil {
 		return ""
 	}
-	return *i.HTMLURL
+	return *e.MemberEmail
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetID() int64 {
-	if i == nil || i.ID == nil {
+// GetMemberID returns the MemberID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberID() int64 {
+	if e == nil || e.MemberID == nil {
 		return 0
 	}
-	return *i.ID
+	return *e.MemberID
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLabelsURL() string {
-	if i == nil || i.LabelsURL == nil {
+// GetMemberLogin returns the MemberLogin field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberLogin() string {
+	if e == nil || e.MemberLogin == nil {
 		return ""
 	}
-	return *i.LabelsURL
+	return *e.MemberLogin
 }
 
-// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
-func (i *Issue) GetLocked() bool {
-	if i == nil || i.Locked == nil {
-		return false
+// GetMemberName returns the MemberName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupMember) GetMemberName() string {
+	if e == nil || e.MemberName == nil {
+		return ""
 	}
-	return *i.Locked
+	return *e.MemberName
 }
 
-// GetMilestone returns the Milestone field.
-func (i *Issue) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupTeam) GetTeamID() int64 {
+	if e == nil || e.TeamID == nil {
+		return 0
 	}
-	return i.Milestone
+	return *e.TeamID
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetTeamName returns the TeamName field if it's non-nil, zero value otherwise.
+func (e *ExternalGroupTeam) GetTeamName() string {
+	if e == nil || e.TeamName == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *e.TeamName
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (i *Issue) GetNumber() int {
-	if i == nil || i.Number == nil {
-		return 0
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetHRef() string {
+	if f == nil || f.HRef == nil {
+		return ""
 	}
-	return *i.Number
+	return *f.HRef
 }
 
-// GetPullRequestLinks returns the PullRequestLinks field.
-func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (f *FeedLink) GetType() string {
+	if f == nil || f.Type == nil {
+		return ""
 	}
-	return i.PullRequestLinks
+	return *f.Type
 }
 
-// GetReactions returns the Reactions field.
-func (i *Issue) GetReactions() *Reactions {
-	if i == nil {
-		return nil
+// GetCurrentUserActorURL returns the CurrentUserActorURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserActorURL() string {
+	if f == nil || f.CurrentUserActorURL == nil {
+		return ""
 	}
-	return i.Reactions
+	return *f.CurrentUserActorURL
 }
 
-// GetRepository returns the Repository field.
-func (i *Issue) GetRepository() *Repository {
-	if i == nil {
-		return nil
+// GetCurrentUserOrganizationURL returns the CurrentUserOrganizationURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserOrganizationURL() string {
+	if f == nil || f.CurrentUserOrganizationURL == nil {
+		return ""
 	}
-	return i.Repository
+	return *f.CurrentUserOrganizationURL
 }
 
-// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetRepositoryURL() string {
-	if i == nil || i.RepositoryURL == nil {
+// GetCurrentUserPublicURL returns the CurrentUserPublicURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserPublicURL() string {
+	if f == nil || f.CurrentUserPublicURL == nil {
 		return ""
 	}
-	return *i.RepositoryURL
+	return *f.CurrentUserPublicURL
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *Issue) GetState() string {
-	if i == nil || i.State == nil {
+// GetCurrentUserURL returns the CurrentUserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetCurrentUserURL() string {
+	if f == nil || f.CurrentUserURL == nil {
 		return ""
 	}
-	return *i.State
+	return *f.CurrentUserURL
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *Issue) GetTitle() string {
-	if i == nil || i.Title == nil {
+// GetTimelineURL returns the TimelineURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetTimelineURL() string {
+	if f == nil || f.TimelineURL == nil {
 		return ""
 	}
-	return *i.Title
+	return *f.TimelineURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *Issue) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetUserURL returns the UserURL field if it's non-nil, zero value otherwise.
+func (f *Feeds) GetUserURL() string {
+	if f == nil || f.UserURL == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *f.UserURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *Issue) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (f *FirstPatchedVersion) GetIdentifier() string {
+	if f == nil || f.Identifier == nil {
 		return ""
 	}
-	return *i.URL
+	return *f.Identifier
 }
 
-// GetUser returns the User field.
-func (i *Issue) GetUser() *User {
-	if i == nil {
+// GetForkee returns the Forkee field.
+func (f *ForkEvent) GetForkee() *Repository {
+	if f == nil {
 		return nil
 	}
-	return i.User
+	return f.Forkee
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetAuthorAssociation() string {
-	if i == nil || i.AuthorAssociation == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (f *ForkEvent) GetInstallation() *Installation {
+	if f == nil {
+		return nil
 	}
-	return *i.AuthorAssociation
+	return f.Installation
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (f *ForkEvent) GetRepo() *Repository {
+	if f == nil {
+		return nil
 	}
-	return *i.Body
+	return f.Repo
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetSender returns the Sender field.
+func (f *ForkEvent) GetSender() *User {
+	if f == nil {
+		return nil
 	}
-	return *i.CreatedAt
+	return f.Sender
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetHTMLURL() string {
-	if i == nil || i.HTMLURL == nil {
-		return ""
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (g *Gist) GetComments() int {
+	if g == nil || g.Comments == nil {
+		return 0
 	}
-	return *i.HTMLURL
+	return *g.Comments
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *i.ID
+	return *g.CreatedAt
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetIssueURL() string {
-	if i == nil || i.IssueURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *Gist) GetDescription() string {
+	if g == nil || g.Description == nil {
 		return ""
 	}
-	return *i.IssueURL
+	return *g.Description
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetNodeID() string {
-	if i == nil || i.NodeID == nil {
+// GetGitPullURL returns the GitPullURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPullURL() string {
+	if g == nil || g.GitPullURL == nil {
 		return ""
 	}
-	return *i.NodeID
+	return *g.GitPullURL
 }
 
-// GetReactions returns the Reactions field.
-func (i *IssueComment) GetReactions() *Reactions {
-	if i == nil {
-		return nil
+// GetGitPushURL returns the GitPushURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetGitPushURL() string {
+	if g == nil || g.GitPushURL == nil {
+		return ""
 	}
-	return i.Reactions
+	return *g.GitPushURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetUpdatedAt() time.Time {
-	if i == nil || i.UpdatedAt == nil {
-		return time.Time{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *Gist) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
+		return ""
 	}
-	return *i.UpdatedAt
+	return *g.HTMLURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueComment) GetURL() string {
-	if i == nil || i.URL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetID() string {
+	if g == nil || g.ID == nil {
 		return ""
 	}
-	return *i.URL
+	return *g.ID
 }
 
-// GetUser returns the User field.
-func (i *IssueComment) GetUser() *User {
-	if i == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *Gist) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
+	}
+	return *g.NodeID
+}
+
+// GetOwner returns the Owner field.
+func (g *Gist) GetOwner() *User {
+	if g == nil {
 		return nil
 	}
-	return i.User
+	return g.Owner
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssueCommentEvent) GetAction() string {
-	if i == nil || i.Action == nil {
-		return ""
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (g *Gist) GetPublic() bool {
+	if g == nil || g.Public == nil {
+		return false
 	}
-	return *i.Action
+	return *g.Public
 }
 
-// GetChanges returns the Changes field.
-func (i *IssueCommentEvent) GetChanges() *EditChange {
-	if i == nil {
-		return nil
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *Gist) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
 	}
-	return i.Changes
+	return *g.Truncated
 }
 
-// GetComment returns the Comment field.
-func (i *IssueCommentEvent) GetComment() *IssueComment {
-	if i == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Gist) GetUpdatedAt() time.Time {
+	if g == nil || g.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return i.Comment
+	return *g.UpdatedAt
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssueCommentEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetBody() string {
+	if g == nil || g.Body == nil {
+		return ""
 	}
-	return i.Installation
+	return *g.Body
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueCommentEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
 	}
-	return i.Issue
+	return *g.CreatedAt
 }
 
-// GetRepo returns the Repo field.
-func (i *IssueCommentEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
 	}
-	return i.Repo
+	return *g.ID
 }
 
-// GetSender returns the Sender field.
-func (i *IssueCommentEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistComment) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
 	}
-	return i.Sender
+	return *g.URL
 }
 
-// GetActor returns the Actor field.
-func (i *IssueEvent) GetActor() *User {
-	if i == nil {
+// GetUser returns the User field.
+func (g *GistComment) GetUser() *User {
+	if g == nil {
 		return nil
 	}
-	return i.Actor
+	return g.User
 }
 
-// GetAssignee returns the Assignee field.
-func (i *IssueEvent) GetAssignee() *User {
-	if i == nil {
+// GetChangeStatus returns the ChangeStatus field.
+func (g *GistCommit) GetChangeStatus() *CommitStats {
+	if g == nil {
 		return nil
 	}
-	return i.Assignee
+	return g.ChangeStatus
 }
 
-// GetAssigner returns the Assigner field.
-func (i *IssueEvent) GetAssigner() *User {
-	if i == nil {
-		return nil
+// GetCommittedAt returns the CommittedAt field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetCommittedAt() Timestamp {
+	if g == nil || g.CommittedAt == nil {
+		return Timestamp{}
 	}
-	return i.Assigner
+	return *g.CommittedAt
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCommitID() string {
-	if i == nil || i.CommitID == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
 		return ""
 	}
-	return *i.CommitID
+	return *g.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetCreatedAt() time.Time {
-	if i == nil || i.CreatedAt == nil {
-		return time.Time{}
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
 	}
-	return *i.CreatedAt
+	return *g.URL
 }
 
-// GetDismissedReview returns the DismissedReview field.
-func (i *IssueEvent) GetDismissedReview() *DismissedReview {
-	if i == nil {
+// GetUser returns the User field.
+func (g *GistCommit) GetUser() *User {
+	if g == nil {
 		return nil
 	}
-	return i.DismissedReview
+	return g.User
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetEvent() string {
-	if i == nil || i.Event == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (g *GistCommit) GetVersion() string {
+	if g == nil || g.Version == nil {
 		return ""
 	}
-	return *i.Event
+	return *g.Version
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetID() int64 {
-	if i == nil || i.ID == nil {
-		return 0
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetContent() string {
+	if g == nil || g.Content == nil {
+		return ""
 	}
-	return *i.ID
+	return *g.Content
 }
 
-// GetIssue returns the Issue field.
-func (i *IssueEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetFilename() string {
+	if g == nil || g.Filename == nil {
+		return ""
 	}
-	return i.Issue
+	return *g.Filename
 }
 
-// GetLabel returns the Label field.
-func (i *IssueEvent) GetLabel() *Label {
-	if i == nil {
-		return nil
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetLanguage() string {
+	if g == nil || g.Language == nil {
+		return ""
 	}
-	return i.Label
+	return *g.Language
 }
 
-// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetLockReason() string {
-	if i == nil || i.LockReason == nil {
+// GetRawURL returns the RawURL field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetRawURL() string {
+	if g == nil || g.RawURL == nil {
 		return ""
 	}
-	return *i.LockReason
+	return *g.RawURL
 }
 
-// GetMilestone returns the Milestone field.
-func (i *IssueEvent) GetMilestone() *Milestone {
-	if i == nil {
-		return nil
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetSize() int {
+	if g == nil || g.Size == nil {
+		return 0
 	}
-	return i.Milestone
+	return *g.Size
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (i *IssueEvent) GetProjectCard() *ProjectCard {
-	if i == nil {
-		return nil
+// GetTruncated returns the Truncated field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetTruncated() bool {
+	if g == nil || g.Truncated == nil {
+		return false
 	}
-	return i.ProjectCard
+	return *g.Truncated
 }
 
-// GetRename returns the Rename field.
-func (i *IssueEvent) GetRename() *Rename {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GistFile) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
 	}
-	return i.Rename
+	return *g.Type
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (i *IssueEvent) GetURL() string {
-	if i == nil || i.URL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.URL
+	return *g.CreatedAt
 }
 
-// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignee() string {
-	if i == nil || i.Assignee == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetID() string {
+	if g == nil || g.ID == nil {
 		return ""
 	}
-	return *i.Assignee
+	return *g.ID
 }
 
-// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetAssignees() []string {
-	if i == nil || i.Assignees == nil {
-		return nil
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetNodeID() string {
+	if g == nil || g.NodeID == nil {
+		return ""
 	}
-	return *i.Assignees
+	return *g.NodeID
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetBody() string {
-	if i == nil || i.Body == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *i.Body
+	return *g.UpdatedAt
 }
 
-// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetLabels() []string {
-	if i == nil || i.Labels == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GistFork) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
+	}
+	return *g.URL
+}
+
+// GetUser returns the User field.
+func (g *GistFork) GetUser() *User {
+	if g == nil {
 		return nil
 	}
-	return *i.Labels
+	return g.User
 }
 
-// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetMilestone() int {
-	if i == nil || i.Milestone == nil {
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPrivateGists() int {
+	if g == nil || g.PrivateGists == nil {
 		return 0
 	}
-	return *i.Milestone
+	return *g.PrivateGists
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetState() string {
-	if i == nil || i.State == nil {
-		return ""
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetPublicGists() int {
+	if g == nil || g.PublicGists == nil {
+		return 0
 	}
-	return *i.State
+	return *g.PublicGists
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (i *IssueRequest) GetTitle() string {
-	if i == nil || i.Title == nil {
-		return ""
+// GetTotalGists returns the TotalGists field if it's non-nil, zero value otherwise.
+func (g *GistStats) GetTotalGists() int {
+	if g == nil || g.TotalGists == nil {
+		return 0
 	}
-	return *i.Title
+	return *g.TotalGists
 }
 
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (i *IssuesEvent) GetAction() string {
-	if i == nil || i.Action == nil {
+func (g *GitHubAppAuthorizationEvent) GetAction() string {
+	if g == nil || g.Action == nil {
 		return ""
 	}
-	return *i.Action
-}
-
-// GetAssignee returns the Assignee field.
-func (i *IssuesEvent) GetAssignee() *User {
-	if i == nil {
-		return nil
-	}
-	return i.Assignee
+	return *g.Action
 }
 
-// GetChanges returns the Changes field.
-func (i *IssuesEvent) GetChanges() *EditChange {
-	if i == nil {
+// GetSender returns the Sender field.
+func (g *GitHubAppAuthorizationEvent) GetSender() *User {
+	if g == nil {
 		return nil
 	}
-	return i.Changes
+	return g.Sender
 }
 
-// GetInstallation returns the Installation field.
-func (i *IssuesEvent) GetInstallation() *Installation {
-	if i == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
 	}
-	return i.Installation
+	return *g.Name
 }
 
-// GetIssue returns the Issue field.
-func (i *IssuesEvent) GetIssue() *Issue {
-	if i == nil {
-		return nil
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (g *Gitignore) GetSource() string {
+	if g == nil || g.Source == nil {
+		return ""
 	}
-	return i.Issue
+	return *g.Source
 }
 
-// GetLabel returns the Label field.
-func (i *IssuesEvent) GetLabel() *Label {
-	if i == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetSHA() string {
+	if g == nil || g.SHA == nil {
+		return ""
 	}
-	return i.Label
+	return *g.SHA
 }
 
-// GetRepo returns the Repo field.
-func (i *IssuesEvent) GetRepo() *Repository {
-	if i == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
 	}
-	return i.Repo
+	return *g.Type
 }
 
-// GetSender returns the Sender field.
-func (i *IssuesEvent) GetSender() *User {
-	if i == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GitObject) GetURL() string {
+	if g == nil || g.URL == nil {
+		return ""
 	}
-	return i.Sender
+	return *g.URL
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetIncompleteResults() bool {
-	if i == nil || i.IncompleteResults == nil {
+// GetAllowDownstreamConfiguration returns the AllowDownstreamConfiguration field if it's non-nil, zero value otherwise.
+func (g *GlobalPreReceiveHook) GetAllowDownstreamConfiguration() bool {
+	if g == nil || g.AllowDownstreamConfiguration == nil {
 		return false
 	}
-	return *i.IncompleteResults
+	return *g.AllowDownstreamConfiguration
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (i *IssuesSearchResult) GetTotal() int {
-	if i == nil || i.Total == nil {
-		return 0
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (g *GlobalPreReceiveHook) GetEnforcement() string {
+	if g == nil || g.Enforcement == nil {
+		return ""
 	}
-	return *i.Total
+	return *g.Enforcement
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetClosedIssues() int {
-	if i == nil || i.ClosedIssues == nil {
-		return 0
+// GetEnvironment returns the Environment field.
+func (g *GlobalPreReceiveHook) GetEnvironment() *PreReceiveEnvironment {
+	if g == nil {
+		return nil
 	}
-	return *i.ClosedIssues
+	return g.Environment
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetOpenIssues() int {
-	if i == nil || i.OpenIssues == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GlobalPreReceiveHook) GetID() int64 {
+	if g == nil || g.ID == nil {
 		return 0
 	}
-	return *i.OpenIssues
+	return *g.ID
 }
 
-// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
-func (i *IssueStats) GetTotalIssues() int {
-	if i == nil || i.TotalIssues == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (g *GlobalPreReceiveHook) GetName() string {
+	if g == nil || g.Name == nil {
+		return ""
 	}
-	return *i.TotalIssues
+	return *g.Name
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (k *Key) GetID() int64 {
-	if k == nil || k.ID == nil {
-		return 0
+// GetScript returns the Script field if it's non-nil, zero value otherwise.
+func (g *GlobalPreReceiveHook) GetScript() string {
+	if g == nil || g.Script == nil {
+		return ""
 	}
-	return *k.ID
+	return *g.Script
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (k *Key) GetKey() string {
-	if k == nil || k.Key == nil {
-		return ""
+// GetScriptRepository returns the ScriptRepository field.
+func (g *GlobalPreReceiveHook) GetScriptRepository() *Repository {
+	if g == nil {
+		return nil
 	}
-	return *k.Key
+	return g.ScriptRepository
 }
 
-// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
-func (k *Key) GetReadOnly() bool {
-	if k == nil || k.ReadOnly == nil {
-		return false
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetCVEID() string {
+	if g == nil || g.CVEID == nil {
+		return ""
 	}
-	return *k.ReadOnly
+	return *g.CVEID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (k *Key) GetTitle() string {
-	if k == nil || k.Title == nil {
-		return ""
+// GetCVSS returns the CVSS field.
+func (g *GlobalSecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if g == nil {
+		return nil
 	}
-	return *k.Title
+	return g.CVSS
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (k *Key) GetURL() string {
-	if k == nil || k.URL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetDescription() string {
+	if g == nil || g.Description == nil {
 		return ""
 	}
-	return *k.URL
+	return *g.Description
 }
 
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *Label) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGHSAID() string {
+	if g == nil || g.GHSAID == nil {
 		return ""
 	}
-	return *l.Color
+	return *g.GHSAID
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *Label) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetGithubReviewedAt returns the GithubReviewedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetGithubReviewedAt() Timestamp {
+	if g == nil || g.GithubReviewedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Default
+	return *g.GithubReviewedAt
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *Label) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetHTMLURL() string {
+	if g == nil || g.HTMLURL == nil {
 		return ""
 	}
-	return *l.Description
+	return *g.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *Label) GetID() int64 {
-	if l == nil || l.ID == nil {
+func (g *GlobalSecurityAdvisory) GetID() int64 {
+	if g == nil || g.ID == nil {
 		return 0
 	}
-	return *l.ID
+	return *g.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *Label) GetName() string {
-	if l == nil || l.Name == nil {
-		return ""
+// GetNVDPublishedAt returns the NVDPublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetNVDPublishedAt() Timestamp {
+	if g == nil || g.NVDPublishedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Name
+	return *g.NVDPublishedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (l *Label) GetNodeID() string {
-	if l == nil || l.NodeID == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetPublishedAt() Timestamp {
+	if g == nil || g.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *l.NodeID
+	return *g.PublishedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *Label) GetURL() string {
-	if l == nil || l.URL == nil {
+// GetRepositoryAdvisoryURL returns the RepositoryAdvisoryURL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetRepositoryAdvisoryURL() string {
+	if g == nil || g.RepositoryAdvisoryURL == nil {
 		return ""
 	}
-	return *l.URL
+	return *g.RepositoryAdvisoryURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (l *LabelEvent) GetAction() string {
-	if l == nil || l.Action == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSeverity() string {
+	if g == nil || g.Severity == nil {
 		return ""
 	}
-	return *l.Action
+	return *g.Severity
 }
 
-// GetChanges returns the Changes field.
-func (l *LabelEvent) GetChanges() *EditChange {
-	if l == nil {
-		return nil
+// GetSourceCodeLocation returns the SourceCodeLocation field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSourceCodeLocation() string {
+	if g == nil || g.SourceCodeLocation == nil {
+		return ""
 	}
-	return l.Changes
+	return *g.SourceCodeLocation
 }
 
-// GetInstallation returns the Installation field.
-func (l *LabelEvent) GetInstallation() *Installation {
-	if l == nil {
-		return nil
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetSummary() string {
+	if g == nil || g.Summary == nil {
+		return ""
 	}
-	return l.Installation
+	return *g.Summary
 }
 
-// GetLabel returns the Label field.
-func (l *LabelEvent) GetLabel() *Label {
-	if l == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetType() string {
+	if g == nil || g.Type == nil {
+		return ""
 	}
-	return l.Label
+	return *g.Type
 }
 
-// GetOrg returns the Org field.
-func (l *LabelEvent) GetOrg() *Organization {
-	if l == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return l.Org
+	return *g.UpdatedAt
 }
 
-// GetRepo returns the Repo field.
-func (l *LabelEvent) GetRepo() *Repository {
-	if l == nil {
-		return nil
-	}
-	return l.Repo
-}
-
-// GetColor returns the Color field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetColor() string {
-	if l == nil || l.Color == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *l.Color
+	return *g.URL
 }
 
-// GetDefault returns the Default field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDefault() bool {
-	if l == nil || l.Default == nil {
-		return false
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if g == nil || g.WithdrawnAt == nil {
+		return Timestamp{}
 	}
-	return *l.Default
+	return *g.WithdrawnAt
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetDescription() string {
-	if l == nil || l.Description == nil {
+// GetFirstPatchedVersion returns the FirstPatchedVersion field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetFirstPatchedVersion() string {
+	if g == nil || g.FirstPatchedVersion == nil {
 		return ""
 	}
-	return *l.Description
+	return *g.FirstPatchedVersion
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetID() int64 {
-	if l == nil || l.ID == nil {
-		return 0
+// GetPackage returns the Package field.
+func (g *GlobalSecurityVulnerability) GetPackage() *VulnerabilityPackage {
+	if g == nil {
+		return nil
 	}
-	return *l.ID
+	return g.Package
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetName() string {
-	if l == nil || l.Name == nil {
+// GetVulnerableVersionRange returns the VulnerableVersionRange field if it's non-nil, zero value otherwise.
+func (g *GlobalSecurityVulnerability) GetVulnerableVersionRange() string {
+	if g == nil || g.VulnerableVersionRange == nil {
 		return ""
 	}
-	return *l.Name
+	return *g.VulnerableVersionRange
 }
 
-// GetScore returns the Score field.
-func (l *LabelResult) GetScore() *float64 {
-	if l == nil {
+// GetInstallation returns the Installation field.
+func (g *GollumEvent) GetInstallation() *Installation {
+	if g == nil {
 		return nil
 	}
-	return l.Score
+	return g.Installation
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *LabelResult) GetURL() string {
-	if l == nil || l.URL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (g *GollumEvent) GetRepo() *Repository {
+	if g == nil {
+		return nil
 	}
-	return *l.URL
+	return g.Repo
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetIncompleteResults() bool {
-	if l == nil || l.IncompleteResults == nil {
-		return false
+// GetSender returns the Sender field.
+func (g *GollumEvent) GetSender() *User {
+	if g == nil {
+		return nil
 	}
-	return *l.IncompleteResults
+	return g.Sender
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *LabelsSearchResult) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetEmail() string {
+	if g == nil || g.Email == nil {
+		return ""
 	}
-	return *l.Total
+	return *g.Email
 }
 
-// GetOID returns the OID field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetOID() string {
-	if l == nil || l.OID == nil {
-		return ""
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (g *GPGEmail) GetVerified() bool {
+	if g == nil || g.Verified == nil {
+		return false
 	}
-	return *l.OID
+	return *g.Verified
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetPath() string {
-	if l == nil || l.Path == nil {
-		return ""
+// GetCanCertify returns the CanCertify field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanCertify() bool {
+	if g == nil || g.CanCertify == nil {
+		return false
 	}
-	return *l.Path
+	return *g.CanCertify
 }
 
-// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetRefName() string {
-	if l == nil || l.RefName == nil {
-		return ""
+// GetCanEncryptComms returns the CanEncryptComms field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptComms() bool {
+	if g == nil || g.CanEncryptComms == nil {
+		return false
 	}
-	return *l.RefName
+	return *g.CanEncryptComms
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (l *LargeFile) GetSize() int {
-	if l == nil || l.Size == nil {
-		return 0
+// GetCanEncryptStorage returns the CanEncryptStorage field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanEncryptStorage() bool {
+	if g == nil || g.CanEncryptStorage == nil {
+		return false
 	}
-	return *l.Size
+	return *g.CanEncryptStorage
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (l *License) GetBody() string {
-	if l == nil || l.Body == nil {
-		return ""
+// GetCanSign returns the CanSign field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCanSign() bool {
+	if g == nil || g.CanSign == nil {
+		return false
 	}
-	return *l.Body
+	return *g.CanSign
 }
 
-// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
-func (l *License) GetConditions() []string {
-	if l == nil || l.Conditions == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetCreatedAt() time.Time {
+	if g == nil || g.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *l.Conditions
+	return *g.CreatedAt
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *License) GetDescription() string {
-	if l == nil || l.Description == nil {
-		return ""
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetExpiresAt() time.Time {
+	if g == nil || g.ExpiresAt == nil {
+		return time.Time{}
 	}
-	return *l.Description
+	return *g.ExpiresAt
 }
 
-// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
-func (l *License) GetFeatured() bool {
-	if l == nil || l.Featured == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
 	}
-	return *l.Featured
+	return *g.ID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (l *License) GetHTMLURL() string {
-	if l == nil || l.HTMLURL == nil {
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetKeyID() string {
+	if g == nil || g.KeyID == nil {
 		return ""
 	}
-	return *l.HTMLURL
+	return *g.KeyID
 }
 
-// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
-func (l *License) GetImplementation() string {
-	if l == nil || l.Implementation == nil {
-		return ""
+// GetPrimaryKeyID returns the PrimaryKeyID field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPrimaryKeyID() int64 {
+	if g == nil || g.PrimaryKeyID == nil {
+		return 0
 	}
-	return *l.Implementation
+	return *g.PrimaryKeyID
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (l *License) GetKey() string {
-	if l == nil || l.Key == nil {
+// GetPublicKey returns the PublicKey field if it's non-nil, zero value otherwise.
+func (g *GPGKey) GetPublicKey() string {
+	if g == nil || g.PublicKey == nil {
 		return ""
 	}
-	return *l.Key
+	return *g.PublicKey
 }
 
-// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
-func (l *License) GetLimitations() []string {
-	if l == nil || l.Limitations == nil {
+// GetApp returns the App field.
+func (g *Grant) GetApp() *AuthorizationApp {
+	if g == nil {
 		return nil
 	}
-	return *l.Limitations
+	return g.App
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *License) GetName() string {
-	if l == nil || l.Name == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetCreatedAt() Timestamp {
+	if g == nil || g.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *l.Name
+	return *g.CreatedAt
 }
 
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (l *License) GetPermissions() []string {
-	if l == nil || l.Permissions == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetID() int64 {
+	if g == nil || g.ID == nil {
+		return 0
 	}
-	return *l.Permissions
+	return *g.ID
 }
 
-// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
-func (l *License) GetSPDXID() string {
-	if l == nil || l.SPDXID == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (g *Grant) GetUpdatedAt() Timestamp {
+	if g == nil || g.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *l.SPDXID
+	return *g.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (l *License) GetURL() string {
-	if l == nil || l.URL == nil {
+func (g *Grant) GetURL() string {
+	if g == nil || g.URL == nil {
 		return ""
 	}
-	return *l.URL
+	return *g.URL
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
-		return ""
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (h *Hook) GetActive() bool {
+	if h == nil || h.Active == nil {
+		return false
 	}
-	return *l.CheckName
+	return *h.Active
 }
 
-// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetFilter() string {
-	if l == nil || l.Filter == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetCreatedAt() time.Time {
+	if h == nil || h.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *l.Filter
+	return *h.CreatedAt
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsOptions) GetStatus() string {
-	if l == nil || l.Status == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() int64 {
+	if h == nil || h.ID == nil {
+		return 0
 	}
-	return *l.Status
+	return *h.ID
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckRunsResults) GetTotal() int {
-	if l == nil || l.Total == nil {
-		return 0
-	}
-	return *l.Total
-}
-
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetAppID() int {
-	if l == nil || l.AppID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (h *Hook) GetUpdatedAt() time.Time {
+	if h == nil || h.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *l.AppID
+	return *h.UpdatedAt
 }
 
-// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteOptions) GetCheckName() string {
-	if l == nil || l.CheckName == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (h *Hook) GetURL() string {
+	if h == nil || h.URL == nil {
 		return ""
 	}
-	return *l.CheckName
+	return *h.URL
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (l *ListCheckSuiteResults) GetTotal() int {
-	if l == nil || l.Total == nil {
+// GetActiveHooks returns the ActiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetActiveHooks() int {
+	if h == nil || h.ActiveHooks == nil {
 		return 0
 	}
-	return *l.Total
+	return *h.ActiveHooks
 }
 
-// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
-func (l *ListCollaboratorOptions) GetAffiliation() string {
-	if l == nil || l.Affiliation == nil {
-		return ""
+// GetInactiveHooks returns the InactiveHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetInactiveHooks() int {
+	if h == nil || h.InactiveHooks == nil {
+		return 0
 	}
-	return *l.Affiliation
+	return *h.InactiveHooks
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetTotalHooks returns the TotalHooks field if it's non-nil, zero value otherwise.
+func (h *HookStats) GetTotalHooks() int {
+	if h == nil || h.TotalHooks == nil {
+		return 0
 	}
-	return *m.EffectiveDate
+	return *h.TotalHooks
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetID() int64 {
-	if m == nil || m.ID == nil {
+func (h *HostedRunner) GetID() int64 {
+	if h == nil || h.ID == nil {
 		return 0
 	}
-	return *m.ID
+	return *h.ID
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
-	if m == nil {
+// GetImageDetails returns the ImageDetails field.
+func (h *HostedRunner) GetImageDetails() *HostedRunnerImageDetail {
+	if h == nil {
 		return nil
 	}
-	return m.Plan
+	return h.ImageDetails
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePendingChange) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetLastActiveOn returns the LastActiveOn field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetLastActiveOn() Timestamp {
+	if h == nil || h.LastActiveOn == nil {
+		return Timestamp{}
 	}
-	return *m.UnitCount
+	return *h.LastActiveOn
 }
 
-// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetAccountsURL() string {
-	if m == nil || m.AccountsURL == nil {
-		return ""
+// GetMachineSizeDetails returns the MachineSizeDetails field.
+func (h *HostedRunner) GetMachineSizeDetails() *HostedRunnerMachineSpec {
+	if h == nil {
+		return nil
 	}
-	return *m.AccountsURL
+	return h.MachineSizeDetails
 }
 
-// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetBullets() []string {
-	if m == nil || m.Bullets == nil {
-		return nil
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetMaximumRunners() int64 {
+	if h == nil || h.MaximumRunners == nil {
+		return 0
 	}
-	return *m.Bullets
+	return *h.MaximumRunners
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetName() string {
+	if h == nil || h.Name == nil {
 		return ""
 	}
-	return *m.Description
+	return *h.Name
 }
 
-// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetHasFreeTrial() bool {
-	if m == nil || m.HasFreeTrial == nil {
-		return false
+// GetPlatform returns the Platform field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPlatform() string {
+	if h == nil || h.Platform == nil {
+		return ""
 	}
-	return *m.HasFreeTrial
+	return *h.Platform
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetPublicIPEnabled returns the PublicIPEnabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetPublicIPEnabled() bool {
+	if h == nil || h.PublicIPEnabled == nil {
+		return false
 	}
-	return *m.ID
+	return *h.PublicIPEnabled
 }
 
-// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
-	if m == nil || m.MonthlyPriceInCents == nil {
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetRunnerGroupID() int64 {
+	if h == nil || h.RunnerGroupID == nil {
 		return 0
 	}
-	return *m.MonthlyPriceInCents
+	return *h.RunnerGroupID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetName() string {
-	if m == nil || m.Name == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (h *HostedRunner) GetStatus() string {
+	if h == nil || h.Status == nil {
 		return ""
 	}
-	return *m.Name
+	return *h.Status
 }
 
-// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetPriceModel() string {
-	if m == nil || m.PriceModel == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetID() string {
+	if h == nil || h.ID == nil {
 		return ""
 	}
-	return *m.PriceModel
+	return *h.ID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetState() string {
-	if m == nil || m.State == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetSource() string {
+	if h == nil || h.Source == nil {
 		return ""
 	}
-	return *m.State
+	return *h.Source
 }
 
-// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetUnitName() string {
-	if m == nil || m.UnitName == nil {
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImage) GetVersion() string {
+	if h == nil || h.Version == nil {
 		return ""
 	}
-	return *m.UnitName
+	return *h.Version
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetDisplayName() string {
+	if h == nil || h.DisplayName == nil {
 		return ""
 	}
-	return *m.URL
-}
-
-// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlan) GetYearlyPriceInCents() int {
-	if m == nil || m.YearlyPriceInCents == nil {
-		return 0
-	}
-	return *m.YearlyPriceInCents
+	return *h.DisplayName
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetEmail() string {
-	if m == nil || m.Email == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetID() string {
+	if h == nil || h.ID == nil {
 		return ""
 	}
-	return *m.Email
+	return *h.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetID() int64 {
-	if m == nil || m.ID == nil {
+// GetSizeGB returns the SizeGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSizeGB() int64 {
+	if h == nil || h.SizeGB == nil {
 		return 0
 	}
-	return *m.ID
+	return *h.SizeGB
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetLogin() string {
-	if m == nil || m.Login == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetSource() string {
+	if h == nil || h.Source == nil {
 		return ""
 	}
-	return *m.Login
+	return *h.Source
 }
 
-// GetMarketplacePendingChange returns the MarketplacePendingChange field.
-func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
-	if m == nil {
-		return nil
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerImageDetail) GetVersion() string {
+	if h == nil || h.Version == nil {
+		return ""
 	}
-	return m.MarketplacePendingChange
+	return *h.Version
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetCPUCores returns the CPUCores field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetCPUCores() int {
+	if h == nil || h.CPUCores == nil {
+		return 0
 	}
-	return m.MarketplacePurchase
+	return *h.CPUCores
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetID() string {
+	if h == nil || h.ID == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *h.ID
 }
 
-// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
-	if m == nil || m.OrganizationBillingEmail == nil {
-		return ""
+// GetMemoryGB returns the MemoryGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetMemoryGB() int {
+	if h == nil || h.MemoryGB == nil {
+		return 0
 	}
-	return *m.OrganizationBillingEmail
+	return *h.MemoryGB
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetType() string {
-	if m == nil || m.Type == nil {
-		return ""
+// GetStorageGB returns the StorageGB field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerMachineSpec) GetStorageGB() int {
+	if h == nil || h.StorageGB == nil {
+		return 0
 	}
-	return *m.Type
+	return *h.StorageGB
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *MarketplacePlanAccount) GetURL() string {
-	if m == nil || m.URL == nil {
-		return ""
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetEnabled() bool {
+	if h == nil || h.Enabled == nil {
+		return false
 	}
-	return *m.URL
+	return *h.Enabled
 }
 
-// GetAccount returns the Account field.
-func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
-	if m == nil {
-		return nil
+// GetLength returns the Length field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetLength() int {
+	if h == nil || h.Length == nil {
+		return 0
 	}
-	return m.Account
+	return *h.Length
 }
 
-// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetBillingCycle() string {
-	if m == nil || m.BillingCycle == nil {
+// GetPrefix returns the Prefix field if it's non-nil, zero value otherwise.
+func (h *HostedRunnerPublicIP) GetPrefix() string {
+	if h == nil || h.Prefix == nil {
 		return ""
 	}
-	return *m.BillingCycle
+	return *h.Prefix
 }
 
-// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
-	if m == nil || m.FreeTrialEndsOn == nil {
-		return Timestamp{}
+// GetAuthorsCount returns the AuthorsCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsCount() int {
+	if i == nil || i.AuthorsCount == nil {
+		return 0
 	}
-	return *m.FreeTrialEndsOn
+	return *i.AuthorsCount
 }
 
-// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
-	if m == nil || m.NextBillingDate == nil {
-		return Timestamp{}
+// GetAuthorsURL returns the AuthorsURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetAuthorsURL() string {
+	if i == nil || i.AuthorsURL == nil {
+		return ""
 	}
-	return *m.NextBillingDate
+	return *i.AuthorsURL
 }
 
-// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetOnFreeTrial() bool {
-	if m == nil || m.OnFreeTrial == nil {
-		return false
+// GetCommitCount returns the CommitCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetCommitCount() int {
+	if i == nil || i.CommitCount == nil {
+		return 0
 	}
-	return *m.OnFreeTrial
+	return *i.CommitCount
 }
 
-// GetPlan returns the Plan field.
-func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
-	if m == nil {
-		return nil
+// GetFailedStep returns the FailedStep field if it's non-nil, zero value otherwise.
+func (i *Import) GetFailedStep() string {
+	if i == nil || i.FailedStep == nil {
+		return ""
 	}
-	return m.Plan
+	return *i.FailedStep
 }
 
-// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchase) GetUnitCount() int {
-	if m == nil || m.UnitCount == nil {
-		return 0
+// GetHasLargeFiles returns the HasLargeFiles field if it's non-nil, zero value otherwise.
+func (i *Import) GetHasLargeFiles() bool {
+	if i == nil || i.HasLargeFiles == nil {
+		return false
 	}
-	return *m.UnitCount
+	return *i.HasLargeFiles
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
 		return ""
 	}
-	return *m.Action
+	return *i.HTMLURL
 }
 
-// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
-func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
-	if m == nil || m.EffectiveDate == nil {
-		return Timestamp{}
+// GetHumanName returns the HumanName field if it's non-nil, zero value otherwise.
+func (i *Import) GetHumanName() string {
+	if i == nil || i.HumanName == nil {
+		return ""
 	}
-	return *m.EffectiveDate
+	return *i.HumanName
 }
 
-// GetInstallation returns the Installation field.
-func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetLargeFilesCount returns the LargeFilesCount field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesCount() int {
+	if i == nil || i.LargeFilesCount == nil {
+		return 0
 	}
-	return m.Installation
+	return *i.LargeFilesCount
 }
 
-// GetMarketplacePurchase returns the MarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetLargeFilesSize returns the LargeFilesSize field if it's non-nil, zero value otherwise.
+func (i *Import) GetLargeFilesSize() int {
+	if i == nil || i.LargeFilesSize == nil {
+		return 0
 	}
-	return m.MarketplacePurchase
+	return *i.LargeFilesSize
 }
 
-// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
-func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
-	if m == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *Import) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
 	}
-	return m.PreviousMarketplacePurchase
+	return *i.Message
 }
 
-// GetSender returns the Sender field.
-func (m *MarketplacePurchaseEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetPercent returns the Percent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPercent() int {
+	if i == nil || i.Percent == nil {
+		return 0
 	}
-	return m.Sender
+	return *i.Percent
 }
 
-// GetText returns the Text field if it's non-nil, zero value otherwise.
-func (m *Match) GetText() string {
-	if m == nil || m.Text == nil {
-		return ""
+// GetPushPercent returns the PushPercent field if it's non-nil, zero value otherwise.
+func (i *Import) GetPushPercent() int {
+	if i == nil || i.PushPercent == nil {
+		return 0
 	}
-	return *m.Text
+	return *i.PushPercent
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MemberEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
 		return ""
 	}
-	return *m.Action
-}
-
-// GetInstallation returns the Installation field.
-func (m *MemberEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
-	}
-	return m.Installation
+	return *i.RepositoryURL
 }
 
-// GetMember returns the Member field.
-func (m *MemberEvent) GetMember() *User {
-	if m == nil {
-		return nil
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
 	}
-	return m.Member
+	return *i.Status
 }
 
-// GetRepo returns the Repo field.
-func (m *MemberEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetStatusText returns the StatusText field if it's non-nil, zero value otherwise.
+func (i *Import) GetStatusText() string {
+	if i == nil || i.StatusText == nil {
+		return ""
 	}
-	return m.Repo
+	return *i.StatusText
 }
 
-// GetSender returns the Sender field.
-func (m *MemberEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetTFVCProject returns the TFVCProject field if it's non-nil, zero value otherwise.
+func (i *Import) GetTFVCProject() string {
+	if i == nil || i.TFVCProject == nil {
+		return ""
 	}
-	return m.Sender
+	return *i.TFVCProject
 }
 
-// GetOrganization returns the Organization field.
-func (m *Membership) GetOrganization() *Organization {
-	if m == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Import) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
 	}
-	return m.Organization
+	return *i.URL
 }
 
-// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetOrganizationURL() string {
-	if m == nil || m.OrganizationURL == nil {
+// GetUseLFS returns the UseLFS field if it's non-nil, zero value otherwise.
+func (i *Import) GetUseLFS() string {
+	if i == nil || i.UseLFS == nil {
 		return ""
 	}
-	return *m.OrganizationURL
+	return *i.UseLFS
 }
 
-// GetRole returns the Role field if it's non-nil, zero value otherwise.
-func (m *Membership) GetRole() string {
-	if m == nil || m.Role == nil {
+// GetVCS returns the VCS field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCS() string {
+	if i == nil || i.VCS == nil {
 		return ""
 	}
-	return *m.Role
+	return *i.VCS
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Membership) GetState() string {
-	if m == nil || m.State == nil {
+// GetVCSPassword returns the VCSPassword field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSPassword() string {
+	if i == nil || i.VCSPassword == nil {
 		return ""
 	}
-	return *m.State
+	return *i.VCSPassword
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Membership) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetVCSURL returns the VCSURL field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSURL() string {
+	if i == nil || i.VCSURL == nil {
 		return ""
 	}
-	return *m.URL
+	return *i.VCSURL
 }
 
-// GetUser returns the User field.
-func (m *Membership) GetUser() *User {
-	if m == nil {
-		return nil
+// GetVCSUsername returns the VCSUsername field if it's non-nil, zero value otherwise.
+func (i *Import) GetVCSUsername() string {
+	if i == nil || i.VCSUsername == nil {
+		return ""
 	}
-	return m.User
+	return *i.VCSUsername
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetAccessTokensURL returns the AccessTokensURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAccessTokensURL() string {
+	if i == nil || i.AccessTokensURL == nil {
 		return ""
 	}
-	return *m.Action
+	return *i.AccessTokensURL
 }
 
-// GetInstallation returns the Installation field.
-func (m *MembershipEvent) GetInstallation() *Installation {
-	if m == nil {
+// GetAccount returns the Account field.
+func (i *Installation) GetAccount() *User {
+	if i == nil {
 		return nil
 	}
-	return m.Installation
+	return i.Account
 }
 
-// GetMember returns the Member field.
-func (m *MembershipEvent) GetMember() *User {
-	if m == nil {
-		return nil
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetAppID() int64 {
+	if i == nil || i.AppID == nil {
+		return 0
 	}
-	return m.Member
+	return *i.AppID
 }
 
-// GetOrg returns the Org field.
-func (m *MembershipEvent) GetOrg() *Organization {
-	if m == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return m.Org
+	return *i.CreatedAt
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (m *MembershipEvent) GetScope() string {
-	if m == nil || m.Scope == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
 		return ""
 	}
-	return *m.Scope
+	return *i.HTMLURL
 }
 
-// GetSender returns the Sender field.
-func (m *MembershipEvent) GetSender() *User {
-	if m == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return m.Sender
+	return *i.ID
 }
 
-// GetTeam returns the Team field.
-func (m *MembershipEvent) GetTeam() *Team {
-	if m == nil {
+// GetPermissions returns the Permissions field.
+func (i *Installation) GetPermissions() *InstallationPermissions {
+	if i == nil {
 		return nil
 	}
-	return m.Team
+	return i.Permissions
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetRepositoriesURL returns the RepositoriesURL field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositoriesURL() string {
+	if i == nil || i.RepositoriesURL == nil {
 		return ""
 	}
-	return *m.Action
+	return *i.RepositoriesURL
 }
 
-// GetHook returns the Hook field.
-func (m *MetaEvent) GetHook() *Hook {
-	if m == nil {
-		return nil
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *Installation) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
 	}
-	return m.Hook
+	return *i.RepositorySelection
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (m *MetaEvent) GetHookID() int64 {
-	if m == nil || m.HookID == nil {
-		return 0
+// GetSingleFileName returns the SingleFileName field if it's non-nil, zero value otherwise.
+func (i *Installation) GetSingleFileName() string {
+	if i == nil || i.SingleFileName == nil {
+		return ""
 	}
-	return *m.HookID
+	return *i.SingleFileName
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
-		return ""
+// GetTargetID returns the TargetID field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetID() int64 {
+	if i == nil || i.TargetID == nil {
+		return 0
 	}
-	return *m.HTMLURL
+	return *i.TargetID
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (m *Metric) GetKey() string {
-	if m == nil || m.Key == nil {
+// GetTargetType returns the TargetType field if it's non-nil, zero value otherwise.
+func (i *Installation) GetTargetType() string {
+	if i == nil || i.TargetType == nil {
 		return ""
 	}
-	return *m.Key
+	return *i.TargetType
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *Metric) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Installation) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *m.Name
+	return *i.UpdatedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Metric) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationEvent) GetAction() string {
+	if i == nil || i.Action == nil {
 		return ""
 	}
-	return *m.URL
+	return *i.Action
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetCreatedAt() string {
-	if m == nil || m.CreatedAt == nil {
-		return ""
+// GetInstallation returns the Installation field.
+func (i *InstallationEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
 	}
-	return *m.CreatedAt
+	return i.Installation
 }
 
-// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
-func (m *Migration) GetExcludeAttachments() bool {
-	if m == nil || m.ExcludeAttachments == nil {
-		return false
+// GetSender returns the Sender field.
+func (i *InstallationEvent) GetSender() *User {
+	if i == nil {
+		return nil
 	}
-	return *m.ExcludeAttachments
+	return i.Sender
 }
 
-// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetGUID() string {
-	if m == nil || m.GUID == nil {
+// GetAdministration returns the Administration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetAdministration() string {
+	if i == nil || i.Administration == nil {
 		return ""
 	}
-	return *m.GUID
+	return *i.Administration
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Migration) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetChecks returns the Checks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetChecks() string {
+	if i == nil || i.Checks == nil {
+		return ""
 	}
-	return *m.ID
+	return *i.Checks
 }
 
-// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
-func (m *Migration) GetLockRepositories() bool {
-	if m == nil || m.LockRepositories == nil {
-		return false
+// GetContents returns the Contents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetContents() string {
+	if i == nil || i.Contents == nil {
+		return ""
 	}
-	return *m.LockRepositories
+	return *i.Contents
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Migration) GetState() string {
-	if m == nil || m.State == nil {
+// GetDeployments returns the Deployments field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetDeployments() string {
+	if i == nil || i.Deployments == nil {
 		return ""
 	}
-	return *m.State
+	return *i.Deployments
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Migration) GetUpdatedAt() string {
-	if m == nil || m.UpdatedAt == nil {
+// GetIssues returns the Issues field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetIssues() string {
+	if i == nil || i.Issues == nil {
 		return ""
 	}
-	return *m.UpdatedAt
+	return *i.Issues
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Migration) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetMembers returns the Members field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMembers() string {
+	if i == nil || i.Members == nil {
 		return ""
 	}
-	return *m.URL
+	return *i.Members
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedAt() time.Time {
-	if m == nil || m.ClosedAt == nil {
-		return time.Time{}
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetMetadata() string {
+	if i == nil || i.Metadata == nil {
+		return ""
 	}
-	return *m.ClosedAt
+	return *i.Metadata
 }
 
-// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetClosedIssues() int {
-	if m == nil || m.ClosedIssues == nil {
-		return 0
+// GetOrganizationAdministration returns the OrganizationAdministration field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationAdministration() string {
+	if i == nil || i.OrganizationAdministration == nil {
+		return ""
 	}
-	return *m.ClosedIssues
+	return *i.OrganizationAdministration
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetCreatedAt() time.Time {
-	if m == nil || m.CreatedAt == nil {
-		return time.Time{}
+// GetOrganizationHooks returns the OrganizationHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationHooks() string {
+	if i == nil || i.OrganizationHooks == nil {
+		return ""
 	}
-	return *m.CreatedAt
+	return *i.OrganizationHooks
 }
 
-// GetCreator returns the Creator field.
-func (m *Milestone) GetCreator() *User {
-	if m == nil {
-		return nil
+// GetOrganizationPlan returns the OrganizationPlan field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationPlan() string {
+	if i == nil || i.OrganizationPlan == nil {
+		return ""
 	}
-	return m.Creator
+	return *i.OrganizationPlan
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDescription() string {
-	if m == nil || m.Description == nil {
+// GetOrganizationProjects returns the OrganizationProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationProjects() string {
+	if i == nil || i.OrganizationProjects == nil {
 		return ""
 	}
-	return *m.Description
+	return *i.OrganizationProjects
 }
 
-// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetDueOn() time.Time {
-	if m == nil || m.DueOn == nil {
-		return time.Time{}
+// GetOrganizationSecrets returns the OrganizationSecrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSecrets() string {
+	if i == nil || i.OrganizationSecrets == nil {
+		return ""
 	}
-	return *m.DueOn
+	return *i.OrganizationSecrets
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetHTMLURL() string {
-	if m == nil || m.HTMLURL == nil {
+// GetOrganizationSelfHostedRunners returns the OrganizationSelfHostedRunners field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationSelfHostedRunners() string {
+	if i == nil || i.OrganizationSelfHostedRunners == nil {
 		return ""
 	}
-	return *m.HTMLURL
+	return *i.OrganizationSelfHostedRunners
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetID() int64 {
-	if m == nil || m.ID == nil {
-		return 0
+// GetOrganizationUserBlocking returns the OrganizationUserBlocking field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetOrganizationUserBlocking() string {
+	if i == nil || i.OrganizationUserBlocking == nil {
+		return ""
 	}
-	return *m.ID
+	return *i.OrganizationUserBlocking
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetLabelsURL() string {
-	if m == nil || m.LabelsURL == nil {
+// GetPages returns the Pages field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPages() string {
+	if i == nil || i.Pages == nil {
 		return ""
 	}
-	return *m.LabelsURL
+	return *i.Pages
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNodeID() string {
-	if m == nil || m.NodeID == nil {
+// GetPullRequests returns the PullRequests field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetPullRequests() string {
+	if i == nil || i.PullRequests == nil {
 		return ""
 	}
-	return *m.NodeID
+	return *i.PullRequests
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetNumber() int {
-	if m == nil || m.Number == nil {
-		return 0
+// GetRepositoryHooks returns the RepositoryHooks field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryHooks() string {
+	if i == nil || i.RepositoryHooks == nil {
+		return ""
 	}
-	return *m.Number
+	return *i.RepositoryHooks
 }
 
-// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetOpenIssues() int {
-	if m == nil || m.OpenIssues == nil {
-		return 0
+// GetRepositoryProjects returns the RepositoryProjects field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetRepositoryProjects() string {
+	if i == nil || i.RepositoryProjects == nil {
+		return ""
 	}
-	return *m.OpenIssues
+	return *i.RepositoryProjects
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetState() string {
-	if m == nil || m.State == nil {
+// GetSecrets returns the Secrets field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecrets() string {
+	if i == nil || i.Secrets == nil {
 		return ""
 	}
-	return *m.State
+	return *i.Secrets
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetTitle() string {
-	if m == nil || m.Title == nil {
+// GetSecurityEvents returns the SecurityEvents field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSecurityEvents() string {
+	if i == nil || i.SecurityEvents == nil {
 		return ""
 	}
-	return *m.Title
+	return *i.SecurityEvents
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetUpdatedAt() time.Time {
-	if m == nil || m.UpdatedAt == nil {
-		return time.Time{}
+// GetSingleFile returns the SingleFile field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetSingleFile() string {
+	if i == nil || i.SingleFile == nil {
+		return ""
 	}
-	return *m.UpdatedAt
+	return *i.SingleFile
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (m *Milestone) GetURL() string {
-	if m == nil || m.URL == nil {
+// GetStatuses returns the Statuses field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetStatuses() string {
+	if i == nil || i.Statuses == nil {
 		return ""
 	}
-	return *m.URL
+	return *i.Statuses
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (m *MilestoneEvent) GetAction() string {
-	if m == nil || m.Action == nil {
+// GetTeamDiscussions returns the TeamDiscussions field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetTeamDiscussions() string {
+	if i == nil || i.TeamDiscussions == nil {
 		return ""
 	}
-	return *m.Action
+	return *i.TeamDiscussions
 }
 
-// GetChanges returns the Changes field.
-func (m *MilestoneEvent) GetChanges() *EditChange {
-	if m == nil {
-		return nil
+// GetVulnerabilityAlerts returns the VulnerabilityAlerts field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetVulnerabilityAlerts() string {
+	if i == nil || i.VulnerabilityAlerts == nil {
+		return ""
 	}
-	return m.Changes
+	return *i.VulnerabilityAlerts
 }
 
-// GetInstallation returns the Installation field.
-func (m *MilestoneEvent) GetInstallation() *Installation {
-	if m == nil {
-		return nil
+// GetWorkflows returns the Workflows field if it's non-nil, zero value otherwise.
+func (i *InstallationPermissions) GetWorkflows() string {
+	if i == nil || i.Workflows == nil {
+		return ""
 	}
-	return m.Installation
+	return *i.Workflows
 }
 
-// GetMilestone returns the Milestone field.
-func (m *MilestoneEvent) GetMilestone() *Milestone {
-	if m == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
 	}
-	return m.Milestone
+	return *i.Action
 }
 
-// GetOrg returns the Org field.
-func (m *MilestoneEvent) GetOrg() *Organization {
-	if m == nil {
+// GetInstallation returns the Installation field.
+func (i *InstallationRepositoriesEvent) GetInstallation() *Installation {
+	if i == nil {
 		return nil
 	}
-	return m.Org
+	return i.Installation
 }
 
-// GetRepo returns the Repo field.
-func (m *MilestoneEvent) GetRepo() *Repository {
-	if m == nil {
-		return nil
+// GetRepositorySelection returns the RepositorySelection field if it's non-nil, zero value otherwise.
+func (i *InstallationRepositoriesEvent) GetRepositorySelection() string {
+	if i == nil || i.RepositorySelection == nil {
+		return ""
 	}
-	return m.Repo
+	return *i.RepositorySelection
 }
 
 // GetSender returns the Sender field.
-func (m *MilestoneEvent) GetSender() *User {
-	if m == nil {
+func (i *InstallationRepositoriesEvent) GetSender() *User {
+	if i == nil {
 		return nil
 	}
-	return m.Sender
+	return i.Sender
 }
 
-// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetClosedMilestones() int {
-	if m == nil || m.ClosedMilestones == nil {
-		return 0
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetExpiresAt() time.Time {
+	if i == nil || i.ExpiresAt == nil {
+		return time.Time{}
 	}
-	return *m.ClosedMilestones
+	return *i.ExpiresAt
 }
 
-// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetOpenMilestones() int {
-	if m == nil || m.OpenMilestones == nil {
-		return 0
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (i *InstallationToken) GetToken() string {
+	if i == nil || i.Token == nil {
+		return ""
 	}
-	return *m.OpenMilestones
+	return *i.Token
 }
 
-// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
-func (m *MilestoneStats) GetTotalMilestones() int {
-	if m == nil || m.TotalMilestones == nil {
-		return 0
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetExpiresAt() Timestamp {
+	if i == nil || i.ExpiresAt == nil {
+		return Timestamp{}
 	}
-	return *m.TotalMilestones
+	return *i.ExpiresAt
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBase() string {
-	if n == nil || n.Base == nil {
-		return ""
+// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetLimit() string {
+	if i == nil || i.Limit == nil {
+		return ""
 	}
-	return *n.Base
+	return *i.Limit
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetBody() string {
-	if n == nil || n.Body == nil {
+// GetOrigin returns the Origin field if it's non-nil, zero value otherwise.
+func (i *InteractionRestriction) GetOrigin() string {
+	if i == nil || i.Origin == nil {
 		return ""
 	}
-	return *n.Body
+	return *i.Origin
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetDraft() bool {
-	if n == nil || n.Draft == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *n.Draft
+	return *i.CreatedAt
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetHead() string {
-	if n == nil || n.Head == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetEmail() string {
+	if i == nil || i.Email == nil {
 		return ""
 	}
-	return *n.Head
+	return *i.Email
 }
 
-// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetIssue() int {
-	if n == nil || n.Issue == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetID() int64 {
+	if i == nil || i.ID == nil {
 		return 0
 	}
-	return *n.Issue
-}
-
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetMaintainerCanModify() bool {
-	if n == nil || n.MaintainerCanModify == nil {
-		return false
-	}
-	return *n.MaintainerCanModify
+	return *i.ID
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NewPullRequest) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetInvitationTeamURL returns the InvitationTeamURL field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetInvitationTeamURL() string {
+	if i == nil || i.InvitationTeamURL == nil {
 		return ""
 	}
-	return *n.Title
+	return *i.InvitationTeamURL
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetDescription() string {
-	if n == nil || n.Description == nil {
-		return ""
+// GetInviter returns the Inviter field.
+func (i *Invitation) GetInviter() *User {
+	if i == nil {
+		return nil
 	}
-	return *n.Description
+	return i.Inviter
 }
 
-// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetLDAPDN() string {
-	if n == nil || n.LDAPDN == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetLogin() string {
+	if i == nil || i.Login == nil {
 		return ""
 	}
-	return *n.LDAPDN
+	return *i.Login
 }
 
-// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetParentTeamID() int64 {
-	if n == nil || n.ParentTeamID == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
 	}
-	return *n.ParentTeamID
+	return *i.NodeID
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPermission() string {
-	if n == nil || n.Permission == nil {
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetRole() string {
+	if i == nil || i.Role == nil {
 		return ""
 	}
-	return *n.Permission
+	return *i.Role
 }
 
-// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
-func (n *NewTeam) GetPrivacy() string {
-	if n == nil || n.Privacy == nil {
-		return ""
+// GetTeamCount returns the TeamCount field if it's non-nil, zero value otherwise.
+func (i *Invitation) GetTeamCount() int {
+	if i == nil || i.TeamCount == nil {
+		return 0
 	}
-	return *n.Privacy
+	return *i.TeamCount
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (n *Notification) GetID() string {
-	if n == nil || n.ID == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (i *Issue) GetActiveLockReason() string {
+	if i == nil || i.ActiveLockReason == nil {
 		return ""
 	}
-	return *n.ID
+	return *i.ActiveLockReason
 }
 
-// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetLastReadAt() time.Time {
-	if n == nil || n.LastReadAt == nil {
-		return time.Time{}
+// GetAssignee returns the Assignee field.
+func (i *Issue) GetAssignee() *User {
+	if i == nil {
+		return nil
 	}
-	return *n.LastReadAt
+	return i.Assignee
 }
 
-// GetReason returns the Reason field if it's non-nil, zero value otherwise.
-func (n *Notification) GetReason() string {
-	if n == nil || n.Reason == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *Issue) GetBody() string {
+	if i == nil || i.Body == nil {
 		return ""
 	}
-	return *n.Reason
+	return *i.Body
 }
 
-// GetRepository returns the Repository field.
-func (n *Notification) GetRepository() *Repository {
-	if n == nil {
-		return nil
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
 	}
-	return n.Repository
+	return *i.ClosedAt
 }
 
-// GetSubject returns the Subject field.
-func (n *Notification) GetSubject() *NotificationSubject {
-	if n == nil {
+// GetClosedBy returns the ClosedBy field.
+func (i *Issue) GetClosedBy() *User {
+	if i == nil {
 		return nil
 	}
-	return n.Subject
+	return i.ClosedBy
 }
 
-// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUnread() bool {
-	if n == nil || n.Unread == nil {
-		return false
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (i *Issue) GetComments() int {
+	if i == nil || i.Comments == nil {
+		return 0
 	}
-	return *n.Unread
+	return *i.Comments
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (n *Notification) GetUpdatedAt() time.Time {
-	if n == nil || n.UpdatedAt == nil {
-		return time.Time{}
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCommentsURL() string {
+	if i == nil || i.CommentsURL == nil {
+		return ""
 	}
-	return *n.UpdatedAt
+	return *i.CommentsURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *Notification) GetURL() string {
-	if n == nil || n.URL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *n.URL
+	return *i.CreatedAt
 }
 
-// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetLatestCommentURL() string {
-	if n == nil || n.LatestCommentURL == nil {
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetEventsURL() string {
+	if i == nil || i.EventsURL == nil {
 		return ""
 	}
-	return *n.LatestCommentURL
+	return *i.EventsURL
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetTitle() string {
-	if n == nil || n.Title == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
 		return ""
 	}
-	return *n.Title
+	return *i.HTMLURL
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetType() string {
-	if n == nil || n.Type == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
 	}
-	return *n.Type
+	return *i.ID
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (n *NotificationSubject) GetURL() string {
-	if n == nil || n.URL == nil {
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLabelsURL() string {
+	if i == nil || i.LabelsURL == nil {
 		return ""
 	}
-	return *n.URL
+	return *i.LabelsURL
 }
 
-// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetAvatarURL() string {
-	if o == nil || o.AvatarURL == nil {
-		return ""
+// GetLocked returns the Locked field if it's non-nil, zero value otherwise.
+func (i *Issue) GetLocked() bool {
+	if i == nil || i.Locked == nil {
+		return false
 	}
-	return *o.AvatarURL
+	return *i.Locked
 }
 
-// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBillingEmail() string {
-	if o == nil || o.BillingEmail == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (i *Issue) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
 	}
-	return *o.BillingEmail
+	return i.Milestone
 }
 
-// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
-func (o *Organization) GetBlog() string {
-	if o == nil || o.Blog == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
 		return ""
 	}
-	return *o.Blog
+	return *i.NodeID
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCollaborators() int {
-	if o == nil || o.Collaborators == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Issue) GetNumber() int {
+	if i == nil || i.Number == nil {
 		return 0
 	}
-	return *o.Collaborators
+	return *i.Number
 }
 
-// GetCompany returns the Company field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCompany() string {
-	if o == nil || o.Company == nil {
-		return ""
+// GetPullRequestLinks returns the PullRequestLinks field.
+func (i *Issue) GetPullRequestLinks() *PullRequestLinks {
+	if i == nil {
+		return nil
 	}
-	return *o.Company
+	return i.PullRequestLinks
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetCreatedAt() time.Time {
-	if o == nil || o.CreatedAt == nil {
-		return time.Time{}
+// GetReactions returns the Reactions field.
+func (i *Issue) GetReactions() *Reactions {
+	if i == nil {
+		return nil
 	}
-	return *o.CreatedAt
+	return i.Reactions
 }
 
-// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoPermission() string {
-	if o == nil || o.DefaultRepoPermission == nil {
+// GetRepository returns the Repository field.
+func (i *Issue) GetRepository() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repository
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
 		return ""
 	}
-	return *o.DefaultRepoPermission
+	return *i.RepositoryURL
 }
 
-// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDefaultRepoSettings() string {
-	if o == nil || o.DefaultRepoSettings == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Issue) GetState() string {
+	if i == nil || i.State == nil {
 		return ""
 	}
-	return *o.DefaultRepoSettings
+	return *i.State
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDescription() string {
-	if o == nil || o.Description == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *Issue) GetTitle() string {
+	if i == nil || i.Title == nil {
 		return ""
 	}
-	return *o.Description
+	return *i.Title
 }
 
-// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDiskUsage() int {
-	if o == nil || o.DiskUsage == nil {
-		return 0
+// GetType returns the Type field.
+func (i *Issue) GetType() *IssueType {
+	if i == nil {
+		return nil
 	}
-	return *o.DiskUsage
+	return i.Type
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEmail() string {
-	if o == nil || o.Email == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Issue) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *o.Email
+	return *i.UpdatedAt
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetEventsURL() string {
-	if o == nil || o.EventsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *Issue) GetURL() string {
+	if i == nil || i.URL == nil {
 		return ""
 	}
-	return *o.EventsURL
+	return *i.URL
 }
 
-// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowers() int {
-	if o == nil || o.Followers == nil {
-		return 0
+// GetUser returns the User field.
+func (i *Issue) GetUser() *User {
+	if i == nil {
+		return nil
 	}
-	return *o.Followers
+	return i.User
 }
 
-// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
-func (o *Organization) GetFollowing() int {
-	if o == nil || o.Following == nil {
-		return 0
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetAuthorAssociation() string {
+	if i == nil || i.AuthorAssociation == nil {
+		return ""
 	}
-	return *o.Following
+	return *i.AuthorAssociation
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHooksURL() string {
-	if o == nil || o.HooksURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetBody() string {
+	if i == nil || i.Body == nil {
 		return ""
 	}
-	return *o.HooksURL
+	return *i.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetHTMLURL() string {
-	if o == nil || o.HTMLURL == nil {
+func (i *IssueComment) GetHTMLURL() string {
+	if i == nil || i.HTMLURL == nil {
 		return ""
 	}
-	return *o.HTMLURL
+	return *i.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetID() int64 {
-	if o == nil || o.ID == nil {
+func (i *IssueComment) GetID() int64 {
+	if i == nil || i.ID == nil {
 		return 0
 	}
-	return *o.ID
+	return *i.ID
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetIssuesURL() string {
-	if o == nil || o.IssuesURL == nil {
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetIssueURL() string {
+	if i == nil || i.IssueURL == nil {
 		return ""
 	}
-	return *o.IssuesURL
+	return *i.IssueURL
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLocation() string {
-	if o == nil || o.Location == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
 		return ""
 	}
-	return *o.Location
+	return *i.NodeID
 }
 
-// GetLogin returns the Login field if it's non-nil, zero value otherwise.
-func (o *Organization) GetLogin() string {
-	if o == nil || o.Login == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (i *IssueComment) GetReactions() *Reactions {
+	if i == nil {
+		return nil
 	}
-	return *o.Login
+	return i.Reactions
 }
 
-// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersCanCreateRepos() bool {
-	if o == nil || o.MembersCanCreateRepos == nil {
-		return false
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *o.MembersCanCreateRepos
+	return *i.UpdatedAt
 }
 
-// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMembersURL() string {
-	if o == nil || o.MembersURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueComment) GetURL() string {
+	if i == nil || i.URL == nil {
 		return ""
 	}
-	return *o.MembersURL
+	return *i.URL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *Organization) GetName() string {
-	if o == nil || o.Name == nil {
-		return ""
+// GetUser returns the User field.
+func (i *IssueComment) GetUser() *User {
+	if i == nil {
+		return nil
 	}
-	return *o.Name
+	return i.User
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetNodeID() string {
-	if o == nil || o.NodeID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssueCommentEvent) GetAction() string {
+	if i == nil || i.Action == nil {
 		return ""
 	}
-	return *o.NodeID
+	return *i.Action
 }
 
-// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetOwnedPrivateRepos() int {
-	if o == nil || o.OwnedPrivateRepos == nil {
-		return 0
+// GetChanges returns the Changes field.
+func (i *IssueCommentEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
 	}
-	return *o.OwnedPrivateRepos
+	return i.Changes
 }
 
-// GetPlan returns the Plan field.
-func (o *Organization) GetPlan() *Plan {
-	if o == nil {
+// GetComment returns the Comment field.
+func (i *IssueCommentEvent) GetComment() *IssueComment {
+	if i == nil {
+		return nil
+	}
+	return i.Comment
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssueCommentEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueCommentEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetRepo returns the Repo field.
+func (i *IssueCommentEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssueCommentEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetActor returns the Actor field.
+func (i *IssueEvent) GetActor() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Actor
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssueEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetAssigner returns the Assigner field.
+func (i *IssueEvent) GetAssigner() *User {
+	if i == nil {
 		return nil
 	}
-	return o.Plan
+	return i.Assigner
+}
+
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCommitID() string {
+	if i == nil || i.CommitID == nil {
+		return ""
+	}
+	return *i.CommitID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDismissedReview returns the DismissedReview field.
+func (i *IssueEvent) GetDismissedReview() *DismissedReview {
+	if i == nil {
+		return nil
+	}
+	return i.DismissedReview
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetEvent() string {
+	if i == nil || i.Event == nil {
+		return ""
+	}
+	return *i.Event
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIssue returns the Issue field.
+func (i *IssueEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssueEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetLockReason returns the LockReason field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetLockReason() string {
+	if i == nil || i.LockReason == nil {
+		return ""
+	}
+	return *i.LockReason
+}
+
+// GetMilestone returns the Milestone field.
+func (i *IssueEvent) GetMilestone() *Milestone {
+	if i == nil {
+		return nil
+	}
+	return i.Milestone
+}
+
+// GetProjectCard returns the ProjectCard field.
+func (i *IssueEvent) GetProjectCard() *ProjectCard {
+	if i == nil {
+		return nil
+	}
+	return i.ProjectCard
+}
+
+// GetRename returns the Rename field.
+func (i *IssueEvent) GetRename() *Rename {
+	if i == nil {
+		return nil
+	}
+	return i.Rename
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueEvent) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosed() bool {
+	if i == nil || i.Closed == nil {
+		return false
+	}
+	return *i.Closed
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *i.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImport) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportComment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetCode() string {
+	if i == nil || i.Code == nil {
+		return ""
+	}
+	return *i.Code
+}
+
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetField() string {
+	if i == nil || i.Field == nil {
+		return ""
+	}
+	return *i.Field
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetLocation() string {
+	if i == nil || i.Location == nil {
+		return ""
+	}
+	return *i.Location
+}
+
+// GetResource returns the Resource field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetResource() string {
+	if i == nil || i.Resource == nil {
+		return ""
+	}
+	return *i.Resource
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (i *IssueImportError) GetValue() string {
+	if i == nil || i.Value == nil {
+		return ""
+	}
+	return *i.Value
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDocumentationURL returns the DocumentationURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetDocumentationURL() string {
+	if i == nil || i.DocumentationURL == nil {
+		return ""
+	}
+	return *i.DocumentationURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetImportIssuesURL returns the ImportIssuesURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetImportIssuesURL() string {
+	if i == nil || i.ImportIssuesURL == nil {
+		return ""
+	}
+	return *i.ImportIssuesURL
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetMessage() string {
+	if i == nil || i.Message == nil {
+		return ""
+	}
+	return *i.Message
+}
+
+// GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetRepositoryURL() string {
+	if i == nil || i.RepositoryURL == nil {
+		return ""
+	}
+	return *i.RepositoryURL
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetStatus() string {
+	if i == nil || i.Status == nil {
+		return ""
+	}
+	return *i.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (i *IssueImportResponse) GetURL() string {
+	if i == nil || i.URL == nil {
+		return ""
+	}
+	return *i.URL
+}
+
+// GetAssignee returns the Assignee field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignee() string {
+	if i == nil || i.Assignee == nil {
+		return ""
+	}
+	return *i.Assignee
+}
+
+// GetAssignees returns the Assignees field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetAssignees() []string {
+	if i == nil || i.Assignees == nil {
+		return nil
+	}
+	return *i.Assignees
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+	return *i.Body
+}
+
+// GetLabels returns the Labels field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetLabels() []string {
+	if i == nil || i.Labels == nil {
+		return nil
+	}
+	return *i.Labels
+}
+
+// GetMilestone returns the Milestone field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetMilestone() int {
+	if i == nil || i.Milestone == nil {
+		return 0
+	}
+	return *i.Milestone
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+	return *i.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetTitle() string {
+	if i == nil || i.Title == nil {
+		return ""
+	}
+	return *i.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (i *IssueRequest) GetType() string {
+	if i == nil || i.Type == nil {
+		return ""
+	}
+	return *i.Type
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (i *IssuesEvent) GetAction() string {
+	if i == nil || i.Action == nil {
+		return ""
+	}
+	return *i.Action
+}
+
+// GetAssignee returns the Assignee field.
+func (i *IssuesEvent) GetAssignee() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Assignee
+}
+
+// GetChanges returns the Changes field.
+func (i *IssuesEvent) GetChanges() *EditChange {
+	if i == nil {
+		return nil
+	}
+	return i.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (i *IssuesEvent) GetInstallation() *Installation {
+	if i == nil {
+		return nil
+	}
+	return i.Installation
+}
+
+// GetIssue returns the Issue field.
+func (i *IssuesEvent) GetIssue() *Issue {
+	if i == nil {
+		return nil
+	}
+	return i.Issue
+}
+
+// GetLabel returns the Label field.
+func (i *IssuesEvent) GetLabel() *Label {
+	if i == nil {
+		return nil
+	}
+	return i.Label
+}
+
+// GetRepo returns the Repo field.
+func (i *IssuesEvent) GetRepo() *Repository {
+	if i == nil {
+		return nil
+	}
+	return i.Repo
+}
+
+// GetSender returns the Sender field.
+func (i *IssuesEvent) GetSender() *User {
+	if i == nil {
+		return nil
+	}
+	return i.Sender
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetIncompleteResults() bool {
+	if i == nil || i.IncompleteResults == nil {
+		return false
+	}
+	return *i.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (i *IssuesSearchResult) GetTotal() int {
+	if i == nil || i.Total == nil {
+		return 0
+	}
+	return *i.Total
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetClosedIssues() int {
+	if i == nil || i.ClosedIssues == nil {
+		return 0
+	}
+	return *i.ClosedIssues
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetOpenIssues() int {
+	if i == nil || i.OpenIssues == nil {
+		return 0
+	}
+	return *i.OpenIssues
+}
+
+// GetTotalIssues returns the TotalIssues field if it's non-nil, zero value otherwise.
+func (i *IssueStats) GetTotalIssues() int {
+	if i == nil || i.TotalIssues == nil {
+		return 0
+	}
+	return *i.TotalIssues
+}
+
+// GetTemplate returns the Template field.
+func (i *IssueTemplateError) GetTemplate() *IssueTemplate {
+	if i == nil {
+		return nil
+	}
+	return i.Template
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetColor() string {
+	if i == nil || i.Color == nil {
+		return ""
+	}
+	return *i.Color
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetCreatedAt() Timestamp {
+	if i == nil || i.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
+	}
+	return *i.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+	return *i.ID
+}
+
+// GetIsEnabled returns the IsEnabled field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetIsEnabled() bool {
+	if i == nil || i.IsEnabled == nil {
+		return false
+	}
+	return *i.IsEnabled
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+	return *i.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetNodeID() string {
+	if i == nil || i.NodeID == nil {
+		return ""
+	}
+	return *i.NodeID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *IssueType) GetUpdatedAt() Timestamp {
+	if i == nil || i.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *i.UpdatedAt
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (i *IssueTypeRequest) GetColor() string {
+	if i == nil || i.Color == nil {
+		return ""
+	}
+	return *i.Color
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *IssueTypeRequest) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
+	}
+	return *i.Description
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (j *Jobs) GetTotalCount() int {
+	if j == nil || j.TotalCount == nil {
+		return 0
+	}
+	return *j.TotalCount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (k *Key) GetID() int64 {
+	if k == nil || k.ID == nil {
+		return 0
+	}
+	return *k.ID
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (k *Key) GetKey() string {
+	if k == nil || k.Key == nil {
+		return ""
+	}
+	return *k.Key
+}
+
+// GetReadOnly returns the ReadOnly field if it's non-nil, zero value otherwise.
+func (k *Key) GetReadOnly() bool {
+	if k == nil || k.ReadOnly == nil {
+		return false
+	}
+	return *k.ReadOnly
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (k *Key) GetTitle() string {
+	if k == nil || k.Title == nil {
+		return ""
+	}
+	return *k.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (k *Key) GetURL() string {
+	if k == nil || k.URL == nil {
+		return ""
+	}
+	return *k.URL
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *Label) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *Label) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Label) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Label) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *Label) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (l *Label) GetNodeID() string {
+	if l == nil || l.NodeID == nil {
+		return ""
+	}
+	return *l.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *Label) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetNew returns the New field.
+func (l *LabelChange) GetNew() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.New
+}
+
+// GetOld returns the Old field.
+func (l *LabelChange) GetOld() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.Old
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (l *LabelEvent) GetAction() string {
+	if l == nil || l.Action == nil {
+		return ""
+	}
+	return *l.Action
+}
+
+// GetChanges returns the Changes field.
+func (l *LabelEvent) GetChanges() *EditChange {
+	if l == nil {
+		return nil
+	}
+	return l.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (l *LabelEvent) GetInstallation() *Installation {
+	if l == nil {
+		return nil
+	}
+	return l.Installation
+}
+
+// GetLabel returns the Label field.
+func (l *LabelEvent) GetLabel() *Label {
+	if l == nil {
+		return nil
+	}
+	return l.Label
+}
+
+// GetOrg returns the Org field.
+func (l *LabelEvent) GetOrg() *Organization {
+	if l == nil {
+		return nil
+	}
+	return l.Org
+}
+
+// GetRepo returns the Repo field.
+func (l *LabelEvent) GetRepo() *Repository {
+	if l == nil {
+		return nil
+	}
+	return l.Repo
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetColor() string {
+	if l == nil || l.Color == nil {
+		return ""
+	}
+	return *l.Color
+}
+
+// GetDefault returns the Default field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDefault() bool {
+	if l == nil || l.Default == nil {
+		return false
+	}
+	return *l.Default
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetID() int64 {
+	if l == nil || l.ID == nil {
+		return 0
+	}
+	return *l.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetScore returns the Score field.
+func (l *LabelResult) GetScore() *float64 {
+	if l == nil {
+		return nil
+	}
+	return l.Score
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *LabelResult) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetIncompleteResults() bool {
+	if l == nil || l.IncompleteResults == nil {
+		return false
+	}
+	return *l.IncompleteResults
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *LabelsSearchResult) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetOID returns the OID field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetOID() string {
+	if l == nil || l.OID == nil {
+		return ""
+	}
+	return *l.OID
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetPath() string {
+	if l == nil || l.Path == nil {
+		return ""
+	}
+	return *l.Path
+}
+
+// GetRefName returns the RefName field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetRefName() string {
+	if l == nil || l.RefName == nil {
+		return ""
+	}
+	return *l.RefName
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (l *LargeFile) GetSize() int {
+	if l == nil || l.Size == nil {
+		return 0
+	}
+	return *l.Size
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *LDAPSyncStatus) GetStatus() string {
+	if l == nil || l.Status == nil {
+		return ""
+	}
+	return *l.Status
+}
+
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (l *LFSAction) GetExpiresAt() time.Time {
+	if l == nil || l.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *l.ExpiresAt
+}
+
+// GetExpiresIn returns the ExpiresIn field if it's non-nil, zero value otherwise.
+func (l *LFSAction) GetExpiresIn() int {
+	if l == nil || l.ExpiresIn == nil {
+		return 0
+	}
+	return *l.ExpiresIn
+}
+
+// GetHashAlgo returns the HashAlgo field if it's non-nil, zero value otherwise.
+func (l *LFSBatchRequest) GetHashAlgo() string {
+	if l == nil || l.HashAlgo == nil {
+		return ""
+	}
+	return *l.HashAlgo
+}
+
+// GetRef returns the Ref field.
+func (l *LFSBatchRequest) GetRef() *LFSRef {
+	if l == nil {
+		return nil
+	}
+	return l.Ref
+}
+
+// GetHashAlgo returns the HashAlgo field if it's non-nil, zero value otherwise.
+func (l *LFSBatchResponse) GetHashAlgo() string {
+	if l == nil || l.HashAlgo == nil {
+		return ""
+	}
+	return *l.HashAlgo
+}
+
+// GetTransfer returns the Transfer field if it's non-nil, zero value otherwise.
+func (l *LFSBatchResponse) GetTransfer() string {
+	if l == nil || l.Transfer == nil {
+		return ""
+	}
+	return *l.Transfer
+}
+
+// GetAuthenticated returns the Authenticated field if it's non-nil, zero value otherwise.
+func (l *LFSObject) GetAuthenticated() bool {
+	if l == nil || l.Authenticated == nil {
+		return false
+	}
+	return *l.Authenticated
+}
+
+// GetError returns the Error field.
+func (l *LFSObject) GetError() *LFSObjectError {
+	if l == nil {
+		return nil
+	}
+	return l.Error
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (l *License) GetBody() string {
+	if l == nil || l.Body == nil {
+		return ""
+	}
+	return *l.Body
+}
+
+// GetConditions returns the Conditions field if it's non-nil, zero value otherwise.
+func (l *License) GetConditions() []string {
+	if l == nil || l.Conditions == nil {
+		return nil
+	}
+	return *l.Conditions
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *License) GetDescription() string {
+	if l == nil || l.Description == nil {
+		return ""
+	}
+	return *l.Description
+}
+
+// GetFeatured returns the Featured field if it's non-nil, zero value otherwise.
+func (l *License) GetFeatured() bool {
+	if l == nil || l.Featured == nil {
+		return false
+	}
+	return *l.Featured
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (l *License) GetHTMLURL() string {
+	if l == nil || l.HTMLURL == nil {
+		return ""
+	}
+	return *l.HTMLURL
+}
+
+// GetImplementation returns the Implementation field if it's non-nil, zero value otherwise.
+func (l *License) GetImplementation() string {
+	if l == nil || l.Implementation == nil {
+		return ""
+	}
+	return *l.Implementation
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (l *License) GetKey() string {
+	if l == nil || l.Key == nil {
+		return ""
+	}
+	return *l.Key
+}
+
+// GetLimitations returns the Limitations field if it's non-nil, zero value otherwise.
+func (l *License) GetLimitations() []string {
+	if l == nil || l.Limitations == nil {
+		return nil
+	}
+	return *l.Limitations
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *License) GetName() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (l *License) GetPermissions() []string {
+	if l == nil || l.Permissions == nil {
+		return nil
+	}
+	return *l.Permissions
+}
+
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (l *License) GetSPDXID() string {
+	if l == nil || l.SPDXID == nil {
+		return ""
+	}
+	return *l.SPDXID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (l *License) GetURL() string {
+	if l == nil || l.URL == nil {
+		return ""
+	}
+	return *l.URL
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
+		return ""
+	}
+	return *l.Filter
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsOptions) GetStatus() string {
+	if l == nil || l.Status == nil {
+		return ""
+	}
+	return *l.Status
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckRunsResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetAppID() int64 {
+	if l == nil || l.AppID == nil {
+		return 0
+	}
+	return *l.AppID
+}
+
+// GetCheckName returns the CheckName field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteOptions) GetCheckName() string {
+	if l == nil || l.CheckName == nil {
+		return ""
+	}
+	return *l.CheckName
+}
+
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (l *ListCheckSuiteResults) GetTotal() int {
+	if l == nil || l.Total == nil {
+		return 0
+	}
+	return *l.Total
+}
+
+// GetAffiliation returns the Affiliation field if it's non-nil, zero value otherwise.
+func (l *ListCollaboratorOptions) GetAffiliation() string {
+	if l == nil || l.Affiliation == nil {
+		return ""
+	}
+	return *l.Affiliation
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListCustomDeploymentRuleIntegrations) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListDeploymentProtectionRules) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (l *ListExternalGroupsOptions) GetDisplayName() string {
+	if l == nil || l.DisplayName == nil {
+		return ""
+	}
+	return *l.DisplayName
+}
+
+// GetIsWithdrawn returns the IsWithdrawn field if it's non-nil, zero value otherwise.
+func (l *ListGlobalSecurityAdvisoriesOptions) GetIsWithdrawn() bool {
+	if l == nil || l.IsWithdrawn == nil {
+		return false
+	}
+	return *l.IsWithdrawn
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (l *ListOrganizations) GetTotalCount() int {
+	if l == nil || l.TotalCount == nil {
+		return 0
+	}
+	return *l.TotalCount
+}
+
+// GetCount returns the Count field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetCount() int {
+	if l == nil || l.Count == nil {
+		return 0
+	}
+	return *l.Count
+}
+
+// GetFilter returns the Filter field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetFilter() string {
+	if l == nil || l.Filter == nil {
+		return ""
+	}
+	return *l.Filter
+}
+
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (l *ListSCIMProvisionedIdentitiesOptions) GetStartIndex() int {
+	if l == nil || l.StartIndex == nil {
+		return 0
+	}
+	return *l.StartIndex
+}
+
+// GetScheduledTime returns the ScheduledTime field if it's non-nil, zero value otherwise.
+func (m *MaintenanceStatus) GetScheduledTime() string {
+	if m == nil || m.ScheduledTime == nil {
+		return ""
+	}
+	return *m.ScheduledTime
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (m *MaintenanceStatus) GetStatus() string {
+	if m == nil || m.Status == nil {
+		return ""
+	}
+	return *m.Status
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePendingChange) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePendingChange) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAccountsURL returns the AccountsURL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetAccountsURL() string {
+	if m == nil || m.AccountsURL == nil {
+		return ""
+	}
+	return *m.AccountsURL
+}
+
+// GetBullets returns the Bullets field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetBullets() []string {
+	if m == nil || m.Bullets == nil {
+		return nil
+	}
+	return *m.Bullets
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetHasFreeTrial returns the HasFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetHasFreeTrial() bool {
+	if m == nil || m.HasFreeTrial == nil {
+		return false
+	}
+	return *m.HasFreeTrial
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetMonthlyPriceInCents returns the MonthlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetMonthlyPriceInCents() int {
+	if m == nil || m.MonthlyPriceInCents == nil {
+		return 0
+	}
+	return *m.MonthlyPriceInCents
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetPriceModel returns the PriceModel field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetPriceModel() string {
+	if m == nil || m.PriceModel == nil {
+		return ""
+	}
+	return *m.PriceModel
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetUnitName() string {
+	if m == nil || m.UnitName == nil {
+		return ""
+	}
+	return *m.UnitName
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetYearlyPriceInCents returns the YearlyPriceInCents field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlan) GetYearlyPriceInCents() int {
+	if m == nil || m.YearlyPriceInCents == nil {
+		return 0
+	}
+	return *m.YearlyPriceInCents
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetEmail() string {
+	if m == nil || m.Email == nil {
+		return ""
+	}
+	return *m.Email
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetLogin() string {
+	if m == nil || m.Login == nil {
+		return ""
+	}
+	return *m.Login
+}
+
+// GetMarketplacePendingChange returns the MarketplacePendingChange field.
+func (m *MarketplacePlanAccount) GetMarketplacePendingChange() *MarketplacePendingChange {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePendingChange
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePlanAccount) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePurchase
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetOrganizationBillingEmail returns the OrganizationBillingEmail field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetOrganizationBillingEmail() string {
+	if m == nil || m.OrganizationBillingEmail == nil {
+		return ""
+	}
+	return *m.OrganizationBillingEmail
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetType() string {
+	if m == nil || m.Type == nil {
+		return ""
+	}
+	return *m.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MarketplacePlanAccount) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetAccount returns the Account field.
+func (m *MarketplacePurchase) GetAccount() *MarketplacePlanAccount {
+	if m == nil {
+		return nil
+	}
+	return m.Account
+}
+
+// GetBillingCycle returns the BillingCycle field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetBillingCycle() string {
+	if m == nil || m.BillingCycle == nil {
+		return ""
+	}
+	return *m.BillingCycle
+}
+
+// GetFreeTrialEndsOn returns the FreeTrialEndsOn field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetFreeTrialEndsOn() Timestamp {
+	if m == nil || m.FreeTrialEndsOn == nil {
+		return Timestamp{}
+	}
+	return *m.FreeTrialEndsOn
+}
+
+// GetNextBillingDate returns the NextBillingDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetNextBillingDate() Timestamp {
+	if m == nil || m.NextBillingDate == nil {
+		return Timestamp{}
+	}
+	return *m.NextBillingDate
+}
+
+// GetOnFreeTrial returns the OnFreeTrial field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetOnFreeTrial() bool {
+	if m == nil || m.OnFreeTrial == nil {
+		return false
+	}
+	return *m.OnFreeTrial
+}
+
+// GetPlan returns the Plan field.
+func (m *MarketplacePurchase) GetPlan() *MarketplacePlan {
+	if m == nil {
+		return nil
+	}
+	return m.Plan
+}
+
+// GetUnitCount returns the UnitCount field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchase) GetUnitCount() int {
+	if m == nil || m.UnitCount == nil {
+		return 0
+	}
+	return *m.UnitCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetEffectiveDate returns the EffectiveDate field if it's non-nil, zero value otherwise.
+func (m *MarketplacePurchaseEvent) GetEffectiveDate() Timestamp {
+	if m == nil || m.EffectiveDate == nil {
+		return Timestamp{}
+	}
+	return *m.EffectiveDate
+}
+
+// GetInstallation returns the Installation field.
+func (m *MarketplacePurchaseEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMarketplacePurchase returns the MarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.MarketplacePurchase
+}
+
+// GetPreviousMarketplacePurchase returns the PreviousMarketplacePurchase field.
+func (m *MarketplacePurchaseEvent) GetPreviousMarketplacePurchase() *MarketplacePurchase {
+	if m == nil {
+		return nil
+	}
+	return m.PreviousMarketplacePurchase
+}
+
+// GetSender returns the Sender field.
+func (m *MarketplacePurchaseEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (m *Match) GetText() string {
+	if m == nil || m.Text == nil {
+		return ""
+	}
+	return *m.Text
+}
+
+// GetInvitation returns the Invitation field.
+func (m *MemberAccess) GetInvitation() *Invitation {
+	if m == nil {
+		return nil
+	}
+	return m.Invitation
+}
+
+// GetTwoFactorEnabled returns the TwoFactorEnabled field if it's non-nil, zero value otherwise.
+func (m *MemberAccess) GetTwoFactorEnabled() bool {
+	if m == nil || m.TwoFactorEnabled == nil {
+		return false
+	}
+	return *m.TwoFactorEnabled
+}
+
+// GetUser returns the User field.
+func (m *MemberAccess) GetUser() *User {
+	if m == nil {
+		return nil
+	}
+	return m.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MemberEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetInstallation returns the Installation field.
+func (m *MemberEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMember returns the Member field.
+func (m *MemberEvent) GetMember() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Member
+}
+
+// GetRepo returns the Repo field.
+func (m *MemberEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetSender returns the Sender field.
+func (m *MemberEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetOrganization returns the Organization field.
+func (m *Membership) GetOrganization() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Organization
+}
+
+// GetOrganizationURL returns the OrganizationURL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetOrganizationURL() string {
+	if m == nil || m.OrganizationURL == nil {
+		return ""
+	}
+	return *m.OrganizationURL
+}
+
+// GetRole returns the Role field if it's non-nil, zero value otherwise.
+func (m *Membership) GetRole() string {
+	if m == nil || m.Role == nil {
+		return ""
+	}
+	return *m.Role
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Membership) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Membership) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetUser returns the User field.
+func (m *Membership) GetUser() *User {
+	if m == nil {
+		return nil
+	}
+	return m.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetInstallation returns the Installation field.
+func (m *MembershipEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMember returns the Member field.
+func (m *MembershipEvent) GetMember() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Member
+}
+
+// GetOrg returns the Org field.
+func (m *MembershipEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (m *MembershipEvent) GetScope() string {
+	if m == nil || m.Scope == nil {
+		return ""
+	}
+	return *m.Scope
+}
+
+// GetSender returns the Sender field.
+func (m *MembershipEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetTeam returns the Team field.
+func (m *MembershipEvent) GetTeam() *Team {
+	if m == nil {
+		return nil
+	}
+	return m.Team
+}
+
+// GetEntries returns the Entries field.
+func (m *MergeQueue) GetEntries() *MergeQueueEntryConnection {
+	if m == nil {
+		return nil
+	}
+	return m.Entries
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MergeQueue) GetID() string {
+	if m == nil || m.ID == nil {
+		return ""
+	}
+	return *m.ID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MergeQueue) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetEnqueuedAt returns the EnqueuedAt field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEnqueuedAt() Timestamp {
+	if m == nil || m.EnqueuedAt == nil {
+		return Timestamp{}
+	}
+	return *m.EnqueuedAt
+}
+
+// GetEnqueuer returns the Enqueuer field.
+func (m *MergeQueueEntry) GetEnqueuer() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Enqueuer
+}
+
+// GetEstimatedTimeToMerge returns the EstimatedTimeToMerge field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetEstimatedTimeToMerge() int {
+	if m == nil || m.EstimatedTimeToMerge == nil {
+		return 0
+	}
+	return *m.EstimatedTimeToMerge
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetID() string {
+	if m == nil || m.ID == nil {
+		return ""
+	}
+	return *m.ID
+}
+
+// GetJump returns the Jump field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetJump() bool {
+	if m == nil || m.Jump == nil {
+		return false
+	}
+	return *m.Jump
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetPosition() int {
+	if m == nil || m.Position == nil {
+		return 0
+	}
+	return *m.Position
+}
+
+// GetPullRequest returns the PullRequest field.
+func (m *MergeQueueEntry) GetPullRequest() *MergeQueuePullRequest {
+	if m == nil {
+		return nil
+	}
+	return m.PullRequest
+}
+
+// GetSolo returns the Solo field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetSolo() bool {
+	if m == nil || m.Solo == nil {
+		return false
+	}
+	return *m.Solo
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntry) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetPageInfo returns the PageInfo field.
+func (m *MergeQueueEntryConnection) GetPageInfo() *PageInfo {
+	if m == nil {
+		return nil
+	}
+	return m.PageInfo
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (m *MergeQueueEntryConnection) GetTotalCount() int {
+	if m == nil || m.TotalCount == nil {
+		return 0
+	}
+	return *m.TotalCount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MergeQueuePullRequest) GetID() string {
+	if m == nil || m.ID == nil {
+		return ""
+	}
+	return *m.ID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *MergeQueuePullRequest) GetNumber() int {
+	if m == nil || m.Number == nil {
+		return 0
+	}
+	return *m.Number
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *MergeQueuePullRequest) GetTitle() string {
+	if m == nil || m.Title == nil {
+		return ""
+	}
+	return *m.Title
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *MergeQueuePullRequest) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetHook returns the Hook field.
+func (m *MetaEvent) GetHook() *Hook {
+	if m == nil {
+		return nil
+	}
+	return m.Hook
+}
+
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (m *MetaEvent) GetHookID() int64 {
+	if m == nil || m.HookID == nil {
+		return 0
+	}
+	return *m.HookID
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (m *Metric) GetKey() string {
+	if m == nil || m.Key == nil {
+		return ""
+	}
+	return *m.Key
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *Metric) GetName() string {
+	if m == nil || m.Name == nil {
+		return ""
+	}
+	return *m.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Metric) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetCreatedAt() string {
+	if m == nil || m.CreatedAt == nil {
+		return ""
+	}
+	return *m.CreatedAt
+}
+
+// GetExcludeAttachments returns the ExcludeAttachments field if it's non-nil, zero value otherwise.
+func (m *Migration) GetExcludeAttachments() bool {
+	if m == nil || m.ExcludeAttachments == nil {
+		return false
+	}
+	return *m.ExcludeAttachments
+}
+
+// GetGUID returns the GUID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetGUID() string {
+	if m == nil || m.GUID == nil {
+		return ""
+	}
+	return *m.GUID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Migration) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLockRepositories returns the LockRepositories field if it's non-nil, zero value otherwise.
+func (m *Migration) GetLockRepositories() bool {
+	if m == nil || m.LockRepositories == nil {
+		return false
+	}
+	return *m.LockRepositories
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Migration) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Migration) GetUpdatedAt() string {
+	if m == nil || m.UpdatedAt == nil {
+		return ""
+	}
+	return *m.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Migration) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedAt() time.Time {
+	if m == nil || m.ClosedAt == nil {
+		return time.Time{}
+	}
+	return *m.ClosedAt
+}
+
+// GetClosedIssues returns the ClosedIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetClosedIssues() int {
+	if m == nil || m.ClosedIssues == nil {
+		return 0
+	}
+	return *m.ClosedIssues
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetCreatedAt() time.Time {
+	if m == nil || m.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *m.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (m *Milestone) GetCreator() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Creator
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDescription() string {
+	if m == nil || m.Description == nil {
+		return ""
+	}
+	return *m.Description
+}
+
+// GetDueOn returns the DueOn field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetDueOn() time.Time {
+	if m == nil || m.DueOn == nil {
+		return time.Time{}
+	}
+	return *m.DueOn
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetHTMLURL() string {
+	if m == nil || m.HTMLURL == nil {
+		return ""
+	}
+	return *m.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+	return *m.ID
+}
+
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetLabelsURL() string {
+	if m == nil || m.LabelsURL == nil {
+		return ""
+	}
+	return *m.LabelsURL
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNodeID() string {
+	if m == nil || m.NodeID == nil {
+		return ""
+	}
+	return *m.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetNumber() int {
+	if m == nil || m.Number == nil {
+		return 0
+	}
+	return *m.Number
+}
+
+// GetOpenIssues returns the OpenIssues field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetOpenIssues() int {
+	if m == nil || m.OpenIssues == nil {
+		return 0
+	}
+	return *m.OpenIssues
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetState() string {
+	if m == nil || m.State == nil {
+		return ""
+	}
+	return *m.State
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetTitle() string {
+	if m == nil || m.Title == nil {
+		return ""
+	}
+	return *m.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetUpdatedAt() time.Time {
+	if m == nil || m.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *m.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (m *Milestone) GetURL() string {
+	if m == nil || m.URL == nil {
+		return ""
+	}
+	return *m.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (m *MilestoneEvent) GetAction() string {
+	if m == nil || m.Action == nil {
+		return ""
+	}
+	return *m.Action
+}
+
+// GetChanges returns the Changes field.
+func (m *MilestoneEvent) GetChanges() *EditChange {
+	if m == nil {
+		return nil
+	}
+	return m.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (m *MilestoneEvent) GetInstallation() *Installation {
+	if m == nil {
+		return nil
+	}
+	return m.Installation
+}
+
+// GetMilestone returns the Milestone field.
+func (m *MilestoneEvent) GetMilestone() *Milestone {
+	if m == nil {
+		return nil
+	}
+	return m.Milestone
+}
+
+// GetOrg returns the Org field.
+func (m *MilestoneEvent) GetOrg() *Organization {
+	if m == nil {
+		return nil
+	}
+	return m.Org
+}
+
+// GetRepo returns the Repo field.
+func (m *MilestoneEvent) GetRepo() *Repository {
+	if m == nil {
+		return nil
+	}
+	return m.Repo
+}
+
+// GetSender returns the Sender field.
+func (m *MilestoneEvent) GetSender() *User {
+	if m == nil {
+		return nil
+	}
+	return m.Sender
+}
+
+// GetClosedMilestones returns the ClosedMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetClosedMilestones() int {
+	if m == nil || m.ClosedMilestones == nil {
+		return 0
+	}
+	return *m.ClosedMilestones
+}
+
+// GetOpenMilestones returns the OpenMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetOpenMilestones() int {
+	if m == nil || m.OpenMilestones == nil {
+		return 0
+	}
+	return *m.OpenMilestones
+}
+
+// GetTotalMilestones returns the TotalMilestones field if it's non-nil, zero value otherwise.
+func (m *MilestoneStats) GetTotalMilestones() int {
+	if m == nil || m.TotalMilestones == nil {
+		return 0
+	}
+	return *m.TotalMilestones
+}
+
+// GetIssue returns the Issue field.
+func (m *ModerationResult) GetIssue() *Issue {
+	if m == nil {
+		return nil
+	}
+	return m.Issue
+}
+
+// GetComputeService returns the ComputeService field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetComputeService() string {
+	if n == nil || n.ComputeService == nil {
+		return ""
+	}
+	return *n.ComputeService
+}
+
+// GetCreatedOn returns the CreatedOn field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetCreatedOn() Timestamp {
+	if n == nil || n.CreatedOn == nil {
+		return Timestamp{}
+	}
+	return *n.CreatedOn
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkConfiguration) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetComputeService returns the ComputeService field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurationRequest) GetComputeService() string {
+	if n == nil || n.ComputeService == nil {
+		return ""
+	}
+	return *n.ComputeService
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurationRequest) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (n *NetworkConfigurations) GetTotalCount() int {
+	if n == nil || n.TotalCount == nil {
+		return 0
+	}
+	return *n.TotalCount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettingsResource) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (n *NetworkSettingsResource) GetName() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetNetworkConfigurationID returns the NetworkConfigurationID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettingsResource) GetNetworkConfigurationID() string {
+	if n == nil || n.NetworkConfigurationID == nil {
+		return ""
+	}
+	return *n.NetworkConfigurationID
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (n *NetworkSettingsResource) GetRegion() string {
+	if n == nil || n.Region == nil {
+		return ""
+	}
+	return *n.Region
+}
+
+// GetSubnetID returns the SubnetID field if it's non-nil, zero value otherwise.
+func (n *NetworkSettingsResource) GetSubnetID() string {
+	if n == nil || n.SubnetID == nil {
+		return ""
+	}
+	return *n.SubnetID
+}
+
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBase() string {
+	if n == nil || n.Base == nil {
+		return ""
+	}
+	return *n.Base
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetBody() string {
+	if n == nil || n.Body == nil {
+		return ""
+	}
+	return *n.Body
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetDraft() bool {
+	if n == nil || n.Draft == nil {
+		return false
+	}
+	return *n.Draft
+}
+
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetHead() string {
+	if n == nil || n.Head == nil {
+		return ""
+	}
+	return *n.Head
+}
+
+// GetIssue returns the Issue field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetIssue() int {
+	if n == nil || n.Issue == nil {
+		return 0
+	}
+	return *n.Issue
+}
+
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetMaintainerCanModify() bool {
+	if n == nil || n.MaintainerCanModify == nil {
+		return false
+	}
+	return *n.MaintainerCanModify
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NewPullRequest) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
+	}
+	return *n.Title
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetDescription() string {
+	if n == nil || n.Description == nil {
+		return ""
+	}
+	return *n.Description
+}
+
+// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetLDAPDN() string {
+	if n == nil || n.LDAPDN == nil {
+		return ""
+	}
+	return *n.LDAPDN
+}
+
+// GetParentTeamID returns the ParentTeamID field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetParentTeamID() int64 {
+	if n == nil || n.ParentTeamID == nil {
+		return 0
+	}
+	return *n.ParentTeamID
+}
+
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPermission() string {
+	if n == nil || n.Permission == nil {
+		return ""
+	}
+	return *n.Permission
+}
+
+// GetPrivacy returns the Privacy field if it's non-nil, zero value otherwise.
+func (n *NewTeam) GetPrivacy() string {
+	if n == nil || n.Privacy == nil {
+		return ""
+	}
+	return *n.Privacy
+}
+
+// GetDatabaseID returns the DatabaseID field if it's non-nil, zero value otherwise.
+func (n *Node) GetDatabaseID() int64 {
+	if n == nil || n.DatabaseID == nil {
+		return 0
+	}
+	return *n.DatabaseID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *Node) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
+	}
+	return *n.URL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (n *Notification) GetID() string {
+	if n == nil || n.ID == nil {
+		return ""
+	}
+	return *n.ID
+}
+
+// GetLastReadAt returns the LastReadAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetLastReadAt() time.Time {
+	if n == nil || n.LastReadAt == nil {
+		return time.Time{}
+	}
+	return *n.LastReadAt
+}
+
+// GetReason returns the Reason field if it's non-nil, zero value otherwise.
+func (n *Notification) GetReason() string {
+	if n == nil || n.Reason == nil {
+		return ""
+	}
+	return *n.Reason
+}
+
+// GetRepository returns the Repository field.
+func (n *Notification) GetRepository() *Repository {
+	if n == nil {
+		return nil
+	}
+	return n.Repository
+}
+
+// GetSubject returns the Subject field.
+func (n *Notification) GetSubject() *NotificationSubject {
+	if n == nil {
+		return nil
+	}
+	return n.Subject
+}
+
+// GetUnread returns the Unread field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUnread() bool {
+	if n == nil || n.Unread == nil {
+		return false
+	}
+	return *n.Unread
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (n *Notification) GetUpdatedAt() time.Time {
+	if n == nil || n.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *n.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *Notification) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
+	}
+	return *n.URL
+}
+
+// GetLatestCommentURL returns the LatestCommentURL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetLatestCommentURL() string {
+	if n == nil || n.LatestCommentURL == nil {
+		return ""
+	}
+	return *n.LatestCommentURL
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetTitle() string {
+	if n == nil || n.Title == nil {
+		return ""
+	}
+	return *n.Title
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetType() string {
+	if n == nil || n.Type == nil {
+		return ""
+	}
+	return *n.Type
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (n *NotificationSubject) GetURL() string {
+	if n == nil || n.URL == nil {
+		return ""
+	}
+	return *n.URL
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetAvatarURL() string {
+	if o == nil || o.AvatarURL == nil {
+		return ""
+	}
+	return *o.AvatarURL
+}
+
+// GetBillingEmail returns the BillingEmail field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBillingEmail() string {
+	if o == nil || o.BillingEmail == nil {
+		return ""
+	}
+	return *o.BillingEmail
+}
+
+// GetBlog returns the Blog field if it's non-nil, zero value otherwise.
+func (o *Organization) GetBlog() string {
+	if o == nil || o.Blog == nil {
+		return ""
+	}
+	return *o.Blog
+}
+
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCollaborators() int {
+	if o == nil || o.Collaborators == nil {
+		return 0
+	}
+	return *o.Collaborators
+}
+
+// GetCompany returns the Company field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCompany() string {
+	if o == nil || o.Company == nil {
+		return ""
+	}
+	return *o.Company
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *o.CreatedAt
+}
+
+// GetDefaultRepoPermission returns the DefaultRepoPermission field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoPermission() string {
+	if o == nil || o.DefaultRepoPermission == nil {
+		return ""
+	}
+	return *o.DefaultRepoPermission
+}
+
+// GetDefaultRepoSettings returns the DefaultRepoSettings field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDefaultRepoSettings() string {
+	if o == nil || o.DefaultRepoSettings == nil {
+		return ""
+	}
+	return *o.DefaultRepoSettings
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+// GetDiskUsage returns the DiskUsage field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDiskUsage() int {
+	if o == nil || o.DiskUsage == nil {
+		return 0
+	}
+	return *o.DiskUsage
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEmail() string {
+	if o == nil || o.Email == nil {
+		return ""
+	}
+	return *o.Email
+}
+
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetEventsURL() string {
+	if o == nil || o.EventsURL == nil {
+		return ""
+	}
+	return *o.EventsURL
+}
+
+// GetFollowers returns the Followers field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowers() int {
+	if o == nil || o.Followers == nil {
+		return 0
+	}
+	return *o.Followers
+}
+
+// GetFollowing returns the Following field if it's non-nil, zero value otherwise.
+func (o *Organization) GetFollowing() int {
+	if o == nil || o.Following == nil {
+		return 0
+	}
+	return *o.Following
+}
+
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHooksURL() string {
+	if o == nil || o.HooksURL == nil {
+		return ""
+	}
+	return *o.HooksURL
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetHTMLURL() string {
+	if o == nil || o.HTMLURL == nil {
+		return ""
+	}
+	return *o.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
+	}
+	return *o.ID
+}
+
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetIssuesURL() string {
+	if o == nil || o.IssuesURL == nil {
+		return ""
+	}
+	return *o.IssuesURL
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLocation() string {
+	if o == nil || o.Location == nil {
+		return ""
+	}
+	return *o.Location
+}
+
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (o *Organization) GetLogin() string {
+	if o == nil || o.Login == nil {
+		return ""
+	}
+	return *o.Login
+}
+
+// GetMembersCanCreateRepos returns the MembersCanCreateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersCanCreateRepos() bool {
+	if o == nil || o.MembersCanCreateRepos == nil {
+		return false
+	}
+	return *o.MembersCanCreateRepos
+}
+
+// GetMembersURL returns the MembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMembersURL() string {
+	if o == nil || o.MembersURL == nil {
+		return ""
+	}
+	return *o.MembersURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Organization) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetNodeID() string {
+	if o == nil || o.NodeID == nil {
+		return ""
+	}
+	return *o.NodeID
+}
+
+// GetOwnedPrivateRepos returns the OwnedPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetOwnedPrivateRepos() int {
+	if o == nil || o.OwnedPrivateRepos == nil {
+		return 0
+	}
+	return *o.OwnedPrivateRepos
+}
+
+// GetPlan returns the Plan field.
+func (o *Organization) GetPlan() *Plan {
+	if o == nil {
+		return nil
+	}
+	return o.Plan
+}
+
+// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPrivateGists() int {
+	if o == nil || o.PrivateGists == nil {
+		return 0
+	}
+	return *o.PrivateGists
+}
+
+// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicGists() int {
+	if o == nil || o.PublicGists == nil {
+		return 0
+	}
+	return *o.PublicGists
+}
+
+// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicMembersURL() string {
+	if o == nil || o.PublicMembersURL == nil {
+		return ""
+	}
+	return *o.PublicMembersURL
+}
+
+// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetPublicRepos() int {
+	if o == nil || o.PublicRepos == nil {
+		return 0
+	}
+	return *o.PublicRepos
+}
+
+// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetReposURL() string {
+	if o == nil || o.ReposURL == nil {
+		return ""
+	}
+	return *o.ReposURL
+}
+
+// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTotalPrivateRepos() int {
+	if o == nil || o.TotalPrivateRepos == nil {
+		return 0
+	}
+	return *o.TotalPrivateRepos
+}
+
+// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
+func (o *Organization) GetTwoFactorRequirementEnabled() bool {
+	if o == nil || o.TwoFactorRequirementEnabled == nil {
+		return false
+	}
+	return *o.TwoFactorRequirementEnabled
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (o *Organization) GetType() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *Organization) GetUpdatedAt() time.Time {
+	if o == nil || o.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *o.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (o *Organization) GetURL() string {
+	if o == nil || o.URL == nil {
+		return ""
+	}
+	return *o.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+// GetInstallation returns the Installation field.
+func (o *OrganizationEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
+	}
+	return o.Installation
+}
+
+// GetInvitation returns the Invitation field.
+func (o *OrganizationEvent) GetInvitation() *Invitation {
+	if o == nil {
+		return nil
+	}
+	return o.Invitation
+}
+
+// GetMembership returns the Membership field.
+func (o *OrganizationEvent) GetMembership() *Membership {
+	if o == nil {
+		return nil
+	}
+	return o.Membership
+}
+
+// GetOrganization returns the Organization field.
+func (o *OrganizationEvent) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// GetSender returns the Sender field.
+func (o *OrganizationEvent) GetSender() *User {
+	if o == nil {
+		return nil
+	}
+	return o.Sender
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrganizationInstallations) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrgBlockEvent) GetAction() string {
+	if o == nil || o.Action == nil {
+		return ""
+	}
+	return *o.Action
+}
+
+// GetBlockedUser returns the BlockedUser field.
+func (o *OrgBlockEvent) GetBlockedUser() *User {
+	if o == nil {
+		return nil
+	}
+	return o.BlockedUser
+}
+
+// GetInstallation returns the Installation field.
+func (o *OrgBlockEvent) GetInstallation() *Installation {
+	if o == nil {
+		return nil
+	}
+	return o.Installation
+}
+
+// GetOrganization returns the Organization field.
+func (o *OrgBlockEvent) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// GetSender returns the Sender field.
+func (o *OrgBlockEvent) GetSender() *User {
+	if o == nil {
+		return nil
+	}
+	return o.Sender
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetCreatedAt() Timestamp {
+	if o == nil || o.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *o.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
+	}
+	return *o.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetPath() string {
+	if o == nil || o.Path == nil {
+		return ""
+	}
+	return *o.Path
+}
+
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetRef() string {
+	if o == nil || o.Ref == nil {
+		return ""
+	}
+	return *o.Ref
+}
+
+// GetRepository returns the Repository field.
+func (o *OrgRequiredWorkflow) GetRepository() *Repository {
+	if o == nil {
+		return nil
+	}
+	return o.Repository
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetScope() string {
+	if o == nil || o.Scope == nil {
+		return ""
+	}
+	return *o.Scope
+}
+
+// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetSelectedRepositoriesURL() string {
+	if o == nil || o.SelectedRepositoriesURL == nil {
+		return ""
+	}
+	return *o.SelectedRepositoriesURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetState() string {
+	if o == nil || o.State == nil {
+		return ""
+	}
+	return *o.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflow) GetUpdatedAt() Timestamp {
+	if o == nil || o.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *o.UpdatedAt
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrgRequiredWorkflows) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetBaseRole returns the BaseRole field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetBaseRole() string {
+	if o == nil || o.BaseRole == nil {
+		return ""
+	}
+	return *o.BaseRole
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetCreatedAt() Timestamp {
+	if o == nil || o.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *o.CreatedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetDescription() string {
+	if o == nil || o.Description == nil {
+		return ""
+	}
+	return *o.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetID() int64 {
+	if o == nil || o.ID == nil {
+		return 0
+	}
+	return *o.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetOrganization returns the Organization field.
+func (o *OrgRole) GetOrganization() *Organization {
+	if o == nil {
+		return nil
+	}
+	return o.Organization
+}
+
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetSource() string {
+	if o == nil || o.Source == nil {
+		return ""
+	}
+	return *o.Source
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (o *OrgRole) GetUpdatedAt() Timestamp {
+	if o == nil || o.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *o.UpdatedAt
+}
+
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (o *OrgRoles) GetTotalCount() int {
+	if o == nil || o.TotalCount == nil {
+		return 0
+	}
+	return *o.TotalCount
+}
+
+// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetDisabledOrgs() int {
+	if o == nil || o.DisabledOrgs == nil {
+		return 0
+	}
+	return *o.DisabledOrgs
+}
+
+// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalOrgs() int {
+	if o == nil || o.TotalOrgs == nil {
+		return 0
+	}
+	return *o.TotalOrgs
+}
+
+// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeamMembers() int {
+	if o == nil || o.TotalTeamMembers == nil {
+		return 0
+	}
+	return *o.TotalTeamMembers
+}
+
+// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
+func (o *OrgStats) GetTotalTeams() int {
+	if o == nil || o.TotalTeams == nil {
+		return 0
+	}
+	return *o.TotalTeams
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Package) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Package) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Package) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetOwner returns the Owner field.
+func (p *Package) GetOwner() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Owner
+}
+
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *Package) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
+		return ""
+	}
+	return *p.PackageType
+}
+
+// GetRepository returns the Repository field.
+func (p *Package) GetRepository() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repository
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Package) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Package) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetVersionCount returns the VersionCount field if it's non-nil, zero value otherwise.
+func (p *Package) GetVersionCount() int64 {
+	if p == nil || p.VersionCount == nil {
+		return 0
+	}
+	return *p.VersionCount
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *Package) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
+	}
+	return *p.Visibility
+}
+
+// GetReferenceCategory returns the ReferenceCategory field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceCategory() string {
+	if p == nil || p.ReferenceCategory == nil {
+		return ""
+	}
+	return *p.ReferenceCategory
+}
+
+// GetReferenceLocator returns the ReferenceLocator field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceLocator() string {
+	if p == nil || p.ReferenceLocator == nil {
+		return ""
+	}
+	return *p.ReferenceLocator
+}
+
+// GetReferenceType returns the ReferenceType field if it's non-nil, zero value otherwise.
+func (p *PackageExternalRef) GetReferenceType() string {
+	if p == nil || p.ReferenceType == nil {
+		return ""
+	}
+	return *p.ReferenceType
+}
+
+// GetContainer returns the Container field.
+func (p *PackageMetadata) GetContainer() *PackageContainerMetadata {
+	if p == nil {
+		return nil
+	}
+	return p.Container
+}
+
+// GetPackageType returns the PackageType field if it's non-nil, zero value otherwise.
+func (p *PackageMetadata) GetPackageType() string {
+	if p == nil || p.PackageType == nil {
+		return ""
+	}
+	return *p.PackageType
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDeletedAt returns the DeletedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDeletedAt() Timestamp {
+	if p == nil || p.DeletedAt == nil {
+		return Timestamp{}
+	}
+	return *p.DeletedAt
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetLicense returns the License field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetLicense() string {
+	if p == nil || p.License == nil {
+		return ""
+	}
+	return *p.License
+}
+
+// GetMetadata returns the Metadata field.
+func (p *PackageVersion) GetMetadata() *PackageMetadata {
+	if p == nil {
+		return nil
+	}
+	return p.Metadata
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPackageHTMLURL returns the PackageHTMLURL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetPackageHTMLURL() string {
+	if p == nil || p.PackageHTMLURL == nil {
+		return ""
+	}
+	return *p.PackageHTMLURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PackageVersion) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *Page) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Page) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
+func (p *Page) GetPageName() string {
+	if p == nil || p.PageName == nil {
+		return ""
+	}
+	return *p.PageName
+}
+
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *Page) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
+	}
+	return *p.SHA
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (p *Page) GetSummary() string {
+	if p == nil || p.Summary == nil {
+		return ""
+	}
+	return *p.Summary
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *Page) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetBuild returns the Build field.
+func (p *PageBuildEvent) GetBuild() *PagesBuild {
+	if p == nil {
+		return nil
+	}
+	return p.Build
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PageBuildEvent) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetInstallation returns the Installation field.
+func (p *PageBuildEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetRepo returns the Repo field.
+func (p *PageBuildEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *PageBuildEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetEndCursor returns the EndCursor field if it's non-nil, zero value otherwise.
+func (p *PageInfo) GetEndCursor() string {
+	if p == nil || p.EndCursor == nil {
+		return ""
+	}
+	return *p.EndCursor
+}
+
+// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCNAME() string {
+	if p == nil || p.CNAME == nil {
+		return ""
+	}
+	return *p.CNAME
+}
+
+// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
+func (p *Pages) GetCustom404() bool {
+	if p == nil || p.Custom404 == nil {
+		return false
+	}
+	return *p.Custom404
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetSource returns the Source field.
+func (p *Pages) GetSource() *PagesSource {
+	if p == nil {
+		return nil
+	}
+	return p.Source
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *Pages) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Pages) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCommit() string {
+	if p == nil || p.Commit == nil {
+		return ""
+	}
+	return *p.Commit
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+// GetError returns the Error field.
+func (p *PagesBuild) GetError() *PagesError {
+	if p == nil {
+		return nil
+	}
+	return p.Error
+}
+
+// GetPusher returns the Pusher field.
+func (p *PagesBuild) GetPusher() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Pusher
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetStatus() string {
+	if p == nil || p.Status == nil {
+		return ""
+	}
+	return *p.Status
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PagesBuild) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PagesError) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
+	}
+	return *p.Message
+}
+
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetBranch() string {
+	if p == nil || p.Branch == nil {
+		return ""
+	}
+	return *p.Branch
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PagesSource) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
+	}
+	return *p.Path
+}
+
+// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
+func (p *PageStats) GetTotalPages() int {
+	if p == nil || p.TotalPages == nil {
+		return 0
+	}
+	return *p.TotalPages
+}
+
+// GetCurrentUserCanApprove returns the CurrentUserCanApprove field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetCurrentUserCanApprove() bool {
+	if p == nil || p.CurrentUserCanApprove == nil {
+		return false
+	}
+	return *p.CurrentUserCanApprove
+}
+
+// GetEnvironment returns the Environment field.
+func (p *PendingDeployment) GetEnvironment() *PendingDeploymentEnvironment {
+	if p == nil {
+		return nil
+	}
+	return p.Environment
+}
+
+// GetWaitTimer returns the WaitTimer field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetWaitTimer() int64 {
+	if p == nil || p.WaitTimer == nil {
+		return 0
+	}
+	return *p.WaitTimer
+}
+
+// GetWaitTimerStartedAt returns the WaitTimerStartedAt field if it's non-nil, zero value otherwise.
+func (p *PendingDeployment) GetWaitTimerStartedAt() Timestamp {
+	if p == nil || p.WaitTimerStartedAt == nil {
+		return Timestamp{}
+	}
+	return *p.WaitTimerStartedAt
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PendingDeploymentEnvironment) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetHook returns the Hook field.
+func (p *PingEvent) GetHook() *Hook {
+	if p == nil {
+		return nil
+	}
+	return p.Hook
+}
+
+// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetHookID() int64 {
+	if p == nil || p.HookID == nil {
+		return 0
+	}
+	return *p.HookID
+}
+
+// GetInstallation returns the Installation field.
+func (p *PingEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetZen returns the Zen field if it's non-nil, zero value otherwise.
+func (p *PingEvent) GetZen() string {
+	if p == nil || p.Zen == nil {
+		return ""
+	}
+	return *p.Zen
+}
+
+// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
+func (p *Plan) GetCollaborators() int {
+	if p == nil || p.Collaborators == nil {
+		return 0
+	}
+	return *p.Collaborators
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Plan) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
+func (p *Plan) GetPrivateRepos() int {
+	if p == nil || p.PrivateRepos == nil {
+		return 0
+	}
+	return *p.PrivateRepos
+}
+
+// GetSpace returns the Space field if it's non-nil, zero value otherwise.
+func (p *Plan) GetSpace() int {
+	if p == nil || p.Space == nil {
+		return 0
+	}
+	return *p.Space
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDefaultEnvironment returns the DefaultEnvironment field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetDefaultEnvironment() bool {
+	if p == nil || p.DefaultEnvironment == nil {
+		return false
+	}
+	return *p.DefaultEnvironment
+}
+
+// GetDownload returns the Download field.
+func (p *PreReceiveEnvironment) GetDownload() *PreReceiveEnvironmentDownload {
+	if p == nil {
+		return nil
+	}
+	return p.Download
+}
+
+// GetHooksCount returns the HooksCount field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetHooksCount() int {
+	if p == nil || p.HooksCount == nil {
+		return 0
+	}
+	return *p.HooksCount
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetImageURL returns the ImageURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetImageURL() string {
+	if p == nil || p.ImageURL == nil {
+		return ""
+	}
+	return *p.ImageURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironment) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetDownloadedAt returns the DownloadedAt field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironmentDownload) GetDownloadedAt() Timestamp {
+	if p == nil || p.DownloadedAt == nil {
+		return Timestamp{}
+	}
+	return *p.DownloadedAt
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironmentDownload) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
+	}
+	return *p.Message
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironmentDownload) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveEnvironmentDownload) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetAllowDownstreamConfiguration returns the AllowDownstreamConfiguration field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetAllowDownstreamConfiguration() bool {
+	if p == nil || p.AllowDownstreamConfiguration == nil {
+		return false
+	}
+	return *p.AllowDownstreamConfiguration
+}
+
+// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetConfigURL() string {
+	if p == nil || p.ConfigURL == nil {
+		return ""
+	}
+	return *p.ConfigURL
+}
+
+// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetEnforcement() string {
+	if p == nil || p.Enforcement == nil {
+		return ""
+	}
+	return *p.Enforcement
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PreReceiveHook) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetRegistryType returns the RegistryType field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetRegistryType() string {
+	if p == nil || p.RegistryType == nil {
+		return ""
+	}
+	return *p.RegistryType
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetUsername() string {
+	if p == nil || p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (p *PrivateRegistry) GetVisibility() string {
+	if p == nil || p.Visibility == nil {
+		return ""
+	}
+	return *p.Visibility
+}
+
+// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
+func (p *PRLink) GetHRef() string {
+	if p == nil || p.HRef == nil {
+		return ""
+	}
+	return *p.HRef
+}
+
+// GetComments returns the Comments field.
+func (p *PRLinks) GetComments() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.Comments
+}
+
+// GetCommits returns the Commits field.
+func (p *PRLinks) GetCommits() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.Commits
+}
+
+// GetHTML returns the HTML field.
+func (p *PRLinks) GetHTML() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.HTML
+}
+
+// GetIssue returns the Issue field.
+func (p *PRLinks) GetIssue() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.Issue
+}
+
+// GetReviewComment returns the ReviewComment field.
+func (p *PRLinks) GetReviewComment() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.ReviewComment
+}
+
+// GetReviewComments returns the ReviewComments field.
+func (p *PRLinks) GetReviewComments() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.ReviewComments
+}
+
+// GetSelf returns the Self field.
+func (p *PRLinks) GetSelf() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.Self
+}
+
+// GetStatuses returns the Statuses field.
+func (p *PRLinks) GetStatuses() *PRLink {
+	if p == nil {
+		return nil
+	}
+	return p.Statuses
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *Project) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
+}
+
+// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetColumnsURL() string {
+	if p == nil || p.ColumnsURL == nil {
+		return ""
+	}
+	return *p.ColumnsURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (p *Project) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
+	}
+	return *p.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Project) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Project) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *Project) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *Project) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
+func (p *Project) GetOwnerURL() string {
+	if p == nil || p.OwnerURL == nil {
+		return ""
+	}
+	return *p.OwnerURL
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *Project) GetState() string {
+	if p == nil || p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *Project) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
+	}
+	return *p.Archived
+}
+
+// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnID() int64 {
+	if p == nil || p.ColumnID == nil {
+		return 0
+	}
+	return *p.ColumnID
+}
+
+// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnName() string {
+	if p == nil || p.ColumnName == nil {
+		return ""
+	}
+	return *p.ColumnName
+}
+
+// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetColumnURL() string {
+	if p == nil || p.ColumnURL == nil {
+		return ""
+	}
+	return *p.ColumnURL
+}
+
+// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetContentURL() string {
+	if p == nil || p.ContentURL == nil {
+		return ""
+	}
+	return *p.ContentURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetCreator returns the Creator field.
+func (p *ProjectCard) GetCreator() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Creator
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetNote returns the Note field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetNote() string {
+	if p == nil || p.Note == nil {
+		return ""
+	}
+	return *p.Note
+}
+
+// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetPreviousColumnName() string {
+	if p == nil || p.PreviousColumnName == nil {
+		return ""
+	}
+	return *p.PreviousColumnName
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectID() int64 {
+	if p == nil || p.ProjectID == nil {
+		return 0
+	}
+	return *p.ProjectID
+}
+
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
+	}
+	return *p.ProjectURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectCard) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectCardEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
+	}
+	return *p.AfterID
+}
+
+// GetChanges returns the Changes field.
+func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (p *ProjectCardEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetOrg returns the Org field.
+func (p *ProjectCardEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
+// GetProjectCard returns the ProjectCard field.
+func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
+	if p == nil {
+		return nil
+	}
+	return p.ProjectCard
+}
+
+// GetRepo returns the Repo field.
+func (p *ProjectCardEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *ProjectCardEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
+func (p *ProjectCardListOptions) GetArchivedState() string {
+	if p == nil || p.ArchivedState == nil {
+		return ""
+	}
+	return *p.ArchivedState
+}
+
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (p *ProjectCardOptions) GetArchived() bool {
+	if p == nil || p.Archived == nil {
+		return false
+	}
+	return *p.Archived
+}
+
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectCollaboratorOptions) GetPermission() string {
+	if p == nil || p.Permission == nil {
+		return ""
+	}
+	return *p.Permission
+}
+
+// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCardsURL() string {
+	if p == nil || p.CardsURL == nil {
+		return ""
+	}
+	return *p.CardsURL
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
+	}
+	return *p.ProjectURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectColumn) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
+	}
+	return *p.URL
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
+func (p *ProjectColumnEvent) GetAfterID() int64 {
+	if p == nil || p.AfterID == nil {
+		return 0
+	}
+	return *p.AfterID
+}
+
+// GetChanges returns the Changes field.
+func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
+}
+
+// GetInstallation returns the Installation field.
+func (p *ProjectColumnEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetOrg returns the Org field.
+func (p *ProjectColumnEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
+	}
+	return p.Org
+}
+
+// GetProjectColumn returns the ProjectColumn field.
+func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
+	if p == nil {
+		return nil
+	}
+	return p.ProjectColumn
+}
+
+// GetRepo returns the Repo field.
+func (p *ProjectColumnEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
+	}
+	return p.Repo
+}
+
+// GetSender returns the Sender field.
+func (p *ProjectColumnEvent) GetSender() *User {
+	if p == nil {
+		return nil
+	}
+	return p.Sender
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *ProjectEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
 }
 
-// GetPrivateGists returns the PrivateGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPrivateGists() int {
-	if o == nil || o.PrivateGists == nil {
-		return 0
+// GetChanges returns the Changes field.
+func (p *ProjectEvent) GetChanges() *ProjectChange {
+	if p == nil {
+		return nil
 	}
-	return *o.PrivateGists
+	return p.Changes
 }
 
-// GetPublicGists returns the PublicGists field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicGists() int {
-	if o == nil || o.PublicGists == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (p *ProjectEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicGists
+	return p.Installation
 }
 
-// GetPublicMembersURL returns the PublicMembersURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicMembersURL() string {
-	if o == nil || o.PublicMembersURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (p *ProjectEvent) GetOrg() *Organization {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicMembersURL
+	return p.Org
 }
 
-// GetPublicRepos returns the PublicRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetPublicRepos() int {
-	if o == nil || o.PublicRepos == nil {
-		return 0
+// GetProject returns the Project field.
+func (p *ProjectEvent) GetProject() *Project {
+	if p == nil {
+		return nil
 	}
-	return *o.PublicRepos
+	return p.Project
 }
 
-// GetReposURL returns the ReposURL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetReposURL() string {
-	if o == nil || o.ReposURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *ProjectEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *o.ReposURL
+	return p.Repo
 }
 
-// GetTotalPrivateRepos returns the TotalPrivateRepos field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTotalPrivateRepos() int {
-	if o == nil || o.TotalPrivateRepos == nil {
-		return 0
+// GetSender returns the Sender field.
+func (p *ProjectEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *o.TotalPrivateRepos
+	return p.Sender
 }
 
-// GetTwoFactorRequirementEnabled returns the TwoFactorRequirementEnabled field if it's non-nil, zero value otherwise.
-func (o *Organization) GetTwoFactorRequirementEnabled() bool {
-	if o == nil || o.TwoFactorRequirementEnabled == nil {
-		return false
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return *o.TwoFactorRequirementEnabled
+	return *p.Body
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (o *Organization) GetType() string {
-	if o == nil || o.Type == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *o.Type
+	return *p.Name
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (o *Organization) GetUpdatedAt() time.Time {
-	if o == nil || o.UpdatedAt == nil {
-		return time.Time{}
+// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetOrganizationPermission() string {
+	if p == nil || p.OrganizationPermission == nil {
+		return ""
 	}
-	return *o.UpdatedAt
+	return *p.OrganizationPermission
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (o *Organization) GetURL() string {
-	if o == nil || o.URL == nil {
-		return ""
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return *o.URL
+	return *p.Public
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrganizationEvent) GetAction() string {
-	if o == nil || o.Action == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *ProjectOptions) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *o.Action
+	return *p.State
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrganizationEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (p *ProjectPermissionLevel) GetPermission() string {
+	if p == nil || p.Permission == nil {
+		return ""
 	}
-	return o.Installation
+	return *p.Permission
 }
 
-// GetInvitation returns the Invitation field.
-func (o *OrganizationEvent) GetInvitation() *Invitation {
-	if o == nil {
+// GetUser returns the User field.
+func (p *ProjectPermissionLevel) GetUser() *User {
+	if p == nil {
 		return nil
 	}
-	return o.Invitation
+	return p.User
 }
 
-// GetMembership returns the Membership field.
-func (o *OrganizationEvent) GetMembership() *Membership {
-	if o == nil {
-		return nil
+// GetClosed returns the Closed field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetClosed() bool {
+	if p == nil || p.Closed == nil {
+		return false
 	}
-	return o.Membership
+	return *p.Closed
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrganizationEvent) GetOrganization() *Organization {
-	if o == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return o.Organization
+	return *p.CreatedAt
 }
 
-// GetSender returns the Sender field.
-func (o *OrganizationEvent) GetSender() *User {
-	if o == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return o.Sender
+	return *p.ID
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (o *OrgBlockEvent) GetAction() string {
-	if o == nil || o.Action == nil {
-		return ""
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *o.Action
+	return *p.Number
 }
 
-// GetBlockedUser returns the BlockedUser field.
-func (o *OrgBlockEvent) GetBlockedUser() *User {
-	if o == nil {
-		return nil
+// GetPublic returns the Public field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetPublic() bool {
+	if p == nil || p.Public == nil {
+		return false
 	}
-	return o.BlockedUser
+	return *p.Public
 }
 
-// GetInstallation returns the Installation field.
-func (o *OrgBlockEvent) GetInstallation() *Installation {
-	if o == nil {
-		return nil
+// GetShortDescription returns the ShortDescription field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetShortDescription() string {
+	if p == nil || p.ShortDescription == nil {
+		return ""
 	}
-	return o.Installation
+	return *p.ShortDescription
 }
 
-// GetOrganization returns the Organization field.
-func (o *OrgBlockEvent) GetOrganization() *Organization {
-	if o == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
 	}
-	return o.Organization
+	return *p.Title
 }
 
-// GetSender returns the Sender field.
-func (o *OrgBlockEvent) GetSender() *User {
-	if o == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return o.Sender
+	return *p.UpdatedAt
 }
 
-// GetDisabledOrgs returns the DisabledOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetDisabledOrgs() int {
-	if o == nil || o.DisabledOrgs == nil {
-		return 0
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return *o.DisabledOrgs
+	return *p.URL
 }
 
-// GetTotalOrgs returns the TotalOrgs field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalOrgs() int {
-	if o == nil || o.TotalOrgs == nil {
-		return 0
+// GetPageInfo returns the PageInfo field.
+func (p *ProjectV2Connection) GetPageInfo() *PageInfo {
+	if p == nil {
+		return nil
 	}
-	return *o.TotalOrgs
+	return p.PageInfo
 }
 
-// GetTotalTeamMembers returns the TotalTeamMembers field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeamMembers() int {
-	if o == nil || o.TotalTeamMembers == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Connection) GetTotalCount() int {
+	if p == nil || p.TotalCount == nil {
 		return 0
 	}
-	return *o.TotalTeamMembers
+	return *p.TotalCount
 }
 
-// GetTotalTeams returns the TotalTeams field if it's non-nil, zero value otherwise.
-func (o *OrgStats) GetTotalTeams() int {
-	if o == nil || o.TotalTeams == nil {
-		return 0
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetDataType() string {
+	if p == nil || p.DataType == nil {
+		return ""
 	}
-	return *o.TotalTeams
+	return *p.DataType
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *Page) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.ID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Page) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.Name
 }
 
-// GetPageName returns the PageName field if it's non-nil, zero value otherwise.
-func (p *Page) GetPageName() string {
-	if p == nil || p.PageName == nil {
-		return ""
+// GetPageInfo returns the PageInfo field.
+func (p *ProjectV2FieldConnection) GetPageInfo() *PageInfo {
+	if p == nil {
+		return nil
 	}
-	return *p.PageName
+	return p.PageInfo
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *Page) GetSHA() string {
-	if p == nil || p.SHA == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldConnection) GetTotalCount() int {
+	if p == nil || p.TotalCount == nil {
+		return 0
 	}
-	return *p.SHA
+	return *p.TotalCount
 }
 
-// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
-func (p *Page) GetSummary() string {
-	if p == nil || p.Summary == nil {
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetDate() string {
+	if p == nil || p.Date == nil {
 		return ""
 	}
-	return *p.Summary
+	return *p.Date
 }
-
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *Page) GetTitle() string {
-	if p == nil || p.Title == nil {
+
+// GetIterationID returns the IterationID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetIterationID() string {
+	if p == nil || p.IterationID == nil {
 		return ""
 	}
-	return *p.Title
+	return *p.IterationID
 }
 
-// GetBuild returns the Build field.
-func (p *PageBuildEvent) GetBuild() *PagesBuild {
+// GetNumber returns the Number field.
+func (p *ProjectV2FieldValue) GetNumber() *float64 {
 	if p == nil {
 		return nil
 	}
-	return p.Build
+	return p.Number
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PageBuildEvent) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetSingleSelectOptionID returns the SingleSelectOptionID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetSingleSelectOptionID() string {
+	if p == nil || p.SingleSelectOptionID == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.SingleSelectOptionID
 }
 
-// GetInstallation returns the Installation field.
-func (p *PageBuildEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetText returns the Text field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldValue) GetText() string {
+	if p == nil || p.Text == nil {
+		return ""
 	}
-	return p.Installation
+	return *p.Text
 }
 
-// GetRepo returns the Repo field.
-func (p *PageBuildEvent) GetRepo() *Repository {
+// GetContent returns the Content field.
+func (p *ProjectV2Item) GetContent() *ProjectV2ItemContent {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.Content
 }
 
-// GetSender returns the Sender field.
-func (p *PageBuildEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Sender
+	return *p.CreatedAt
 }
 
-// GetCNAME returns the CNAME field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCNAME() string {
-	if p == nil || p.CNAME == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.CNAME
+	return *p.ID
 }
 
-// GetCustom404 returns the Custom404 field if it's non-nil, zero value otherwise.
-func (p *Pages) GetCustom404() bool {
-	if p == nil || p.Custom404 == nil {
+// GetIsArchived returns the IsArchived field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetIsArchived() bool {
+	if p == nil || p.IsArchived == nil {
 		return false
 	}
-	return *p.Custom404
+	return *p.IsArchived
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetType() string {
+	if p == nil || p.Type == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *p.Type
 }
 
-// GetSource returns the Source field.
-func (p *Pages) GetSource() *PagesSource {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Source
+	return *p.UpdatedAt
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *Pages) GetStatus() string {
-	if p == nil || p.Status == nil {
-		return ""
+// GetPageInfo returns the PageInfo field.
+func (p *ProjectV2ItemConnection) GetPageInfo() *PageInfo {
+	if p == nil {
+		return nil
 	}
-	return *p.Status
+	return p.PageInfo
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Pages) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemConnection) GetTotalCount() int {
+	if p == nil || p.TotalCount == nil {
+		return 0
 	}
-	return *p.URL
+	return *p.TotalCount
 }
 
-// GetCommit returns the Commit field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCommit() string {
-	if p == nil || p.Commit == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.Commit
+	return *p.Body
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.ID
 }
 
-// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetDuration() int {
-	if p == nil || p.Duration == nil {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetNumber() int {
+	if p == nil || p.Number == nil {
 		return 0
 	}
-	return *p.Duration
+	return *p.Number
 }
 
-// GetError returns the Error field.
-func (p *PagesBuild) GetError() *PagesError {
-	if p == nil {
-		return nil
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
 	}
-	return p.Error
+	return *p.Title
 }
 
-// GetPusher returns the Pusher field.
-func (p *PagesBuild) GetPusher() *User {
-	if p == nil {
-		return nil
+// GetTypename returns the Typename field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetTypename() string {
+	if p == nil || p.Typename == nil {
+		return ""
 	}
-	return p.Pusher
+	return *p.Typename
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetStatus() string {
-	if p == nil || p.Status == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemContent) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.Status
+	return *p.URL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
 	}
-	return *p.UpdatedAt
+	return *p.Duration
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PagesBuild) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.ID
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PagesError) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetStartDate() string {
+	if p == nil || p.StartDate == nil {
 		return ""
 	}
-	return *p.Message
+	return *p.StartDate
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetBranch() string {
-	if p == nil || p.Branch == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Iteration) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *p.Branch
+	return *p.Title
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PagesSource) GetPath() string {
-	if p == nil || p.Path == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetID() string {
+	if p == nil || p.ID == nil {
 		return ""
 	}
-	return *p.Path
+	return *p.ID
 }
 
-// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
-func (p *PageStats) GetTotalPages() int {
-	if p == nil || p.TotalPages == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2SingleSelectOption) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
 	}
-	return *p.TotalPages
+	return *p.Name
 }
 
-// GetHook returns the Hook field.
-func (p *PingEvent) GetHook() *Hook {
+// GetEnforceAdmins returns the EnforceAdmins field.
+func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
 	if p == nil {
 		return nil
 	}
-	return p.Hook
+	return p.EnforceAdmins
 }
 
-// GetHookID returns the HookID field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetHookID() int64 {
-	if p == nil || p.HookID == nil {
-		return 0
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+	if p == nil {
+		return nil
 	}
-	return *p.HookID
+	return p.RequiredPullRequestReviews
 }
 
-// GetInstallation returns the Installation field.
-func (p *PingEvent) GetInstallation() *Installation {
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.RequiredStatusChecks
 }
 
-// GetZen returns the Zen field if it's non-nil, zero value otherwise.
-func (p *PingEvent) GetZen() string {
-	if p == nil || p.Zen == nil {
-		return ""
+// GetRestrictions returns the Restrictions field.
+func (p *Protection) GetRestrictions() *BranchRestrictions {
+	if p == nil {
+		return nil
 	}
-	return *p.Zen
+	return p.Restrictions
 }
 
-// GetCollaborators returns the Collaborators field if it's non-nil, zero value otherwise.
-func (p *Plan) GetCollaborators() int {
-	if p == nil || p.Collaborators == nil {
-		return 0
+// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
+func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.Collaborators
+	return p.RequiredPullRequestReviews
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Plan) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetRequiredStatusChecks returns the RequiredStatusChecks field.
+func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
+	if p == nil {
+		return nil
 	}
-	return *p.Name
+	return p.RequiredStatusChecks
 }
 
-// GetPrivateRepos returns the PrivateRepos field if it's non-nil, zero value otherwise.
-func (p *Plan) GetPrivateRepos() int {
-	if p == nil || p.PrivateRepos == nil {
-		return 0
+// GetRestrictions returns the Restrictions field.
+func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.PrivateRepos
+	return p.Restrictions
 }
 
-// GetSpace returns the Space field if it's non-nil, zero value otherwise.
-func (p *Plan) GetSpace() int {
-	if p == nil || p.Space == nil {
-		return 0
+// GetInstallation returns the Installation field.
+func (p *PublicEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.Space
+	return p.Installation
 }
 
-// GetConfigURL returns the ConfigURL field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetConfigURL() string {
-	if p == nil || p.ConfigURL == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (p *PublicEvent) GetRepo() *Repository {
+	if p == nil {
+		return nil
 	}
-	return *p.ConfigURL
+	return p.Repo
 }
 
-// GetEnforcement returns the Enforcement field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetEnforcement() string {
-	if p == nil || p.Enforcement == nil {
-		return ""
+// GetSender returns the Sender field.
+func (p *PublicEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Enforcement
-}
-
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+	return p.Sender
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKey() string {
+	if p == nil || p.Key == nil {
+		return ""
 	}
-	return *p.ID
+	return *p.Key
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PreReceiveHook) GetName() string {
-	if p == nil || p.Name == nil {
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (p *PublicKey) GetKeyID() string {
+	if p == nil || p.KeyID == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.KeyID
 }
 
-// GetHRef returns the HRef field if it's non-nil, zero value otherwise.
-func (p *PRLink) GetHRef() string {
-	if p == nil || p.HRef == nil {
+// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetActiveLockReason() string {
+	if p == nil || p.ActiveLockReason == nil {
 		return ""
 	}
-	return *p.HRef
+	return *p.ActiveLockReason
 }
 
-// GetComments returns the Comments field.
-func (p *PRLinks) GetComments() *PRLink {
-	if p == nil {
-		return nil
+// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAdditions() int {
+	if p == nil || p.Additions == nil {
+		return 0
 	}
-	return p.Comments
+	return *p.Additions
 }
 
-// GetCommits returns the Commits field.
-func (p *PRLinks) GetCommits() *PRLink {
+// GetAssignee returns the Assignee field.
+func (p *PullRequest) GetAssignee() *User {
 	if p == nil {
 		return nil
 	}
-	return p.Commits
+	return p.Assignee
 }
 
-// GetHTML returns the HTML field.
-func (p *PRLinks) GetHTML() *PRLink {
-	if p == nil {
-		return nil
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
 	}
-	return p.HTML
+	return *p.AuthorAssociation
 }
 
-// GetIssue returns the Issue field.
-func (p *PRLinks) GetIssue() *PRLink {
+// GetBase returns the Base field.
+func (p *PullRequest) GetBase() *PullRequestBranch {
 	if p == nil {
 		return nil
 	}
-	return p.Issue
+	return p.Base
 }
 
-// GetReviewComment returns the ReviewComment field.
-func (p *PRLinks) GetReviewComment() *PRLink {
-	if p == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return p.ReviewComment
+	return *p.Body
 }
 
-// GetReviewComments returns the ReviewComments field.
-func (p *PRLinks) GetReviewComments() *PRLink {
-	if p == nil {
-		return nil
+// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetChangedFiles() int {
+	if p == nil || p.ChangedFiles == nil {
+		return 0
 	}
-	return p.ReviewComments
+	return *p.ChangedFiles
 }
 
-// GetSelf returns the Self field.
-func (p *PRLinks) GetSelf() *PRLink {
-	if p == nil {
-		return nil
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetClosedAt() time.Time {
+	if p == nil || p.ClosedAt == nil {
+		return time.Time{}
 	}
-	return p.Self
+	return *p.ClosedAt
 }
 
-// GetStatuses returns the Statuses field.
-func (p *PRLinks) GetStatuses() *PRLink {
-	if p == nil {
-		return nil
+// GetComments returns the Comments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetComments() int {
+	if p == nil || p.Comments == nil {
+		return 0
 	}
-	return p.Statuses
+	return *p.Comments
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *Project) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommentsURL() string {
+	if p == nil || p.CommentsURL == nil {
 		return ""
 	}
-	return *p.Body
+	return *p.CommentsURL
 }
 
-// GetColumnsURL returns the ColumnsURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetColumnsURL() string {
-	if p == nil || p.ColumnsURL == nil {
+// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommits() int {
+	if p == nil || p.Commits == nil {
+		return 0
+	}
+	return *p.Commits
+}
+
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetCommitsURL() string {
+	if p == nil || p.CommitsURL == nil {
 		return ""
 	}
-	return *p.ColumnsURL
+	return *p.CommitsURL
 }
 
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetCreatedAt() Timestamp {
+func (p *PullRequest) GetCreatedAt() time.Time {
 	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.CreatedAt
 }
 
-// GetCreator returns the Creator field.
-func (p *Project) GetCreator() *User {
+// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDeletions() int {
+	if p == nil || p.Deletions == nil {
+		return 0
+	}
+	return *p.Deletions
+}
+
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
+		return ""
+	}
+	return *p.DiffURL
+}
+
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetDraft() bool {
+	if p == nil || p.Draft == nil {
+		return false
+	}
+	return *p.Draft
+}
+
+// GetHead returns the Head field.
+func (p *PullRequest) GetHead() *PullRequestBranch {
 	if p == nil {
 		return nil
 	}
-	return p.Creator
+	return p.Head
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetHTMLURL() string {
+func (p *PullRequest) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
@@ -6637,359 +11829,399 @@ func (p *Project) GetHTMLURL() string {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *Project) GetID() int64 {
+func (p *PullRequest) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *Project) GetName() string {
-	if p == nil || p.Name == nil {
+// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetIssueURL() string {
+	if p == nil || p.IssueURL == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.IssueURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *Project) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
+// GetLinks returns the Links field.
+func (p *PullRequest) GetLinks() *PRLinks {
+	if p == nil {
+		return nil
 	}
-	return *p.NodeID
+	return p.Links
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *Project) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMaintainerCanModify() bool {
+	if p == nil || p.MaintainerCanModify == nil {
+		return false
 	}
-	return *p.Number
+	return *p.MaintainerCanModify
 }
 
-// GetOwnerURL returns the OwnerURL field if it's non-nil, zero value otherwise.
-func (p *Project) GetOwnerURL() string {
-	if p == nil || p.OwnerURL == nil {
-		return ""
+// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeable() bool {
+	if p == nil || p.Mergeable == nil {
+		return false
 	}
-	return *p.OwnerURL
+	return *p.Mergeable
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *Project) GetState() string {
-	if p == nil || p.State == nil {
+// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeableState() string {
+	if p == nil || p.MergeableState == nil {
 		return ""
 	}
-	return *p.State
-}
-
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *Project) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
-	}
-	return *p.UpdatedAt
+	return *p.MergeableState
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *Project) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergeCommitSHA() string {
+	if p == nil || p.MergeCommitSHA == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.MergeCommitSHA
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetArchived() bool {
-	if p == nil || p.Archived == nil {
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMerged() bool {
+	if p == nil || p.Merged == nil {
 		return false
 	}
-	return *p.Archived
+	return *p.Merged
 }
 
-// GetColumnID returns the ColumnID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnID() int64 {
-	if p == nil || p.ColumnID == nil {
-		return 0
+// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetMergedAt() time.Time {
+	if p == nil || p.MergedAt == nil {
+		return time.Time{}
 	}
-	return *p.ColumnID
+	return *p.MergedAt
 }
 
-// GetColumnName returns the ColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnName() string {
-	if p == nil || p.ColumnName == nil {
-		return ""
+// GetMergedBy returns the MergedBy field.
+func (p *PullRequest) GetMergedBy() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.ColumnName
+	return p.MergedBy
 }
 
-// GetColumnURL returns the ColumnURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetColumnURL() string {
-	if p == nil || p.ColumnURL == nil {
-		return ""
+// GetMilestone returns the Milestone field.
+func (p *PullRequest) GetMilestone() *Milestone {
+	if p == nil {
+		return nil
 	}
-	return *p.ColumnURL
+	return p.Milestone
 }
-
-// GetContentURL returns the ContentURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetContentURL() string {
-	if p == nil || p.ContentURL == nil {
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.ContentURL
+	return *p.NodeID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
 	}
-	return *p.CreatedAt
+	return *p.Number
 }
 
-// GetCreator returns the Creator field.
-func (p *ProjectCard) GetCreator() *User {
-	if p == nil {
-		return nil
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
 	}
-	return p.Creator
+	return *p.PatchURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewComments() int {
+	if p == nil || p.ReviewComments == nil {
 		return 0
 	}
-	return *p.ID
+	return *p.ReviewComments
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentsURL() string {
+	if p == nil || p.ReviewCommentsURL == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.ReviewCommentsURL
 }
 
-// GetNote returns the Note field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetNote() string {
-	if p == nil || p.Note == nil {
+// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetReviewCommentURL() string {
+	if p == nil || p.ReviewCommentURL == nil {
 		return ""
 	}
-	return *p.Note
+	return *p.ReviewCommentURL
 }
 
-// GetPreviousColumnName returns the PreviousColumnName field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetPreviousColumnName() string {
-	if p == nil || p.PreviousColumnName == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetState() string {
+	if p == nil || p.State == nil {
 		return ""
 	}
-	return *p.PreviousColumnName
+	return *p.State
 }
 
-// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectID() int64 {
-	if p == nil || p.ProjectID == nil {
-		return 0
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
+		return ""
 	}
-	return *p.ProjectID
+	return *p.StatusesURL
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *PullRequest) GetTitle() string {
+	if p == nil || p.Title == nil {
 		return ""
 	}
-	return *p.ProjectURL
+	return *p.Title
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetUpdatedAt() Timestamp {
+func (p *PullRequest) GetUpdatedAt() time.Time {
 	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectCard) GetURL() string {
+func (p *PullRequest) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetUser returns the User field.
+func (p *PullRequest) GetUser() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Action
+	return p.User
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectCardEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetLabel() string {
+	if p == nil || p.Label == nil {
+		return ""
 	}
-	return *p.AfterID
+	return *p.Label
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectCardEvent) GetChanges() *ProjectCardChange {
-	if p == nil {
-		return nil
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetRef() string {
+	if p == nil || p.Ref == nil {
+		return ""
 	}
-	return p.Changes
+	return *p.Ref
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectCardEvent) GetInstallation() *Installation {
+// GetRepo returns the Repo field.
+func (p *PullRequestBranch) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.Repo
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectCardEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestBranch) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return p.Org
+	return *p.SHA
 }
 
-// GetProjectCard returns the ProjectCard field.
-func (p *ProjectCardEvent) GetProjectCard() *ProjectCard {
+// GetUser returns the User field.
+func (p *PullRequestBranch) GetUser() *User {
 	if p == nil {
 		return nil
 	}
-	return p.ProjectCard
+	return p.User
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectCardEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetAuthorAssociation() string {
+	if p == nil || p.AuthorAssociation == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.AuthorAssociation
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectCardEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
 	}
-	return p.Sender
+	return *p.Body
 }
 
-// GetArchivedState returns the ArchivedState field if it's non-nil, zero value otherwise.
-func (p *ProjectCardListOptions) GetArchivedState() string {
-	if p == nil || p.ArchivedState == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.ArchivedState
+	return *p.CommitID
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (p *ProjectCardOptions) GetArchived() bool {
-	if p == nil || p.Archived == nil {
-		return false
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *p.Archived
+	return *p.CreatedAt
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectCollaboratorOptions) GetPermission() string {
-	if p == nil || p.Permission == nil {
+// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetDiffHunk() string {
+	if p == nil || p.DiffHunk == nil {
 		return ""
 	}
-	return *p.Permission
+	return *p.DiffHunk
 }
 
-// GetCardsURL returns the CardsURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCardsURL() string {
-	if p == nil || p.CardsURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.CardsURL
-}
-
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
-	}
-	return *p.CreatedAt
+	return *p.HTMLURL
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetID() int64 {
+func (p *PullRequestComment) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetInReplyTo() int64 {
+	if p == nil || p.InReplyTo == nil {
+		return 0
 	}
-	return *p.Name
+	return *p.InReplyTo
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetNodeID() string {
+func (p *PullRequestComment) GetNodeID() string {
 	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *p.NodeID
+}
+
+// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalCommitID() string {
+	if p == nil || p.OriginalCommitID == nil {
+		return ""
+	}
+	return *p.OriginalCommitID
+}
+
+// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetOriginalPosition() int {
+	if p == nil || p.OriginalPosition == nil {
+		return 0
+	}
+	return *p.OriginalPosition
+}
+
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPath() string {
+	if p == nil || p.Path == nil {
+		return ""
+	}
+	return *p.Path
+}
+
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPosition() int {
+	if p == nil || p.Position == nil {
+		return 0
+	}
+	return *p.Position
+}
+
+// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestReviewID() int64 {
+	if p == nil || p.PullRequestReviewID == nil {
+		return 0
+	}
+	return *p.PullRequestReviewID
+}
+
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestComment) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
+		return ""
+	}
+	return *p.PullRequestURL
 }
 
-// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetProjectURL() string {
-	if p == nil || p.ProjectURL == nil {
-		return ""
+// GetReactions returns the Reactions field.
+func (p *PullRequestComment) GetReactions() *Reactions {
+	if p == nil {
+		return nil
 	}
-	return *p.ProjectURL
+	return p.Reactions
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetUpdatedAt() Timestamp {
+func (p *PullRequestComment) GetUpdatedAt() time.Time {
 	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+		return time.Time{}
 	}
 	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *ProjectColumn) GetURL() string {
+func (p *PullRequestComment) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
+// GetUser returns the User field.
+func (p *PullRequestComment) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAction() string {
+func (p *PullRequestEvent) GetAction() string {
 	if p == nil || p.Action == nil {
 		return ""
 	}
 	return *p.Action
 }
 
-// GetAfterID returns the AfterID field if it's non-nil, zero value otherwise.
-func (p *ProjectColumnEvent) GetAfterID() int64 {
-	if p == nil || p.AfterID == nil {
-		return 0
+// GetAssignee returns the Assignee field.
+func (p *PullRequestEvent) GetAssignee() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.AfterID
+	return p.Assignee
 }
 
 // GetChanges returns the Changes field.
-func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
+func (p *PullRequestEvent) GetChanges() *EditChange {
 	if p == nil {
 		return nil
 	}
@@ -6997,647 +12229,775 @@ func (p *ProjectColumnEvent) GetChanges() *ProjectColumnChange {
 }
 
 // GetInstallation returns the Installation field.
-func (p *ProjectColumnEvent) GetInstallation() *Installation {
+func (p *PullRequestEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
 	return p.Installation
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectColumnEvent) GetOrg() *Organization {
+// GetLabel returns the Label field.
+func (p *PullRequestEvent) GetLabel() *Label {
 	if p == nil {
 		return nil
 	}
-	return p.Org
+	return p.Label
 }
 
-// GetProjectColumn returns the ProjectColumn field.
-func (p *ProjectColumnEvent) GetProjectColumn() *ProjectColumn {
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (p *PullRequestEvent) GetNumber() int {
+	if p == nil || p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+// GetOrganization returns the Organization field.
+func (p *PullRequestEvent) GetOrganization() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.ProjectColumn
+	return p.Organization
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestEvent) GetPullRequest() *PullRequest {
+	if p == nil {
+		return nil
+	}
+	return p.PullRequest
 }
 
 // GetRepo returns the Repo field.
-func (p *ProjectColumnEvent) GetRepo() *Repository {
+func (p *PullRequestEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
 	return p.Repo
 }
 
+// GetRequestedReviewer returns the RequestedReviewer field.
+func (p *PullRequestEvent) GetRequestedReviewer() *User {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedReviewer
+}
+
+// GetRequestedTeam returns the RequestedTeam field.
+func (p *PullRequestEvent) GetRequestedTeam() *Team {
+	if p == nil {
+		return nil
+	}
+	return p.RequestedTeam
+}
+
 // GetSender returns the Sender field.
-func (p *ProjectColumnEvent) GetSender() *User {
+func (p *PullRequestEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *ProjectEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetDiffURL() string {
+	if p == nil || p.DiffURL == nil {
 		return ""
 	}
-	return *p.Action
+	return *p.DiffURL
 }
 
-// GetChanges returns the Changes field.
-func (p *ProjectEvent) GetChanges() *ProjectChange {
-	if p == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
+		return ""
 	}
-	return p.Changes
+	return *p.HTMLURL
 }
 
-// GetInstallation returns the Installation field.
-func (p *ProjectEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetPatchURL() string {
+	if p == nil || p.PatchURL == nil {
+		return ""
 	}
-	return p.Installation
+	return *p.PatchURL
 }
 
-// GetOrg returns the Org field.
-func (p *ProjectEvent) GetOrg() *Organization {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PullRequestLinks) GetURL() string {
+	if p == nil || p.URL == nil {
+		return ""
 	}
-	return p.Org
+	return *p.URL
 }
 
-// GetProject returns the Project field.
-func (p *ProjectEvent) GetProject() *Project {
-	if p == nil {
-		return nil
+// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMerged() bool {
+	if p == nil || p.Merged == nil {
+		return false
 	}
-	return p.Project
+	return *p.Merged
 }
 
-// GetRepo returns the Repo field.
-func (p *ProjectEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return p.Repo
+	return *p.Message
 }
 
-// GetSender returns the Sender field.
-func (p *ProjectEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PullRequestMergeResult) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return p.Sender
+	return *p.SHA
 }
 
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetBody() string {
+func (p *PullRequestReview) GetBody() string {
 	if p == nil || p.Body == nil {
 		return ""
 	}
 	return *p.Body
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetName() string {
-	if p == nil || p.Name == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
 		return ""
 	}
-	return *p.Name
+	return *p.CommitID
 }
 
-// GetOrganizationPermission returns the OrganizationPermission field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetOrganizationPermission() string {
-	if p == nil || p.OrganizationPermission == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetHTMLURL() string {
+	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
-	return *p.OrganizationPermission
+	return *p.HTMLURL
 }
 
-// GetPublic returns the Public field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetPublic() bool {
-	if p == nil || p.Public == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
 	}
-	return *p.Public
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetPullRequestURL() string {
+	if p == nil || p.PullRequestURL == nil {
+		return ""
+	}
+	return *p.PullRequestURL
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *ProjectOptions) GetState() string {
+func (p *PullRequestReview) GetState() string {
 	if p == nil || p.State == nil {
 		return ""
 	}
 	return *p.State
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (p *ProjectPermissionLevel) GetPermission() string {
-	if p == nil || p.Permission == nil {
-		return ""
+// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
+func (p *PullRequestReview) GetSubmittedAt() time.Time {
+	if p == nil || p.SubmittedAt == nil {
+		return time.Time{}
 	}
-	return *p.Permission
+	return *p.SubmittedAt
 }
 
 // GetUser returns the User field.
-func (p *ProjectPermissionLevel) GetUser() *User {
+func (p *PullRequestReview) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewCommentEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+// GetChanges returns the Changes field.
+func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
+	if p == nil {
+		return nil
+	}
+	return p.Changes
+}
+
+// GetComment returns the Comment field.
+func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
+	if p == nil {
+		return nil
+	}
+	return p.Comment
+}
+
+// GetInstallation returns the Installation field.
+func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
+	}
+	return p.Installation
+}
+
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
 	if p == nil {
 		return nil
 	}
-	return p.User
+	return p.PullRequest
 }
 
-// GetEnforceAdmins returns the EnforceAdmins field.
-func (p *Protection) GetEnforceAdmins() *AdminEnforcement {
+// GetRepo returns the Repo field.
+func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.EnforceAdmins
+	return p.Repo
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *Protection) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcement {
+// GetSender returns the Sender field.
+func (p *PullRequestReviewCommentEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredPullRequestReviews
+	return p.Sender
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *Protection) GetRequiredStatusChecks() *RequiredStatusChecks {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewDismissalRequest) GetMessage() string {
+	if p == nil || p.Message == nil {
+		return ""
 	}
-	return p.RequiredStatusChecks
+	return *p.Message
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *Protection) GetRestrictions() *BranchRestrictions {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewEvent) GetAction() string {
+	if p == nil || p.Action == nil {
+		return ""
 	}
-	return p.Restrictions
+	return *p.Action
 }
 
-// GetRequiredPullRequestReviews returns the RequiredPullRequestReviews field.
-func (p *ProtectionRequest) GetRequiredPullRequestReviews() *PullRequestReviewsEnforcementRequest {
+// GetInstallation returns the Installation field.
+func (p *PullRequestReviewEvent) GetInstallation() *Installation {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredPullRequestReviews
+	return p.Installation
 }
 
-// GetRequiredStatusChecks returns the RequiredStatusChecks field.
-func (p *ProtectionRequest) GetRequiredStatusChecks() *RequiredStatusChecks {
+// GetOrganization returns the Organization field.
+func (p *PullRequestReviewEvent) GetOrganization() *Organization {
 	if p == nil {
 		return nil
 	}
-	return p.RequiredStatusChecks
+	return p.Organization
 }
 
-// GetRestrictions returns the Restrictions field.
-func (p *ProtectionRequest) GetRestrictions() *BranchRestrictionsRequest {
+// GetPullRequest returns the PullRequest field.
+func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
 	if p == nil {
 		return nil
 	}
-	return p.Restrictions
+	return p.PullRequest
 }
 
-// GetInstallation returns the Installation field.
-func (p *PublicEvent) GetInstallation() *Installation {
+// GetRepo returns the Repo field.
+func (p *PullRequestReviewEvent) GetRepo() *Repository {
 	if p == nil {
 		return nil
 	}
-	return p.Installation
+	return p.Repo
 }
 
-// GetRepo returns the Repo field.
-func (p *PublicEvent) GetRepo() *Repository {
+// GetReview returns the Review field.
+func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
 	if p == nil {
 		return nil
 	}
-	return p.Repo
+	return p.Review
 }
 
 // GetSender returns the Sender field.
-func (p *PublicEvent) GetSender() *User {
+func (p *PullRequestReviewEvent) GetSender() *User {
 	if p == nil {
 		return nil
 	}
 	return p.Sender
 }
 
-// GetActiveLockReason returns the ActiveLockReason field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetActiveLockReason() string {
-	if p == nil || p.ActiveLockReason == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetBody() string {
+	if p == nil || p.Body == nil {
 		return ""
 	}
-	return *p.ActiveLockReason
+	return *p.Body
 }
 
-// GetAdditions returns the Additions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAdditions() int {
-	if p == nil || p.Additions == nil {
-		return 0
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetCommitID() string {
+	if p == nil || p.CommitID == nil {
+		return ""
 	}
-	return *p.Additions
+	return *p.CommitID
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequest) GetAssignee() *User {
-	if p == nil {
-		return nil
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetEvent() string {
+	if p == nil || p.Event == nil {
+		return ""
 	}
-	return p.Assignee
+	return *p.Event
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewRequest) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
 		return ""
 	}
-	return *p.AuthorAssociation
+	return *p.NodeID
 }
 
-// GetBase returns the Base field.
-func (p *PullRequest) GetBase() *PullRequestBranch {
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
 	if p == nil {
 		return nil
 	}
-	return p.Base
+	return p.DismissalRestrictionsRequest
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
+	if p == nil {
+		return nil
 	}
-	return *p.Body
+	return p.DismissalRestrictionsRequest
 }
 
-// GetChangedFiles returns the ChangedFiles field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetChangedFiles() int {
-	if p == nil || p.ChangedFiles == nil {
+// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
+func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
+	if p == nil || p.DismissStaleReviews == nil {
+		return false
+	}
+	return *p.DismissStaleReviews
+}
+
+// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergablePulls() int {
+	if p == nil || p.MergablePulls == nil {
 		return 0
 	}
-	return *p.ChangedFiles
+	return *p.MergablePulls
 }
 
-// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetClosedAt() time.Time {
-	if p == nil || p.ClosedAt == nil {
-		return time.Time{}
+// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetMergedPulls() int {
+	if p == nil || p.MergedPulls == nil {
+		return 0
 	}
-	return *p.ClosedAt
+	return *p.MergedPulls
 }
 
-// GetComments returns the Comments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetComments() int {
-	if p == nil || p.Comments == nil {
+// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetTotalPulls() int {
+	if p == nil || p.TotalPulls == nil {
 		return 0
 	}
-	return *p.Comments
+	return *p.TotalPulls
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommentsURL() string {
-	if p == nil || p.CommentsURL == nil {
-		return ""
+// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
+func (p *PullStats) GetUnmergablePulls() int {
+	if p == nil || p.UnmergablePulls == nil {
+		return 0
 	}
-	return *p.CommentsURL
+	return *p.UnmergablePulls
 }
 
 // GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommits() int {
+func (p *PunchCard) GetCommits() int {
 	if p == nil || p.Commits == nil {
 		return 0
 	}
 	return *p.Commits
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCommitsURL() string {
-	if p == nil || p.CommitsURL == nil {
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetDay() int {
+	if p == nil || p.Day == nil {
+		return 0
+	}
+	return *p.Day
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (p *PunchCard) GetHour() int {
+	if p == nil || p.Hour == nil {
+		return 0
+	}
+	return *p.Hour
+}
+
+// GetAfter returns the After field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetAfter() string {
+	if p == nil || p.After == nil {
 		return ""
 	}
-	return *p.CommitsURL
+	return *p.After
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBaseRef() string {
+	if p == nil || p.BaseRef == nil {
+		return ""
 	}
-	return *p.CreatedAt
+	return *p.BaseRef
 }
 
-// GetDeletions returns the Deletions field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDeletions() int {
-	if p == nil || p.Deletions == nil {
-		return 0
+// GetBefore returns the Before field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetBefore() string {
+	if p == nil || p.Before == nil {
+		return ""
 	}
-	return *p.Deletions
+	return *p.Before
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
+// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCompare() string {
+	if p == nil || p.Compare == nil {
 		return ""
 	}
-	return *p.DiffURL
+	return *p.Compare
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetDraft() bool {
-	if p == nil || p.Draft == nil {
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetCreated() bool {
+	if p == nil || p.Created == nil {
 		return false
 	}
-	return *p.Draft
+	return *p.Created
 }
 
-// GetHead returns the Head field.
-func (p *PullRequest) GetHead() *PullRequestBranch {
-	if p == nil {
-		return nil
+// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDeleted() bool {
+	if p == nil || p.Deleted == nil {
+		return false
 	}
-	return p.Head
+	return *p.Deleted
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetDistinctSize() int {
+	if p == nil || p.DistinctSize == nil {
+		return 0
 	}
-	return *p.HTMLURL
+	return *p.DistinctSize
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetForced returns the Forced field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetForced() bool {
+	if p == nil || p.Forced == nil {
+		return false
 	}
-	return *p.ID
+	return *p.Forced
 }
 
-// GetIssueURL returns the IssueURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetIssueURL() string {
-	if p == nil || p.IssueURL == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetHead() string {
+	if p == nil || p.Head == nil {
 		return ""
 	}
-	return *p.IssueURL
+	return *p.Head
 }
 
-// GetLinks returns the Links field.
-func (p *PullRequest) GetLinks() *PRLinks {
+// GetHeadCommit returns the HeadCommit field.
+func (p *PushEvent) GetHeadCommit() *PushEventCommit {
 	if p == nil {
 		return nil
 	}
-	return p.Links
+	return p.HeadCommit
 }
 
-// GetMaintainerCanModify returns the MaintainerCanModify field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMaintainerCanModify() bool {
-	if p == nil || p.MaintainerCanModify == nil {
-		return false
+// GetInstallation returns the Installation field.
+func (p *PushEvent) GetInstallation() *Installation {
+	if p == nil {
+		return nil
 	}
-	return *p.MaintainerCanModify
+	return p.Installation
 }
 
-// GetMergeable returns the Mergeable field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeable() bool {
-	if p == nil || p.Mergeable == nil {
-		return false
+// GetPusher returns the Pusher field.
+func (p *PushEvent) GetPusher() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.Mergeable
+	return p.Pusher
 }
 
-// GetMergeableState returns the MergeableState field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeableState() string {
-	if p == nil || p.MergeableState == nil {
-		return ""
+// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetPushID() int64 {
+	if p == nil || p.PushID == nil {
+		return 0
 	}
-	return *p.MergeableState
+	return *p.PushID
 }
 
-// GetMergeCommitSHA returns the MergeCommitSHA field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergeCommitSHA() string {
-	if p == nil || p.MergeCommitSHA == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetRef() string {
+	if p == nil || p.Ref == nil {
 		return ""
 	}
-	return *p.MergeCommitSHA
+	return *p.Ref
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetRepo returns the Repo field.
+func (p *PushEvent) GetRepo() *PushEventRepository {
+	if p == nil {
+		return nil
 	}
-	return *p.Merged
+	return p.Repo
 }
 
-// GetMergedAt returns the MergedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetMergedAt() time.Time {
-	if p == nil || p.MergedAt == nil {
-		return time.Time{}
+// GetSender returns the Sender field.
+func (p *PushEvent) GetSender() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.MergedAt
+	return p.Sender
 }
 
-// GetMergedBy returns the MergedBy field.
-func (p *PullRequest) GetMergedBy() *User {
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEvent) GetSize() int {
+	if p == nil || p.Size == nil {
+		return 0
+	}
+	return *p.Size
+}
+
+// GetAuthor returns the Author field.
+func (p *PushEventCommit) GetAuthor() *CommitAuthor {
 	if p == nil {
 		return nil
 	}
-	return p.MergedBy
+	return p.Author
 }
 
-// GetMilestone returns the Milestone field.
-func (p *PullRequest) GetMilestone() *Milestone {
+// GetCommitter returns the Committer field.
+func (p *PushEventCommit) GetCommitter() *CommitAuthor {
 	if p == nil {
 		return nil
 	}
-	return p.Milestone
+	return p.Committer
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
+// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetDistinct() bool {
+	if p == nil || p.Distinct == nil {
+		return false
 	}
-	return *p.NodeID
+	return *p.Distinct
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetNumber() int {
-	if p == nil || p.Number == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
 	}
-	return *p.Number
+	return *p.ID
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *p.Message
 }
 
-// GetReviewComments returns the ReviewComments field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewComments() int {
-	if p == nil || p.ReviewComments == nil {
-		return 0
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetSHA() string {
+	if p == nil || p.SHA == nil {
+		return ""
 	}
-	return *p.ReviewComments
+	return *p.SHA
 }
 
-// GetReviewCommentsURL returns the ReviewCommentsURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentsURL() string {
-	if p == nil || p.ReviewCommentsURL == nil {
-		return ""
+// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetTimestamp() Timestamp {
+	if p == nil || p.Timestamp == nil {
+		return Timestamp{}
 	}
-	return *p.ReviewCommentsURL
+	return *p.Timestamp
 }
 
-// GetReviewCommentURL returns the ReviewCommentURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetReviewCommentURL() string {
-	if p == nil || p.ReviewCommentURL == nil {
+// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetTreeID() string {
+	if p == nil || p.TreeID == nil {
 		return ""
 	}
-	return *p.ReviewCommentURL
+	return *p.TreeID
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetState() string {
-	if p == nil || p.State == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (p *PushEventCommit) GetURL() string {
+	if p == nil || p.URL == nil {
 		return ""
 	}
-	return *p.State
+	return *p.URL
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (p *PushEventRepoOwner) GetEmail() string {
+	if p == nil || p.Email == nil {
 		return ""
 	}
-	return *p.StatusesURL
+	return *p.Email
 }
 
-// GetTitle returns the Title field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetTitle() string {
-	if p == nil || p.Title == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PushEventRepoOwner) GetName() string {
+	if p == nil || p.Name == nil {
 		return ""
 	}
-	return *p.Title
+	return *p.Name
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetUpdatedAt() time.Time {
-	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetArchiveURL() string {
+	if p == nil || p.ArchiveURL == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *p.ArchiveURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequest) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCloneURL() string {
+	if p == nil || p.CloneURL == nil {
 		return ""
 	}
-	return *p.URL
+	return *p.CloneURL
 }
 
-// GetUser returns the User field.
-func (p *PullRequest) GetUser() *User {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.User
+	return *p.CreatedAt
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetLabel() string {
-	if p == nil || p.Label == nil {
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDefaultBranch() string {
+	if p == nil || p.DefaultBranch == nil {
 		return ""
 	}
-	return *p.Label
+	return *p.DefaultBranch
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetRef() string {
-	if p == nil || p.Ref == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetDescription() string {
+	if p == nil || p.Description == nil {
 		return ""
 	}
-	return *p.Ref
+	return *p.Description
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestBranch) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFork() bool {
+	if p == nil || p.Fork == nil {
+		return false
 	}
-	return p.Repo
+	return *p.Fork
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestBranch) GetSHA() string {
-	if p == nil || p.SHA == nil {
-		return ""
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetForksCount() int {
+	if p == nil || p.ForksCount == nil {
+		return 0
 	}
-	return *p.SHA
+	return *p.ForksCount
 }
 
-// GetUser returns the User field.
-func (p *PullRequestBranch) GetUser() *User {
-	if p == nil {
-		return nil
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetFullName() string {
+	if p == nil || p.FullName == nil {
+		return ""
 	}
-	return p.User
+	return *p.FullName
 }
 
-// GetAuthorAssociation returns the AuthorAssociation field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetAuthorAssociation() string {
-	if p == nil || p.AuthorAssociation == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetGitURL() string {
+	if p == nil || p.GitURL == nil {
 		return ""
 	}
-	return *p.AuthorAssociation
+	return *p.GitURL
+}
+
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasDownloads() bool {
+	if p == nil || p.HasDownloads == nil {
+		return false
+	}
+	return *p.HasDownloads
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetBody() string {
-	if p == nil || p.Body == nil {
-		return ""
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasIssues() bool {
+	if p == nil || p.HasIssues == nil {
+		return false
 	}
-	return *p.Body
+	return *p.HasIssues
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
-		return ""
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasPages() bool {
+	if p == nil || p.HasPages == nil {
+		return false
 	}
-	return *p.CommitID
+	return *p.HasPages
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetCreatedAt() time.Time {
-	if p == nil || p.CreatedAt == nil {
-		return time.Time{}
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHasWiki() bool {
+	if p == nil || p.HasWiki == nil {
+		return false
 	}
-	return *p.CreatedAt
+	return *p.HasWiki
 }
 
-// GetDiffHunk returns the DiffHunk field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetDiffHunk() string {
-	if p == nil || p.DiffHunk == nil {
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetHomepage() string {
+	if p == nil || p.Homepage == nil {
 		return ""
 	}
-	return *p.DiffHunk
+	return *p.Homepage
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetHTMLURL() string {
+func (p *PushEventRepository) GetHTMLURL() string {
 	if p == nil || p.HTMLURL == nil {
 		return ""
 	}
@@ -7645,2079 +13005,2143 @@ func (p *PullRequestComment) GetHTMLURL() string {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetID() int64 {
+func (p *PushEventRepository) GetID() int64 {
 	if p == nil || p.ID == nil {
 		return 0
 	}
 	return *p.ID
 }
 
-// GetInReplyTo returns the InReplyTo field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetInReplyTo() int64 {
-	if p == nil || p.InReplyTo == nil {
-		return 0
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetLanguage() string {
+	if p == nil || p.Language == nil {
+		return ""
 	}
-	return *p.InReplyTo
+	return *p.Language
+}
+
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetMasterBranch() string {
+	if p == nil || p.MasterBranch == nil {
+		return ""
+	}
+	return *p.MasterBranch
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
 }
 
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetNodeID() string {
+func (p *PushEventRepository) GetNodeID() string {
 	if p == nil || p.NodeID == nil {
 		return ""
 	}
 	return *p.NodeID
 }
 
-// GetOriginalCommitID returns the OriginalCommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalCommitID() string {
-	if p == nil || p.OriginalCommitID == nil {
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOpenIssuesCount() int {
+	if p == nil || p.OpenIssuesCount == nil {
+		return 0
+	}
+	return *p.OpenIssuesCount
+}
+
+// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetOrganization() string {
+	if p == nil || p.Organization == nil {
 		return ""
 	}
-	return *p.OriginalCommitID
+	return *p.Organization
 }
 
-// GetOriginalPosition returns the OriginalPosition field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetOriginalPosition() int {
-	if p == nil || p.OriginalPosition == nil {
-		return 0
+// GetOwner returns the Owner field.
+func (p *PushEventRepository) GetOwner() *User {
+	if p == nil {
+		return nil
 	}
-	return *p.OriginalPosition
+	return p.Owner
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPath() string {
-	if p == nil || p.Path == nil {
-		return ""
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPrivate() bool {
+	if p == nil || p.Private == nil {
+		return false
 	}
-	return *p.Path
+	return *p.Private
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPosition() int {
-	if p == nil || p.Position == nil {
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetPushedAt() Timestamp {
+	if p == nil || p.PushedAt == nil {
+		return Timestamp{}
+	}
+	return *p.PushedAt
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSize() int {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *p.Position
+	return *p.Size
 }
 
-// GetPullRequestReviewID returns the PullRequestReviewID field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestReviewID() int64 {
-	if p == nil || p.PullRequestReviewID == nil {
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSSHURL() string {
+	if p == nil || p.SSHURL == nil {
+		return ""
+	}
+	return *p.SSHURL
+}
+
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStargazersCount() int {
+	if p == nil || p.StargazersCount == nil {
 		return 0
 	}
-	return *p.PullRequestReviewID
+	return *p.StargazersCount
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetStatusesURL() string {
+	if p == nil || p.StatusesURL == nil {
 		return ""
 	}
-	return *p.PullRequestURL
+	return *p.StatusesURL
 }
 
-// GetReactions returns the Reactions field.
-func (p *PullRequestComment) GetReactions() *Reactions {
-	if p == nil {
-		return nil
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetSVNURL() string {
+	if p == nil || p.SVNURL == nil {
+		return ""
 	}
-	return p.Reactions
+	return *p.SVNURL
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetUpdatedAt() time.Time {
+func (p *PushEventRepository) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *p.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestComment) GetURL() string {
+func (p *PushEventRepository) GetURL() string {
 	if p == nil || p.URL == nil {
 		return ""
 	}
 	return *p.URL
 }
 
-// GetUser returns the User field.
-func (p *PullRequestComment) GetUser() *User {
-	if p == nil {
-		return nil
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (p *PushEventRepository) GetWatchersCount() int {
+	if p == nil || p.WatchersCount == nil {
+		return 0
 	}
-	return p.User
+	return *p.WatchersCount
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetCore returns the Core field.
+func (r *RateLimits) GetCore() *Rate {
+	if r == nil {
+		return nil
 	}
-	return *p.Action
+	return r.Core
 }
 
-// GetAssignee returns the Assignee field.
-func (p *PullRequestEvent) GetAssignee() *User {
-	if p == nil {
+// GetGraphQL returns the GraphQL field.
+func (r *RateLimits) GetGraphQL() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Assignee
+	return r.GraphQL
 }
 
-// GetChanges returns the Changes field.
-func (p *PullRequestEvent) GetChanges() *EditChange {
-	if p == nil {
+// GetSearch returns the Search field.
+func (r *RateLimits) GetSearch() *Rate {
+	if r == nil {
 		return nil
 	}
-	return p.Changes
+	return r.Search
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestEvent) GetInstallation() *Installation {
-	if p == nil {
-		return nil
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetContent() string {
+	if r == nil || r.Content == nil {
+		return ""
 	}
-	return p.Installation
+	return *r.Content
 }
 
-// GetLabel returns the Label field.
-func (p *PullRequestEvent) GetLabel() *Label {
-	if p == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reaction) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
+	}
+	return *r.NodeID
+}
+
+// GetUser returns the User field.
+func (r *Reaction) GetUser() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Label
+	return r.User
 }
 
-// GetNumber returns the Number field if it's non-nil, zero value otherwise.
-func (p *PullRequestEvent) GetNumber() int {
-	if p == nil || p.Number == nil {
+// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetConfused() int {
+	if r == nil || r.Confused == nil {
 		return 0
 	}
-	return *p.Number
+	return *r.Confused
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestEvent) GetOrganization() *Organization {
-	if p == nil {
-		return nil
+// GetEyes returns the Eyes field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetEyes() int {
+	if r == nil || r.Eyes == nil {
+		return 0
 	}
-	return p.Organization
+	return *r.Eyes
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHeart() int {
+	if r == nil || r.Heart == nil {
+		return 0
 	}
-	return p.PullRequest
+	return *r.Heart
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetHooray() int {
+	if r == nil || r.Hooray == nil {
+		return 0
 	}
-	return p.Repo
+	return *r.Hooray
 }
 
-// GetRequestedReviewer returns the RequestedReviewer field.
-func (p *PullRequestEvent) GetRequestedReviewer() *User {
-	if p == nil {
-		return nil
+// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetLaugh() int {
+	if r == nil || r.Laugh == nil {
+		return 0
 	}
-	return p.RequestedReviewer
+	return *r.Laugh
 }
 
-// GetRequestedTeam returns the RequestedTeam field.
-func (p *PullRequestEvent) GetRequestedTeam() *Team {
-	if p == nil {
-		return nil
+// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetMinusOne() int {
+	if r == nil || r.MinusOne == nil {
+		return 0
 	}
-	return p.RequestedTeam
+	return *r.MinusOne
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetPlusOne() int {
+	if r == nil || r.PlusOne == nil {
+		return 0
 	}
-	return p.Sender
+	return *r.PlusOne
 }
 
-// GetDiffURL returns the DiffURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetDiffURL() string {
-	if p == nil || p.DiffURL == nil {
-		return ""
+// GetRocket returns the Rocket field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetRocket() int {
+	if r == nil || r.Rocket == nil {
+		return 0
 	}
-	return *p.DiffURL
+	return *r.Rocket
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
 	}
-	return *p.HTMLURL
+	return *r.TotalCount
 }
 
-// GetPatchURL returns the PatchURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetPatchURL() string {
-	if p == nil || p.PatchURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Reactions) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.PatchURL
+	return *r.URL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PullRequestLinks) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *Reference) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.NodeID
 }
 
-// GetMerged returns the Merged field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMerged() bool {
-	if p == nil || p.Merged == nil {
-		return false
+// GetObject returns the Object field.
+func (r *Reference) GetObject() *GitObject {
+	if r == nil {
+		return nil
 	}
-	return *p.Merged
+	return r.Object
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetRef returns the Ref field if it's non-nil, zero value otherwise.
+func (r *Reference) GetRef() string {
+	if r == nil || r.Ref == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.Ref
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PullRequestMergeResult) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Reference) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.SHA
+	return *r.URL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetBrowserDownloadURL() string {
+	if r == nil || r.BrowserDownloadURL == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.BrowserDownloadURL
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetContentType() string {
+	if r == nil || r.ContentType == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.ContentType
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.HTMLURL
+	return *r.CreatedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetID() int64 {
-	if p == nil || p.ID == nil {
+// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetDownloadCount() int {
+	if r == nil || r.DownloadCount == nil {
 		return 0
 	}
-	return *p.ID
+	return *r.DownloadCount
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *p.NodeID
+	return *r.ID
 }
 
-// GetPullRequestURL returns the PullRequestURL field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetPullRequestURL() string {
-	if p == nil || p.PullRequestURL == nil {
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetLabel() string {
+	if r == nil || r.Label == nil {
 		return ""
 	}
-	return *p.PullRequestURL
+	return *r.Label
 }
 
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetState() string {
-	if p == nil || p.State == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.State
+	return *r.Name
 }
 
-// GetSubmittedAt returns the SubmittedAt field if it's non-nil, zero value otherwise.
-func (p *PullRequestReview) GetSubmittedAt() time.Time {
-	if p == nil || p.SubmittedAt == nil {
-		return time.Time{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *p.SubmittedAt
+	return *r.NodeID
 }
 
-// GetUser returns the User field.
-func (p *PullRequestReview) GetUser() *User {
-	if p == nil {
-		return nil
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return p.User
+	return *r.Size
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewCommentEvent) GetAction() string {
-	if p == nil || p.Action == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.Action
-}
-
-// GetChanges returns the Changes field.
-func (p *PullRequestReviewCommentEvent) GetChanges() *EditChange {
-	if p == nil {
-		return nil
-	}
-	return p.Changes
+	return *r.State
 }
 
-// GetComment returns the Comment field.
-func (p *PullRequestReviewCommentEvent) GetComment() *PullRequestComment {
-	if p == nil {
-		return nil
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Comment
+	return *r.UpdatedAt
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestReviewCommentEvent) GetInstallation() *Installation {
-	if p == nil {
+// GetUploader returns the Uploader field.
+func (r *ReleaseAsset) GetUploader() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Uploader
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewCommentEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *ReleaseAsset) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.URL
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestReviewCommentEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *ReleaseEvent) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
 	}
-	return p.Repo
+	return *r.Action
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestReviewCommentEvent) GetSender() *User {
-	if p == nil {
+// GetInstallation returns the Installation field.
+func (r *ReleaseEvent) GetInstallation() *Installation {
+	if r == nil {
 		return nil
 	}
-	return p.Sender
-}
-
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewDismissalRequest) GetMessage() string {
-	if p == nil || p.Message == nil {
-		return ""
-	}
-	return *p.Message
+	return r.Installation
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewEvent) GetAction() string {
-	if p == nil || p.Action == nil {
-		return ""
+// GetRelease returns the Release field.
+func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
+	if r == nil {
+		return nil
 	}
-	return *p.Action
+	return r.Release
 }
 
-// GetInstallation returns the Installation field.
-func (p *PullRequestReviewEvent) GetInstallation() *Installation {
-	if p == nil {
+// GetRepo returns the Repo field.
+func (r *ReleaseEvent) GetRepo() *Repository {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Repo
 }
 
-// GetOrganization returns the Organization field.
-func (p *PullRequestReviewEvent) GetOrganization() *Organization {
-	if p == nil {
+// GetSender returns the Sender field.
+func (r *ReleaseEvent) GetSender() *User {
+	if r == nil {
 		return nil
 	}
-	return p.Organization
+	return r.Sender
 }
 
-// GetPullRequest returns the PullRequest field.
-func (p *PullRequestReviewEvent) GetPullRequest() *PullRequest {
-	if p == nil {
-		return nil
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (r *Rename) GetFrom() string {
+	if r == nil || r.From == nil {
+		return ""
 	}
-	return p.PullRequest
+	return *r.From
 }
 
-// GetRepo returns the Repo field.
-func (p *PullRequestReviewEvent) GetRepo() *Repository {
-	if p == nil {
-		return nil
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (r *Rename) GetTo() string {
+	if r == nil || r.To == nil {
+		return ""
 	}
-	return p.Repo
+	return *r.To
 }
 
-// GetReview returns the Review field.
-func (p *PullRequestReviewEvent) GetReview() *PullRequestReview {
-	if p == nil {
-		return nil
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RenameResponse) GetMessage() string {
+	if r == nil || r.Message == nil {
+		return ""
 	}
-	return p.Review
+	return *r.Message
 }
 
-// GetSender returns the Sender field.
-func (p *PullRequestReviewEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RenameResponse) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return p.Sender
+	return *r.URL
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetBody() string {
-	if p == nil || p.Body == nil {
+// GetLogin returns the Login field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetLogin() string {
+	if r == nil || r.Login == nil {
 		return ""
 	}
-	return *p.Body
+	return *r.Login
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetCommitID() string {
-	if p == nil || p.CommitID == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCredit) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *p.CommitID
+	return *r.Type
 }
 
-// GetEvent returns the Event field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetEvent() string {
-	if p == nil || p.Event == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.Event
+	return *r.State
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewRequest) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepoAdvisoryCreditDetailed) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.Type
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementRequest) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
+// GetUser returns the User field.
+func (r *RepoAdvisoryCreditDetailed) GetUser() *User {
+	if r == nil {
 		return nil
 	}
-	return p.DismissalRestrictionsRequest
+	return r.User
 }
 
-// GetDismissalRestrictionsRequest returns the DismissalRestrictionsRequest field.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissalRestrictionsRequest() *DismissalRestrictionsRequest {
-	if p == nil {
-		return nil
+// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetAllowMergeCommit() bool {
+	if r == nil || r.AllowMergeCommit == nil {
+		return false
 	}
-	return p.DismissalRestrictionsRequest
+	return *r.AllowMergeCommit
 }
 
-// GetDismissStaleReviews returns the DismissStaleReviews field if it's non-nil, zero value otherwise.
-func (p *PullRequestReviewsEnforcementUpdate) GetDismissStaleReviews() bool {
-	if p == nil || p.DismissStaleReviews == nil {
+// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetAllowRebaseMerge() bool {
+	if r == nil || r.AllowRebaseMerge == nil {
 		return false
 	}
-	return *p.DismissStaleReviews
+	return *r.AllowRebaseMerge
 }
 
-// GetMergablePulls returns the MergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergablePulls() int {
-	if p == nil || p.MergablePulls == nil {
-		return 0
+// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetAllowSquashMerge() bool {
+	if r == nil || r.AllowSquashMerge == nil {
+		return false
 	}
-	return *p.MergablePulls
+	return *r.AllowSquashMerge
 }
 
-// GetMergedPulls returns the MergedPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetMergedPulls() int {
-	if p == nil || p.MergedPulls == nil {
-		return 0
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetDefaultBranch() string {
+	if r == nil || r.DefaultBranch == nil {
+		return ""
 	}
-	return *p.MergedPulls
+	return *r.DefaultBranch
 }
 
-// GetTotalPulls returns the TotalPulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetTotalPulls() int {
-	if p == nil || p.TotalPulls == nil {
-		return 0
+// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetDeleteBranchOnMerge() bool {
+	if r == nil || r.DeleteBranchOnMerge == nil {
+		return false
 	}
-	return *p.TotalPulls
+	return *r.DeleteBranchOnMerge
 }
 
-// GetUnmergablePulls returns the UnmergablePulls field if it's non-nil, zero value otherwise.
-func (p *PullStats) GetUnmergablePulls() int {
-	if p == nil || p.UnmergablePulls == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return *p.UnmergablePulls
+	return *r.Description
 }
 
-// GetCommits returns the Commits field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetCommits() int {
-	if p == nil || p.Commits == nil {
-		return 0
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetHasIssues() bool {
+	if r == nil || r.HasIssues == nil {
+		return false
 	}
-	return *p.Commits
+	return *r.HasIssues
 }
 
-// GetDay returns the Day field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetDay() int {
-	if p == nil || p.Day == nil {
-		return 0
+// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetHasProjects() bool {
+	if r == nil || r.HasProjects == nil {
+		return false
 	}
-	return *p.Day
+	return *r.HasProjects
 }
 
-// GetHour returns the Hour field if it's non-nil, zero value otherwise.
-func (p *PunchCard) GetHour() int {
-	if p == nil || p.Hour == nil {
-		return 0
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetHasWiki() bool {
+	if r == nil || r.HasWiki == nil {
+		return false
 	}
-	return *p.Hour
+	return *r.HasWiki
 }
 
-// GetAfter returns the After field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetAfter() string {
-	if p == nil || p.After == nil {
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetHomepage() string {
+	if r == nil || r.Homepage == nil {
 		return ""
 	}
-	return *p.After
+	return *r.Homepage
 }
 
-// GetBaseRef returns the BaseRef field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBaseRef() string {
-	if p == nil || p.BaseRef == nil {
-		return ""
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (r *RepoConfig) GetPrivate() bool {
+	if r == nil || r.Private == nil {
+		return false
 	}
-	return *p.BaseRef
+	return *r.Private
 }
 
-// GetBefore returns the Before field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetBefore() string {
-	if p == nil || p.Before == nil {
+// GetCopyrightText returns the CopyrightText field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetCopyrightText() string {
+	if r == nil || r.CopyrightText == nil {
 		return ""
 	}
-	return *p.Before
+	return *r.CopyrightText
 }
 
-// GetCompare returns the Compare field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCompare() string {
-	if p == nil || p.Compare == nil {
+// GetDownloadLocation returns the DownloadLocation field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetDownloadLocation() string {
+	if r == nil || r.DownloadLocation == nil {
 		return ""
 	}
-	return *p.Compare
+	return *r.DownloadLocation
 }
 
-// GetCreated returns the Created field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetCreated() bool {
-	if p == nil || p.Created == nil {
+// GetFilesAnalyzed returns the FilesAnalyzed field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetFilesAnalyzed() bool {
+	if r == nil || r.FilesAnalyzed == nil {
 		return false
 	}
-	return *p.Created
+	return *r.FilesAnalyzed
 }
 
-// GetDeleted returns the Deleted field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDeleted() bool {
-	if p == nil || p.Deleted == nil {
-		return false
+// GetLicenseConcluded returns the LicenseConcluded field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseConcluded() string {
+	if r == nil || r.LicenseConcluded == nil {
+		return ""
 	}
-	return *p.Deleted
+	return *r.LicenseConcluded
 }
 
-// GetDistinctSize returns the DistinctSize field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetDistinctSize() int {
-	if p == nil || p.DistinctSize == nil {
-		return 0
+// GetLicenseDeclared returns the LicenseDeclared field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetLicenseDeclared() string {
+	if r == nil || r.LicenseDeclared == nil {
+		return ""
 	}
-	return *p.DistinctSize
+	return *r.LicenseDeclared
 }
 
-// GetForced returns the Forced field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetForced() bool {
-	if p == nil || p.Forced == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *p.Forced
+	return *r.Name
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetHead() string {
-	if p == nil || p.Head == nil {
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetSPDXID() string {
+	if r == nil || r.SPDXID == nil {
 		return ""
 	}
-	return *p.Head
+	return *r.SPDXID
 }
 
-// GetHeadCommit returns the HeadCommit field.
-func (p *PushEvent) GetHeadCommit() *PushEventCommit {
-	if p == nil {
-		return nil
+// GetVersionInfo returns the VersionInfo field if it's non-nil, zero value otherwise.
+func (r *RepoDependencies) GetVersionInfo() string {
+	if r == nil || r.VersionInfo == nil {
+		return ""
 	}
-	return p.HeadCommit
+	return *r.VersionInfo
 }
 
-// GetInstallation returns the Installation field.
-func (p *PushEvent) GetInstallation() *Installation {
-	if p == nil {
+// GetAuthor returns the Author field.
+func (r *RepoDiscussionComment) GetAuthor() *DiscussionAuthor {
+	if r == nil {
 		return nil
 	}
-	return p.Installation
+	return r.Author
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetBody() string {
+	if r == nil || r.Body == nil {
+		return ""
+	}
+	return *r.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *r.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetID() string {
+	if r == nil || r.ID == nil {
+		return ""
+	}
+	return *r.ID
 }
 
-// GetPusher returns the Pusher field.
-func (p *PushEvent) GetPusher() *User {
-	if p == nil {
-		return nil
+// GetIsAnswer returns the IsAnswer field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetIsAnswer() bool {
+	if r == nil || r.IsAnswer == nil {
+		return false
 	}
-	return p.Pusher
+	return *r.IsAnswer
 }
 
-// GetPushID returns the PushID field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetPushID() int64 {
-	if p == nil || p.PushID == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.PushID
+	return *r.UpdatedAt
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetRef() string {
-	if p == nil || p.Ref == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionComment) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.Ref
+	return *r.URL
 }
 
-// GetRepo returns the Repo field.
-func (p *PushEvent) GetRepo() *PushEventRepository {
-	if p == nil {
+// GetPageInfo returns the PageInfo field.
+func (r *RepoDiscussionCommentConnection) GetPageInfo() *PageInfo {
+	if r == nil {
 		return nil
 	}
-	return p.Repo
+	return r.PageInfo
 }
 
-// GetSender returns the Sender field.
-func (p *PushEvent) GetSender() *User {
-	if p == nil {
-		return nil
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *RepoDiscussionCommentConnection) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
 	}
-	return p.Sender
+	return *r.TotalCount
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEvent) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetBadgeURL returns the BadgeURL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetBadgeURL() string {
+	if r == nil || r.BadgeURL == nil {
+		return ""
 	}
-	return *p.Size
+	return *r.BadgeURL
 }
 
-// GetAuthor returns the Author field.
-func (p *PushEventCommit) GetAuthor() *CommitAuthor {
-	if p == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return p.Author
+	return *r.CreatedAt
 }
 
-// GetCommitter returns the Committer field.
-func (p *PushEventCommit) GetCommitter() *CommitAuthor {
-	if p == nil {
-		return nil
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return p.Committer
+	return *r.HTMLURL
 }
 
-// GetDistinct returns the Distinct field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetDistinct() bool {
-	if p == nil || p.Distinct == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *p.Distinct
+	return *r.ID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetID() string {
-	if p == nil || p.ID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *p.ID
+	return *r.Name
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetMessage() string {
-	if p == nil || p.Message == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *p.Message
+	return *r.NodeID
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetSHA() string {
-	if p == nil || p.SHA == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *p.SHA
+	return *r.Path
 }
 
-// GetTimestamp returns the Timestamp field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetTimestamp() Timestamp {
-	if p == nil || p.Timestamp == nil {
-		return Timestamp{}
+// GetSourceRepository returns the SourceRepository field.
+func (r *RepoRequiredWorkflow) GetSourceRepository() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *p.Timestamp
+	return r.SourceRepository
 }
 
-// GetTreeID returns the TreeID field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetTreeID() string {
-	if p == nil || p.TreeID == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *p.TreeID
+	return *r.State
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PushEventCommit) GetURL() string {
-	if p == nil || p.URL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.URL
+	return *r.UpdatedAt
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetEmail() string {
-	if p == nil || p.Email == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflow) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *p.Email
+	return *r.URL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepoOwner) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (r *RepoRequiredWorkflows) GetTotalCount() int {
+	if r == nil || r.TotalCount == nil {
+		return 0
 	}
-	return *p.Name
+	return *r.TotalCount
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetArchiveURL() string {
-	if p == nil || p.ArchiveURL == nil {
-		return ""
+// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
+	if r == nil || r.IncompleteResults == nil {
+		return false
 	}
-	return *p.ArchiveURL
+	return *r.IncompleteResults
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCloneURL() string {
-	if p == nil || p.CloneURL == nil {
-		return ""
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (r *RepositoriesSearchResult) GetTotal() int {
+	if r == nil || r.Total == nil {
+		return 0
 	}
-	return *p.CloneURL
+	return *r.Total
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetCreatedAt() Timestamp {
-	if p == nil || p.CreatedAt == nil {
-		return Timestamp{}
+// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowMergeCommit() bool {
+	if r == nil || r.AllowMergeCommit == nil {
+		return false
 	}
-	return *p.CreatedAt
+	return *r.AllowMergeCommit
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDefaultBranch() string {
-	if p == nil || p.DefaultBranch == nil {
-		return ""
+// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowRebaseMerge() bool {
+	if r == nil || r.AllowRebaseMerge == nil {
+		return false
 	}
-	return *p.DefaultBranch
+	return *r.AllowRebaseMerge
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetDescription() string {
-	if p == nil || p.Description == nil {
-		return ""
+// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAllowSquashMerge() bool {
+	if r == nil || r.AllowSquashMerge == nil {
+		return false
 	}
-	return *p.Description
+	return *r.AllowSquashMerge
 }
 
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFork() bool {
-	if p == nil || p.Fork == nil {
+// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchived() bool {
+	if r == nil || r.Archived == nil {
 		return false
 	}
-	return *p.Fork
+	return *r.Archived
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetForksCount() int {
-	if p == nil || p.ForksCount == nil {
-		return 0
+// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetArchiveURL() string {
+	if r == nil || r.ArchiveURL == nil {
+		return ""
 	}
-	return *p.ForksCount
+	return *r.ArchiveURL
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetFullName() string {
-	if p == nil || p.FullName == nil {
+// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAssigneesURL() string {
+	if r == nil || r.AssigneesURL == nil {
 		return ""
 	}
-	return *p.FullName
+	return *r.AssigneesURL
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetGitURL() string {
-	if p == nil || p.GitURL == nil {
+// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
+func (r *Repository) GetAutoInit() bool {
+	if r == nil || r.AutoInit == nil {
+		return false
+	}
+	return *r.AutoInit
+}
+
+// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBlobsURL() string {
+	if r == nil || r.BlobsURL == nil {
 		return ""
 	}
-	return *p.GitURL
+	return *r.BlobsURL
 }
 
-// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasDownloads() bool {
-	if p == nil || p.HasDownloads == nil {
-		return false
+// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetBranchesURL() string {
+	if r == nil || r.BranchesURL == nil {
+		return ""
 	}
-	return *p.HasDownloads
+	return *r.BranchesURL
 }
 
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasIssues() bool {
-	if p == nil || p.HasIssues == nil {
-		return false
+// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCloneURL() string {
+	if r == nil || r.CloneURL == nil {
+		return ""
 	}
-	return *p.HasIssues
+	return *r.CloneURL
 }
 
-// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasPages() bool {
-	if p == nil || p.HasPages == nil {
-		return false
+// GetCodeOfConduct returns the CodeOfConduct field.
+func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
+	if r == nil {
+		return nil
 	}
-	return *p.HasPages
+	return r.CodeOfConduct
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHasWiki() bool {
-	if p == nil || p.HasWiki == nil {
-		return false
+// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCollaboratorsURL() string {
+	if r == nil || r.CollaboratorsURL == nil {
+		return ""
 	}
-	return *p.HasWiki
+	return *r.CollaboratorsURL
 }
 
-// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHomepage() string {
-	if p == nil || p.Homepage == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
 		return ""
 	}
-	return *p.Homepage
+	return *r.CommentsURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetHTMLURL() string {
-	if p == nil || p.HTMLURL == nil {
+// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCommitsURL() string {
+	if r == nil || r.CommitsURL == nil {
 		return ""
 	}
-	return *p.HTMLURL
+	return *r.CommitsURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetID() int64 {
-	if p == nil || p.ID == nil {
-		return 0
+// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCompareURL() string {
+	if r == nil || r.CompareURL == nil {
+		return ""
 	}
-	return *p.ID
+	return *r.CompareURL
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetLanguage() string {
-	if p == nil || p.Language == nil {
+// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContentsURL() string {
+	if r == nil || r.ContentsURL == nil {
 		return ""
 	}
-	return *p.Language
+	return *r.ContentsURL
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetMasterBranch() string {
-	if p == nil || p.MasterBranch == nil {
+// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetContributorsURL() string {
+	if r == nil || r.ContributorsURL == nil {
 		return ""
 	}
-	return *p.MasterBranch
+	return *r.ContributorsURL
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetName() string {
-	if p == nil || p.Name == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *p.Name
+	return *r.CreatedAt
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetNodeID() string {
-	if p == nil || p.NodeID == nil {
+// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDefaultBranch() string {
+	if r == nil || r.DefaultBranch == nil {
 		return ""
 	}
-	return *p.NodeID
+	return *r.DefaultBranch
 }
 
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOpenIssuesCount() int {
-	if p == nil || p.OpenIssuesCount == nil {
-		return 0
+// GetDeleteBranchOnMerge returns the DeleteBranchOnMerge field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeleteBranchOnMerge() bool {
+	if r == nil || r.DeleteBranchOnMerge == nil {
+		return false
 	}
-	return *p.OpenIssuesCount
+	return *r.DeleteBranchOnMerge
 }
 
-// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetOrganization() string {
-	if p == nil || p.Organization == nil {
+// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDeploymentsURL() string {
+	if r == nil || r.DeploymentsURL == nil {
 		return ""
 	}
-	return *p.Organization
+	return *r.DeploymentsURL
 }
 
-// GetOwner returns the Owner field.
-func (p *PushEventRepository) GetOwner() *User {
-	if p == nil {
-		return nil
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDescription() string {
+	if r == nil || r.Description == nil {
+		return ""
 	}
-	return p.Owner
+	return *r.Description
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPrivate() bool {
-	if p == nil || p.Private == nil {
+// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDisabled() bool {
+	if r == nil || r.Disabled == nil {
 		return false
 	}
-	return *p.Private
+	return *r.Disabled
 }
 
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetPushedAt() Timestamp {
-	if p == nil || p.PushedAt == nil {
-		return Timestamp{}
+// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetDownloadsURL() string {
+	if r == nil || r.DownloadsURL == nil {
+		return ""
 	}
-	return *p.PushedAt
+	return *r.DownloadsURL
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSize() int {
-	if p == nil || p.Size == nil {
-		return 0
+// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetEventsURL() string {
+	if r == nil || r.EventsURL == nil {
+		return ""
 	}
-	return *p.Size
+	return *r.EventsURL
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSSHURL() string {
-	if p == nil || p.SSHURL == nil {
-		return ""
+// GetFork returns the Fork field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFork() bool {
+	if r == nil || r.Fork == nil {
+		return false
 	}
-	return *p.SSHURL
+	return *r.Fork
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStargazersCount() int {
-	if p == nil || p.StargazersCount == nil {
+// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksCount() int {
+	if r == nil || r.ForksCount == nil {
 		return 0
 	}
-	return *p.StargazersCount
+	return *r.ForksCount
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetStatusesURL() string {
-	if p == nil || p.StatusesURL == nil {
+// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetForksURL() string {
+	if r == nil || r.ForksURL == nil {
 		return ""
 	}
-	return *p.StatusesURL
+	return *r.ForksURL
 }
 
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetSVNURL() string {
-	if p == nil || p.SVNURL == nil {
+// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
+func (r *Repository) GetFullName() string {
+	if r == nil || r.FullName == nil {
 		return ""
 	}
-	return *p.SVNURL
+	return *r.FullName
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetUpdatedAt() Timestamp {
-	if p == nil || p.UpdatedAt == nil {
-		return Timestamp{}
+// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitCommitsURL() string {
+	if r == nil || r.GitCommitsURL == nil {
+		return ""
 	}
-	return *p.UpdatedAt
+	return *r.GitCommitsURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetURL() string {
-	if p == nil || p.URL == nil {
+// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitignoreTemplate() string {
+	if r == nil || r.GitignoreTemplate == nil {
 		return ""
 	}
-	return *p.URL
+	return *r.GitignoreTemplate
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (p *PushEventRepository) GetWatchersCount() int {
-	if p == nil || p.WatchersCount == nil {
-		return 0
+// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitRefsURL() string {
+	if r == nil || r.GitRefsURL == nil {
+		return ""
 	}
-	return *p.WatchersCount
+	return *r.GitRefsURL
 }
 
-// GetCore returns the Core field.
-func (r *RateLimits) GetCore() *Rate {
-	if r == nil {
-		return nil
+// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitTagsURL() string {
+	if r == nil || r.GitTagsURL == nil {
+		return ""
 	}
-	return r.Core
+	return *r.GitTagsURL
 }
 
-// GetSearch returns the Search field.
-func (r *RateLimits) GetSearch() *Rate {
-	if r == nil {
-		return nil
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
+		return ""
 	}
-	return r.Search
+	return *r.GitURL
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetContent() string {
-	if r == nil || r.Content == nil {
-		return ""
+// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasDownloads() bool {
+	if r == nil || r.HasDownloads == nil {
+		return false
 	}
-	return *r.Content
+	return *r.HasDownloads
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasIssues() bool {
+	if r == nil || r.HasIssues == nil {
+		return false
 	}
-	return *r.ID
+	return *r.HasIssues
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reaction) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
-		return ""
+// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasPages() bool {
+	if r == nil || r.HasPages == nil {
+		return false
 	}
-	return *r.NodeID
+	return *r.HasPages
 }
 
-// GetUser returns the User field.
-func (r *Reaction) GetUser() *User {
-	if r == nil {
-		return nil
+// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasProjects() bool {
+	if r == nil || r.HasProjects == nil {
+		return false
 	}
-	return r.User
+	return *r.HasProjects
 }
 
-// GetConfused returns the Confused field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetConfused() int {
-	if r == nil || r.Confused == nil {
-		return 0
+// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHasWiki() bool {
+	if r == nil || r.HasWiki == nil {
+		return false
 	}
-	return *r.Confused
+	return *r.HasWiki
 }
 
-// GetHeart returns the Heart field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHeart() int {
-	if r == nil || r.Heart == nil {
-		return 0
+// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHomepage() string {
+	if r == nil || r.Homepage == nil {
+		return ""
 	}
-	return *r.Heart
+	return *r.Homepage
 }
 
-// GetHooray returns the Hooray field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetHooray() int {
-	if r == nil || r.Hooray == nil {
-		return 0
+// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHooksURL() string {
+	if r == nil || r.HooksURL == nil {
+		return ""
 	}
-	return *r.Hooray
+	return *r.HooksURL
 }
 
-// GetLaugh returns the Laugh field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetLaugh() int {
-	if r == nil || r.Laugh == nil {
-		return 0
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
+		return ""
 	}
-	return *r.Laugh
+	return *r.HTMLURL
 }
 
-// GetMinusOne returns the MinusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetMinusOne() int {
-	if r == nil || r.MinusOne == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *r.MinusOne
+	return *r.ID
 }
 
-// GetPlusOne returns the PlusOne field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetPlusOne() int {
-	if r == nil || r.PlusOne == nil {
-		return 0
+// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueCommentURL() string {
+	if r == nil || r.IssueCommentURL == nil {
+		return ""
 	}
-	return *r.PlusOne
+	return *r.IssueCommentURL
 }
 
-// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetTotalCount() int {
-	if r == nil || r.TotalCount == nil {
-		return 0
+// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssueEventsURL() string {
+	if r == nil || r.IssueEventsURL == nil {
+		return ""
 	}
-	return *r.TotalCount
+	return *r.IssueEventsURL
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reactions) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetIssuesURL() string {
+	if r == nil || r.IssuesURL == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.IssuesURL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Reference) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetKeysURL() string {
+	if r == nil || r.KeysURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.KeysURL
 }
 
-// GetObject returns the Object field.
-func (r *Reference) GetObject() *GitObject {
-	if r == nil {
-		return nil
+// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLabelsURL() string {
+	if r == nil || r.LabelsURL == nil {
+		return ""
 	}
-	return r.Object
+	return *r.LabelsURL
 }
 
-// GetRef returns the Ref field if it's non-nil, zero value otherwise.
-func (r *Reference) GetRef() string {
-	if r == nil || r.Ref == nil {
+// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguage() string {
+	if r == nil || r.Language == nil {
 		return ""
 	}
-	return *r.Ref
+	return *r.Language
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Reference) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLanguagesURL() string {
+	if r == nil || r.LanguagesURL == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.LanguagesURL
 }
 
-// GetBrowserDownloadURL returns the BrowserDownloadURL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetBrowserDownloadURL() string {
-	if r == nil || r.BrowserDownloadURL == nil {
-		return ""
+// GetLicense returns the License field.
+func (r *Repository) GetLicense() *License {
+	if r == nil {
+		return nil
 	}
-	return *r.BrowserDownloadURL
+	return r.License
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetContentType() string {
-	if r == nil || r.ContentType == nil {
+// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
+func (r *Repository) GetLicenseTemplate() string {
+	if r == nil || r.LicenseTemplate == nil {
 		return ""
 	}
-	return *r.ContentType
+	return *r.LicenseTemplate
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMasterBranch() string {
+	if r == nil || r.MasterBranch == nil {
+		return ""
 	}
-	return *r.CreatedAt
+	return *r.MasterBranch
 }
 
-// GetDownloadCount returns the DownloadCount field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetDownloadCount() int {
-	if r == nil || r.DownloadCount == nil {
-		return 0
+// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMergesURL() string {
+	if r == nil || r.MergesURL == nil {
+		return ""
 	}
-	return *r.DownloadCount
+	return *r.MergesURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMilestonesURL() string {
+	if r == nil || r.MilestonesURL == nil {
+		return ""
 	}
-	return *r.ID
+	return *r.MilestonesURL
 }
 
-// GetLabel returns the Label field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetLabel() string {
-	if r == nil || r.Label == nil {
+// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetMirrorURL() string {
+	if r == nil || r.MirrorURL == nil {
 		return ""
 	}
-	return *r.Label
+	return *r.MirrorURL
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetName() string {
+func (r *Repository) GetName() string {
 	if r == nil || r.Name == nil {
 		return ""
 	}
 	return *r.Name
 }
 
+// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNetworkCount() int {
+	if r == nil || r.NetworkCount == nil {
+		return 0
+	}
+	return *r.NetworkCount
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetNodeID() string {
+func (r *Repository) GetNodeID() string {
 	if r == nil || r.NodeID == nil {
 		return ""
 	}
 	return *r.NodeID
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
-	}
-	return *r.Size
-}
-
-// GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetState() string {
-	if r == nil || r.State == nil {
+// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetNotificationsURL() string {
+	if r == nil || r.NotificationsURL == nil {
 		return ""
 	}
-	return *r.State
+	return *r.NotificationsURL
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetUpdatedAt() Timestamp {
-	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetOpenIssuesCount() int {
+	if r == nil || r.OpenIssuesCount == nil {
+		return 0
 	}
-	return *r.UpdatedAt
+	return *r.OpenIssuesCount
 }
 
-// GetUploader returns the Uploader field.
-func (r *ReleaseAsset) GetUploader() *User {
+// GetOrganization returns the Organization field.
+func (r *Repository) GetOrganization() *Organization {
 	if r == nil {
 		return nil
 	}
-	return r.Uploader
-}
-
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *ReleaseAsset) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
-	}
-	return *r.URL
-}
-
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *ReleaseEvent) GetAction() string {
-	if r == nil || r.Action == nil {
-		return ""
-	}
-	return *r.Action
+	return r.Organization
 }
 
-// GetInstallation returns the Installation field.
-func (r *ReleaseEvent) GetInstallation() *Installation {
+// GetOwner returns the Owner field.
+func (r *Repository) GetOwner() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Installation
+	return r.Owner
 }
 
-// GetRelease returns the Release field.
-func (r *ReleaseEvent) GetRelease() *RepositoryRelease {
+// GetParent returns the Parent field.
+func (r *Repository) GetParent() *Repository {
 	if r == nil {
 		return nil
 	}
-	return r.Release
+	return r.Parent
 }
 
-// GetRepo returns the Repo field.
-func (r *ReleaseEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPermissions() map[string]bool {
+	if r == nil || r.Permissions == nil {
+		return map[string]bool{}
 	}
-	return r.Repo
+	return *r.Permissions
 }
 
-// GetSender returns the Sender field.
-func (r *ReleaseEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPrivate() bool {
+	if r == nil || r.Private == nil {
+		return false
 	}
-	return r.Sender
+	return *r.Private
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (r *Rename) GetFrom() string {
-	if r == nil || r.From == nil {
+// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPullsURL() string {
+	if r == nil || r.PullsURL == nil {
 		return ""
 	}
-	return *r.From
+	return *r.PullsURL
 }
 
-// GetTo returns the To field if it's non-nil, zero value otherwise.
-func (r *Rename) GetTo() string {
-	if r == nil || r.To == nil {
-		return ""
+// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetPushedAt() Timestamp {
+	if r == nil || r.PushedAt == nil {
+		return Timestamp{}
 	}
-	return *r.To
+	return *r.PushedAt
 }
 
-// GetIncompleteResults returns the IncompleteResults field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetIncompleteResults() bool {
-	if r == nil || r.IncompleteResults == nil {
-		return false
+// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetReleasesURL() string {
+	if r == nil || r.ReleasesURL == nil {
+		return ""
 	}
-	return *r.IncompleteResults
+	return *r.ReleasesURL
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (r *RepositoriesSearchResult) GetTotal() int {
-	if r == nil || r.Total == nil {
-		return 0
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (r *Repository) GetRoleName() string {
+	if r == nil || r.RoleName == nil {
+		return ""
 	}
-	return *r.Total
+	return *r.RoleName
 }
 
-// GetAllowMergeCommit returns the AllowMergeCommit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowMergeCommit() bool {
-	if r == nil || r.AllowMergeCommit == nil {
-		return false
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *r.AllowMergeCommit
+	return *r.Size
 }
 
-// GetAllowRebaseMerge returns the AllowRebaseMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowRebaseMerge() bool {
-	if r == nil || r.AllowRebaseMerge == nil {
-		return false
+// GetSource returns the Source field.
+func (r *Repository) GetSource() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.AllowRebaseMerge
+	return r.Source
 }
 
-// GetAllowSquashMerge returns the AllowSquashMerge field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAllowSquashMerge() bool {
-	if r == nil || r.AllowSquashMerge == nil {
-		return false
+// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSSHURL() string {
+	if r == nil || r.SSHURL == nil {
+		return ""
 	}
-	return *r.AllowSquashMerge
+	return *r.SSHURL
 }
 
-// GetArchived returns the Archived field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchived() bool {
-	if r == nil || r.Archived == nil {
-		return false
+// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersCount() int {
+	if r == nil || r.StargazersCount == nil {
+		return 0
 	}
-	return *r.Archived
+	return *r.StargazersCount
 }
 
-// GetArchiveURL returns the ArchiveURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetArchiveURL() string {
-	if r == nil || r.ArchiveURL == nil {
+// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStargazersURL() string {
+	if r == nil || r.StargazersURL == nil {
 		return ""
 	}
-	return *r.ArchiveURL
+	return *r.StargazersURL
 }
 
-// GetAssigneesURL returns the AssigneesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAssigneesURL() string {
-	if r == nil || r.AssigneesURL == nil {
+// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetStatusesURL() string {
+	if r == nil || r.StatusesURL == nil {
 		return ""
 	}
-	return *r.AssigneesURL
+	return *r.StatusesURL
 }
 
-// GetAutoInit returns the AutoInit field if it's non-nil, zero value otherwise.
-func (r *Repository) GetAutoInit() bool {
-	if r == nil || r.AutoInit == nil {
-		return false
+// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersCount() int {
+	if r == nil || r.SubscribersCount == nil {
+		return 0
 	}
-	return *r.AutoInit
+	return *r.SubscribersCount
 }
 
-// GetBlobsURL returns the BlobsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBlobsURL() string {
-	if r == nil || r.BlobsURL == nil {
+// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscribersURL() string {
+	if r == nil || r.SubscribersURL == nil {
 		return ""
 	}
-	return *r.BlobsURL
+	return *r.SubscribersURL
 }
 
-// GetBranchesURL returns the BranchesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetBranchesURL() string {
-	if r == nil || r.BranchesURL == nil {
+// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSubscriptionURL() string {
+	if r == nil || r.SubscriptionURL == nil {
 		return ""
 	}
-	return *r.BranchesURL
+	return *r.SubscriptionURL
 }
 
-// GetCloneURL returns the CloneURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCloneURL() string {
-	if r == nil || r.CloneURL == nil {
+// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetSVNURL() string {
+	if r == nil || r.SVNURL == nil {
 		return ""
 	}
-	return *r.CloneURL
+	return *r.SVNURL
 }
 
-// GetCodeOfConduct returns the CodeOfConduct field.
-func (r *Repository) GetCodeOfConduct() *CodeOfConduct {
-	if r == nil {
-		return nil
+// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTagsURL() string {
+	if r == nil || r.TagsURL == nil {
+		return ""
 	}
-	return r.CodeOfConduct
+	return *r.TagsURL
 }
 
-// GetCollaboratorsURL returns the CollaboratorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCollaboratorsURL() string {
-	if r == nil || r.CollaboratorsURL == nil {
-		return ""
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamID() int64 {
+	if r == nil || r.TeamID == nil {
+		return 0
 	}
-	return *r.CollaboratorsURL
+	return *r.TeamID
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
+// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTeamsURL() string {
+	if r == nil || r.TeamsURL == nil {
 		return ""
 	}
-	return *r.CommentsURL
+	return *r.TeamsURL
 }
 
-// GetCommitsURL returns the CommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCommitsURL() string {
-	if r == nil || r.CommitsURL == nil {
+// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetTreesURL() string {
+	if r == nil || r.TreesURL == nil {
 		return ""
 	}
-	return *r.CommitsURL
+	return *r.TreesURL
 }
 
-// GetCompareURL returns the CompareURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCompareURL() string {
-	if r == nil || r.CompareURL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *Repository) GetUpdatedAt() Timestamp {
+	if r == nil || r.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.CompareURL
+	return *r.UpdatedAt
 }
 
-// GetContentsURL returns the ContentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContentsURL() string {
-	if r == nil || r.ContentsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Repository) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.ContentsURL
+	return *r.URL
 }
 
-// GetContributorsURL returns the ContributorsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetContributorsURL() string {
-	if r == nil || r.ContributorsURL == nil {
-		return ""
+// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
+func (r *Repository) GetWatchersCount() int {
+	if r == nil || r.WatchersCount == nil {
+		return 0
 	}
-	return *r.ContributorsURL
+	return *r.WatchersCount
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetBody() string {
+	if r == nil || r.Body == nil {
+		return ""
 	}
-	return *r.CreatedAt
+	return *r.Body
 }
 
-// GetDefaultBranch returns the DefaultBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDefaultBranch() string {
-	if r == nil || r.DefaultBranch == nil {
+// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetCommitID() string {
+	if r == nil || r.CommitID == nil {
 		return ""
 	}
-	return *r.DefaultBranch
+	return *r.CommitID
 }
 
-// GetDeploymentsURL returns the DeploymentsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDeploymentsURL() string {
-	if r == nil || r.DeploymentsURL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *r.DeploymentsURL
+	return *r.CreatedAt
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDescription() string {
-	if r == nil || r.Description == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.Description
+	return *r.HTMLURL
 }
 
-// GetDisabled returns the Disabled field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDisabled() bool {
-	if r == nil || r.Disabled == nil {
-		return false
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.Disabled
+	return *r.ID
 }
 
-// GetDownloadsURL returns the DownloadsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetDownloadsURL() string {
-	if r == nil || r.DownloadsURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.DownloadsURL
+	return *r.Path
 }
 
-// GetEventsURL returns the EventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetEventsURL() string {
-	if r == nil || r.EventsURL == nil {
-		return ""
+// GetPosition returns the Position field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetPosition() int {
+	if r == nil || r.Position == nil {
+		return 0
 	}
-	return *r.EventsURL
+	return *r.Position
 }
 
-// GetFork returns the Fork field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFork() bool {
-	if r == nil || r.Fork == nil {
-		return false
+// GetReactions returns the Reactions field.
+func (r *RepositoryComment) GetReactions() *Reactions {
+	if r == nil {
+		return nil
 	}
-	return *r.Fork
+	return r.Reactions
 }
 
-// GetForksCount returns the ForksCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksCount() int {
-	if r == nil || r.ForksCount == nil {
-		return 0
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *r.ForksCount
+	return *r.UpdatedAt
 }
 
-// GetForksURL returns the ForksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetForksURL() string {
-	if r == nil || r.ForksURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryComment) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.ForksURL
+	return *r.URL
 }
 
-// GetFullName returns the FullName field if it's non-nil, zero value otherwise.
-func (r *Repository) GetFullName() string {
-	if r == nil || r.FullName == nil {
-		return ""
+// GetUser returns the User field.
+func (r *RepositoryComment) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.FullName
+	return r.User
 }
 
-// GetGitCommitsURL returns the GitCommitsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitCommitsURL() string {
-	if r == nil || r.GitCommitsURL == nil {
-		return ""
+// GetAuthor returns the Author field.
+func (r *RepositoryCommit) GetAuthor() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.GitCommitsURL
+	return r.Author
 }
 
-// GetGitignoreTemplate returns the GitignoreTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitignoreTemplate() string {
-	if r == nil || r.GitignoreTemplate == nil {
+// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetCommentsURL() string {
+	if r == nil || r.CommentsURL == nil {
 		return ""
 	}
-	return *r.GitignoreTemplate
+	return *r.CommentsURL
 }
 
-// GetGitRefsURL returns the GitRefsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitRefsURL() string {
-	if r == nil || r.GitRefsURL == nil {
-		return ""
+// GetCommit returns the Commit field.
+func (r *RepositoryCommit) GetCommit() *Commit {
+	if r == nil {
+		return nil
 	}
-	return *r.GitRefsURL
+	return r.Commit
 }
 
-// GetGitTagsURL returns the GitTagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitTagsURL() string {
-	if r == nil || r.GitTagsURL == nil {
-		return ""
+// GetCommitter returns the Committer field.
+func (r *RepositoryCommit) GetCommitter() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.GitTagsURL
+	return r.Committer
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.GitURL
+	return *r.HTMLURL
 }
 
-// GetHasDownloads returns the HasDownloads field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasDownloads() bool {
-	if r == nil || r.HasDownloads == nil {
-		return false
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *r.HasDownloads
+	return *r.NodeID
 }
 
-// GetHasIssues returns the HasIssues field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasIssues() bool {
-	if r == nil || r.HasIssues == nil {
-		return false
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetSHA() string {
+	if r == nil || r.SHA == nil {
+		return ""
 	}
-	return *r.HasIssues
+	return *r.SHA
 }
 
-// GetHasPages returns the HasPages field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasPages() bool {
-	if r == nil || r.HasPages == nil {
-		return false
+// GetStats returns the Stats field.
+func (r *RepositoryCommit) GetStats() *CommitStats {
+	if r == nil {
+		return nil
 	}
-	return *r.HasPages
+	return r.Stats
 }
 
-// GetHasProjects returns the HasProjects field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasProjects() bool {
-	if r == nil || r.HasProjects == nil {
-		return false
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryCommit) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return *r.HasProjects
+	return *r.URL
 }
 
-// GetHasWiki returns the HasWiki field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHasWiki() bool {
-	if r == nil || r.HasWiki == nil {
-		return false
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
+		return ""
 	}
-	return *r.HasWiki
+	return *r.DownloadURL
 }
 
-// GetHomepage returns the Homepage field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHomepage() string {
-	if r == nil || r.Homepage == nil {
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
 		return ""
 	}
-	return *r.Homepage
+	return *r.Encoding
 }
 
-// GetHooksURL returns the HooksURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHooksURL() string {
-	if r == nil || r.HooksURL == nil {
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
 		return ""
 	}
-	return *r.HooksURL
+	return *r.GitURL
 }
 
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetHTMLURL() string {
+func (r *RepositoryContent) GetHTMLURL() string {
 	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
 	return *r.HTMLURL
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
 	}
-	return *r.ID
+	return *r.Name
 }
 
-// GetIssueCommentURL returns the IssueCommentURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueCommentURL() string {
-	if r == nil || r.IssueCommentURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.IssueCommentURL
+	return *r.Path
 }
 
-// GetIssueEventsURL returns the IssueEventsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssueEventsURL() string {
-	if r == nil || r.IssueEventsURL == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *r.IssueEventsURL
+	return *r.SHA
 }
 
-// GetIssuesURL returns the IssuesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetIssuesURL() string {
-	if r == nil || r.IssuesURL == nil {
-		return ""
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *r.IssuesURL
+	return *r.Size
 }
 
-// GetKeysURL returns the KeysURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetKeysURL() string {
-	if r == nil || r.KeysURL == nil {
+// GetTarget returns the Target field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetTarget() string {
+	if r == nil || r.Target == nil {
 		return ""
 	}
-	return *r.KeysURL
+	return *r.Target
 }
 
-// GetLabelsURL returns the LabelsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLabelsURL() string {
-	if r == nil || r.LabelsURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.LabelsURL
+	return *r.Type
 }
 
-// GetLanguage returns the Language field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguage() string {
-	if r == nil || r.Language == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryContent) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.Language
+	return *r.URL
 }
 
-// GetLanguagesURL returns the LanguagesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLanguagesURL() string {
-	if r == nil || r.LanguagesURL == nil {
+// GetAuthor returns the Author field.
+func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
+	if r == nil {
+		return nil
+	}
+	return r.Author
+}
+
+// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetBranch() string {
+	if r == nil || r.Branch == nil {
 		return ""
 	}
-	return *r.LanguagesURL
+	return *r.Branch
 }
 
-// GetLicense returns the License field.
-func (r *Repository) GetLicense() *License {
+// GetCommitter returns the Committer field.
+func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
 	if r == nil {
 		return nil
 	}
-	return r.License
+	return r.Committer
 }
 
-// GetLicenseTemplate returns the LicenseTemplate field if it's non-nil, zero value otherwise.
-func (r *Repository) GetLicenseTemplate() string {
-	if r == nil || r.LicenseTemplate == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetMessage() string {
+	if r == nil || r.Message == nil {
 		return ""
 	}
-	return *r.LicenseTemplate
+	return *r.Message
 }
 
-// GetMasterBranch returns the MasterBranch field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMasterBranch() string {
-	if r == nil || r.MasterBranch == nil {
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryContentFileOptions) GetSHA() string {
+	if r == nil || r.SHA == nil {
 		return ""
 	}
-	return *r.MasterBranch
+	return *r.SHA
 }
 
-// GetMergesURL returns the MergesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMergesURL() string {
-	if r == nil || r.MergesURL == nil {
-		return ""
+// GetContent returns the Content field.
+func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
+	if r == nil {
+		return nil
 	}
-	return *r.MergesURL
+	return r.Content
 }
 
-// GetMilestonesURL returns the MilestonesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMilestonesURL() string {
-	if r == nil || r.MilestonesURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.MilestonesURL
+	return *r.Action
+}
+
+// GetInstallation returns the Installation field.
+func (r *RepositoryEvent) GetInstallation() *Installation {
+	if r == nil {
+		return nil
+	}
+	return r.Installation
 }
 
-// GetMirrorURL returns the MirrorURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetMirrorURL() string {
-	if r == nil || r.MirrorURL == nil {
-		return ""
+// GetOrg returns the Org field.
+func (r *RepositoryEvent) GetOrg() *Organization {
+	if r == nil {
+		return nil
 	}
-	return *r.MirrorURL
+	return r.Org
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *Repository) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetRepo returns the Repo field.
+func (r *RepositoryEvent) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.Name
+	return r.Repo
 }
 
-// GetNetworkCount returns the NetworkCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNetworkCount() int {
-	if r == nil || r.NetworkCount == nil {
-		return 0
+// GetSender returns the Sender field.
+func (r *RepositoryEvent) GetSender() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.NetworkCount
+	return r.Sender
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
+	if r == nil || r.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.NodeID
+	return *r.CreatedAt
 }
 
-// GetNotificationsURL returns the NotificationsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetNotificationsURL() string {
-	if r == nil || r.NotificationsURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.NotificationsURL
+	return *r.HTMLURL
 }
 
-// GetOpenIssuesCount returns the OpenIssuesCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetOpenIssuesCount() int {
-	if r == nil || r.OpenIssuesCount == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetID() int64 {
+	if r == nil || r.ID == nil {
 		return 0
 	}
-	return *r.OpenIssuesCount
-}
-
-// GetOrganization returns the Organization field.
-func (r *Repository) GetOrganization() *Organization {
-	if r == nil {
-		return nil
-	}
-	return r.Organization
+	return *r.ID
 }
 
-// GetOwner returns the Owner field.
-func (r *Repository) GetOwner() *User {
+// GetInvitee returns the Invitee field.
+func (r *RepositoryInvitation) GetInvitee() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Owner
+	return r.Invitee
 }
 
-// GetParent returns the Parent field.
-func (r *Repository) GetParent() *Repository {
+// GetInviter returns the Inviter field.
+func (r *RepositoryInvitation) GetInviter() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Parent
+	return r.Inviter
 }
 
 // GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPermissions() map[string]bool {
+func (r *RepositoryInvitation) GetPermissions() string {
 	if r == nil || r.Permissions == nil {
-		return map[string]bool{}
+		return ""
 	}
 	return *r.Permissions
 }
 
-// GetPrivate returns the Private field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPrivate() bool {
-	if r == nil || r.Private == nil {
-		return false
+// GetRepo returns the Repo field.
+func (r *RepositoryInvitation) GetRepo() *Repository {
+	if r == nil {
+		return nil
 	}
-	return *r.Private
+	return r.Repo
 }
 
-// GetPullsURL returns the PullsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPullsURL() string {
-	if r == nil || r.PullsURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryInvitation) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.PullsURL
+	return *r.URL
 }
 
-// GetPushedAt returns the PushedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetPushedAt() Timestamp {
-	if r == nil || r.PushedAt == nil {
-		return Timestamp{}
+// GetContent returns the Content field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetContent() string {
+	if r == nil || r.Content == nil {
+		return ""
 	}
-	return *r.PushedAt
+	return *r.Content
 }
 
-// GetReleasesURL returns the ReleasesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetReleasesURL() string {
-	if r == nil || r.ReleasesURL == nil {
+// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetDownloadURL() string {
+	if r == nil || r.DownloadURL == nil {
 		return ""
 	}
-	return *r.ReleasesURL
+	return *r.DownloadURL
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSize() int {
-	if r == nil || r.Size == nil {
-		return 0
+// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetEncoding() string {
+	if r == nil || r.Encoding == nil {
+		return ""
 	}
-	return *r.Size
+	return *r.Encoding
 }
 
-// GetSource returns the Source field.
-func (r *Repository) GetSource() *Repository {
-	if r == nil {
-		return nil
+// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetGitURL() string {
+	if r == nil || r.GitURL == nil {
+		return ""
 	}
-	return r.Source
+	return *r.GitURL
 }
 
-// GetSSHURL returns the SSHURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSSHURL() string {
-	if r == nil || r.SSHURL == nil {
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetHTMLURL() string {
+	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
-	return *r.SSHURL
+	return *r.HTMLURL
 }
 
-// GetStargazersCount returns the StargazersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersCount() int {
-	if r == nil || r.StargazersCount == nil {
-		return 0
+// GetLicense returns the License field.
+func (r *RepositoryLicense) GetLicense() *License {
+	if r == nil {
+		return nil
 	}
-	return *r.StargazersCount
+	return r.License
 }
 
-// GetStargazersURL returns the StargazersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStargazersURL() string {
-	if r == nil || r.StargazersURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.StargazersURL
+	return *r.Name
 }
 
-// GetStatusesURL returns the StatusesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetStatusesURL() string {
-	if r == nil || r.StatusesURL == nil {
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetPath() string {
+	if r == nil || r.Path == nil {
 		return ""
 	}
-	return *r.StatusesURL
+	return *r.Path
 }
 
-// GetSubscribersCount returns the SubscribersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersCount() int {
-	if r == nil || r.SubscribersCount == nil {
-		return 0
+// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSHA() string {
+	if r == nil || r.SHA == nil {
+		return ""
 	}
-	return *r.SubscribersCount
+	return *r.SHA
 }
 
-// GetSubscribersURL returns the SubscribersURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscribersURL() string {
-	if r == nil || r.SubscribersURL == nil {
-		return ""
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetSize() int {
+	if r == nil || r.Size == nil {
+		return 0
 	}
-	return *r.SubscribersURL
+	return *r.Size
 }
 
-// GetSubscriptionURL returns the SubscriptionURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSubscriptionURL() string {
-	if r == nil || r.SubscriptionURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.SubscriptionURL
+	return *r.Type
 }
 
-// GetSVNURL returns the SVNURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetSVNURL() string {
-	if r == nil || r.SVNURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryLicense) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.SVNURL
+	return *r.URL
 }
 
-// GetTagsURL returns the TagsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTagsURL() string {
-	if r == nil || r.TagsURL == nil {
+// GetBase returns the Base field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetBase() string {
+	if r == nil || r.Base == nil {
 		return ""
 	}
-	return *r.TagsURL
+	return *r.Base
 }
 
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamID() int64 {
-	if r == nil || r.TeamID == nil {
-		return 0
+// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetCommitMessage() string {
+	if r == nil || r.CommitMessage == nil {
+		return ""
 	}
-	return *r.TeamID
+	return *r.CommitMessage
 }
 
-// GetTeamsURL returns the TeamsURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTeamsURL() string {
-	if r == nil || r.TeamsURL == nil {
+// GetHead returns the Head field if it's non-nil, zero value otherwise.
+func (r *RepositoryMergeRequest) GetHead() string {
+	if r == nil || r.Head == nil {
 		return ""
 	}
-	return *r.TeamsURL
+	return *r.Head
 }
 
-// GetTreesURL returns the TreesURL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetTreesURL() string {
-	if r == nil || r.TreesURL == nil {
+// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
+func (r *RepositoryPermissionLevel) GetPermission() string {
+	if r == nil || r.Permission == nil {
 		return ""
 	}
-	return *r.TreesURL
+	return *r.Permission
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *Repository) GetUpdatedAt() Timestamp {
-	if r == nil || r.UpdatedAt == nil {
-		return Timestamp{}
+// GetUser returns the User field.
+func (r *RepositoryPermissionLevel) GetUser() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.UpdatedAt
+	return r.User
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *Repository) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetAssetsURL() string {
+	if r == nil || r.AssetsURL == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.AssetsURL
 }
 
-// GetWatchersCount returns the WatchersCount field if it's non-nil, zero value otherwise.
-func (r *Repository) GetWatchersCount() int {
-	if r == nil || r.WatchersCount == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (r *RepositoryRelease) GetAuthor() *User {
+	if r == nil {
+		return nil
 	}
-	return *r.WatchersCount
+	return r.Author
 }
 
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetBody() string {
+func (r *RepositoryRelease) GetBody() string {
 	if r == nil || r.Body == nil {
 		return ""
 	}
 	return *r.Body
 }
 
-// GetCommitID returns the CommitID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCommitID() string {
-	if r == nil || r.CommitID == nil {
-		return ""
-	}
-	return *r.CommitID
-}
-
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetCreatedAt() time.Time {
+func (r *RepositoryRelease) GetCreatedAt() Timestamp {
 	if r == nil || r.CreatedAt == nil {
-		return time.Time{}
+		return Timestamp{}
 	}
 	return *r.CreatedAt
 }
 
+// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetDraft() bool {
+	if r == nil || r.Draft == nil {
+		return false
+	}
+	return *r.Draft
+}
+
 // GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetHTMLURL() string {
+func (r *RepositoryRelease) GetHTMLURL() string {
 	if r == nil || r.HTMLURL == nil {
 		return ""
 	}
@@ -9725,827 +15149,835 @@ func (r *RepositoryComment) GetHTMLURL() string {
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetID() int64 {
+func (r *RepositoryRelease) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
 	return *r.ID
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.Path
+	return *r.Name
 }
 
-// GetPosition returns the Position field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetPosition() int {
-	if r == nil || r.Position == nil {
-		return 0
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *r.Position
+	return *r.NodeID
 }
 
-// GetReactions returns the Reactions field.
-func (r *RepositoryComment) GetReactions() *Reactions {
-	if r == nil {
-		return nil
+// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPrerelease() bool {
+	if r == nil || r.Prerelease == nil {
+		return false
 	}
-	return r.Reactions
+	return *r.Prerelease
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetPublishedAt() Timestamp {
+	if r == nil || r.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *r.UpdatedAt
+	return *r.PublishedAt
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryComment) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTagName() string {
+	if r == nil || r.TagName == nil {
 		return ""
 	}
-	return *r.URL
-}
-
-// GetUser returns the User field.
-func (r *RepositoryComment) GetUser() *User {
-	if r == nil {
-		return nil
-	}
-	return r.User
-}
-
-// GetAuthor returns the Author field.
-func (r *RepositoryCommit) GetAuthor() *User {
-	if r == nil {
-		return nil
-	}
-	return r.Author
+	return *r.TagName
 }
 
-// GetCommentsURL returns the CommentsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetCommentsURL() string {
-	if r == nil || r.CommentsURL == nil {
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
 		return ""
 	}
-	return *r.CommentsURL
+	return *r.TarballURL
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryCommit) GetCommit() *Commit {
-	if r == nil {
-		return nil
+// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetTargetCommitish() string {
+	if r == nil || r.TargetCommitish == nil {
+		return ""
 	}
-	return r.Commit
+	return *r.TargetCommitish
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryCommit) GetCommitter() *User {
-	if r == nil {
-		return nil
+// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetUploadURL() string {
+	if r == nil || r.UploadURL == nil {
+		return ""
 	}
-	return r.Committer
+	return *r.UploadURL
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetURL() string {
+	if r == nil || r.URL == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *r.URL
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryRelease) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *r.ZipballURL
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetSHA() string {
-	if r == nil || r.SHA == nil {
-		return ""
+// GetBranchProtection returns the BranchProtection field.
+func (r *RepositorySettings) GetBranchProtection() *ProtectionRequest {
+	if r == nil {
+		return nil
 	}
-	return *r.SHA
+	return r.BranchProtection
 }
 
-// GetStats returns the Stats field.
-func (r *RepositoryCommit) GetStats() *CommitStats {
+// GetCommit returns the Commit field.
+func (r *RepositoryTag) GetCommit() *Commit {
 	if r == nil {
 		return nil
 	}
-	return r.Stats
+	return r.Commit
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryCommit) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.Name
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
+// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetTarballURL() string {
+	if r == nil || r.TarballURL == nil {
 		return ""
 	}
-	return *r.DownloadURL
+	return *r.TarballURL
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
+// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
+func (r *RepositoryTag) GetZipballURL() string {
+	if r == nil || r.ZipballURL == nil {
 		return ""
 	}
-	return *r.Encoding
+	return *r.ZipballURL
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
+	if r == nil || r.Action == nil {
 		return ""
 	}
-	return *r.GitURL
+	return *r.Action
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
-		return ""
+// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetForkRepos() int {
+	if r == nil || r.ForkRepos == nil {
+		return 0
 	}
-	return *r.HTMLURL
+	return *r.ForkRepos
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetOrgRepos() int {
+	if r == nil || r.OrgRepos == nil {
+		return 0
 	}
-	return *r.Name
+	return *r.OrgRepos
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetPath() string {
-	if r == nil || r.Path == nil {
-		return ""
+// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetRootRepos() int {
+	if r == nil || r.RootRepos == nil {
+		return 0
 	}
-	return *r.Path
+	return *r.RootRepos
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSHA() string {
-	if r == nil || r.SHA == nil {
-		return ""
+// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalPushes() int {
+	if r == nil || r.TotalPushes == nil {
+		return 0
 	}
-	return *r.SHA
+	return *r.TotalPushes
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetSize() int {
-	if r == nil || r.Size == nil {
+// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalRepos() int {
+	if r == nil || r.TotalRepos == nil {
 		return 0
 	}
-	return *r.Size
+	return *r.TotalRepos
 }
 
-// GetTarget returns the Target field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetTarget() string {
-	if r == nil || r.Target == nil {
-		return ""
+// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
+func (r *RepoStats) GetTotalWikis() int {
+	if r == nil || r.TotalWikis == nil {
+		return 0
 	}
-	return *r.Target
+	return *r.TotalWikis
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetType() string {
-	if r == nil || r.Type == nil {
+// GetContext returns the Context field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetContext() string {
+	if r == nil || r.Context == nil {
 		return ""
 	}
-	return *r.Type
+	return *r.Context
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryContent) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
 	}
-	return *r.URL
+	return *r.CreatedAt
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryContentFileOptions) GetAuthor() *CommitAuthor {
+// GetCreator returns the Creator field.
+func (r *RepoStatus) GetCreator() *User {
 	if r == nil {
 		return nil
 	}
-	return r.Author
+	return r.Creator
 }
 
-// GetBranch returns the Branch field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetBranch() string {
-	if r == nil || r.Branch == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetDescription() string {
+	if r == nil || r.Description == nil {
 		return ""
 	}
-	return *r.Branch
+	return *r.Description
 }
 
-// GetCommitter returns the Committer field.
-func (r *RepositoryContentFileOptions) GetCommitter() *CommitAuthor {
-	if r == nil {
-		return nil
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return r.Committer
+	return *r.ID
 }
 
-// GetMessage returns the Message field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetMessage() string {
-	if r == nil || r.Message == nil {
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
 		return ""
 	}
-	return *r.Message
+	return *r.NodeID
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryContentFileOptions) GetSHA() string {
-	if r == nil || r.SHA == nil {
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetState() string {
+	if r == nil || r.State == nil {
 		return ""
 	}
-	return *r.SHA
+	return *r.State
 }
 
-// GetContent returns the Content field.
-func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
-	if r == nil {
-		return nil
+// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetTargetURL() string {
+	if r == nil || r.TargetURL == nil {
+		return ""
 	}
-	return r.Content
+	return *r.TargetURL
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryEvent) GetAction() string {
-	if r == nil || r.Action == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
 	}
-	return *r.Action
+	return *r.UpdatedAt
 }
 
-// GetInstallation returns the Installation field.
-func (r *RepositoryEvent) GetInstallation() *Installation {
-	if r == nil {
-		return nil
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *RepoStatus) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
 	}
-	return r.Installation
+	return *r.URL
 }
 
-// GetOrg returns the Org field.
-func (r *RepositoryEvent) GetOrg() *Organization {
+// GetRetry returns the Retry field.
+func (r *RequestPolicy) GetRetry() *RetryPolicy {
 	if r == nil {
 		return nil
 	}
-	return r.Org
+	return r.Retry
 }
 
-// GetRepo returns the Repo field.
-func (r *RepositoryEvent) GetRepo() *Repository {
-	if r == nil {
-		return nil
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RequiredReviewer) GetType() string {
+	if r == nil || r.Type == nil {
+		return ""
 	}
-	return r.Repo
+	return *r.Type
 }
 
-// GetSender returns the Sender field.
-func (r *RepositoryEvent) GetSender() *User {
-	if r == nil {
-		return nil
+// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
+func (r *RequiredStatusChecksRequest) GetStrict() bool {
+	if r == nil || r.Strict == nil {
+		return false
 	}
-	return r.Sender
+	return *r.Strict
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
-		return Timestamp{}
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (r *ReviewersRequest) GetNodeID() string {
+	if r == nil || r.NodeID == nil {
+		return ""
 	}
-	return *r.CreatedAt
+	return *r.NodeID
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
-		return ""
+// GetBusy returns the Busy field if it's non-nil, zero value otherwise.
+func (r *Runner) GetBusy() bool {
+	if r == nil || r.Busy == nil {
+		return false
 	}
-	return *r.HTMLURL
+	return *r.Busy
 }
 
 // GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetID() int64 {
+func (r *Runner) GetID() int64 {
 	if r == nil || r.ID == nil {
 		return 0
 	}
 	return *r.ID
 }
 
-// GetInvitee returns the Invitee field.
-func (r *RepositoryInvitation) GetInvitee() *User {
-	if r == nil {
-		return nil
-	}
-	return r.Invitee
-}
-
-// GetInviter returns the Inviter field.
-func (r *RepositoryInvitation) GetInviter() *User {
-	if r == nil {
-		return nil
-	}
-	return r.Inviter
-}
-
-// GetPermissions returns the Permissions field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetPermissions() string {
-	if r == nil || r.Permissions == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Runner) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.Permissions
-}
-
-// GetRepo returns the Repo field.
-func (r *RepositoryInvitation) GetRepo() *Repository {
-	if r == nil {
-		return nil
-	}
-	return r.Repo
+	return *r.Name
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryInvitation) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetOS returns the OS field if it's non-nil, zero value otherwise.
+func (r *Runner) GetOS() string {
+	if r == nil || r.OS == nil {
 		return ""
 	}
-	return *r.URL
+	return *r.OS
 }
 
-// GetContent returns the Content field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetContent() string {
-	if r == nil || r.Content == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (r *Runner) GetStatus() string {
+	if r == nil || r.Status == nil {
 		return ""
 	}
-	return *r.Content
+	return *r.Status
 }
 
-// GetDownloadURL returns the DownloadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetDownloadURL() string {
-	if r == nil || r.DownloadURL == nil {
-		return ""
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
 	}
-	return *r.DownloadURL
+	return *r.ID
 }
 
-// GetEncoding returns the Encoding field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetEncoding() string {
-	if r == nil || r.Encoding == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetName() string {
+	if r == nil || r.Name == nil {
 		return ""
 	}
-	return *r.Encoding
+	return *r.Name
 }
 
-// GetGitURL returns the GitURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetGitURL() string {
-	if r == nil || r.GitURL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (r *RunnerLabels) GetType() string {
+	if r == nil || r.Type == nil {
 		return ""
 	}
-	return *r.GitURL
+	return *r.Type
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (s *SavedReply) GetBody() string {
+	if s == nil || s.Body == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.Body
 }
 
-// GetLicense returns the License field.
-func (r *RepositoryLicense) GetLicense() *License {
-	if r == nil {
-		return nil
+// GetDatabaseID returns the DatabaseID field if it's non-nil, zero value otherwise.
+func (s *SavedReply) GetDatabaseID() int64 {
+	if s == nil || s.DatabaseID == nil {
+		return 0
 	}
-	return r.License
+	return *s.DatabaseID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetName() string {
-	if r == nil || r.Name == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SavedReply) GetID() string {
+	if s == nil || s.ID == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.ID
 }
 
-// GetPath returns the Path field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetPath() string {
-	if r == nil || r.Path == nil {
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (s *SavedReply) GetTitle() string {
+	if s == nil || s.Title == nil {
 		return ""
 	}
-	return *r.Path
+	return *s.Title
 }
 
-// GetSHA returns the SHA field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSHA() string {
-	if r == nil || r.SHA == nil {
-		return ""
+// GetPageInfo returns the PageInfo field.
+func (s *SavedReplyConnection) GetPageInfo() *PageInfo {
+	if s == nil {
+		return nil
 	}
-	return *r.SHA
+	return s.PageInfo
 }
 
-// GetSize returns the Size field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetSize() int {
-	if r == nil || r.Size == nil {
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (s *SavedReplyConnection) GetTotalCount() int {
+	if s == nil || s.TotalCount == nil {
 		return 0
 	}
-	return *r.Size
+	return *s.TotalCount
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetType() string {
-	if r == nil || r.Type == nil {
-		return ""
+// GetSBOM returns the SBOM field.
+func (s *SBOM) GetSBOM() *SBOMInfo {
+	if s == nil {
+		return nil
 	}
-	return *r.Type
+	return s.SBOM
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryLicense) GetURL() string {
-	if r == nil || r.URL == nil {
-		return ""
+// GetCreationInfo returns the CreationInfo field.
+func (s *SBOMInfo) GetCreationInfo() *CreationInfo {
+	if s == nil {
+		return nil
 	}
-	return *r.URL
+	return s.CreationInfo
 }
 
-// GetBase returns the Base field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetBase() string {
-	if r == nil || r.Base == nil {
+// GetDataLicense returns the DataLicense field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDataLicense() string {
+	if s == nil || s.DataLicense == nil {
 		return ""
 	}
-	return *r.Base
+	return *s.DataLicense
 }
 
-// GetCommitMessage returns the CommitMessage field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetCommitMessage() string {
-	if r == nil || r.CommitMessage == nil {
+// GetDocumentNamespace returns the DocumentNamespace field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetDocumentNamespace() string {
+	if s == nil || s.DocumentNamespace == nil {
 		return ""
 	}
-	return *r.CommitMessage
+	return *s.DocumentNamespace
 }
 
-// GetHead returns the Head field if it's non-nil, zero value otherwise.
-func (r *RepositoryMergeRequest) GetHead() string {
-	if r == nil || r.Head == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *r.Head
+	return *s.Name
 }
 
-// GetPermission returns the Permission field if it's non-nil, zero value otherwise.
-func (r *RepositoryPermissionLevel) GetPermission() string {
-	if r == nil || r.Permission == nil {
+// GetSPDXID returns the SPDXID field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXID() string {
+	if s == nil || s.SPDXID == nil {
 		return ""
 	}
-	return *r.Permission
+	return *s.SPDXID
 }
-
-// GetUser returns the User field.
-func (r *RepositoryPermissionLevel) GetUser() *User {
-	if r == nil {
-		return nil
+
+// GetSPDXVersion returns the SPDXVersion field if it's non-nil, zero value otherwise.
+func (s *SBOMInfo) GetSPDXVersion() string {
+	if s == nil || s.SPDXVersion == nil {
+		return ""
 	}
-	return r.User
+	return *s.SPDXVersion
 }
 
-// GetAssetsURL returns the AssetsURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetAssetsURL() string {
-	if r == nil || r.AssetsURL == nil {
+// GetRelatedSPDXElement returns the RelatedSPDXElement field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelatedSPDXElement() string {
+	if s == nil || s.RelatedSPDXElement == nil {
 		return ""
 	}
-	return *r.AssetsURL
+	return *s.RelatedSPDXElement
 }
 
-// GetAuthor returns the Author field.
-func (r *RepositoryRelease) GetAuthor() *User {
-	if r == nil {
-		return nil
+// GetRelationshipType returns the RelationshipType field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetRelationshipType() string {
+	if s == nil || s.RelationshipType == nil {
+		return ""
 	}
-	return r.Author
+	return *s.RelationshipType
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetBody() string {
-	if r == nil || r.Body == nil {
+// GetSPDXElementID returns the SPDXElementID field if it's non-nil, zero value otherwise.
+func (s *SBOMRelationship) GetSPDXElementID() string {
+	if s == nil || s.SPDXElementID == nil {
 		return ""
 	}
-	return *r.Body
+	return *s.SPDXElementID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetCreatedAt() Timestamp {
-	if r == nil || r.CreatedAt == nil {
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetCreated() Timestamp {
+	if s == nil || s.Created == nil {
 		return Timestamp{}
 	}
-	return *r.CreatedAt
+	return *s.Created
 }
 
-// GetDraft returns the Draft field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetDraft() bool {
-	if r == nil || r.Draft == nil {
-		return false
+// GetLastModified returns the LastModified field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetLastModified() Timestamp {
+	if s == nil || s.LastModified == nil {
+		return Timestamp{}
 	}
-	return *r.Draft
+	return *s.LastModified
 }
 
-// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetHTMLURL() string {
-	if r == nil || r.HTMLURL == nil {
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetLocation() string {
+	if s == nil || s.Location == nil {
 		return ""
 	}
-	return *r.HTMLURL
+	return *s.Location
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetID() int64 {
-	if r == nil || r.ID == nil {
+// GetResourceType returns the ResourceType field if it's non-nil, zero value otherwise.
+func (s *SCIMMeta) GetResourceType() string {
+	if s == nil || s.ResourceType == nil {
+		return ""
+	}
+	return *s.ResourceType
+}
+
+// GetItemsPerPage returns the ItemsPerPage field if it's non-nil, zero value otherwise.
+func (s *SCIMProvisionedIdentities) GetItemsPerPage() int {
+	if s == nil || s.ItemsPerPage == nil {
 		return 0
 	}
-	return *r.ID
+	return *s.ItemsPerPage
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetName() string {
-	if r == nil || r.Name == nil {
-		return ""
+// GetStartIndex returns the StartIndex field if it's non-nil, zero value otherwise.
+func (s *SCIMProvisionedIdentities) GetStartIndex() int {
+	if s == nil || s.StartIndex == nil {
+		return 0
 	}
-	return *r.Name
+	return *s.StartIndex
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
-		return ""
+// GetTotalResults returns the TotalResults field if it's non-nil, zero value otherwise.
+func (s *SCIMProvisionedIdentities) GetTotalResults() int {
+	if s == nil || s.TotalResults == nil {
+		return 0
 	}
-	return *r.NodeID
+	return *s.TotalResults
 }
 
-// GetPrerelease returns the Prerelease field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPrerelease() bool {
-	if r == nil || r.Prerelease == nil {
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (s *SCIMUserAttributes) GetActive() bool {
+	if s == nil || s.Active == nil {
 		return false
 	}
-	return *r.Prerelease
+	return *s.Active
 }
 
-// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetPublishedAt() Timestamp {
-	if r == nil || r.PublishedAt == nil {
-		return Timestamp{}
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (s *SCIMUserAttributes) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
 	}
-	return *r.PublishedAt
+	return *s.DisplayName
 }
 
-// GetTagName returns the TagName field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTagName() string {
-	if r == nil || r.TagName == nil {
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (s *SCIMUserAttributes) GetExternalID() string {
+	if s == nil || s.ExternalID == nil {
 		return ""
 	}
-	return *r.TagName
+	return *s.ExternalID
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SCIMUserAttributes) GetID() string {
+	if s == nil || s.ID == nil {
 		return ""
 	}
-	return *r.TarballURL
+	return *s.ID
 }
 
-// GetTargetCommitish returns the TargetCommitish field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetTargetCommitish() string {
-	if r == nil || r.TargetCommitish == nil {
-		return ""
+// GetMeta returns the Meta field.
+func (s *SCIMUserAttributes) GetMeta() *SCIMMeta {
+	if s == nil {
+		return nil
 	}
-	return *r.TargetCommitish
+	return s.Meta
 }
 
-// GetUploadURL returns the UploadURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetUploadURL() string {
-	if r == nil || r.UploadURL == nil {
-		return ""
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (s *SCIMUserEmail) GetPrimary() bool {
+	if s == nil || s.Primary == nil {
+		return false
 	}
-	return *r.UploadURL
+	return *s.Primary
 }
 
-// GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetURL() string {
-	if r == nil || r.URL == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (s *SCIMUserEmail) GetType() string {
+	if s == nil || s.Type == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.Type
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryRelease) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
+// GetFormatted returns the Formatted field if it's non-nil, zero value otherwise.
+func (s *SCIMUserName) GetFormatted() string {
+	if s == nil || s.Formatted == nil {
 		return ""
 	}
-	return *r.ZipballURL
+	return *s.Formatted
 }
 
-// GetCommit returns the Commit field.
-func (r *RepositoryTag) GetCommit() *Commit {
-	if r == nil {
-		return nil
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *Secret) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return r.Commit
+	return *s.CreatedAt
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetName() string {
-	if r == nil || r.Name == nil {
+func (s *Secret) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *r.Name
+	return *s.Name
 }
 
-// GetTarballURL returns the TarballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetTarballURL() string {
-	if r == nil || r.TarballURL == nil {
+// GetSelectedRepositoriesURL returns the SelectedRepositoriesURL field if it's non-nil, zero value otherwise.
+func (s *Secret) GetSelectedRepositoriesURL() string {
+	if s == nil || s.SelectedRepositoriesURL == nil {
 		return ""
 	}
-	return *r.TarballURL
+	return *s.SelectedRepositoriesURL
 }
 
-// GetZipballURL returns the ZipballURL field if it's non-nil, zero value otherwise.
-func (r *RepositoryTag) GetZipballURL() string {
-	if r == nil || r.ZipballURL == nil {
-		return ""
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (s *Secret) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.ZipballURL
+	return *s.UpdatedAt
 }
 
-// GetAction returns the Action field if it's non-nil, zero value otherwise.
-func (r *RepositoryVulnerabilityAlertEvent) GetAction() string {
-	if r == nil || r.Action == nil {
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (s *Secret) GetVisibility() string {
+	if s == nil || s.Visibility == nil {
 		return ""
 	}
-	return *r.Action
+	return *s.Visibility
 }
 
-// GetForkRepos returns the ForkRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetForkRepos() int {
-	if r == nil || r.ForkRepos == nil {
-		return 0
+// GetAuthor returns the Author field.
+func (s *SecurityAdvisory) GetAuthor() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.ForkRepos
+	return s.Author
 }
 
-// GetOrgRepos returns the OrgRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetOrgRepos() int {
-	if r == nil || r.OrgRepos == nil {
-		return 0
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetClosedAt() Timestamp {
+	if s == nil || s.ClosedAt == nil {
+		return Timestamp{}
 	}
-	return *r.OrgRepos
+	return *s.ClosedAt
 }
 
-// GetRootRepos returns the RootRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetRootRepos() int {
-	if r == nil || r.RootRepos == nil {
-		return 0
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.RootRepos
+	return *s.CreatedAt
 }
 
-// GetTotalPushes returns the TotalPushes field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalPushes() int {
-	if r == nil || r.TotalPushes == nil {
-		return 0
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
+		return ""
 	}
-	return *r.TotalPushes
+	return *s.CVEID
 }
 
-// GetTotalRepos returns the TotalRepos field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalRepos() int {
-	if r == nil || r.TotalRepos == nil {
-		return 0
+// GetCVSS returns the CVSS field.
+func (s *SecurityAdvisory) GetCVSS() *AdvisoryCVSS {
+	if s == nil {
+		return nil
 	}
-	return *r.TotalRepos
+	return s.CVSS
 }
 
-// GetTotalWikis returns the TotalWikis field if it's non-nil, zero value otherwise.
-func (r *RepoStats) GetTotalWikis() int {
-	if r == nil || r.TotalWikis == nil {
-		return 0
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
 	}
-	return *r.TotalWikis
+	return *s.Description
 }
 
-// GetContext returns the Context field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetContext() string {
-	if r == nil || r.Context == nil {
+// GetGHSAID returns the GHSAID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetGHSAID() string {
+	if s == nil || s.GHSAID == nil {
 		return ""
 	}
-	return *r.Context
+	return *s.GHSAID
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetCreatedAt() time.Time {
-	if r == nil || r.CreatedAt == nil {
-		return time.Time{}
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetHTMLURL() string {
+	if s == nil || s.HTMLURL == nil {
+		return ""
 	}
-	return *r.CreatedAt
+	return *s.HTMLURL
 }
 
-// GetCreator returns the Creator field.
-func (r *RepoStatus) GetCreator() *User {
-	if r == nil {
+// GetPrivateFork returns the PrivateFork field.
+func (s *SecurityAdvisory) GetPrivateFork() *Repository {
+	if s == nil {
 		return nil
 	}
-	return r.Creator
+	return s.PrivateFork
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetDescription() string {
-	if r == nil || r.Description == nil {
-		return ""
+// GetPublishedAt returns the PublishedAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetPublishedAt() Timestamp {
+	if s == nil || s.PublishedAt == nil {
+		return Timestamp{}
 	}
-	return *r.Description
+	return *s.PublishedAt
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetID() int64 {
-	if r == nil || r.ID == nil {
-		return 0
+// GetPublisher returns the Publisher field.
+func (s *SecurityAdvisory) GetPublisher() *User {
+	if s == nil {
+		return nil
 	}
-	return *r.ID
+	return s.Publisher
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSeverity() string {
+	if s == nil || s.Severity == nil {
 		return ""
 	}
-	return *r.NodeID
+	return *s.Severity
 }
 
 // GetState returns the State field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetState() string {
-	if r == nil || r.State == nil {
+func (s *SecurityAdvisory) GetState() string {
+	if s == nil || s.State == nil {
 		return ""
 	}
-	return *r.State
+	return *s.State
 }
 
-// GetTargetURL returns the TargetURL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetTargetURL() string {
-	if r == nil || r.TargetURL == nil {
+// GetSubmission returns the Submission field.
+func (s *SecurityAdvisory) GetSubmission() *SecurityAdvisorySubmission {
+	if s == nil {
+		return nil
+	}
+	return s.Submission
+}
+
+// GetSummary returns the Summary field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetSummary() string {
+	if s == nil || s.Summary == nil {
 		return ""
 	}
-	return *r.TargetURL
+	return *s.Summary
 }
 
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetUpdatedAt() time.Time {
-	if r == nil || r.UpdatedAt == nil {
-		return time.Time{}
+func (s *SecurityAdvisory) GetUpdatedAt() Timestamp {
+	if s == nil || s.UpdatedAt == nil {
+		return Timestamp{}
 	}
-	return *r.UpdatedAt
+	return *s.UpdatedAt
 }
 
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
-func (r *RepoStatus) GetURL() string {
-	if r == nil || r.URL == nil {
+func (s *SecurityAdvisory) GetURL() string {
+	if s == nil || s.URL == nil {
 		return ""
 	}
-	return *r.URL
+	return *s.URL
 }
 
-// GetStrict returns the Strict field if it's non-nil, zero value otherwise.
-func (r *RequiredStatusChecksRequest) GetStrict() bool {
-	if r == nil || r.Strict == nil {
+// GetWithdrawnAt returns the WithdrawnAt field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisory) GetWithdrawnAt() Timestamp {
+	if s == nil || s.WithdrawnAt == nil {
+		return Timestamp{}
+	}
+	return *s.WithdrawnAt
+}
+
+// GetCVEID returns the CVEID field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetCVEID() string {
+	if s == nil || s.CVEID == nil {
+		return ""
+	}
+	return *s.CVEID
+}
+
+// GetCVSSVectorString returns the CVSSVectorString field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetCVSSVectorString() string {
+	if s == nil || s.CVSSVectorString == nil {
+		return ""
+	}
+	return *s.CVSSVectorString
+}
+
+// GetSeverity returns the Severity field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisoryRequest) GetSeverity() string {
+	if s == nil || s.Severity == nil {
+		return ""
+	}
+	return *s.Severity
+}
+
+// GetAccepted returns the Accepted field if it's non-nil, zero value otherwise.
+func (s *SecurityAdvisorySubmission) GetAccepted() bool {
+	if s == nil || s.Accepted == nil {
 		return false
 	}
-	return *r.Strict
+	return *s.Accepted
 }
 
-// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
-func (r *ReviewersRequest) GetNodeID() string {
-	if r == nil || r.NodeID == nil {
-		return ""
+// GetTotalCount returns the TotalCount field if it's non-nil, zero value otherwise.
+func (s *SelectedReposList) GetTotalCount() int {
+	if s == nil || s.TotalCount == nil {
+		return 0
 	}
-	return *r.NodeID
+	return *s.TotalCount
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
@@ -10700,6 +16132,54 @@ func (s *SourceImportAuthor) GetURL() string {
 	return *s.URL
 }
 
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (s *SSHCertificateAuthority) GetCreatedAt() Timestamp {
+	if s == nil || s.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *s.CreatedAt
+}
+
+// GetFingerprint returns the Fingerprint field if it's non-nil, zero value otherwise.
+func (s *SSHCertificateAuthority) GetFingerprint() string {
+	if s == nil || s.Fingerprint == nil {
+		return ""
+	}
+	return *s.Fingerprint
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (s *SSHCertificateAuthority) GetID() int64 {
+	if s == nil || s.ID == nil {
+		return 0
+	}
+	return *s.ID
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (s *SSHCertificateAuthority) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (s *SSHKey) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// GetPrettyPrint returns the PrettyPrint field if it's non-nil, zero value otherwise.
+func (s *SSHKey) GetPrettyPrint() string {
+	if s == nil || s.PrettyPrint == nil {
+		return ""
+	}
+	return *s.PrettyPrint
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (s *StarEvent) GetAction() string {
 	if s == nil || s.Action == nil {
@@ -10852,6 +16332,14 @@ func (s *StatusEvent) GetUpdatedAt() Timestamp {
 	return *s.UpdatedAt
 }
 
+// GetStep returns the Step field.
+func (s *StepTiming) GetStep() *TaskStep {
+	if s == nil {
+		return nil
+	}
+	return s.Step
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (s *Subscription) GetCreatedAt() Timestamp {
 	if s == nil || s.CreatedAt == nil {
@@ -10972,6 +16460,54 @@ func (t *Tag) GetVerification() *SignatureVerification {
 	return t.Verification
 }
 
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetCompletedAt() Timestamp {
+	if t == nil || t.CompletedAt == nil {
+		return Timestamp{}
+	}
+	return *t.CompletedAt
+}
+
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetConclusion() string {
+	if t == nil || t.Conclusion == nil {
+		return ""
+	}
+	return *t.Conclusion
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetNumber() int64 {
+	if t == nil || t.Number == nil {
+		return 0
+	}
+	return *t.Number
+}
+
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetStartedAt() Timestamp {
+	if t == nil || t.StartedAt == nil {
+		return Timestamp{}
+	}
+	return *t.StartedAt
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (t *TaskStep) GetStatus() string {
+	if t == nil || t.Status == nil {
+		return ""
+	}
+	return *t.Status
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (t *Team) GetDescription() string {
 	if t == nil || t.Description == nil {
@@ -11556,6 +17092,14 @@ func (t *Timeline) GetURL() string {
 	return *t.URL
 }
 
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (t *TokenInfo) GetExpiresAt() time.Time {
+	if t == nil || t.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return *t.ExpiresAt
+}
+
 // GetCount returns the Count field if it's non-nil, zero value otherwise.
 func (t *TrafficClones) GetCount() int {
 	if t == nil || t.Count == nil {
@@ -11740,6 +17284,14 @@ func (t *TreeEntry) GetURL() string {
 	return *t.URL
 }
 
+// GetPath returns the Path field if it's non-nil, zero value otherwise.
+func (u *UpdateAttributeForSCIMUserOperations) GetPath() string {
+	if u == nil || u.Path == nil {
+		return ""
+	}
+	return *u.Path
+}
+
 // GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
 func (u *UpdateCheckRunOptions) GetCompletedAt() Timestamp {
 	if u == nil || u.CompletedAt == nil {
@@ -11804,6 +17356,286 @@ func (u *UpdateCheckRunOptions) GetStatus() string {
 	return *u.Status
 }
 
+// GetQuerySuite returns the QuerySuite field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationOptions) GetQuerySuite() string {
+	if u == nil || u.QuerySuite == nil {
+		return ""
+	}
+	return *u.QuerySuite
+}
+
+// GetRunID returns the RunID field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunID() int64 {
+	if u == nil || u.RunID == nil {
+		return 0
+	}
+	return *u.RunID
+}
+
+// GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
+func (u *UpdateDefaultSetupConfigurationResponse) GetRunURL() string {
+	if u == nil || u.RunURL == nil {
+		return ""
+	}
+	return *u.RunURL
+}
+
+// GetAllowsPublicRepositories returns the AllowsPublicRepositories field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetAllowsPublicRepositories() bool {
+	if u == nil || u.AllowsPublicRepositories == nil {
+		return false
+	}
+	return *u.AllowsPublicRepositories
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetRestrictedToWorkflows returns the RestrictedToWorkflows field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetRestrictedToWorkflows() bool {
+	if u == nil || u.RestrictedToWorkflows == nil {
+		return false
+	}
+	return *u.RestrictedToWorkflows
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (u *UpdateEnterpriseRunnerGroupRequest) GetVisibility() string {
+	if u == nil || u.Visibility == nil {
+		return ""
+	}
+	return *u.Visibility
+}
+
+// GetEnableStaticIP returns the EnableStaticIP field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetEnableStaticIP() bool {
+	if u == nil || u.EnableStaticIP == nil {
+		return false
+	}
+	return *u.EnableStaticIP
+}
+
+// GetImageID returns the ImageID field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetImageID() string {
+	if u == nil || u.ImageID == nil {
+		return ""
+	}
+	return *u.ImageID
+}
+
+// GetImageVersion returns the ImageVersion field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetImageVersion() string {
+	if u == nil || u.ImageVersion == nil {
+		return ""
+	}
+	return *u.ImageVersion
+}
+
+// GetMaximumRunners returns the MaximumRunners field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetMaximumRunners() int64 {
+	if u == nil || u.MaximumRunners == nil {
+		return 0
+	}
+	return *u.MaximumRunners
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetRunnerGroupID() int64 {
+	if u == nil || u.RunnerGroupID == nil {
+		return 0
+	}
+	return *u.RunnerGroupID
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (u *UpdateHostedRunnerRequest) GetSize() string {
+	if u == nil || u.Size == nil {
+		return ""
+	}
+	return *u.Size
+}
+
+// GetEncryptedValue returns the EncryptedValue field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetEncryptedValue() string {
+	if u == nil || u.EncryptedValue == nil {
+		return ""
+	}
+	return *u.EncryptedValue
+}
+
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetKeyID() string {
+	if u == nil || u.KeyID == nil {
+		return ""
+	}
+	return *u.KeyID
+}
+
+// GetRegistryType returns the RegistryType field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetRegistryType() string {
+	if u == nil || u.RegistryType == nil {
+		return ""
+	}
+	return *u.RegistryType
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetURL() string {
+	if u == nil || u.URL == nil {
+		return ""
+	}
+	return *u.URL
+}
+
+// GetUsername returns the Username field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetUsername() string {
+	if u == nil || u.Username == nil {
+		return ""
+	}
+	return *u.Username
+}
+
+// GetVisibility returns the Visibility field if it's non-nil, zero value otherwise.
+func (u *UpdatePrivateRegistryRequest) GetVisibility() string {
+	if u == nil || u.Visibility == nil {
+		return ""
+	}
+	return *u.Visibility
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetDate() string {
+	if u == nil || u.Date == nil {
+		return ""
+	}
+	return *u.Date
+}
+
+// GetDiscountAmount returns the DiscountAmount field.
+func (u *UsageItem) GetDiscountAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DiscountAmount
+}
+
+// GetGrossAmount returns the GrossAmount field.
+func (u *UsageItem) GetGrossAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.GrossAmount
+}
+
+// GetNetAmount returns the NetAmount field.
+func (u *UsageItem) GetNetAmount() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.NetAmount
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetOrganizationName() string {
+	if u == nil || u.OrganizationName == nil {
+		return ""
+	}
+	return *u.OrganizationName
+}
+
+// GetPricePerUnit returns the PricePerUnit field.
+func (u *UsageItem) GetPricePerUnit() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.PricePerUnit
+}
+
+// GetProduct returns the Product field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetProduct() string {
+	if u == nil || u.Product == nil {
+		return ""
+	}
+	return *u.Product
+}
+
+// GetQuantity returns the Quantity field.
+func (u *UsageItem) GetQuantity() *float64 {
+	if u == nil {
+		return nil
+	}
+	return u.Quantity
+}
+
+// GetRepositoryName returns the RepositoryName field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetRepositoryName() string {
+	if u == nil || u.RepositoryName == nil {
+		return ""
+	}
+	return *u.RepositoryName
+}
+
+// GetSKU returns the SKU field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetSKU() string {
+	if u == nil || u.SKU == nil {
+		return ""
+	}
+	return *u.SKU
+}
+
+// GetUnitType returns the UnitType field if it's non-nil, zero value otherwise.
+func (u *UsageItem) GetUnitType() string {
+	if u == nil || u.UnitType == nil {
+		return ""
+	}
+	return *u.UnitType
+}
+
+// GetDay returns the Day field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetDay() int {
+	if u == nil || u.Day == nil {
+		return 0
+	}
+	return *u.Day
+}
+
+// GetHour returns the Hour field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetHour() int {
+	if u == nil || u.Hour == nil {
+		return 0
+	}
+	return *u.Hour
+}
+
+// GetMonth returns the Month field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetMonth() int {
+	if u == nil || u.Month == nil {
+		return 0
+	}
+	return *u.Month
+}
+
+// GetYear returns the Year field if it's non-nil, zero value otherwise.
+func (u *UsageReportOptions) GetYear() int {
+	if u == nil || u.Year == nil {
+		return 0
+	}
+	return *u.Year
+}
+
 // GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
 func (u *User) GetAvatarURL() string {
 	if u == nil || u.AvatarURL == nil {
@@ -11945,7 +17777,15 @@ func (u *User) GetID() int64 {
 	if u == nil || u.ID == nil {
 		return 0
 	}
-	return *u.ID
+	return *u.ID
+}
+
+// GetLDAPDN returns the LDAPDN field if it's non-nil, zero value otherwise.
+func (u *User) GetLDAPDN() string {
+	if u == nil || u.LDAPDN == nil {
+		return ""
+	}
+	return *u.LDAPDN
 }
 
 // GetLocation returns the Location field if it's non-nil, zero value otherwise.
@@ -12412,6 +18252,22 @@ func (u *UserSuspendOptions) GetReason() string {
 	return *u.Reason
 }
 
+// GetEcosystem returns the Ecosystem field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetEcosystem() string {
+	if v == nil || v.Ecosystem == nil {
+		return ""
+	}
+	return *v.Ecosystem
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (v *VulnerabilityPackage) GetName() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (w *WatchEvent) GetAction() string {
 	if w == nil || w.Action == nil {
@@ -12651,3 +18507,411 @@ func (w *WeeklyStats) GetWeek() Timestamp {
 	}
 	return *w.Week
 }
+
+// GetTotalMS returns the TotalMS field if it's non-nil, zero value otherwise.
+func (w *WorkflowBill) GetTotalMS() int64 {
+	if w == nil || w.TotalMS == nil {
+		return 0
+	}
+	return *w.TotalMS
+}
+
+// GetCheckRunURL returns the CheckRunURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetCheckRunURL() string {
+	if w == nil || w.CheckRunURL == nil {
+		return ""
+	}
+	return *w.CheckRunURL
+}
+
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetCompletedAt() Timestamp {
+	if w == nil || w.CompletedAt == nil {
+		return Timestamp{}
+	}
+	return *w.CompletedAt
+}
+
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetConclusion() string {
+	if w == nil || w.Conclusion == nil {
+		return ""
+	}
+	return *w.Conclusion
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetCreatedAt() Timestamp {
+	if w == nil || w.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *w.CreatedAt
+}
+
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetHeadSHA() string {
+	if w == nil || w.HeadSHA == nil {
+		return ""
+	}
+	return *w.HeadSHA
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetHTMLURL() string {
+	if w == nil || w.HTMLURL == nil {
+		return ""
+	}
+	return *w.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetID() int64 {
+	if w == nil || w.ID == nil {
+		return 0
+	}
+	return *w.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetNodeID() string {
+	if w == nil || w.NodeID == nil {
+		return ""
+	}
+	return *w.NodeID
+}
+
+// GetRunAttempt returns the RunAttempt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunAttempt() int64 {
+	if w == nil || w.RunAttempt == nil {
+		return 0
+	}
+	return *w.RunAttempt
+}
+
+// GetRunID returns the RunID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunID() int64 {
+	if w == nil || w.RunID == nil {
+		return 0
+	}
+	return *w.RunID
+}
+
+// GetRunnerGroupID returns the RunnerGroupID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupID() int64 {
+	if w == nil || w.RunnerGroupID == nil {
+		return 0
+	}
+	return *w.RunnerGroupID
+}
+
+// GetRunnerGroupName returns the RunnerGroupName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerGroupName() string {
+	if w == nil || w.RunnerGroupName == nil {
+		return ""
+	}
+	return *w.RunnerGroupName
+}
+
+// GetRunnerID returns the RunnerID field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerID() int64 {
+	if w == nil || w.RunnerID == nil {
+		return 0
+	}
+	return *w.RunnerID
+}
+
+// GetRunnerName returns the RunnerName field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunnerName() string {
+	if w == nil || w.RunnerName == nil {
+		return ""
+	}
+	return *w.RunnerName
+}
+
+// GetRunURL returns the RunURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetRunURL() string {
+	if w == nil || w.RunURL == nil {
+		return ""
+	}
+	return *w.RunURL
+}
+
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetStartedAt() Timestamp {
+	if w == nil || w.StartedAt == nil {
+		return Timestamp{}
+	}
+	return *w.StartedAt
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetStatus() string {
+	if w == nil || w.Status == nil {
+		return ""
+	}
+	return *w.Status
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (w *WorkflowJob) GetURL() string {
+	if w == nil || w.URL == nil {
+		return ""
+	}
+	return *w.URL
+}
+
+// GetActor returns the Actor field.
+func (w *WorkflowRun) GetActor() *User {
+	if w == nil {
+		return nil
+	}
+	return w.Actor
+}
+
+// GetArtifactsURL returns the ArtifactsURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetArtifactsURL() string {
+	if w == nil || w.ArtifactsURL == nil {
+		return ""
+	}
+	return *w.ArtifactsURL
+}
+
+// GetCancelURL returns the CancelURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetCancelURL() string {
+	if w == nil || w.CancelURL == nil {
+		return ""
+	}
+	return *w.CancelURL
+}
+
+// GetCheckSuiteID returns the CheckSuiteID field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetCheckSuiteID() int64 {
+	if w == nil || w.CheckSuiteID == nil {
+		return 0
+	}
+	return *w.CheckSuiteID
+}
+
+// GetCheckSuiteURL returns the CheckSuiteURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetCheckSuiteURL() string {
+	if w == nil || w.CheckSuiteURL == nil {
+		return ""
+	}
+	return *w.CheckSuiteURL
+}
+
+// GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetConclusion() string {
+	if w == nil || w.Conclusion == nil {
+		return ""
+	}
+	return *w.Conclusion
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetCreatedAt() Timestamp {
+	if w == nil || w.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *w.CreatedAt
+}
+
+// GetEvent returns the Event field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetEvent() string {
+	if w == nil || w.Event == nil {
+		return ""
+	}
+	return *w.Event
+}
+
+// GetHeadBranch returns the HeadBranch field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetHeadBranch() string {
+	if w == nil || w.HeadBranch == nil {
+		return ""
+	}
+	return *w.HeadBranch
+}
+
+// GetHeadRepository returns the HeadRepository field.
+func (w *WorkflowRun) GetHeadRepository() *Repository {
+	if w == nil {
+		return nil
+	}
+	return w.HeadRepository
+}
+
+// GetHeadSHA returns the HeadSHA field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetHeadSHA() string {
+	if w == nil || w.HeadSHA == nil {
+		return ""
+	}
+	return *w.HeadSHA
+}
+
+// GetHTMLURL returns the HTMLURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetHTMLURL() string {
+	if w == nil || w.HTMLURL == nil {
+		return ""
+	}
+	return *w.HTMLURL
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetID() int64 {
+	if w == nil || w.ID == nil {
+		return 0
+	}
+	return *w.ID
+}
+
+// GetJobsURL returns the JobsURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetJobsURL() string {
+	if w == nil || w.JobsURL == nil {
+		return ""
+	}
+	return *w.JobsURL
+}
+
+// GetLogsURL returns the LogsURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetLogsURL() string {
+	if w == nil || w.LogsURL == nil {
+		return ""
+	}
+	return *w.LogsURL
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetName() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetNodeID() string {
+	if w == nil || w.NodeID == nil {
+		return ""
+	}
+	return *w.NodeID
+}
+
+// GetPreviousAttemptURL returns the PreviousAttemptURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetPreviousAttemptURL() string {
+	if w == nil || w.PreviousAttemptURL == nil {
+		return ""
+	}
+	return *w.PreviousAttemptURL
+}
+
+// GetRepository returns the Repository field.
+func (w *WorkflowRun) GetRepository() *Repository {
+	if w == nil {
+		return nil
+	}
+	return w.Repository
+}
+
+// GetRerunURL returns the RerunURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRerunURL() string {
+	if w == nil || w.RerunURL == nil {
+		return ""
+	}
+	return *w.RerunURL
+}
+
+// GetRunAttempt returns the RunAttempt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRunAttempt() int {
+	if w == nil || w.RunAttempt == nil {
+		return 0
+	}
+	return *w.RunAttempt
+}
+
+// GetRunNumber returns the RunNumber field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRunNumber() int {
+	if w == nil || w.RunNumber == nil {
+		return 0
+	}
+	return *w.RunNumber
+}
+
+// GetRunStartedAt returns the RunStartedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetRunStartedAt() Timestamp {
+	if w == nil || w.RunStartedAt == nil {
+		return Timestamp{}
+	}
+	return *w.RunStartedAt
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetStatus() string {
+	if w == nil || w.Status == nil {
+		return ""
+	}
+	return *w.Status
+}
+
+// GetTriggeringActor returns the TriggeringActor field.
+func (w *WorkflowRun) GetTriggeringActor() *User {
+	if w == nil {
+		return nil
+	}
+	return w.TriggeringActor
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetUpdatedAt() Timestamp {
+	if w == nil || w.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *w.UpdatedAt
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetURL() string {
+	if w == nil || w.URL == nil {
+		return ""
+	}
+	return *w.URL
+}
+
+// GetWorkflowID returns the WorkflowID field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetWorkflowID() int64 {
+	if w == nil || w.WorkflowID == nil {
+		return 0
+	}
+	return *w.WorkflowID
+}
+
+// GetWorkflowURL returns the WorkflowURL field if it's non-nil, zero value otherwise.
+func (w *WorkflowRun) GetWorkflowURL() string {
+	if w == nil || w.WorkflowURL == nil {
+		return ""
+	}
+	return *w.WorkflowURL
+}
+
+// GetExcludePullRequests returns the ExcludePullRequests field if it's non-nil, zero value otherwise.
+func (w *WorkflowRunAttemptOptions) GetExcludePullRequests() bool {
+	if w == nil || w.ExcludePullRequests == nil {
+		return false
+	}
+	return *w.ExcludePullRequests
+}
+
+// GetBillable returns the Billable field.
+func (w *WorkflowUsage) GetBillable() *WorkflowBillMap {
+	if w == nil {
+		return nil
+	}
+	return w.Billable
+}
@@ -0,0 +1,988 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-interfaces; DO NOT EDIT.
+
+package github
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"time"
+)
+
+// ActionsServiceInterface lists the methods of ActionsService, so that it can be
+// replaced by a fake in tests. See ActionsService for documentation.
+type ActionsServiceInterface interface {
+	AddRepoToRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error)
+	ApproveWorkflowRun(ctx context.Context, owner, repo string, runID int64) (*Response, error)
+	CreateHostedRunner(ctx context.Context, org string, createReq CreateHostedRunnerRequest) (*HostedRunner, *Response, error)
+	CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error)
+	CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error)
+	CreateRequiredWorkflow(ctx context.Context, org string, workflow *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error)
+	CreateValidatedWorkflowDispatchEvent(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest) (*Response, error)
+	CreateWorkflowDispatchEventByFileName(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest) (*Response, error)
+	CreateWorkflowDispatchEventByID(ctx context.Context, owner, repo string, workflowID int64, event CreateWorkflowDispatchEventRequest) (*Response, error)
+	DeleteHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error)
+	DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error)
+	DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error)
+	DeleteRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64) (*Response, error)
+	EditActionsAllowed(ctx context.Context, org string, allowed ActionsAllowed) (*ActionsAllowed, *Response, error)
+	EditActionsPermissions(ctx context.Context, org string, permissions ActionsPermissions) (*ActionsPermissions, *Response, error)
+	GetActionsAllowed(ctx context.Context, org string) (*ActionsAllowed, *Response, error)
+	GetActionsPermissions(ctx context.Context, org string) (*ActionsPermissions, *Response, error)
+	GetHostedRunner(ctx context.Context, org string, runnerID int64) (*HostedRunner, *Response, error)
+	GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error)
+	GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error)
+	GetPendingDeployments(ctx context.Context, owner, repo string, runID int64) ([]*PendingDeployment, *Response, error)
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error)
+	GetRequiredWorkflowByID(ctx context.Context, org string, requiredWorkflowID int64) (*OrgRequiredWorkflow, *Response, error)
+	GetWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opt *WorkflowRunAttemptOptions) (*WorkflowRun, *Response, error)
+	GetWorkflowRunAttemptLogs(ctx context.Context, owner, repo string, runID int64, attemptNumber int) (*url.URL, *Response, error)
+	GetWorkflowUsageByFileName(ctx context.Context, owner, repo, workflowFileName string) (*WorkflowUsage, *Response, error)
+	GetWorkflowUsageByID(ctx context.Context, owner, repo string, workflowID int64) (*WorkflowUsage, *Response, error)
+	ListHostedRunnerMachineSpecs(ctx context.Context, org string) (*HostedRunnerMachineSpecs, *Response, error)
+	ListHostedRunners(ctx context.Context, org string, opt *ListOptions) (*HostedRunners, *Response, error)
+	ListJobsForWorkflowRunAttempt(ctx context.Context, owner, repo string, runID int64, attemptNumber int, opt *ListOptions) (*Jobs, *Response, error)
+	ListOrgRequiredWorkflows(ctx context.Context, org string, opt *ListOptions) (*OrgRequiredWorkflows, *Response, error)
+	ListOrgSecrets(ctx context.Context, org string, opt *ListOptions) (*Secrets, *Response, error)
+	ListRepoRequiredWorkflows(ctx context.Context, owner, repo string, opt *ListOptions) (*RepoRequiredWorkflows, *Response, error)
+	ListRepoSecrets(ctx context.Context, owner, repo string, opt *ListOptions) (*Secrets, *Response, error)
+	ListRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, opt *ListOptions) (*SelectedReposList, *Response, error)
+	PendingDeployments(ctx context.Context, owner, repo string, runID int64, request *PendingDeploymentsRequest) ([]*Deployment, *Response, error)
+	RemoveRepoFromRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID, repoID int64) (*Response, error)
+	ReviewCustomDeploymentProtectionRule(ctx context.Context, owner, repo string, runID int64, request *ReviewCustomDeploymentProtectionRuleRequest) (*Response, error)
+	RolloutSecrets(ctx context.Context, secrets map[string]string, targets []string, opt *RolloutSecretsOptions) []*RolloutSecretsResult
+	SetRequiredWorkflowSelectedRepos(ctx context.Context, org string, requiredWorkflowID int64, ids SelectedRepoIDs) (*Response, error)
+	UpdateHostedRunner(ctx context.Context, org string, runnerID int64, updateReq UpdateHostedRunnerRequest) (*HostedRunner, *Response, error)
+	UpdateRequiredWorkflow(ctx context.Context, org string, requiredWorkflowID int64, workflow *CreateUpdateRequiredWorkflowOptions) (*OrgRequiredWorkflow, *Response, error)
+}
+
+var _ ActionsServiceInterface = (*ActionsService)(nil)
+
+// ActivityServiceInterface lists the methods of ActivityService, so that it can be
+// replaced by a fake in tests. See ActivityService for documentation.
+type ActivityServiceInterface interface {
+	DeleteRepositorySubscription(ctx context.Context, owner, repo string) (*Response, error)
+	DeleteThreadSubscription(ctx context.Context, id string) (*Response, error)
+	GetRepositorySubscription(ctx context.Context, owner, repo string) (*Subscription, *Response, error)
+	GetThread(ctx context.Context, id string) (*Notification, *Response, error)
+	GetThreadSubscription(ctx context.Context, id string) (*Subscription, *Response, error)
+	IsStarred(ctx context.Context, owner, repo string) (bool, *Response, error)
+	ListEvents(ctx context.Context, opt *ListOptions) ([]*Event, *Response, error)
+	ListEventsForOrganization(ctx context.Context, org string, opt *ListOptions) ([]*Event, *Response, error)
+	ListEventsForRepoNetwork(ctx context.Context, owner, repo string, opt *ListOptions) ([]*Event, *Response, error)
+	ListEventsPerformedByUser(ctx context.Context, user string, publicOnly bool, opt *ListOptions) ([]*Event, *Response, error)
+	ListEventsReceivedByUser(ctx context.Context, user string, publicOnly bool, opt *ListOptions) ([]*Event, *Response, error)
+	ListFeeds(ctx context.Context) (*Feeds, *Response, error)
+	ListIssueEventsForRepository(ctx context.Context, owner, repo string, opt *ListOptions) ([]*IssueEvent, *Response, error)
+	ListNotifications(ctx context.Context, opt *NotificationListOptions) ([]*Notification, *Response, error)
+	ListRepositoryEvents(ctx context.Context, owner, repo string, opt *ListOptions) ([]*Event, *Response, error)
+	ListRepositoryNotifications(ctx context.Context, owner, repo string, opt *NotificationListOptions) ([]*Notification, *Response, error)
+	ListStargazers(ctx context.Context, owner, repo string, opt *ListOptions) ([]*Stargazer, *Response, error)
+	ListStarred(ctx context.Context, user string, opt *ActivityListStarredOptions) ([]*StarredRepository, *Response, error)
+	ListUserEventsForOrganization(ctx context.Context, org, user string, opt *ListOptions) ([]*Event, *Response, error)
+	ListWatched(ctx context.Context, user string, opt *ListOptions) ([]*Repository, *Response, error)
+	ListWatchers(ctx context.Context, owner, repo string, opt *ListOptions) ([]*User, *Response, error)
+	MarkNotificationsRead(ctx context.Context, lastRead time.Time) (*Response, error)
+	MarkRepositoryNotificationsRead(ctx context.Context, owner, repo string, lastRead time.Time) (*Response, error)
+	MarkThreadRead(ctx context.Context, id string) (*Response, error)
+	PollRepositoryEvents(ctx context.Context, owner, repo string, events chan<- *Event) error
+	SetRepositorySubscription(ctx context.Context, owner, repo string, subscription *Subscription) (*Subscription, *Response, error)
+	SetThreadSubscription(ctx context.Context, id string, subscription *Subscription) (*Subscription, *Response, error)
+	Star(ctx context.Context, owner, repo string) (*Response, error)
+	StarTrend(ctx context.Context, owner, repo string, interval StarHistoryInterval) (*StarTrend, error)
+	StargazerHistory(ctx context.Context, owner, repo string, interval StarHistoryInterval) ([]*StarHistoryBucket, error)
+	Unstar(ctx context.Context, owner, repo string) (*Response, error)
+	UnwatchRepositories(ctx context.Context, filter RepositoryFilter) ([]*Repository, error)
+}
+
+var _ ActivityServiceInterface = (*ActivityService)(nil)
+
+// AdminServiceInterface lists the methods of AdminService, so that it can be
+// replaced by a fake in tests. See AdminService for documentation.
+type AdminServiceInterface interface {
+	CreateOrg(ctx context.Context, org *Organization, admin string) (*Organization, *Response, error)
+	CreatePreReceiveEnvironment(ctx context.Context, environment *PreReceiveEnvironment) (*PreReceiveEnvironment, *Response, error)
+	CreatePreReceiveHook(ctx context.Context, hook *GlobalPreReceiveHook) (*GlobalPreReceiveHook, *Response, error)
+	CreateUser(ctx context.Context, login, email string) (*User, *Response, error)
+	DeletePreReceiveEnvironment(ctx context.Context, id int64) (*Response, error)
+	DeletePreReceiveHook(ctx context.Context, id int64) (*Response, error)
+	DeleteUser(ctx context.Context, username string) (*Response, error)
+	EditPreReceiveEnvironment(ctx context.Context, id int64, environment *PreReceiveEnvironment) (*PreReceiveEnvironment, *Response, error)
+	EditPreReceiveHook(ctx context.Context, id int64, hook *GlobalPreReceiveHook) (*GlobalPreReceiveHook, *Response, error)
+	GetAdminStats(ctx context.Context) (*AdminStats, *Response, error)
+	GetPreReceiveEnvironment(ctx context.Context, id int64) (*PreReceiveEnvironment, *Response, error)
+	GetPreReceiveEnvironmentDownloadStatus(ctx context.Context, id int64) (*PreReceiveEnvironmentDownload, *Response, error)
+	GetPreReceiveHook(ctx context.Context, id int64) (*GlobalPreReceiveHook, *Response, error)
+	ListPreReceiveEnvironments(ctx context.Context, opt *ListOptions) ([]*PreReceiveEnvironment, *Response, error)
+	ListPreReceiveHooks(ctx context.Context, opt *ListOptions) ([]*GlobalPreReceiveHook, *Response, error)
+	RenameOrg(ctx context.Context, org, newName string) (*RenameResponse, *Response, error)
+	RenameUser(ctx context.Context, username, newLogin string) (*RenameResponse, *Response, error)
+	StartPreReceiveEnvironmentDownload(ctx context.Context, id int64) (*PreReceiveEnvironmentDownload, *Response, error)
+	SyncTeamLDAPMapping(ctx context.Context, team int64) (*LDAPSyncStatus, *Response, error)
+	SyncUserLDAPMapping(ctx context.Context, user string) (*LDAPSyncStatus, *Response, error)
+	UpdateTeamLDAPMapping(ctx context.Context, team int64, mapping *TeamLDAPMapping) (*TeamLDAPMapping, *Response, error)
+	UpdateUserLDAPMapping(ctx context.Context, user string, mapping *UserLDAPMapping) (*UserLDAPMapping, *Response, error)
+}
+
+var _ AdminServiceInterface = (*AdminService)(nil)
+
+// AppsServiceInterface lists the methods of AppsService, so that it can be
+// replaced by a fake in tests. See AppsService for documentation.
+type AppsServiceInterface interface {
+	AddRepository(ctx context.Context, instID, repoID int64) (*Repository, *Response, error)
+	CreateAttachment(ctx context.Context, contentReferenceID int64, title, body string) (*Attachment, *Response, error)
+	CreateInstallationToken(ctx context.Context, id int64) (*InstallationToken, *Response, error)
+	FindOrganizationInstallation(ctx context.Context, org string) (*Installation, *Response, error)
+	FindRepositoryInstallation(ctx context.Context, owner, repo string) (*Installation, *Response, error)
+	FindRepositoryInstallationByID(ctx context.Context, id int64) (*Installation, *Response, error)
+	FindUserInstallation(ctx context.Context, user string) (*Installation, *Response, error)
+	Get(ctx context.Context, appSlug string) (*App, *Response, error)
+	GetInstallation(ctx context.Context, id int64) (*Installation, *Response, error)
+	ListInstallations(ctx context.Context, opt *ListOptions) ([]*Installation, *Response, error)
+	ListRepos(ctx context.Context, opt *ListOptions) ([]*Repository, *Response, error)
+	ListUserInstallations(ctx context.Context, opt *ListOptions) ([]*Installation, *Response, error)
+	ListUserRepos(ctx context.Context, id int64, opt *ListOptions) ([]*Repository, *Response, error)
+	RemoveRepository(ctx context.Context, instID, repoID int64) (*Response, error)
+}
+
+var _ AppsServiceInterface = (*AppsService)(nil)
+
+// AuthorizationsServiceInterface lists the methods of AuthorizationsService, so that it can be
+// replaced by a fake in tests. See AuthorizationsService for documentation.
+type AuthorizationsServiceInterface interface {
+	Check(ctx context.Context, clientID string, token string) (*Authorization, *Response, error)
+	Create(ctx context.Context, auth *AuthorizationRequest) (*Authorization, *Response, error)
+	CreateImpersonation(ctx context.Context, username string, authReq *AuthorizationRequest) (*Authorization, *Response, error)
+	Delete(ctx context.Context, id int64) (*Response, error)
+	DeleteGrant(ctx context.Context, id int64) (*Response, error)
+	DeleteImpersonation(ctx context.Context, username string) (*Response, error)
+	Edit(ctx context.Context, id int64, auth *AuthorizationUpdateRequest) (*Authorization, *Response, error)
+	Get(ctx context.Context, id int64) (*Authorization, *Response, error)
+	GetGrant(ctx context.Context, id int64) (*Grant, *Response, error)
+	GetOrCreateForApp(ctx context.Context, clientID string, auth *AuthorizationRequest) (*Authorization, *Response, error)
+	List(ctx context.Context, opt *ListOptions) ([]*Authorization, *Response, error)
+	ListGrants(ctx context.Context, opt *ListOptions) ([]*Grant, *Response, error)
+	Reset(ctx context.Context, clientID string, token string) (*Authorization, *Response, error)
+	Revoke(ctx context.Context, clientID string, token string) (*Response, error)
+}
+
+var _ AuthorizationsServiceInterface = (*AuthorizationsService)(nil)
+
+// BillingServiceInterface lists the methods of BillingService, so that it can be
+// replaced by a fake in tests. See BillingService for documentation.
+type BillingServiceInterface interface {
+	GetOrgActionsBillingUsage(ctx context.Context, org string) (*ActionsBilling, *Response, error)
+	GetOrgPackagesBillingUsage(ctx context.Context, org string) (*PackagesBilling, *Response, error)
+	GetOrgStorageBillingUsage(ctx context.Context, org string) (*StorageBilling, *Response, error)
+	GetOrgUsageReport(ctx context.Context, org string, opt *UsageReportOptions) (*UsageReport, *Response, error)
+	GetUserActionsBillingUsage(ctx context.Context, user string) (*ActionsBilling, *Response, error)
+	GetUserPackagesBillingUsage(ctx context.Context, user string) (*PackagesBilling, *Response, error)
+	GetUserStorageBillingUsage(ctx context.Context, user string) (*StorageBilling, *Response, error)
+	GetUserUsageReport(ctx context.Context, user string, opt *UsageReportOptions) (*UsageReport, *Response, error)
+}
+
+var _ BillingServiceInterface = (*BillingService)(nil)
+
+// ChecksServiceInterface lists the methods of ChecksService, so that it can be
+// replaced by a fake in tests. See ChecksService for documentation.
+type ChecksServiceInterface interface {
+	CreateCheckRun(ctx context.Context, owner, repo string, opt CreateCheckRunOptions) (*CheckRun, *Response, error)
+	CreateCheckSuite(ctx context.Context, owner, repo string, opt CreateCheckSuiteOptions) (*CheckSuite, *Response, error)
+	GetCheckRun(ctx context.Context, owner, repo string, checkRunID int64) (*CheckRun, *Response, error)
+	GetCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64) (*CheckSuite, *Response, error)
+	ListCheckRunAnnotations(ctx context.Context, owner, repo string, checkRunID int64, opt *ListOptions) ([]*CheckRunAnnotation, *Response, error)
+	ListCheckRunsCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64, opt *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error)
+	ListCheckRunsForRef(ctx context.Context, owner, repo, ref string, opt *ListCheckRunsOptions) (*ListCheckRunsResults, *Response, error)
+	ListCheckSuitesForRef(ctx context.Context, owner, repo, ref string, opt *ListCheckSuiteOptions) (*ListCheckSuiteResults, *Response, error)
+	ReRequestCheckSuite(ctx context.Context, owner, repo string, checkSuiteID int64) (*Response, error)
+	SetCheckSuitePreferences(ctx context.Context, owner, repo string, opt CheckSuitePreferenceOptions) (*CheckSuitePreferenceResults, *Response, error)
+	UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64, opt UpdateCheckRunOptions) (*CheckRun, *Response, error)
+}
+
+var _ ChecksServiceInterface = (*ChecksService)(nil)
+
+// ClassroomServiceInterface lists the methods of ClassroomService, so that it can be
+// replaced by a fake in tests. See ClassroomService for documentation.
+type ClassroomServiceInterface interface {
+	GetAssignment(ctx context.Context, assignmentID int64) (*ClassroomAssignment, *Response, error)
+	GetAssignmentGrades(ctx context.Context, assignmentID int64) ([]*ClassroomAssignmentGrade, *Response, error)
+	GetClassroom(ctx context.Context, classroomID int64) (*Classroom, *Response, error)
+	ListAcceptedAssignments(ctx context.Context, assignmentID int64, opt *ListOptions) ([]*ClassroomAcceptedAssignment, *Response, error)
+	ListClassroomAssignments(ctx context.Context, classroomID int64, opt *ListOptions) ([]*ClassroomAssignment, *Response, error)
+	ListClassrooms(ctx context.Context, opt *ListOptions) ([]*Classroom, *Response, error)
+}
+
+var _ ClassroomServiceInterface = (*ClassroomService)(nil)
+
+// CodeScanningServiceInterface lists the methods of CodeScanningService, so that it can be
+// replaced by a fake in tests. See CodeScanningService for documentation.
+type CodeScanningServiceInterface interface {
+	GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*DefaultSetupConfiguration, *Response, error)
+	UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, opt *UpdateDefaultSetupConfigurationOptions) (*UpdateDefaultSetupConfigurationResponse, *Response, error)
+}
+
+var _ CodeScanningServiceInterface = (*CodeScanningService)(nil)
+
+// DependabotServiceInterface lists the methods of DependabotService, so that it can be
+// replaced by a fake in tests. See DependabotService for documentation.
+type DependabotServiceInterface interface {
+	AddSelectedRepoToOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error)
+	CreateOrUpdateOrgSecret(ctx context.Context, org string, eSecret *EncryptedSecret) (*Response, error)
+	CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *EncryptedSecret) (*Response, error)
+	CreateOrgPrivateRegistry(ctx context.Context, org string, registry *CreatePrivateRegistryRequest) (*PrivateRegistry, *Response, error)
+	DeleteOrgPrivateRegistry(ctx context.Context, org, name string) (*Response, error)
+	DeleteOrgSecret(ctx context.Context, org, name string) (*Response, error)
+	DeleteRepoSecret(ctx context.Context, owner, repo, name string) (*Response, error)
+	GetOrgPrivateRegistriesPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error)
+	GetOrgPrivateRegistry(ctx context.Context, org, name string) (*PrivateRegistry, *Response, error)
+	GetOrgPublicKey(ctx context.Context, org string) (*PublicKey, *Response, error)
+	GetOrgSecret(ctx context.Context, org, name string) (*Secret, *Response, error)
+	GetRepoAlert(ctx context.Context, owner, repo string, number int) (*DependabotAlert, *Response, error)
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*PublicKey, *Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*Secret, *Response, error)
+	ListOrgAlerts(ctx context.Context, org string, opt *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error)
+	ListOrgPrivateRegistries(ctx context.Context, org string, opt *ListOptions) (*PrivateRegistries, *Response, error)
+	ListOrgSecrets(ctx context.Context, org string, opt *ListOptions) (*Secrets, *Response, error)
+	ListRepoAlerts(ctx context.Context, owner, repo string, opt *DependabotAlertListOptions) ([]*DependabotAlert, *Response, error)
+	ListRepoSecrets(ctx context.Context, owner, repo string, opt *ListOptions) (*Secrets, *Response, error)
+	ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opt *ListOptions) (*SelectedReposList, *Response, error)
+	RemoveSelectedRepoFromOrgSecret(ctx context.Context, org, name string, repo *Repository) (*Response, error)
+	SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids SelectedRepoIDs) (*Response, error)
+	UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *DependabotAlertState) (*DependabotAlert, *Response, error)
+	UpdateOrgPrivateRegistry(ctx context.Context, org, name string, registry *UpdatePrivateRegistryRequest) (*Response, error)
+}
+
+var _ DependabotServiceInterface = (*DependabotService)(nil)
+
+// DependencyGraphServiceInterface lists the methods of DependencyGraphService, so that it can be
+// replaced by a fake in tests. See DependencyGraphService for documentation.
+type DependencyGraphServiceInterface interface {
+	CreateSnapshot(ctx context.Context, owner, repo string, snapshot *DependencyGraphSnapshot) (*DependencyGraphSnapshotCreationData, *Response, error)
+	GetDependencyDiff(ctx context.Context, owner, repo, base, head string, opt *DependencyDiffOptions) ([]*DependencyChange, *Response, error)
+	GetSBOM(ctx context.Context, owner, repo string) (*SBOM, *Response, error)
+}
+
+var _ DependencyGraphServiceInterface = (*DependencyGraphService)(nil)
+
+// DiscussionsServiceInterface lists the methods of DiscussionsService, so that it can be
+// replaced by a fake in tests. See DiscussionsService for documentation.
+type DiscussionsServiceInterface interface {
+	AddDiscussionComment(ctx context.Context, discussionID, body, replyToID string) (*RepoDiscussionComment, *Response, error)
+	AddDiscussionPollVote(ctx context.Context, pollOptionID string) (*DiscussionPollOption, *Response, error)
+	CloseDiscussion(ctx context.Context, discussionID, reason string) (*Response, error)
+	CreateDiscussion(ctx context.Context, input *CreateDiscussionInput) (*Discussion, *Response, error)
+	DeleteDiscussion(ctx context.Context, discussionID string) (*Response, error)
+	DeleteDiscussionComment(ctx context.Context, commentID string) (*Response, error)
+	GetDiscussion(ctx context.Context, owner, repo string, number int) (*Discussion, *Response, error)
+	ListDiscussionCategories(ctx context.Context, owner, repo string, opt *GraphQLListOptions) (*DiscussionCategoryConnection, *Response, error)
+	ListDiscussionComments(ctx context.Context, discussionID string, opt *GraphQLListOptions) (*RepoDiscussionCommentConnection, *Response, error)
+	ListDiscussions(ctx context.Context, owner, repo string, opt *RepoDiscussionListOptions) (*DiscussionConnection, *Response, error)
+	MarkDiscussionCommentAsAnswer(ctx context.Context, commentID string) (*Response, error)
+	ReopenDiscussion(ctx context.Context, discussionID string) (*Response, error)
+	UnmarkDiscussionCommentAsAnswer(ctx context.Context, commentID string) (*Response, error)
+}
+
+var _ DiscussionsServiceInterface = (*DiscussionsService)(nil)
+
+// EnterpriseServiceInterface lists the methods of EnterpriseService, so that it can be
+// replaced by a fake in tests. See EnterpriseService for documentation.
+type EnterpriseServiceInterface interface {
+	AddOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID, orgID int64) (*Response, error)
+	AddRunnerGroupRunners(ctx context.Context, enterprise string, groupID, runnerID int64) (*Response, error)
+	CreateAuditLogStream(ctx context.Context, enterprise string, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error)
+	CreateEnterpriseHostedRunner(ctx context.Context, enterprise string, createReq CreateHostedRunnerRequest) (*HostedRunner, *Response, error)
+	CreateEnterpriseNetworkConfiguration(ctx context.Context, enterprise string, createReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error)
+	CreateEnterpriseRunnerGroup(ctx context.Context, enterprise string, createReq CreateEnterpriseRunnerGroupRequest) (*EnterpriseRunnerGroup, *Response, error)
+	CreateSSHCertificateAuthority(ctx context.Context, enterprise, key string) (*SSHCertificateAuthority, *Response, error)
+	DeleteAuditLogStream(ctx context.Context, enterprise string, id int64) (*Response, error)
+	DeleteEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64) (*HostedRunner, *Response, error)
+	DeleteEnterpriseNetworkConfiguration(ctx context.Context, enterprise, networkID string) (*Response, error)
+	DeleteEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*Response, error)
+	DeleteSSHCertificateAuthority(ctx context.Context, enterprise string, id int64) (*Response, error)
+	EnableDisableSecurityFeature(ctx context.Context, enterprise, securityProduct, enablement string) (*Response, error)
+	GetAuditLogStream(ctx context.Context, enterprise string, id int64) (*AuditLogStream, *Response, error)
+	GetAuditLogStreamKey(ctx context.Context, enterprise string) (*AuditLogStreamKey, *Response, error)
+	GetCodeSecurityAndAnalysis(ctx context.Context, enterprise string) (*EnterpriseSecurityAnalysisSettings, *Response, error)
+	GetConsumedLicenses(ctx context.Context, enterprise string, opt *ListOptions) (*EnterpriseConsumedLicenses, *Response, error)
+	GetEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64) (*HostedRunner, *Response, error)
+	GetEnterpriseNetworkConfiguration(ctx context.Context, enterprise, networkID string) (*NetworkConfiguration, *Response, error)
+	GetEnterpriseNetworkSettingsResource(ctx context.Context, enterprise, networkID string) (*NetworkSettingsResource, *Response, error)
+	GetEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64) (*EnterpriseRunnerGroup, *Response, error)
+	ListAuditLogStreams(ctx context.Context, enterprise string) ([]*AuditLogStream, *Response, error)
+	ListEnterpriseHostedRunnerMachineSpecs(ctx context.Context, enterprise string) (*HostedRunnerMachineSpecs, *Response, error)
+	ListEnterpriseHostedRunners(ctx context.Context, enterprise string, opt *ListOptions) (*HostedRunners, *Response, error)
+	ListEnterpriseNetworkConfigurations(ctx context.Context, enterprise string, opt *ListOptions) (*NetworkConfigurations, *Response, error)
+	ListOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, opt *ListOptions) (*ListOrganizations, *Response, error)
+	ListRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, opt *ListOptions) (*Runners, *Response, error)
+	ListRunnerGroups(ctx context.Context, enterprise string, opt *ListEnterpriseRunnerGroupOptions) (*EnterpriseRunnerGroups, *Response, error)
+	ListSSHCertificateAuthorities(ctx context.Context, enterprise string, opt *ListOptions) ([]*SSHCertificateAuthority, *Response, error)
+	RemoveOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID, orgID int64) (*Response, error)
+	RemoveRunnerGroupRunners(ctx context.Context, enterprise string, groupID, runnerID int64) (*Response, error)
+	SetOrganizationAccessRunnerGroup(ctx context.Context, enterprise string, groupID int64, ids SetOrgAccessRunnerGroupRequest) (*Response, error)
+	SetRunnerGroupRunners(ctx context.Context, enterprise string, groupID int64, ids SetRunnerGroupRunnersRequest) (*Response, error)
+	UpdateAuditLogStream(ctx context.Context, enterprise string, id int64, config *AuditLogStreamConfig) (*AuditLogStream, *Response, error)
+	UpdateCodeSecurityAndAnalysis(ctx context.Context, enterprise string, settings *EnterpriseSecurityAnalysisSettings) (*Response, error)
+	UpdateEnterpriseHostedRunner(ctx context.Context, enterprise string, runnerID int64, updateReq UpdateHostedRunnerRequest) (*HostedRunner, *Response, error)
+	UpdateEnterpriseNetworkConfiguration(ctx context.Context, enterprise, networkID string, updateReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error)
+	UpdateEnterpriseRunnerGroup(ctx context.Context, enterprise string, groupID int64, updateReq UpdateEnterpriseRunnerGroupRequest) (*EnterpriseRunnerGroup, *Response, error)
+}
+
+var _ EnterpriseServiceInterface = (*EnterpriseService)(nil)
+
+// GistsServiceInterface lists the methods of GistsService, so that it can be
+// replaced by a fake in tests. See GistsService for documentation.
+type GistsServiceInterface interface {
+	Create(ctx context.Context, gist *Gist) (*Gist, *Response, error)
+	CreateComment(ctx context.Context, gistID string, comment *GistComment) (*GistComment, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+	DeleteComment(ctx context.Context, gistID string, commentID int64) (*Response, error)
+	DownloadFile(ctx context.Context, file *GistFile) (io.ReadCloser, error)
+	Edit(ctx context.Context, id string, gist *Gist) (*Gist, *Response, error)
+	EditComment(ctx context.Context, gistID string, commentID int64, comment *GistComment) (*GistComment, *Response, error)
+	Fork(ctx context.Context, id string) (*Gist, *Response, error)
+	Get(ctx context.Context, id string) (*Gist, *Response, error)
+	GetComment(ctx context.Context, gistID string, commentID int64) (*GistComment, *Response, error)
+	GetRevision(ctx context.Context, id, sha string) (*Gist, *Response, error)
+	IsStarred(ctx context.Context, id string) (bool, *Response, error)
+	List(ctx context.Context, user string, opt *GistListOptions) ([]*Gist, *Response, error)
+	ListAll(ctx context.Context, opt *GistListOptions) ([]*Gist, *Response, error)
+	ListComments(ctx context.Context, gistID string, opt *ListOptions) ([]*GistComment, *Response, error)
+	ListCommits(ctx context.Context, id string, opt *ListOptions) ([]*GistCommit, *Response, error)
+	ListForks(ctx context.Context, id string) ([]*GistFork, *Response, error)
+	ListStarred(ctx context.Context, opt *GistListOptions) ([]*Gist, *Response, error)
+	Star(ctx context.Context, id string) (*Response, error)
+	Unstar(ctx context.Context, id string) (*Response, error)
+}
+
+var _ GistsServiceInterface = (*GistsService)(nil)
+
+// GitServiceInterface lists the methods of GitService, so that it can be
+// replaced by a fake in tests. See GitService for documentation.
+type GitServiceInterface interface {
+	CreateBlob(ctx context.Context, owner string, repo string, blob *Blob) (*Blob, *Response, error)
+	CreateCommit(ctx context.Context, owner string, repo string, commit *Commit) (*Commit, *Response, error)
+	CreateRef(ctx context.Context, owner string, repo string, ref *Reference) (*Reference, *Response, error)
+	CreateRefs(ctx context.Context, repos []string, ref string, opt *CreateRefsOptions) ([]*CreateRefsResult, error)
+	CreateTag(ctx context.Context, owner string, repo string, tag *Tag) (*Tag, *Response, error)
+	CreateTree(ctx context.Context, owner string, repo string, baseTree string, entries []TreeEntry) (*Tree, *Response, error)
+	DeleteRef(ctx context.Context, owner string, repo string, ref string) (*Response, error)
+	GetBlob(ctx context.Context, owner string, repo string, sha string) (*Blob, *Response, error)
+	GetBlobRaw(ctx context.Context, owner, repo, sha string) ([]byte, *Response, error)
+	GetCommit(ctx context.Context, owner string, repo string, sha string) (*Commit, *Response, error)
+	GetRef(ctx context.Context, owner string, repo string, ref string) (*Reference, *Response, error)
+	GetRefs(ctx context.Context, owner string, repo string, ref string) ([]*Reference, *Response, error)
+	GetTag(ctx context.Context, owner string, repo string, sha string) (*Tag, *Response, error)
+	GetTree(ctx context.Context, owner string, repo string, sha string, recursive bool) (*Tree, *Response, error)
+	ListRefs(ctx context.Context, owner, repo string, opt *ReferenceListOptions) ([]*Reference, *Response, error)
+	UpdateRef(ctx context.Context, owner string, repo string, ref *Reference, force bool) (*Reference, *Response, error)
+	Walk(ctx context.Context, owner, repo, ref string, fn WalkFunc) error
+}
+
+var _ GitServiceInterface = (*GitService)(nil)
+
+// GitignoresServiceInterface lists the methods of GitignoresService, so that it can be
+// replaced by a fake in tests. See GitignoresService for documentation.
+type GitignoresServiceInterface interface {
+	Get(ctx context.Context, name string) (*Gitignore, *Response, error)
+	List(ctx context.Context) ([]string, *Response, error)
+}
+
+var _ GitignoresServiceInterface = (*GitignoresService)(nil)
+
+// InteractionsServiceInterface lists the methods of InteractionsService, so that it can be
+// replaced by a fake in tests. See InteractionsService for documentation.
+type InteractionsServiceInterface interface {
+	GetRestrictionsForOrg(ctx context.Context, organization string) (*InteractionRestriction, *Response, error)
+	GetRestrictionsForRepo(ctx context.Context, owner, repo string) (*InteractionRestriction, *Response, error)
+	RemoveRestrictionsFromOrg(ctx context.Context, organization string) (*Response, error)
+	RemoveRestrictionsFromRepo(ctx context.Context, owner, repo string) (*Response, error)
+	UpdateRestrictionsForOrg(ctx context.Context, organization, limit string) (*InteractionRestriction, *Response, error)
+	UpdateRestrictionsForRepo(ctx context.Context, owner, repo, limit string) (*InteractionRestriction, *Response, error)
+}
+
+var _ InteractionsServiceInterface = (*InteractionsService)(nil)
+
+// IssueImportServiceInterface lists the methods of IssueImportService, so that it can be
+// replaced by a fake in tests. See IssueImportService for documentation.
+type IssueImportServiceInterface interface {
+	CheckStatus(ctx context.Context, owner, repo string, issueID int64) (*IssueImportResponse, *Response, error)
+	CheckStatusSince(ctx context.Context, owner, repo string, since time.Time) ([]*IssueImportResponse, *Response, error)
+	Create(ctx context.Context, owner, repo string, issue *IssueImportRequest) (*IssueImportResponse, *Response, error)
+}
+
+var _ IssueImportServiceInterface = (*IssueImportService)(nil)
+
+// IssuesServiceInterface lists the methods of IssuesService, so that it can be
+// replaced by a fake in tests. See IssuesService for documentation.
+type IssuesServiceInterface interface {
+	AddAssignees(ctx context.Context, owner, repo string, number int, assignees []string) (*Issue, *Response, error)
+	AddLabelsToIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*Label, *Response, error)
+	Create(ctx context.Context, owner string, repo string, issue *IssueRequest) (*Issue, *Response, error)
+	CreateComment(ctx context.Context, owner string, repo string, number int, comment *IssueComment) (*IssueComment, *Response, error)
+	CreateLabel(ctx context.Context, owner string, repo string, label *Label) (*Label, *Response, error)
+	CreateMilestone(ctx context.Context, owner string, repo string, milestone *Milestone) (*Milestone, *Response, error)
+	DeleteComment(ctx context.Context, owner string, repo string, commentID int64) (*Response, error)
+	DeleteLabel(ctx context.Context, owner string, repo string, name string) (*Response, error)
+	DeleteMilestone(ctx context.Context, owner string, repo string, number int) (*Response, error)
+	Edit(ctx context.Context, owner string, repo string, number int, issue *IssueRequest) (*Issue, *Response, error)
+	EditComment(ctx context.Context, owner string, repo string, commentID int64, comment *IssueComment) (*IssueComment, *Response, error)
+	EditLabel(ctx context.Context, owner string, repo string, name string, label *Label) (*Label, *Response, error)
+	EditMilestone(ctx context.Context, owner string, repo string, number int, milestone *Milestone) (*Milestone, *Response, error)
+	Get(ctx context.Context, owner string, repo string, number int) (*Issue, *Response, error)
+	GetComment(ctx context.Context, owner string, repo string, commentID int64) (*IssueComment, *Response, error)
+	GetEvent(ctx context.Context, owner, repo string, id int64) (*IssueEvent, *Response, error)
+	GetLabel(ctx context.Context, owner string, repo string, name string) (*Label, *Response, error)
+	GetMilestone(ctx context.Context, owner string, repo string, number int) (*Milestone, *Response, error)
+	IsAssignee(ctx context.Context, owner, repo, user string) (bool, *Response, error)
+	List(ctx context.Context, all bool, opt *IssueListOptions) ([]*Issue, *Response, error)
+	ListAssignees(ctx context.Context, owner, repo string, opt *ListOptions) ([]*User, *Response, error)
+	ListByOrg(ctx context.Context, org string, opt *IssueListOptions) ([]*Issue, *Response, error)
+	ListByRepo(ctx context.Context, owner string, repo string, opt *IssueListByRepoOptions) ([]*Issue, *Response, error)
+	ListComments(ctx context.Context, owner string, repo string, number int, opt *IssueListCommentsOptions) ([]*IssueComment, *Response, error)
+	ListIssueEvents(ctx context.Context, owner, repo string, number int, opt *ListOptions) ([]*IssueEvent, *Response, error)
+	ListIssueTemplates(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) ([]*IssueTemplate, *Response, error)
+	ListIssueTimeline(ctx context.Context, owner, repo string, number int, opt *ListOptions) ([]*Timeline, *Response, error)
+	ListLabels(ctx context.Context, owner string, repo string, opt *ListOptions) ([]*Label, *Response, error)
+	ListLabelsByIssue(ctx context.Context, owner string, repo string, number int, opt *ListOptions) ([]*Label, *Response, error)
+	ListLabelsForMilestone(ctx context.Context, owner string, repo string, number int, opt *ListOptions) ([]*Label, *Response, error)
+	ListMilestones(ctx context.Context, owner string, repo string, opt *MilestoneListOptions) ([]*Milestone, *Response, error)
+	ListRepositoryEvents(ctx context.Context, owner, repo string, opt *ListOptions) ([]*IssueEvent, *Response, error)
+	Lock(ctx context.Context, owner string, repo string, number int, opt *LockIssueOptions) (*Response, error)
+	ModerateIssues(ctx context.Context, query string, opt *ModerationOptions) ([]*ModerationResult, error)
+	RemoveAssignees(ctx context.Context, owner, repo string, number int, assignees []string) (*Issue, *Response, error)
+	RemoveLabelForIssue(ctx context.Context, owner string, repo string, number int, label string) (*Response, error)
+	RemoveLabelsForIssue(ctx context.Context, owner string, repo string, number int) (*Response, error)
+	ReplaceLabelsForIssue(ctx context.Context, owner string, repo string, number int, labels []string) ([]*Label, *Response, error)
+	SyncLabels(ctx context.Context, repos []string, specs []*LabelSpec, opt *SyncLabelsOptions) []*SyncLabelsResult
+	Unlock(ctx context.Context, owner string, repo string, number int) (*Response, error)
+}
+
+var _ IssuesServiceInterface = (*IssuesService)(nil)
+
+// LFSServiceInterface lists the methods of LFSService, so that it can be
+// replaced by a fake in tests. See LFSService for documentation.
+type LFSServiceInterface interface {
+	Batch(ctx context.Context, owner, repo string, batch *LFSBatchRequest) (*LFSBatchResponse, *Response, error)
+	Download(ctx context.Context, action *LFSAction) (io.ReadCloser, error)
+	Upload(ctx context.Context, action *LFSAction, r io.Reader, size int64) (*Response, error)
+	Verify(ctx context.Context, action *LFSAction, object *LFSObject) (*Response, error)
+}
+
+var _ LFSServiceInterface = (*LFSService)(nil)
+
+// LicensesServiceInterface lists the methods of LicensesService, so that it can be
+// replaced by a fake in tests. See LicensesService for documentation.
+type LicensesServiceInterface interface {
+	Get(ctx context.Context, licenseName string) (*License, *Response, error)
+	List(ctx context.Context) ([]*License, *Response, error)
+}
+
+var _ LicensesServiceInterface = (*LicensesService)(nil)
+
+// MigrationServiceInterface lists the methods of MigrationService, so that it can be
+// replaced by a fake in tests. See MigrationService for documentation.
+type MigrationServiceInterface interface {
+	CancelImport(ctx context.Context, owner, repo string) (*Response, error)
+	CommitAuthors(ctx context.Context, owner, repo string) ([]*SourceImportAuthor, *Response, error)
+	DeleteMigration(ctx context.Context, org string, id int64) (*Response, error)
+	DeleteUserMigration(ctx context.Context, id int64) (*Response, error)
+	DownloadUserMigrationArchive(ctx context.Context, id int64) (io.ReadCloser, error)
+	ImportProgress(ctx context.Context, owner, repo string) (*Import, *Response, error)
+	LargeFiles(ctx context.Context, owner, repo string) ([]*LargeFile, *Response, error)
+	ListMigrations(ctx context.Context, org string) ([]*Migration, *Response, error)
+	ListUserMigrations(ctx context.Context) ([]*UserMigration, *Response, error)
+	MapCommitAuthor(ctx context.Context, owner, repo string, id int64, author *SourceImportAuthor) (*SourceImportAuthor, *Response, error)
+	MigrationArchiveURL(ctx context.Context, org string, id int64) (url string, err error)
+	MigrationStatus(ctx context.Context, org string, id int64) (*Migration, *Response, error)
+	SetLFSPreference(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error)
+	StartImport(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error)
+	StartMigration(ctx context.Context, org string, repos []string, opt *MigrationOptions) (*Migration, *Response, error)
+	StartUserMigration(ctx context.Context, repos []string, opt *UserMigrationOptions) (*UserMigration, *Response, error)
+	UnlockRepo(ctx context.Context, org string, id int64, repo string) (*Response, error)
+	UnlockUserRepo(ctx context.Context, id int64, repo string) (*Response, error)
+	UpdateImport(ctx context.Context, owner, repo string, in *Import) (*Import, *Response, error)
+	UserMigrationArchiveURL(ctx context.Context, id int64) (string, error)
+	UserMigrationStatus(ctx context.Context, id int64) (*UserMigration, *Response, error)
+}
+
+var _ MigrationServiceInterface = (*MigrationService)(nil)
+
+// OrganizationsServiceInterface lists the methods of OrganizationsService, so that it can be
+// replaced by a fake in tests. See OrganizationsService for documentation.
+type OrganizationsServiceInterface interface {
+	AssignOrgRoleToTeam(ctx context.Context, org, teamSlug string, roleID int64) (*Response, error)
+	AssignOrgRoleToUser(ctx context.Context, org, username string, roleID int64) (*Response, error)
+	BlockUser(ctx context.Context, org string, user string) (*Response, error)
+	ConcealMembership(ctx context.Context, org, user string) (*Response, error)
+	ConvertMemberToOutsideCollaborator(ctx context.Context, org string, user string) (*Response, error)
+	CreateHook(ctx context.Context, org string, hook *Hook) (*Hook, *Response, error)
+	CreateIssueType(ctx context.Context, org string, issueType *IssueTypeRequest) (*IssueType, *Response, error)
+	CreateNetworkConfiguration(ctx context.Context, org string, createReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error)
+	CreateOrgInvitation(ctx context.Context, org string, opt *CreateOrgInvitationOptions) (*Invitation, *Response, error)
+	CreateProject(ctx context.Context, org string, opt *ProjectOptions) (*Project, *Response, error)
+	CreateSSHCertificateAuthority(ctx context.Context, org, key string) (*SSHCertificateAuthority, *Response, error)
+	DeleteHook(ctx context.Context, org string, id int64) (*Response, error)
+	DeleteIssueType(ctx context.Context, org string, issueTypeID int64) (*Response, error)
+	DeleteNetworkConfiguration(ctx context.Context, org, networkID string) (*Response, error)
+	DeletePreReceiveHook(ctx context.Context, org string, id int64) (*Response, error)
+	DeleteSSHCertificateAuthority(ctx context.Context, org string, id int64) (*Response, error)
+	Edit(ctx context.Context, name string, org *Organization) (*Organization, *Response, error)
+	EditHook(ctx context.Context, org string, id int64, hook *Hook) (*Hook, *Response, error)
+	EditOrgMembership(ctx context.Context, user, org string, membership *Membership) (*Membership, *Response, error)
+	Get(ctx context.Context, org string) (*Organization, *Response, error)
+	GetAuditLog(ctx context.Context, org string, opt *GetAuditLogOptions) ([]*AuditEntry, *Response, error)
+	GetByID(ctx context.Context, id int64) (*Organization, *Response, error)
+	GetHook(ctx context.Context, org string, id int64) (*Hook, *Response, error)
+	GetNetworkConfiguration(ctx context.Context, org, networkID string) (*NetworkConfiguration, *Response, error)
+	GetNetworkSettingsResource(ctx context.Context, org, networkID string) (*NetworkSettingsResource, *Response, error)
+	GetOrgMembership(ctx context.Context, user, org string) (*Membership, *Response, error)
+	GetOrgRole(ctx context.Context, org string, roleID int64) (*OrgRole, *Response, error)
+	GetPreReceiveHook(ctx context.Context, org string, id int64) (*PreReceiveHook, *Response, error)
+	IsBlocked(ctx context.Context, org string, user string) (bool, *Response, error)
+	IsMember(ctx context.Context, org, user string) (bool, *Response, error)
+	IsPublicMember(ctx context.Context, org, user string) (bool, *Response, error)
+	List(ctx context.Context, user string, opt *ListOptions) ([]*Organization, *Response, error)
+	ListAll(ctx context.Context, opt *OrganizationsListOptions) ([]*Organization, *Response, error)
+	ListBlockedUsers(ctx context.Context, org string, opt *ListOptions) ([]*User, *Response, error)
+	ListCredentialAuthorizations(ctx context.Context, org string, opt *ListCredentialAuthorizationsOptions) ([]*CredentialAuthorization, *Response, error)
+	ListHooks(ctx context.Context, org string, opt *ListOptions) ([]*Hook, *Response, error)
+	ListInstallations(ctx context.Context, org string, opt *ListOptions) (*OrganizationInstallations, *Response, error)
+	ListIssueTypes(ctx context.Context, org string) ([]*IssueType, *Response, error)
+	ListMembers(ctx context.Context, org string, opt *ListMembersOptions) ([]*User, *Response, error)
+	ListNetworkConfigurations(ctx context.Context, org string, opt *ListOptions) (*NetworkConfigurations, *Response, error)
+	ListOrgInvitationTeams(ctx context.Context, org, invitationID string, opt *ListOptions) ([]*Team, *Response, error)
+	ListOrgMemberships(ctx context.Context, opt *ListOrgMembershipsOptions) ([]*Membership, *Response, error)
+	ListOrgRoles(ctx context.Context, org string) (*OrgRoles, *Response, error)
+	ListOutsideCollaborators(ctx context.Context, org string, opt *ListOutsideCollaboratorsOptions) ([]*User, *Response, error)
+	ListPendingOrgInvitations(ctx context.Context, org string, opt *ListOptions) ([]*Invitation, *Response, error)
+	ListPreReceiveHooks(ctx context.Context, org string, opt *ListOptions) ([]*PreReceiveHook, *Response, error)
+	ListProjects(ctx context.Context, org string, opt *ProjectListOptions) ([]*Project, *Response, error)
+	ListSSHCertificateAuthorities(ctx context.Context, org string, opt *ListOptions) ([]*SSHCertificateAuthority, *Response, error)
+	ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opt *ListOptions) ([]*Team, *Response, error)
+	ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opt *ListOptions) ([]*User, *Response, error)
+	MembershipReport(ctx context.Context, org string) (*MembershipReport, error)
+	ModerationReport(ctx context.Context, org string) (*ModerationReport, error)
+	PingHook(ctx context.Context, org string, id int64) (*Response, error)
+	PublicizeMembership(ctx context.Context, org, user string) (*Response, error)
+	RemoveAllOrgRolesFromTeam(ctx context.Context, org, teamSlug string) (*Response, error)
+	RemoveAllOrgRolesFromUser(ctx context.Context, org, username string) (*Response, error)
+	RemoveCredentialAuthorization(ctx context.Context, org string, credentialID int64) (*Response, error)
+	RemoveMember(ctx context.Context, org, user string) (*Response, error)
+	RemoveOrgMembership(ctx context.Context, user, org string) (*Response, error)
+	RemoveOrgRoleFromTeam(ctx context.Context, org, teamSlug string, roleID int64) (*Response, error)
+	RemoveOrgRoleFromUser(ctx context.Context, org, username string, roleID int64) (*Response, error)
+	RemoveOutsideCollaborator(ctx context.Context, org string, user string) (*Response, error)
+	UnblockUser(ctx context.Context, org string, user string) (*Response, error)
+	UpdateIssueType(ctx context.Context, org string, issueTypeID int64, issueType *IssueTypeRequest) (*IssueType, *Response, error)
+	UpdateNetworkConfiguration(ctx context.Context, org, networkID string, updateReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error)
+	UpdatePreReceiveHook(ctx context.Context, org string, id int64, hook *PreReceiveHook) (*PreReceiveHook, *Response, error)
+}
+
+var _ OrganizationsServiceInterface = (*OrganizationsService)(nil)
+
+// PackagesServiceInterface lists the methods of PackagesService, so that it can be
+// replaced by a fake in tests. See PackagesService for documentation.
+type PackagesServiceInterface interface {
+	DeleteOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error)
+	DeleteOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error)
+	DeleteUserPackage(ctx context.Context, user, packageType, packageName string) (*Response, error)
+	DeleteUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error)
+	GetOrgPackage(ctx context.Context, org, packageType, packageName string) (*Package, *Response, error)
+	GetOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error)
+	GetUserPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error)
+	GetUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error)
+	ListOrgPackageVersions(ctx context.Context, org, packageType, packageName string, opt *PackageVersionListOptions) ([]*PackageVersion, *Response, error)
+	ListOrgPackages(ctx context.Context, org string, opt *PackageListOptions) ([]*Package, *Response, error)
+	ListUserPackageVersions(ctx context.Context, user, packageType, packageName string, opt *PackageVersionListOptions) ([]*PackageVersion, *Response, error)
+	ListUserPackages(ctx context.Context, user string, opt *PackageListOptions) ([]*Package, *Response, error)
+	RestoreOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error)
+	RestoreOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error)
+	RestoreUserPackage(ctx context.Context, user, packageType, packageName string) (*Response, error)
+	RestoreUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error)
+}
+
+var _ PackagesServiceInterface = (*PackagesService)(nil)
+
+// ProjectsServiceInterface lists the methods of ProjectsService, so that it can be
+// replaced by a fake in tests. See ProjectsService for documentation.
+type ProjectsServiceInterface interface {
+	AddProjectCollaborator(ctx context.Context, id int64, username string, opt *ProjectCollaboratorOptions) (*Response, error)
+	AddProjectV2DraftIssue(ctx context.Context, projectID, title, body string) (*ProjectV2Item, *Response, error)
+	AddProjectV2Item(ctx context.Context, projectID, contentID string) (*ProjectV2Item, *Response, error)
+	CreateProjectCard(ctx context.Context, columnID int64, opt *ProjectCardOptions) (*ProjectCard, *Response, error)
+	CreateProjectColumn(ctx context.Context, projectID int64, opt *ProjectColumnOptions) (*ProjectColumn, *Response, error)
+	DeleteProject(ctx context.Context, id int64) (*Response, error)
+	DeleteProjectCard(ctx context.Context, cardID int64) (*Response, error)
+	DeleteProjectColumn(ctx context.Context, columnID int64) (*Response, error)
+	DeleteProjectV2Item(ctx context.Context, projectID, itemID string) (*Response, error)
+	GetProject(ctx context.Context, id int64) (*Project, *Response, error)
+	GetProjectCard(ctx context.Context, columnID int64) (*ProjectCard, *Response, error)
+	GetProjectColumn(ctx context.Context, id int64) (*ProjectColumn, *Response, error)
+	ListOrgProjectsV2(ctx context.Context, org string, opt *GraphQLListOptions) (*ProjectV2Connection, *Response, error)
+	ListProjectCards(ctx context.Context, columnID int64, opt *ProjectCardListOptions) ([]*ProjectCard, *Response, error)
+	ListProjectCollaborators(ctx context.Context, id int64, opt *ListCollaboratorOptions) ([]*User, *Response, error)
+	ListProjectColumns(ctx context.Context, projectID int64, opt *ListOptions) ([]*ProjectColumn, *Response, error)
+	ListProjectV2Fields(ctx context.Context, projectID string, opt *GraphQLListOptions) (*ProjectV2FieldConnection, *Response, error)
+	ListProjectV2Items(ctx context.Context, projectID string, opt *GraphQLListOptions) (*ProjectV2ItemConnection, *Response, error)
+	ListUserProjectsV2(ctx context.Context, user string, opt *GraphQLListOptions) (*ProjectV2Connection, *Response, error)
+	MoveProjectCard(ctx context.Context, cardID int64, opt *ProjectCardMoveOptions) (*Response, error)
+	MoveProjectColumn(ctx context.Context, columnID int64, opt *ProjectColumnMoveOptions) (*Response, error)
+	RemoveProjectCollaborator(ctx context.Context, id int64, username string) (*Response, error)
+	ReviewProjectCollaboratorPermission(ctx context.Context, id int64, username string) (*ProjectPermissionLevel, *Response, error)
+	UpdateProject(ctx context.Context, id int64, opt *ProjectOptions) (*Project, *Response, error)
+	UpdateProjectCard(ctx context.Context, cardID int64, opt *ProjectCardOptions) (*ProjectCard, *Response, error)
+	UpdateProjectColumn(ctx context.Context, columnID int64, opt *ProjectColumnOptions) (*ProjectColumn, *Response, error)
+	UpdateProjectV2ItemFieldValue(ctx context.Context, projectID, itemID, fieldID string, value *ProjectV2FieldValue) (*ProjectV2Item, *Response, error)
+}
+
+var _ ProjectsServiceInterface = (*ProjectsService)(nil)
+
+// PullRequestsServiceInterface lists the methods of PullRequestsService, so that it can be
+// replaced by a fake in tests. See PullRequestsService for documentation.
+type PullRequestsServiceInterface interface {
+	Create(ctx context.Context, owner string, repo string, pull *NewPullRequest) (*PullRequest, *Response, error)
+	CreateComment(ctx context.Context, owner string, repo string, number int, comment *PullRequestComment) (*PullRequestComment, *Response, error)
+	CreateCommentInReplyTo(ctx context.Context, owner string, repo string, number int, body string, commentID int64) (*PullRequestComment, *Response, error)
+	CreateReview(ctx context.Context, owner, repo string, number int, review *PullRequestReviewRequest) (*PullRequestReview, *Response, error)
+	DeleteComment(ctx context.Context, owner string, repo string, commentID int64) (*Response, error)
+	DeletePendingReview(ctx context.Context, owner, repo string, number int, reviewID int64) (*PullRequestReview, *Response, error)
+	DequeuePullRequest(ctx context.Context, pullRequestID string) (*MergeQueueEntry, *Response, error)
+	DismissReview(ctx context.Context, owner, repo string, number int, reviewID int64, review *PullRequestReviewDismissalRequest) (*PullRequestReview, *Response, error)
+	Edit(ctx context.Context, owner string, repo string, number int, pull *PullRequest) (*PullRequest, *Response, error)
+	EditComment(ctx context.Context, owner string, repo string, commentID int64, comment *PullRequestComment) (*PullRequestComment, *Response, error)
+	EnqueuePullRequest(ctx context.Context, pullRequestID string, opt *EnqueuePullRequestOptions) (*MergeQueueEntry, *Response, error)
+	Get(ctx context.Context, owner string, repo string, number int) (*PullRequest, *Response, error)
+	GetComment(ctx context.Context, owner string, repo string, commentID int64) (*PullRequestComment, *Response, error)
+	GetMergeQueue(ctx context.Context, owner, repo, branch string, opt *GraphQLListOptions) (*MergeQueue, *Response, error)
+	GetMergeQueueEntry(ctx context.Context, owner, repo string, number int) (*MergeQueueEntry, *Response, error)
+	GetRaw(ctx context.Context, owner string, repo string, number int, opt RawOptions) (string, *Response, error)
+	GetReview(ctx context.Context, owner, repo string, number int, reviewID int64) (*PullRequestReview, *Response, error)
+	IsMerged(ctx context.Context, owner string, repo string, number int) (bool, *Response, error)
+	List(ctx context.Context, owner string, repo string, opt *PullRequestListOptions) ([]*PullRequest, *Response, error)
+	ListComments(ctx context.Context, owner string, repo string, number int, opt *PullRequestListCommentsOptions) ([]*PullRequestComment, *Response, error)
+	ListCommits(ctx context.Context, owner string, repo string, number int, opt *ListOptions) ([]*RepositoryCommit, *Response, error)
+	ListFiles(ctx context.Context, owner string, repo string, number int, opt *ListOptions) ([]*CommitFile, *Response, error)
+	ListReviewComments(ctx context.Context, owner, repo string, number int, reviewID int64, opt *ListOptions) ([]*PullRequestComment, *Response, error)
+	ListReviewers(ctx context.Context, owner, repo string, number int, opt *ListOptions) (*Reviewers, *Response, error)
+	ListReviews(ctx context.Context, owner, repo string, number int, opt *ListOptions) ([]*PullRequestReview, *Response, error)
+	Merge(ctx context.Context, owner string, repo string, number int, commitMessage string, options *PullRequestOptions) (*PullRequestMergeResult, *Response, error)
+	RemoveReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*Response, error)
+	RequestReviewFromTeamMember(ctx context.Context, owner, repo string, number int, org, teamSlug string, opt *ReviewerAssignmentOptions) (*User, *PullRequest, error)
+	RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers ReviewersRequest) (*PullRequest, *Response, error)
+	SubmitReview(ctx context.Context, owner, repo string, number int, reviewID int64, review *PullRequestReviewRequest) (*PullRequestReview, *Response, error)
+}
+
+var _ PullRequestsServiceInterface = (*PullRequestsService)(nil)
+
+// ReactionsServiceInterface lists the methods of ReactionsService, so that it can be
+// replaced by a fake in tests. See ReactionsService for documentation.
+type ReactionsServiceInterface interface {
+	CreateCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error)
+	CreateIssueCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error)
+	CreateIssueReaction(ctx context.Context, owner, repo string, number int, content string) (*Reaction, *Response, error)
+	CreatePullRequestCommentReaction(ctx context.Context, owner, repo string, id int64, content string) (*Reaction, *Response, error)
+	CreateTeamDiscussionCommentReaction(ctx context.Context, teamID int64, discussionNumber, commentNumber int, content string) (*Reaction, *Response, error)
+	CreateTeamDiscussionReaction(ctx context.Context, teamID int64, discussionNumber int, content string) (*Reaction, *Response, error)
+	DeleteReaction(ctx context.Context, id int64) (*Response, error)
+	ListCommentReactions(ctx context.Context, owner, repo string, id int64, opt *ListOptions) ([]*Reaction, *Response, error)
+	ListIssueCommentReactions(ctx context.Context, owner, repo string, id int64, opt *ListOptions) ([]*Reaction, *Response, error)
+	ListIssueReactions(ctx context.Context, owner, repo string, number int, opt *ListOptions) ([]*Reaction, *Response, error)
+	ListPullRequestCommentReactions(ctx context.Context, owner, repo string, id int64, opt *ListOptions) ([]*Reaction, *Response, error)
+	ListTeamDiscussionCommentReactions(ctx context.Context, teamID int64, discussionNumber, commentNumber int, opt *ListOptions) ([]*Reaction, *Response, error)
+	ListTeamDiscussionReactions(ctx context.Context, teamID int64, discussionNumber int, opt *ListOptions) ([]*Reaction, *Response, error)
+}
+
+var _ ReactionsServiceInterface = (*ReactionsService)(nil)
+
+// RepositoriesServiceInterface lists the methods of RepositoriesService, so that it can be
+// replaced by a fake in tests. See RepositoriesService for documentation.
+type RepositoriesServiceInterface interface {
+	AddAdminEnforcement(ctx context.Context, owner, repo, branch string) (*AdminEnforcement, *Response, error)
+	AddCollaborator(ctx context.Context, owner, repo, user string, opt *RepositoryAddCollaboratorOptions) (*Response, error)
+	ApplyRepoConfig(ctx context.Context, plan *RepoConfigPlan) error
+	CompareCommits(ctx context.Context, owner, repo string, base, head string) (*CommitsComparison, *Response, error)
+	CompareCommitsAll(ctx context.Context, owner, repo string, base, head string) (*CommitsComparison, *Response, error)
+	CompareCommitsPaginated(ctx context.Context, owner, repo string, base, head string, opt *ListOptions) (*CommitsComparison, *Response, error)
+	Create(ctx context.Context, org string, repo *Repository) (*Repository, *Response, error)
+	CreateComment(ctx context.Context, owner, repo, sha string, comment *RepositoryComment) (*RepositoryComment, *Response, error)
+	CreateCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, request *CustomDeploymentProtectionRuleRequest) (*CustomDeploymentProtectionRule, *Response, error)
+	CreateDeployment(ctx context.Context, owner, repo string, request *DeploymentRequest) (*Deployment, *Response, error)
+	CreateDeploymentStatus(ctx context.Context, owner, repo string, deployment int64, request *DeploymentStatusRequest) (*DeploymentStatus, *Response, error)
+	CreateFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error)
+	CreateFork(ctx context.Context, owner, repo string, opt *RepositoryCreateForkOptions) (*Repository, *Response, error)
+	CreateHook(ctx context.Context, owner, repo string, hook *Hook) (*Hook, *Response, error)
+	CreateKey(ctx context.Context, owner string, repo string, key *Key) (*Key, *Response, error)
+	CreateProject(ctx context.Context, owner, repo string, opt *ProjectOptions) (*Project, *Response, error)
+	CreateRelease(ctx context.Context, owner, repo string, release *RepositoryRelease) (*RepositoryRelease, *Response, error)
+	CreateStatus(ctx context.Context, owner, repo, ref string, status *RepoStatus) (*RepoStatus, *Response, error)
+	CreateWithSettings(ctx context.Context, org string, repo *Repository, settings *RepositorySettings) (*Repository, error)
+	Delete(ctx context.Context, owner, repo string) (*Response, error)
+	DeleteBranch(ctx context.Context, owner, repo, branch string) (*Response, error)
+	DeleteComment(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	DeleteFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error)
+	DeleteHook(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	DeleteInvitation(ctx context.Context, owner, repo string, invitationID int64) (*Response, error)
+	DeleteKey(ctx context.Context, owner string, repo string, id int64) (*Response, error)
+	DeletePreReceiveHook(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	DeleteRelease(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	DeleteReleaseAsset(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	DeleteTag(ctx context.Context, owner, repo, tag string) (*Response, error)
+	DisableCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, ruleID int64) (*Response, error)
+	DisableDismissalRestrictions(ctx context.Context, owner, repo, branch string) (*PullRequestReviewsEnforcement, *Response, error)
+	DisablePages(ctx context.Context, owner, repo string) (*Response, error)
+	DisablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error)
+	DisableVulnerabilityAlerts(ctx context.Context, owner, repository string) (*Response, error)
+	DownloadContents(ctx context.Context, owner, repo, filepath string, opt *RepositoryContentGetOptions) (io.ReadCloser, error)
+	DownloadReleaseAsset(ctx context.Context, owner, repo string, id int64) (rc io.ReadCloser, redirectURL string, err error)
+	Edit(ctx context.Context, owner, repo string, repository *Repository) (*Repository, *Response, error)
+	EditHook(ctx context.Context, owner, repo string, id int64, hook *Hook) (*Hook, *Response, error)
+	EditKey(ctx context.Context, owner string, repo string, id int64, key *Key) (*Key, *Response, error)
+	EditRelease(ctx context.Context, owner, repo string, id int64, release *RepositoryRelease) (*RepositoryRelease, *Response, error)
+	EditReleaseAsset(ctx context.Context, owner, repo string, id int64, release *ReleaseAsset) (*ReleaseAsset, *Response, error)
+	EditWithNulls(ctx context.Context, owner, repo string, repository *Repository, nullFields ...string) (*Repository, *Response, error)
+	EnablePages(ctx context.Context, owner, repo string) (*Pages, *Response, error)
+	EnablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error)
+	EnableVulnerabilityAlerts(ctx context.Context, owner, repository string) (*Response, error)
+	ExportRepoConfig(ctx context.Context, owner, repo string) (*RepoConfig, error)
+	ForEachOrgRepo(ctx context.Context, org string, filter RepositoryFilter, opt *ForEachOrgRepoOptions, fn func(context.Context, *Repository) error) error
+	Get(ctx context.Context, owner, repo string) (*Repository, *Response, error)
+	GetAdminEnforcement(ctx context.Context, owner, repo, branch string) (*AdminEnforcement, *Response, error)
+	GetArchiveLink(ctx context.Context, owner, repo string, archiveformat archiveFormat, opt *RepositoryContentGetOptions) (*url.URL, *Response, error)
+	GetBranch(ctx context.Context, owner, repo, branch string) (*Branch, *Response, error)
+	GetBranchProtection(ctx context.Context, owner, repo, branch string) (*Protection, *Response, error)
+	GetByID(ctx context.Context, id int64) (*Repository, *Response, error)
+	GetCodeOfConduct(ctx context.Context, owner, repo string) (*CodeOfConduct, *Response, error)
+	GetCodeOwners(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) (*CodeOwners, *Response, error)
+	GetCodeOwnersErrors(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) ([]*CodeOwnersError, *Response, error)
+	GetCombinedStatus(ctx context.Context, owner, repo, ref string, opt *ListOptions) (*CombinedStatus, *Response, error)
+	GetComment(ctx context.Context, owner, repo string, id int64) (*RepositoryComment, *Response, error)
+	GetCommit(ctx context.Context, owner, repo, sha string) (*RepositoryCommit, *Response, error)
+	GetCommitRaw(ctx context.Context, owner string, repo string, sha string, opt RawOptions) (string, *Response, error)
+	GetCommitSHA1(ctx context.Context, owner, repo, ref, lastSHA string) (string, *Response, error)
+	GetCommunityHealthMetrics(ctx context.Context, owner, repo string) (*CommunityHealthMetrics, *Response, error)
+	GetContents(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (fileContent *RepositoryContent, directoryContent []*RepositoryContent, resp *Response, err error)
+	GetContentsBatch(ctx context.Context, repos []string, path string, opt *BatchContentsOptions) []*BatchContentsResult
+	GetCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, ruleID int64) (*CustomDeploymentProtectionRule, *Response, error)
+	GetDeployment(ctx context.Context, owner, repo string, deploymentID int64) (*Deployment, *Response, error)
+	GetDeploymentStatus(ctx context.Context, owner, repo string, deploymentID, deploymentStatusID int64) (*DeploymentStatus, *Response, error)
+	GetFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (io.ReadCloser, error)
+	GetHealth(ctx context.Context, owner, repo string) (*RepoHealth, error)
+	GetHook(ctx context.Context, owner, repo string, id int64) (*Hook, *Response, error)
+	GetKey(ctx context.Context, owner string, repo string, id int64) (*Key, *Response, error)
+	GetLatestPagesBuild(ctx context.Context, owner, repo string) (*PagesBuild, *Response, error)
+	GetLatestRelease(ctx context.Context, owner, repo string) (*RepositoryRelease, *Response, error)
+	GetPageBuild(ctx context.Context, owner, repo string, id int64) (*PagesBuild, *Response, error)
+	GetPagesInfo(ctx context.Context, owner, repo string) (*Pages, *Response, error)
+	GetPermissionLevel(ctx context.Context, owner, repo, user string) (*RepositoryPermissionLevel, *Response, error)
+	GetPreReceiveHook(ctx context.Context, owner, repo string, id int64) (*PreReceiveHook, *Response, error)
+	GetPullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string) (*PullRequestReviewsEnforcement, *Response, error)
+	GetReadme(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) (*RepositoryContent, *Response, error)
+	GetRelease(ctx context.Context, owner, repo string, id int64) (*RepositoryRelease, *Response, error)
+	GetReleaseAsset(ctx context.Context, owner, repo string, id int64) (*ReleaseAsset, *Response, error)
+	GetReleaseByTag(ctx context.Context, owner, repo, tag string) (*RepositoryRelease, *Response, error)
+	GetRequiredStatusChecks(ctx context.Context, owner, repo, branch string) (*RequiredStatusChecks, *Response, error)
+	GetSignaturesProtectedBranch(ctx context.Context, owner, repo, branch string) (*SignaturesProtectedBranch, *Response, error)
+	GetStatusRollup(ctx context.Context, owner, repo, ref string) (*StatusRollup, error)
+	GetVulnerabilityAlerts(ctx context.Context, owner, repository string) (bool, *Response, error)
+	IsCollaborator(ctx context.Context, owner, repo, user string) (bool, *Response, error)
+	IsPrivateReportingEnabled(ctx context.Context, owner, repo string) (bool, *Response, error)
+	License(ctx context.Context, owner, repo string) (*RepositoryLicense, *Response, error)
+	List(ctx context.Context, user string, opt *RepositoryListOptions) ([]*Repository, *Response, error)
+	ListAll(ctx context.Context, opt *RepositoryListAllOptions) ([]*Repository, *Response, error)
+	ListAllTopics(ctx context.Context, owner, repo string) ([]string, *Response, error)
+	ListBranches(ctx context.Context, owner string, repo string, opt *ListOptions) ([]*Branch, *Response, error)
+	ListByAuthenticatedUser(ctx context.Context, opt *RepositoryListByAuthenticatedUserOptions) ([]*Repository, *Response, error)
+	ListByOrg(ctx context.Context, org string, opt *RepositoryListByOrgOptions) ([]*Repository, *Response, error)
+	ListCodeFrequency(ctx context.Context, owner, repo string) ([]*WeeklyStats, *Response, error)
+	ListCollaborators(ctx context.Context, owner, repo string, opt *ListCollaboratorsOptions) ([]*User, *Response, error)
+	ListComments(ctx context.Context, owner, repo string, opt *ListOptions) ([]*RepositoryComment, *Response, error)
+	ListCommitActivity(ctx context.Context, owner, repo string) ([]*WeeklyCommitActivity, *Response, error)
+	ListCommitComments(ctx context.Context, owner, repo, sha string, opt *ListOptions) ([]*RepositoryComment, *Response, error)
+	ListCommits(ctx context.Context, owner, repo string, opt *CommitsListOptions) ([]*RepositoryCommit, *Response, error)
+	ListContributors(ctx context.Context, owner string, repository string, opt *ListContributorsOptions) ([]*Contributor, *Response, error)
+	ListContributorsStats(ctx context.Context, owner, repo string) ([]*ContributorStats, *Response, error)
+	ListCustomDeploymentRuleIntegrations(ctx context.Context, owner, repo, environment string, opt *ListOptions) (*ListCustomDeploymentRuleIntegrations, *Response, error)
+	ListDeploymentProtectionRules(ctx context.Context, owner, repo, environment string) (*ListDeploymentProtectionRules, *Response, error)
+	ListDeploymentStatuses(ctx context.Context, owner, repo string, deployment int64, opt *ListOptions) ([]*DeploymentStatus, *Response, error)
+	ListDeployments(ctx context.Context, owner, repo string, opt *DeploymentsListOptions) ([]*Deployment, *Response, error)
+	ListForks(ctx context.Context, owner, repo string, opt *RepositoryListForksOptions) ([]*Repository, *Response, error)
+	ListHooks(ctx context.Context, owner, repo string, opt *ListOptions) ([]*Hook, *Response, error)
+	ListInvitations(ctx context.Context, owner, repo string, opt *ListOptions) ([]*RepositoryInvitation, *Response, error)
+	ListKeys(ctx context.Context, owner string, repo string, opt *ListOptions) ([]*Key, *Response, error)
+	ListLanguages(ctx context.Context, owner string, repo string) (map[string]int, *Response, error)
+	ListOrgContributors(ctx context.Context, org string, opt *ListOrgContributorsOptions) ([]*Contributor, error)
+	ListPagesBuilds(ctx context.Context, owner, repo string, opt *ListOptions) ([]*PagesBuild, *Response, error)
+	ListParticipation(ctx context.Context, owner, repo string) (*RepositoryParticipation, *Response, error)
+	ListPreReceiveHooks(ctx context.Context, owner, repo string, opt *ListOptions) ([]*PreReceiveHook, *Response, error)
+	ListProjects(ctx context.Context, owner, repo string, opt *ProjectListOptions) ([]*Project, *Response, error)
+	ListPunchCard(ctx context.Context, owner, repo string) ([]*PunchCard, *Response, error)
+	ListReleaseAssets(ctx context.Context, owner, repo string, id int64, opt *ListOptions) ([]*ReleaseAsset, *Response, error)
+	ListReleases(ctx context.Context, owner, repo string, opt *ListOptions) ([]*RepositoryRelease, *Response, error)
+	ListRequiredStatusChecksContexts(ctx context.Context, owner, repo, branch string) (contexts []string, resp *Response, err error)
+	ListStatuses(ctx context.Context, owner, repo, ref string, opt *ListOptions) ([]*RepoStatus, *Response, error)
+	ListTags(ctx context.Context, owner string, repo string, opt *ListOptions) ([]*RepositoryTag, *Response, error)
+	ListTeams(ctx context.Context, owner string, repo string, opt *ListOptions) ([]*Team, *Response, error)
+	ListTrafficClones(ctx context.Context, owner, repo string, opt *TrafficBreakdownOptions) (*TrafficClones, *Response, error)
+	ListTrafficPaths(ctx context.Context, owner, repo string) ([]*TrafficPath, *Response, error)
+	ListTrafficReferrers(ctx context.Context, owner, repo string) ([]*TrafficReferrer, *Response, error)
+	ListTrafficViews(ctx context.Context, owner, repo string, opt *TrafficBreakdownOptions) (*TrafficViews, *Response, error)
+	Merge(ctx context.Context, owner, repo string, request *RepositoryMergeRequest) (*RepositoryCommit, *Response, error)
+	OptionalSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*Response, error)
+	PingHook(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	PlanRepoConfig(ctx context.Context, owner, repo string, cfg *RepoConfig) (*RepoConfigPlan, error)
+	RemoveAdminEnforcement(ctx context.Context, owner, repo, branch string) (*Response, error)
+	RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*Response, error)
+	RemoveCollaborator(ctx context.Context, owner, repo, user string) (*Response, error)
+	RemovePullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string) (*Response, error)
+	RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*Branch, *Response, error)
+	ReplaceAllTopics(ctx context.Context, owner, repo string, topics []string) ([]string, *Response, error)
+	RequestPageBuild(ctx context.Context, owner, repo string) (*PagesBuild, *Response, error)
+	RequireSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*SignaturesProtectedBranch, *Response, error)
+	StaleCodeOwners(ctx context.Context, owner, repo string, codeOwners *CodeOwners) ([]*StaleCodeOwner, error)
+	TestHook(ctx context.Context, owner, repo string, id int64) (*Response, error)
+	Transfer(ctx context.Context, owner, repo string, transfer TransferRequest) (*Repository, *Response, error)
+	UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *ProtectionRequest) (*Protection, *Response, error)
+	UpdateComment(ctx context.Context, owner, repo string, id int64, comment *RepositoryComment) (*RepositoryComment, *Response, error)
+	UpdateFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentFileOptions) (*RepositoryContentResponse, *Response, error)
+	UpdateInvitation(ctx context.Context, owner, repo string, invitationID int64, permissions string) (*RepositoryInvitation, *Response, error)
+	UpdatePreReceiveHook(ctx context.Context, owner, repo string, id int64, hook *PreReceiveHook) (*PreReceiveHook, *Response, error)
+	UpdatePullRequestReviewEnforcement(ctx context.Context, owner, repo, branch string, patch *PullRequestReviewsEnforcementUpdate) (*PullRequestReviewsEnforcement, *Response, error)
+	UpdateRequiredStatusChecks(ctx context.Context, owner, repo, branch string, sreq *RequiredStatusChecksRequest) (*RequiredStatusChecks, *Response, error)
+	UploadReleaseAsset(ctx context.Context, owner, repo string, id int64, opt *UploadOptions, file *os.File) (*ReleaseAsset, *Response, error)
+}
+
+var _ RepositoriesServiceInterface = (*RepositoriesService)(nil)
+
+// SCIMServiceInterface lists the methods of SCIMService, so that it can be
+// replaced by a fake in tests. See SCIMService for documentation.
+type SCIMServiceInterface interface {
+	DeleteSCIMUserFromOrg(ctx context.Context, org, scimUserID string) (*Response, error)
+	GetSCIMProvisioningInfo(ctx context.Context, org, scimUserID string) (*SCIMUserAttributes, *Response, error)
+	ListSCIMProvisionedIdentities(ctx context.Context, org string, opt *ListSCIMProvisionedIdentitiesOptions) (*SCIMProvisionedIdentities, *Response, error)
+	ProvisionAndInviteSCIMUser(ctx context.Context, org string, opt *SCIMUserAttributes) (*SCIMUserAttributes, *Response, error)
+	UpdateAttributeForSCIMUser(ctx context.Context, org, scimUserID string, opt *UpdateAttributeForSCIMUserOptions) (*Response, error)
+	UpdateProvisionedOrgMembership(ctx context.Context, org, scimUserID string, opt *SCIMUserAttributes) (*Response, error)
+}
+
+var _ SCIMServiceInterface = (*SCIMService)(nil)
+
+// SearchServiceInterface lists the methods of SearchService, so that it can be
+// replaced by a fake in tests. See SearchService for documentation.
+type SearchServiceInterface interface {
+	Code(ctx context.Context, query string, opt *SearchOptions) (*CodeSearchResult, *Response, error)
+	Commits(ctx context.Context, query string, opt *SearchOptions) (*CommitsSearchResult, *Response, error)
+	CommitsByAuthorDate(ctx context.Context, query string, from, to time.Time) (*CommitsSearchResult, error)
+	Issues(ctx context.Context, query string, opt *SearchOptions) (*IssuesSearchResult, *Response, error)
+	IssuesByQualifiers(ctx context.Context, q *SearchQuery, opt *SearchOptions) (*IssuesSearchResult, *Response, error)
+	Labels(ctx context.Context, repoID int64, query string, opt *SearchOptions) (*LabelsSearchResult, *Response, error)
+	Repositories(ctx context.Context, query string, opt *SearchOptions) (*RepositoriesSearchResult, *Response, error)
+	Users(ctx context.Context, query string, opt *SearchOptions) (*UsersSearchResult, *Response, error)
+}
+
+var _ SearchServiceInterface = (*SearchService)(nil)
+
+// SecurityAdvisoriesServiceInterface lists the methods of SecurityAdvisoriesService, so that it can be
+// replaced by a fake in tests. See SecurityAdvisoriesService for documentation.
+type SecurityAdvisoriesServiceInterface interface {
+	CreateSecurityAdvisory(ctx context.Context, owner, repo string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error)
+	CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error)
+	GetGlobalSecurityAdvisory(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error)
+	ListGlobalSecurityAdvisories(ctx context.Context, opt *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error)
+	ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error)
+	ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error)
+	RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error)
+}
+
+var _ SecurityAdvisoriesServiceInterface = (*SecurityAdvisoriesService)(nil)
+
+// TeamsServiceInterface lists the methods of TeamsService, so that it can be
+// replaced by a fake in tests. See TeamsService for documentation.
+type TeamsServiceInterface interface {
+	AddTeamMembership(ctx context.Context, team int64, user string, opt *TeamAddTeamMembershipOptions) (*Membership, *Response, error)
+	AddTeamProject(ctx context.Context, teamID, projectID int64, opt *TeamProjectOptions) (*Response, error)
+	AddTeamRepo(ctx context.Context, team int64, owner string, repo string, opt *TeamAddTeamRepoOptions) (*Response, error)
+	CreateComment(ctx context.Context, teamID int64, discsusionNumber int, comment DiscussionComment) (*DiscussionComment, *Response, error)
+	CreateDiscussion(ctx context.Context, teamID int64, discussion TeamDiscussion) (*TeamDiscussion, *Response, error)
+	CreateTeam(ctx context.Context, org string, team NewTeam) (*Team, *Response, error)
+	DeleteComment(ctx context.Context, teamID int64, discussionNumber, commentNumber int) (*Response, error)
+	DeleteDiscussion(ctx context.Context, teamID int64, discussionNumber int) (*Response, error)
+	DeleteTeam(ctx context.Context, team int64) (*Response, error)
+	EditComment(ctx context.Context, teamID int64, discussionNumber, commentNumber int, comment DiscussionComment) (*DiscussionComment, *Response, error)
+	EditDiscussion(ctx context.Context, teamID int64, discussionNumber int, discussion TeamDiscussion) (*TeamDiscussion, *Response, error)
+	EditTeam(ctx context.Context, id int64, team NewTeam) (*Team, *Response, error)
+	GetComment(ctx context.Context, teamID int64, discussionNumber, commentNumber int) (*DiscussionComment, *Response, error)
+	GetDiscussion(ctx context.Context, teamID int64, discussionNumber int) (*TeamDiscussion, *Response, error)
+	GetExternalGroup(ctx context.Context, org string, groupID int64) (*ExternalGroup, *Response, error)
+	GetTeam(ctx context.Context, team int64) (*Team, *Response, error)
+	GetTeamMembership(ctx context.Context, team int64, user string) (*Membership, *Response, error)
+	IsTeamMember(ctx context.Context, team int64, user string) (bool, *Response, error)
+	IsTeamRepo(ctx context.Context, team int64, owner string, repo string) (*Repository, *Response, error)
+	ListChildTeams(ctx context.Context, teamID int64, opt *ListOptions) ([]*Team, *Response, error)
+	ListComments(ctx context.Context, teamID int64, discussionNumber int, options *DiscussionCommentListOptions) ([]*DiscussionComment, *Response, error)
+	ListDiscussions(ctx context.Context, teamID int64, options *DiscussionListOptions) ([]*TeamDiscussion, *Response, error)
+	ListExternalGroups(ctx context.Context, org string, opt *ListExternalGroupsOptions) (*ExternalGroupList, *Response, error)
+	ListPendingTeamInvitations(ctx context.Context, team int64, opt *ListOptions) ([]*Invitation, *Response, error)
+	ListTeamMembers(ctx context.Context, team int64, opt *TeamListTeamMembersOptions) ([]*User, *Response, error)
+	ListTeamMembersBySlug(ctx context.Context, org, slug string, opt *TeamListTeamMembersOptions) ([]*User, *Response, error)
+	ListTeamProjects(ctx context.Context, teamID int64) ([]*Project, *Response, error)
+	ListTeamRepos(ctx context.Context, team int64, opt *ListOptions) ([]*Repository, *Response, error)
+	ListTeams(ctx context.Context, org string, opt *ListOptions) ([]*Team, *Response, error)
+	ListUserTeams(ctx context.Context, opt *ListOptions) ([]*Team, *Response, error)
+	RemoveConnectedExternalGroup(ctx context.Context, org, slug string) (*Response, error)
+	RemoveTeamMembership(ctx context.Context, team int64, user string) (*Response, error)
+	RemoveTeamProject(ctx context.Context, teamID int64, projectID int64) (*Response, error)
+	RemoveTeamRepo(ctx context.Context, team int64, owner string, repo string) (*Response, error)
+	ReviewTeamProjects(ctx context.Context, teamID, projectID int64) (*Project, *Response, error)
+	UpdateConnectedExternalGroup(ctx context.Context, org, slug string, eg *ExternalGroup) (*ExternalGroup, *Response, error)
+}
+
+var _ TeamsServiceInterface = (*TeamsService)(nil)
+
+// UsersServiceInterface lists the methods of UsersService, so that it can be
+// replaced by a fake in tests. See UsersService for documentation.
+type UsersServiceInterface interface {
+	AcceptInvitation(ctx context.Context, invitationID int64) (*Response, error)
+	AddEmails(ctx context.Context, emails []string) ([]*UserEmail, *Response, error)
+	BlockUser(ctx context.Context, user string) (*Response, error)
+	CreateGPGKey(ctx context.Context, armoredPublicKey string) (*GPGKey, *Response, error)
+	CreateKey(ctx context.Context, key *Key) (*Key, *Response, error)
+	DeclineInvitation(ctx context.Context, invitationID int64) (*Response, error)
+	DeleteEmails(ctx context.Context, emails []string) (*Response, error)
+	DeleteGPGKey(ctx context.Context, id int64) (*Response, error)
+	DeleteKey(ctx context.Context, id int64) (*Response, error)
+	DemoteSiteAdmin(ctx context.Context, user string) (*Response, error)
+	Edit(ctx context.Context, user *User) (*User, *Response, error)
+	Follow(ctx context.Context, user string) (*Response, error)
+	Get(ctx context.Context, user string) (*User, *Response, error)
+	GetByID(ctx context.Context, id int64) (*User, *Response, error)
+	GetContributionCalendar(ctx context.Context, user string, from, to time.Time) (*ContributionCalendar, *Response, error)
+	GetGPGKey(ctx context.Context, id int64) (*GPGKey, *Response, error)
+	GetHovercard(ctx context.Context, user string, opt *HovercardOptions) (*Hovercard, *Response, error)
+	GetKey(ctx context.Context, id int64) (*Key, *Response, error)
+	GetSavedReply(ctx context.Context, id string) (*SavedReply, *Response, error)
+	IsBlocked(ctx context.Context, user string) (bool, *Response, error)
+	IsFollowing(ctx context.Context, user, target string) (bool, *Response, error)
+	ListAll(ctx context.Context, opt *UserListOptions) ([]*User, *Response, error)
+	ListBlockedUsers(ctx context.Context, opt *ListOptions) ([]*User, *Response, error)
+	ListEmails(ctx context.Context, opt *ListOptions) ([]*UserEmail, *Response, error)
+	ListFollowers(ctx context.Context, user string, opt *ListOptions) ([]*User, *Response, error)
+	ListFollowing(ctx context.Context, user string, opt *ListOptions) ([]*User, *Response, error)
+	ListGPGKeys(ctx context.Context, user string, opt *ListOptions) ([]*GPGKey, *Response, error)
+	ListInvitations(ctx context.Context, opt *ListOptions) ([]*RepositoryInvitation, *Response, error)
+	ListKeys(ctx context.Context, user string, opt *ListOptions) ([]*Key, *Response, error)
+	ListSavedReplies(ctx context.Context, opt *GraphQLListOptions) (*SavedReplyConnection, *Response, error)
+	PromoteSiteAdmin(ctx context.Context, user string) (*Response, error)
+	Suspend(ctx context.Context, user string, opt *UserSuspendOptions) (*Response, error)
+	UnblockUser(ctx context.Context, user string) (*Response, error)
+	Unfollow(ctx context.Context, user string) (*Response, error)
+	Unsuspend(ctx context.Context, user string) (*Response, error)
+}
+
+var _ UsersServiceInterface = (*UsersService)(nil)
@@ -0,0 +1,40 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"testing"
+)
+
+// fakeRepositories overrides a single method of RepositoriesServiceInterface;
+// calling any other method panics.
+type fakeRepositories struct {
+	RepositoriesServiceInterface
+}
+
+func (fakeRepositories) Get(ctx context.Context, owner, repo string) (*Repository, *Response, error) {
+	return &Repository{FullName: String(owner + "/" + repo)}, nil, nil
+}
+
+func TestServiceInterfaces_fake(t *testing.T) {
+	client := NewClient(nil)
+
+	for _, repos := range []RepositoriesServiceInterface{client.Repositories, fakeRepositories{}} {
+		if repos == nil {
+			t.Fatal("RepositoriesServiceInterface is nil")
+		}
+	}
+
+	var repos RepositoriesServiceInterface = fakeRepositories{}
+	got, _, err := repos.Get(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if want := "o/r"; got.GetFullName() != want {
+		t.Errorf("Get returned %q, want %q", got.GetFullName(), want)
+	}
+}
@@ -4,6 +4,7 @@
 // license that can be found in the LICENSE file.
 
 //go:generate go run gen-accessors.go
+//go:generate go run gen-interfaces.go
 
 package github
 
@@ -36,17 +37,17 @@ const (
 	headerRateReset     = "X-RateLimit-Reset"
 	headerOTP           = "X-GitHub-OTP"
 
-	mediaTypeV3                = "application/vnd.github.v3+json"
+	mediaTypeV3                = MediaTypeJSON
 	defaultMediaType           = "application/octet-stream"
-	mediaTypeV3SHA             = "application/vnd.github.v3.sha"
-	mediaTypeV3Diff            = "application/vnd.github.v3.diff"
-	mediaTypeV3Patch           = "application/vnd.github.v3.patch"
+	mediaTypeV3SHA             = MediaTypeSHA
+	mediaTypeV3Diff            = MediaTypeDiff
+	mediaTypeV3Patch           = MediaTypePatch
 	mediaTypeOrgPermissionRepo = "application/vnd.github.v3.repository+json"
 
 	// Media Type values to access preview APIs
 
 	// https://developer.github.com/changes/2014-12-09-new-attributes-for-stars-api/
-	mediaTypeStarringPreview = "application/vnd.github.v3.star+json"
+	mediaTypeStarringPreview = MediaTypeStar
 
 	// https://help.github.com/enterprise/2.4/admin/guides/migrations/exporting-the-github-com-organization-s-repositories/
 	mediaTypeMigrationsPreview = "application/vnd.github.wyandotte-preview+json"
@@ -88,7 +89,7 @@ const (
 	mediaTypeCodesOfConductPreview = "application/vnd.github.scarlet-witch-preview+json"
 
 	// https://developer.github.com/changes/2017-07-17-update-topics-on-repositories/
-	mediaTypeTopicsPreview = "application/vnd.github.mercy-preview+json"
+	mediaTypeTopicsPreview = MediaTypeTopics
 
 	// https://developer.github.com/changes/2017-08-30-preview-nested-teams/
 	mediaTypeNestedTeamsPreview = "application/vnd.github.hellcat-preview+json"
@@ -137,6 +138,9 @@ const (
 
 	// https://developer.github.com/changes/2019-04-24-vulnerability-alerts/
 	mediaTypeRequiredVulnerabilityAlertsPreview = "application/vnd.github.dorian-preview+json"
+
+	// https://gist.github.com/jonmagic/5282384165e0f86ef105
+	mediaTypeIssueImportAPI = "application/vnd.github.golden-comet-preview+json"
 )
 
 // A Client manages communication with the GitHub API.
@@ -155,33 +159,76 @@ type Client struct {
 	// User agent used when communicating with the GitHub API.
 	UserAgent string
 
+	// MaxResponseSize is the maximum size in bytes of a response body read
+	// by Do. Larger responses fail with a *ResponseTooLargeError instead of
+	// being read into memory. Zero means no limit.
+	MaxResponseSize int64
+
+	// RetryCoordinator, if set, coordinates the retries of the goroutines
+	// sharing the client.
+	RetryCoordinator *RetryCoordinator
+
+	// RetainRawBody makes Do keep the raw body of successful responses in
+	// Response.RawBody, so that fields the API returns before this package
+	// supports them can be read. RequestPolicy.RetainRawBody enables it for
+	// individual requests.
+	RetainRawBody bool
+
+	// ReportUnknownFields makes Do list the JSON fields of successful
+	// responses that were ignored while decoding them in
+	// Response.UnknownFields, to detect API changes this package does not
+	// support yet. RequestPolicy.ReportUnknownFields enables it for
+	// individual requests.
+	ReportUnknownFields bool
+
+	// UseNumber makes Do decode the JSON numbers of responses into
+	// interface{} values, such as those of Hook.Config, as json.Number
+	// rather than float64, which cannot represent IDs above 2^53 exactly.
+	// RequestPolicy.UseNumber enables it for individual requests.
+	UseNumber bool
+
 	rateMu     sync.Mutex
 	rateLimits [categories]Rate // Rate limits for the client as determined by the most recent API calls.
 
+	secretKeys sync.Map // Actions secrets public keys, keyed by their URL.
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
-	Activity       *ActivityService
-	Admin          *AdminService
-	Apps           *AppsService
-	Authorizations *AuthorizationsService
-	Checks         *ChecksService
-	Gists          *GistsService
-	Git            *GitService
-	Gitignores     *GitignoresService
-	Interactions   *InteractionsService
-	Issues         *IssuesService
-	Licenses       *LicensesService
-	Marketplace    *MarketplaceService
-	Migrations     *MigrationService
-	Organizations  *OrganizationsService
-	Projects       *ProjectsService
-	PullRequests   *PullRequestsService
-	Reactions      *ReactionsService
-	Repositories   *RepositoriesService
-	Search         *SearchService
-	Teams          *TeamsService
-	Users          *UsersService
+	Actions            *ActionsService
+	Activity           *ActivityService
+	Admin              *AdminService
+	Apps               *AppsService
+	Authorizations     *AuthorizationsService
+	Billing            *BillingService
+	Checks             *ChecksService
+	Classroom          *ClassroomService
+	CodeScanning       *CodeScanningService
+	Dependabot         *DependabotService
+	DependencyGraph    *DependencyGraphService
+	Discussions        *DiscussionsService
+	Enterprise         *EnterpriseService
+	Gists              *GistsService
+	Git                *GitService
+	Gitignores         *GitignoresService
+	Interactions       *InteractionsService
+	IssueImport        *IssueImportService
+	Issues             *IssuesService
+	LFS                *LFSService
+	Licenses           *LicensesService
+	Marketplace        *MarketplaceService
+	Migrations         *MigrationService
+	Organizations      *OrganizationsService
+	Packages           *PackagesService
+	Projects           *ProjectsService
+	PullRequests       *PullRequestsService
+	Reactions          *ReactionsService
+	Repositories       *RepositoriesService
+	SCIM               *SCIMService
+	Search             *SearchService
+	SecurityAdvisories *SecurityAdvisoriesService
+	Teams              *TeamsService
+	Users              *UsersService
 }
 
 type service struct {
@@ -198,6 +245,21 @@ type ListOptions struct {
 	PerPage int `url:"per_page,omitempty"`
 }
 
+// ListCursorOptions specifies the optional parameters to various List methods that
+// support cursor pagination.
+type ListCursorOptions struct {
+	// For paginated result sets, the number of results to include per page.
+	PerPage int `url:"per_page,omitempty"`
+
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for events before this cursor.
+	Before string `url:"before,omitempty"`
+
+	// A cursor, as given in the Link header. If specified, the query only
+	// searches for events after this cursor.
+	After string `url:"after,omitempty"`
+}
+
 // UploadOptions specifies the parameters to methods that support uploads.
 type UploadOptions struct {
 	Name      string `url:"name,omitempty"`
@@ -256,25 +318,38 @@ func NewClient(httpClient *http.Client) *Client {
 
 	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent, UploadURL: uploadURL}
 	c.common.client = c
+	c.Actions = (*ActionsService)(&c.common)
 	c.Activity = (*ActivityService)(&c.common)
 	c.Admin = (*AdminService)(&c.common)
 	c.Apps = (*AppsService)(&c.common)
 	c.Authorizations = (*AuthorizationsService)(&c.common)
+	c.Billing = (*BillingService)(&c.common)
 	c.Checks = (*ChecksService)(&c.common)
+	c.Classroom = (*ClassroomService)(&c.common)
+	c.CodeScanning = (*CodeScanningService)(&c.common)
+	c.Dependabot = (*DependabotService)(&c.common)
+	c.DependencyGraph = (*DependencyGraphService)(&c.common)
+	c.Discussions = (*DiscussionsService)(&c.common)
+	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
 	c.Git = (*GitService)(&c.common)
 	c.Gitignores = (*GitignoresService)(&c.common)
 	c.Interactions = (*InteractionsService)(&c.common)
+	c.IssueImport = (*IssueImportService)(&c.common)
 	c.Issues = (*IssuesService)(&c.common)
+	c.LFS = (*LFSService)(&c.common)
 	c.Licenses = (*LicensesService)(&c.common)
 	c.Marketplace = &MarketplaceService{client: c}
 	c.Migrations = (*MigrationService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.Packages = (*PackagesService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.Reactions = (*ReactionsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
+	c.SCIM = (*SCIMService)(&c.common)
 	c.Search = (*SearchService)(&c.common)
+	c.SecurityAdvisories = (*SecurityAdvisoriesService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
 	return c
@@ -394,9 +469,25 @@ type Response struct {
 	FirstPage int
 	LastPage  int
 
+	// Additionally, some APIs support cursor pagination instead of offset.
+	// Before and After hold the cursors to pass in ListCursorOptions to
+	// retrieve the previous and next page of results, respectively.
+	Before string
+	After  string
+
 	// Explicitly specify the Rate type so Rate's String() receiver doesn't
 	// propagate to Response.
 	Rate Rate
+
+	// RawBody is the raw body of the response, set only if raw bodies are
+	// retained by Client.RetainRawBody or RequestPolicy.RetainRawBody.
+	RawBody []byte
+
+	// UnknownFields lists the JSON fields of the response body that were
+	// ignored while decoding it, such as "owner.new_field", set only if
+	// enabled by Client.ReportUnknownFields or
+	// RequestPolicy.ReportUnknownFields.
+	UnknownFields []string
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -430,8 +521,10 @@ func (r *Response) populatePageValues() {
 			if err != nil {
 				continue
 			}
-			page := url.Query().Get("page")
-			if page == "" {
+			q := url.Query()
+			page := q.Get("page")
+			before, after := q.Get("before"), q.Get("after")
+			if page == "" && before == "" && after == "" {
 				continue
 			}
 
@@ -439,8 +532,10 @@ func (r *Response) populatePageValues() {
 				switch strings.TrimSpace(segment) {
 				case `rel="next"`:
 					r.NextPage, _ = strconv.Atoi(page)
+					r.After = after
 				case `rel="prev"`:
 					r.PrevPage, _ = strconv.Atoi(page)
+					r.Before = before
 				case `rel="first"`:
 					r.FirstPage, _ = strconv.Atoi(page)
 				case `rel="last"`:
@@ -477,9 +572,23 @@ func parseRate(r *http.Response) Rate {
 // Do returns *RateLimitError immediately without making a network API call.
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
-// ctx.Err() will be returned.
+// ctx.Err() will be returned. A RequestPolicy attached to ctx with
+// WithRequestPolicy overrides the timeout, retries and rate limit reserve
+// of the request, and a media type attached to ctx with WithMediaType
+// overrides its Accept header.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if p := RequestPolicyFromContext(ctx); p != nil {
+		return c.doWithPolicy(ctx, req, v, p)
+	}
+	return c.doCoordinated(ctx, req, v)
+}
+
+// do sends a single API request; see Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	req = withContext(ctx, req)
+	if mediaType, ok := ctx.Value(mediaTypeKey{}).(string); ok {
+		req.Header.Set("Accept", mediaType)
+	}
 
 	rateLimitCategory := category(req.URL.Path)
 
@@ -511,7 +620,12 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 		return nil, err
 	}
-	defer resp.Body.Close()
+	body, keepOpen := resp.Body, false
+	defer func() {
+		if !keepOpen {
+			body.Close()
+		}
+	}()
 
 	response := newResponse(resp)
 
@@ -519,6 +633,13 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	c.rateLimits[rateLimitCategory] = response.Rate
 	c.rateMu.Unlock()
 
+	if c.MaxResponseSize > 0 {
+		if resp.ContentLength > c.MaxResponseSize {
+			return response, &ResponseTooLargeError{Response: resp, Limit: c.MaxResponseSize}
+		}
+		resp.Body = &limitedBody{ReadCloser: resp.Body, response: resp, limit: c.MaxResponseSize, remaining: c.MaxResponseSize}
+	}
+
 	err = CheckResponse(resp)
 	if err != nil {
 		// Special case for AcceptedErrors. If an AcceptedError
@@ -540,16 +661,46 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		return response, err
 	}
 
+	policy := RequestPolicyFromContext(ctx)
+	retain := c.RetainRawBody || policy.retainRawBody()
+	report := c.ReportUnknownFields || policy.reportUnknownFields()
+	var raw []byte
+	if retain || report {
+		raw, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return response, err
+		}
+		if retain {
+			response.RawBody = raw
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	}
+
+	if stream, ok := v.(*bodyStream); ok {
+		// A body read already to be retained is closed here.
+		stream.ReadCloser, keepOpen = resp.Body, !retain && !report
+		return response, nil
+	}
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			if _, copyErr := io.Copy(w, resp.Body); copyErr != nil {
+				if _, ok := copyErr.(*ResponseTooLargeError); ok {
+					err = copyErr
+				}
+			}
 		} else {
-			decErr := json.NewDecoder(resp.Body).Decode(v)
+			dec := json.NewDecoder(resp.Body)
+			if c.UseNumber || policy.useNumber() {
+				dec.UseNumber()
+			}
+			decErr := dec.Decode(v)
 			if decErr == io.EOF {
 				decErr = nil // ignore EOF errors caused by empty response body
 			}
 			if decErr != nil {
 				err = decErr
+			} else if report {
+				response.UnknownFields = unknownFields(raw, reflect.TypeOf(v))
 			}
 		}
 	}
@@ -584,6 +735,41 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request, rateLimitCategory rat
 	return nil
 }
 
+// maxRateLimitRetries is the number of times retryOnRateLimit retries a
+// request that hit a rate limit.
+const maxRateLimitRetries = 3
+
+// retryOnRateLimit calls f, and calls it again once the limit resets while
+// it fails with a rate limit error, up to maxRateLimitRetries times.
+func retryOnRateLimit(ctx context.Context, f func() error) error {
+	for retries := 0; ; retries++ {
+		err := f()
+		var d time.Duration
+		switch e := err.(type) {
+		case *RateLimitError:
+			d = time.Until(e.Rate.Reset.Time)
+		case *AbuseRateLimitError:
+			d = e.GetRetryAfter()
+			if d <= 0 {
+				d = defaultAbusePause
+			}
+		default:
+			return err
+		}
+		if retries == maxRateLimitRetries {
+			return err
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 /*
 An ErrorResponse reports one or more errors caused by an API request.
 
@@ -633,6 +819,50 @@ func (r *RateLimitError) Error() string {
 		r.Response.StatusCode, r.Message, formatRateReset(r.Rate.Reset.Time.Sub(time.Now())))
 }
 
+// ResponseTooLargeError occurs when a response body is larger than
+// Client.MaxResponseSize.
+type ResponseTooLargeError struct {
+	Response *http.Response // HTTP response that caused this error
+	Limit    int64          // Limit is the Client.MaxResponseSize in effect
+}
+
+func (r *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%v %v: response body exceeds limit of %d bytes",
+		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL), r.Limit)
+}
+
+// bodyStream, passed to Client.Do as v, receives the body of a successful
+// response unread, to be streamed and closed by the caller.
+type bodyStream struct {
+	io.ReadCloser
+}
+
+// limitedBody reads at most limit bytes from a response body, and returns a
+// *ResponseTooLargeError if the body is longer.
+type limitedBody struct {
+	io.ReadCloser
+	response  *http.Response
+	limit     int64
+	remaining int64
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, &ResponseTooLargeError{Response: l.response, Limit: l.limit}
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.ReadCloser.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = -1
+		return n, &ResponseTooLargeError{Response: l.response, Limit: l.limit}
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
+
 // AcceptedError occurs when GitHub returns 202 Accepted response with an
 // empty body, which means a job was scheduled on the GitHub side to process
 // the information needed and cache it.
@@ -810,6 +1040,12 @@ type RateLimits struct {
 	//
 	// GitHub API docs: https://developer.github.com/v3/search/#rate-limit
 	Search *Rate `json:"search"`
+
+	// The rate limit for GraphQL API requests, in points per hour. Queries
+	// cost points according to the number of nodes they may return.
+	//
+	// GitHub API docs: https://docs.github.com/en/graphql/overview/resource-limitations
+	GraphQL *Rate `json:"graphql"`
 }
 
 func (r RateLimits) String() string {
@@ -821,6 +1057,7 @@ type rateLimitCategory uint8
 const (
 	coreCategory rateLimitCategory = iota
 	searchCategory
+	graphqlCategory
 
 	categories // An array of this length will be able to contain all rate limit categories.
 )
@@ -832,6 +1069,8 @@ func category(path string) rateLimitCategory {
 		return coreCategory
 	case strings.HasPrefix(path, "/search/"):
 		return searchCategory
+	case path == "/graphql" || path == "/api/graphql":
+		return graphqlCategory
 	}
 }
 
@@ -858,6 +1097,9 @@ func (c *Client) RateLimits(ctx context.Context) (*RateLimits, *Response, error)
 		if response.Resources.Search != nil {
 			c.rateLimits[searchCategory] = *response.Resources.Search
 		}
+		if response.Resources.GraphQL != nil {
+			c.rateLimits[graphqlCategory] = *response.Resources.GraphQL
+		}
 		c.rateMu.Unlock()
 	}
 
@@ -986,6 +1228,47 @@ func (t *BasicAuthTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
+// bareClient returns an *http.Client for requests to hosts other than the
+// API, such as object storage, which must not carry the client's
+// authentication. It uses the transport that the authenticating transports
+// of the client wrap, so that proxies and TLS settings still apply.
+func (c *Client) bareClient() *http.Client {
+	return &http.Client{
+		Transport:     unwrapTransport(c.client.Transport),
+		CheckRedirect: c.client.CheckRedirect,
+		Timeout:       c.client.Timeout,
+	}
+}
+
+// unwrapTransport returns the transport wrapped by the authenticating
+// transports t is made of: BasicAuthTransport,
+// UnauthenticatedRateLimitedTransport, and those with a Base transport
+// field, such as oauth2.Transport.
+func unwrapTransport(t http.RoundTripper) http.RoundTripper {
+	for {
+		switch tt := t.(type) {
+		case nil:
+			return http.DefaultTransport
+		case *BasicAuthTransport:
+			t = tt.transport()
+			continue
+		case *UnauthenticatedRateLimitedTransport:
+			t = tt.transport()
+			continue
+		}
+
+		v := reflect.ValueOf(t)
+		if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return t
+		}
+		base := v.Elem().FieldByName("Base")
+		if !base.IsValid() || base.Type() != reflect.TypeOf((*http.RoundTripper)(nil)).Elem() {
+			return t
+		}
+		t, _ = base.Interface().(http.RoundTripper)
+	}
+}
+
 // formatRateReset formats d to look like "[rate reset in 2s]" or
 // "[rate reset in 87m02s]" for the positive durations. And like "[rate limit was reset 87m02s ago]"
 // for the negative cases.
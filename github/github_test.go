@@ -365,6 +365,27 @@ func TestResponse_populatePageValues(t *testing.T) {
 	}
 }
 
+func TestResponse_cursorPagination(t *testing.T) {
+	r := http.Response{
+		Header: http.Header{
+			"Link": {`<https://api.github.com/?after=dGFyZ2V0&per_page=2>; rel="next",` +
+				` <https://api.github.com/?before=c291cmNl&per_page=2>; rel="prev"`,
+			},
+		},
+	}
+
+	response := newResponse(&r)
+	if got, want := response.After, "dGFyZ2V0"; got != want {
+		t.Errorf("response.After: %v, want %v", got, want)
+	}
+	if got, want := response.Before, "c291cmNl"; got != want {
+		t.Errorf("response.Before: %v, want %v", got, want)
+	}
+	if got, want := response.NextPage, 0; got != want {
+		t.Errorf("response.NextPage: %v, want %v", got, want)
+	}
+}
+
 func TestResponse_populatePageValues_invalid(t *testing.T) {
 	r := http.Response{
 		Header: http.Header{
@@ -446,6 +467,171 @@ func TestDo_httpError(t *testing.T) {
 	}
 }
 
+func TestDo_maxResponseSize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// Flush before writing the body, so that it is sent without a
+		// Content-Length and the limit is enforced while reading.
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, `{"A":"`+strings.Repeat("a", 100)+`"}`)
+	})
+
+	client.MaxResponseSize = 50
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(context.Background(), req, new(struct{ A string }))
+	if rerr, ok := err.(*ResponseTooLargeError); !ok {
+		t.Errorf("Do returned error %#v, want *ResponseTooLargeError", err)
+	} else if rerr.Limit != 50 {
+		t.Errorf("ResponseTooLargeError.Limit = %v, want 50", rerr.Limit)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	var buf bytes.Buffer
+	_, err = client.Do(context.Background(), req, &buf)
+	if _, ok := err.(*ResponseTooLargeError); !ok {
+		t.Errorf("Do with io.Writer returned error %#v, want *ResponseTooLargeError", err)
+	}
+	if buf.Len() != 50 {
+		t.Errorf("Do with io.Writer wrote %v bytes, want 50", buf.Len())
+	}
+
+	client.MaxResponseSize = 108
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.Background(), req, new(struct{ A string })); err != nil {
+		t.Errorf("Do returned error %v for a response within the limit", err)
+	}
+}
+
+func TestDo_bodyStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, strings.Repeat("a", 100))
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	var body bodyStream
+	if _, err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	got, err := ioutil.ReadAll(body)
+	body.Close()
+	if err != nil || len(got) != 100 {
+		t.Errorf("streamed body has %v bytes and error %v, want 100 bytes", len(got), err)
+	}
+
+	client.MaxResponseSize = 50
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.Background(), req, &body); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	_, err = ioutil.ReadAll(body)
+	body.Close()
+	if _, ok := err.(*ResponseTooLargeError); !ok {
+		t.Errorf("reading the streamed body returned %#v, want *ResponseTooLargeError", err)
+	}
+}
+
+func TestDo_retainRawBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"A":"a","New":"field"}`
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	type foo struct{ A string }
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(context.Background(), req, new(foo))
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.RawBody != nil {
+		t.Errorf("Response.RawBody = %s, want nil", resp.RawBody)
+	}
+
+	client.RetainRawBody = true
+	req, _ = client.NewRequest("GET", ".", nil)
+	v := new(foo)
+	resp, err = client.Do(context.Background(), req, v)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if string(resp.RawBody) != body {
+		t.Errorf("Response.RawBody = %s, want %s", resp.RawBody, body)
+	}
+	if want := (&foo{"a"}); !reflect.DeepEqual(v, want) {
+		t.Errorf("Response body = %v, want %v", v, want)
+	}
+
+	client.RetainRawBody = false
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{RetainRawBody: true})
+	req, _ = client.NewRequest("GET", ".", nil)
+	var buf bytes.Buffer
+	resp, err = client.Do(ctx, req, &buf)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if string(resp.RawBody) != body || buf.String() != body {
+		t.Errorf("Response.RawBody = %s and written body = %s, want %s", resp.RawBody, buf.String(), body)
+	}
+}
+
+func TestDo_useNumber(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":9007199254740993}`)
+	})
+
+	for _, tt := range []struct {
+		ctx       context.Context
+		useNumber bool
+		want      interface{}
+	}{
+		{context.Background(), false, float64(9007199254740992)},
+		{context.Background(), true, json.Number("9007199254740993")},
+		{WithRequestPolicy(context.Background(), &RequestPolicy{UseNumber: true}), false, json.Number("9007199254740993")},
+	} {
+		client.UseNumber = tt.useNumber
+		req, _ := client.NewRequest("GET", ".", nil)
+		var body map[string]interface{}
+		if _, err := client.Do(tt.ctx, req, &body); err != nil {
+			t.Fatalf("Do returned error: %v", err)
+		}
+		if got := body["id"]; got != tt.want {
+			t.Errorf("Do decoded id as %#v, want %#v", got, tt.want)
+		}
+	}
+}
+
+func TestDo_maxResponseSizeContentLength(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		w.Write(bytes.Repeat([]byte(" "), 100))
+	})
+
+	client.MaxResponseSize = 50
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if _, ok := err.(*ResponseTooLargeError); !ok {
+		t.Errorf("Do returned error %#v, want *ResponseTooLargeError", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Do returned response %v, want status 200", resp)
+	}
+}
+
 // Test handling of an error caused by the internal http client's Do()
 // function. A redirect loop is pretty unlikely to occur within the GitHub
 // API, but does allow us to exercise the right code path.
@@ -888,7 +1074,8 @@ func TestRateLimits(t *testing.T) {
 		testMethod(t, r, "GET")
 		fmt.Fprint(w, `{"resources":{
 			"core": {"limit":2,"remaining":1,"reset":1372700873},
-			"search": {"limit":3,"remaining":2,"reset":1372700874}
+			"search": {"limit":3,"remaining":2,"reset":1372700874},
+			"graphql": {"limit":4,"remaining":3,"reset":1372700875}
 		}}`)
 	})
 
@@ -908,6 +1095,11 @@ func TestRateLimits(t *testing.T) {
 			Remaining: 2,
 			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 54, 0, time.UTC).Local()},
 		},
+		GraphQL: &Rate{
+			Limit:     4,
+			Remaining: 3,
+			Reset:     Timestamp{time.Date(2013, time.July, 1, 17, 47, 55, 0, time.UTC).Local()},
+		},
 	}
 	if !reflect.DeepEqual(rate, want) {
 		t.Errorf("RateLimits returned %+v, want %+v", rate, want)
@@ -919,6 +1111,24 @@ func TestRateLimits(t *testing.T) {
 	if got, want := client.rateLimits[searchCategory], *want.Search; got != want {
 		t.Errorf("client.rateLimits[searchCategory] is %+v, want %+v", got, want)
 	}
+	if got, want := client.rateLimits[graphqlCategory], *want.GraphQL; got != want {
+		t.Errorf("client.rateLimits[graphqlCategory] is %+v, want %+v", got, want)
+	}
+}
+
+func TestCategory(t *testing.T) {
+	tests := map[string]rateLimitCategory{
+		"/repos/o/r":           coreCategory,
+		"/repos/o/graphql":     coreCategory,
+		"/search/repositories": searchCategory,
+		"/graphql":             graphqlCategory,
+		"/api/graphql":         graphqlCategory,
+	}
+	for path, want := range tests {
+		if got := category(path); got != want {
+			t.Errorf("category(%q) = %v, want %v", path, got, want)
+		}
+	}
 }
 
 func TestUnauthenticatedRateLimitedTransport(t *testing.T) {
@@ -1036,6 +1246,42 @@ func TestBasicAuthTransport_transport(t *testing.T) {
 	}
 }
 
+// baseTransport stands for transports such as oauth2.Transport.
+type baseTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *baseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.Base.RoundTrip(req)
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestUnwrapTransport(t *testing.T) {
+	base := &http.Transport{}
+	tests := []struct {
+		transport http.RoundTripper
+		want      http.RoundTripper
+	}{
+		{nil, http.DefaultTransport},
+		{base, base},
+		{&BasicAuthTransport{Transport: base}, base},
+		{&UnauthenticatedRateLimitedTransport{}, http.DefaultTransport},
+		{&baseTransport{Base: &BasicAuthTransport{Transport: base}}, base},
+		{&baseTransport{}, http.DefaultTransport},
+	}
+	for _, tt := range tests {
+		if got := unwrapTransport(tt.transport); got != tt.want {
+			t.Errorf("unwrapTransport(%#v) = %#v, want %#v", tt.transport, got, tt.want)
+		}
+	}
+}
+
 func TestFormatRateReset(t *testing.T) {
 	d := 120*time.Minute + 12*time.Second
 	got := formatRateReset(d)
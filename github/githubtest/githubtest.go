@@ -0,0 +1,253 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubtest provides an in-process GitHub API server for testing
+// code that uses the github package.
+//
+// A Server wraps an httptest.Server and a github.Client configured to talk
+// to it. Tests register canned responses for the endpoints they exercise
+// and use the Assert helpers to check the requests they receive:
+//
+//	s := githubtest.NewServer(t)
+//	defer s.Close()
+//
+//	s.HandleJSON("GET", "/repos/o/r", http.StatusOK, &github.Repository{Name: github.String("r")})
+//
+//	repo, _, err := s.Client.Repositories.Get(ctx, "o", "r")
+//
+// Paths are relative to the API root and never include the base URL path.
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// baseURLPath is the path prefix of the Client's BaseURL. It is non-empty so
+// that absolute endpoint URLs are caught.
+const baseURLPath = "/api-v3"
+
+// Server is a mock GitHub API server.
+type Server struct {
+	*httptest.Server
+
+	// Client is a GitHub client whose BaseURL and UploadURL point at the server.
+	Client *github.Client
+
+	t   testing.TB
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	handlers map[string]map[string]http.HandlerFunc // path -> method -> handler
+}
+
+// NewServer starts a Server. Requests to unregistered endpoints, or with an
+// unregistered method, are reported as test errors on t. The caller should
+// call Close when finished, to shut it down.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		t:        t,
+		mux:      http.NewServeMux(),
+		handlers: make(map[string]map[string]http.HandlerFunc),
+	}
+
+	apiHandler := http.NewServeMux()
+	apiHandler.Handle(baseURLPath+"/", http.StripPrefix(baseURLPath, s.mux))
+	apiHandler.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("githubtest: request URL %v does not preserve the Client.BaseURL path prefix", r.URL)
+		http.Error(w, "Client.BaseURL path prefix is not preserved in the request URL.", http.StatusInternalServerError)
+	})
+	s.Server = httptest.NewServer(apiHandler)
+
+	u, _ := url.Parse(s.Server.URL + baseURLPath + "/")
+	s.Client = github.NewClient(nil)
+	s.Client.BaseURL = u
+	s.Client.UploadURL = u
+
+	return s
+}
+
+// Handle registers h for requests with the given method and path.
+func (s *Server) Handle(method, path string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	methods, ok := s.handlers[path]
+	if !ok {
+		methods = make(map[string]http.HandlerFunc)
+		s.handlers[path] = methods
+		s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			s.mu.Lock()
+			h, ok := methods[r.Method]
+			s.mu.Unlock()
+			if !ok {
+				s.t.Errorf("githubtest: unexpected %v request to %v", r.Method, r.URL.Path)
+				http.Error(w, "method not registered", http.StatusMethodNotAllowed)
+				return
+			}
+			h(w, r)
+		})
+	}
+	methods[method] = h
+}
+
+// HandleJSON registers a canned response for requests with the given method
+// and path. body is encoded as JSON, unless it is a string or []byte, in
+// which case it is written as is. A nil body writes no content.
+func (s *Server) HandleJSON(method, path string, status int, body interface{}) {
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(s.t, w, status, body)
+	})
+}
+
+// HandlePages registers a paginated response for GET requests to path. Page
+// n (1-based, as selected by the "page" query parameter) responds with
+// pages[n-1], and a Link header referring to the next, previous, first and
+// last pages as appropriate.
+func (s *Server) HandlePages(path string, pages ...interface{}) {
+	s.Handle("GET", path, func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			var err error
+			if page, err = strconv.Atoi(p); err != nil || page < 1 || page > len(pages) {
+				s.t.Errorf("githubtest: request for invalid page %q of %v", p, path)
+				http.Error(w, "invalid page", http.StatusBadRequest)
+				return
+			}
+		}
+
+		var links []string
+		link := func(n int, rel string) {
+			q := r.URL.Query()
+			q.Set("page", strconv.Itoa(n))
+			u := url.URL{Scheme: "http", Host: r.Host, Path: baseURLPath + r.URL.Path, RawQuery: q.Encode()}
+			links = append(links, fmt.Sprintf(`<%v>; rel="%v"`, u.String(), rel))
+		}
+		if page < len(pages) {
+			link(page+1, "next")
+			link(len(pages), "last")
+		}
+		if page > 1 {
+			link(page-1, "prev")
+			link(1, "first")
+		}
+		if len(links) > 0 {
+			w.Header().Set("Link", strings.Join(links, ", "))
+		}
+
+		writeJSON(s.t, w, http.StatusOK, pages[page-1])
+	})
+}
+
+// HandleRateLimited registers a response for requests with the given method
+// and path that reports the primary rate limit as exhausted until reset.
+func (s *Server) HandleRateLimited(method, path string, reset time.Time) {
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		writeJSON(s.t, w, http.StatusForbidden, map[string]string{
+			"message":           "API rate limit exceeded for xxx.xxx.xxx.xxx.",
+			"documentation_url": "https://developer.github.com/v3/#rate-limiting",
+		})
+	})
+}
+
+func writeJSON(t testing.TB, w http.ResponseWriter, status int, body interface{}) {
+	var data []byte
+	switch b := body.(type) {
+	case nil:
+	case string:
+		data = []byte(b)
+	case []byte:
+		data = b
+	default:
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			t.Errorf("githubtest: unable to encode response body: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if data != nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	}
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// AssertMethod reports an error if r does not use the method want.
+func AssertMethod(t testing.TB, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+// AssertHeader reports an error if the header key of r is not want.
+func AssertHeader(t testing.TB, r *http.Request, key, want string) {
+	t.Helper()
+	if got := r.Header.Get(key); got != want {
+		t.Errorf("Header.Get(%q) returned %q, want %q", key, got, want)
+	}
+}
+
+// AssertQuery reports an error if the query parameters of r are not exactly
+// want.
+func AssertQuery(t testing.TB, r *http.Request, want map[string]string) {
+	t.Helper()
+	w := url.Values{}
+	for k, v := range want {
+		w.Set(k, v)
+	}
+	if got := r.URL.Query(); !reflect.DeepEqual(got, w) {
+		t.Errorf("Request parameters: %v, want %v", got, w)
+	}
+}
+
+// AssertJSONBody decodes the body of r into a new value of the same type as
+// want and reports an error if it is not deeply equal to want. want is
+// usually a pointer to a struct of the github package, such as
+// *github.IssueRequest.
+func AssertJSONBody(t testing.TB, r *http.Request, want interface{}) {
+	t.Helper()
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Errorf("Error reading request body: %v", err)
+		return
+	}
+
+	typ := reflect.TypeOf(want)
+	var got reflect.Value
+	if typ.Kind() == reflect.Ptr {
+		got = reflect.New(typ.Elem())
+	} else {
+		got = reflect.New(typ)
+	}
+	if err := json.Unmarshal(b, got.Interface()); err != nil {
+		t.Errorf("Error decoding request body %q: %v", b, err)
+		return
+	}
+	if typ.Kind() != reflect.Ptr {
+		got = got.Elem()
+	}
+
+	if !reflect.DeepEqual(got.Interface(), want) {
+		t.Errorf("Request body: %+v, want %+v", got.Interface(), want)
+	}
+}
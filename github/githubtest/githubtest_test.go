@@ -0,0 +1,103 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v25/github"
+)
+
+func TestServer_HandleJSON(t *testing.T) {
+	s := NewServer(t)
+	defer s.Close()
+
+	s.HandleJSON("GET", "/repos/o/r", http.StatusOK, &github.Repository{ID: github.Int64(1)})
+
+	repo, _, err := s.Client.Repositories.Get(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if want := (&github.Repository{ID: github.Int64(1)}); !reflect.DeepEqual(repo, want) {
+		t.Errorf("Repositories.Get returned %+v, want %+v", repo, want)
+	}
+}
+
+func TestServer_Handle_assertions(t *testing.T) {
+	s := NewServer(t)
+	defer s.Close()
+
+	input := &github.IssueRequest{Title: github.String("t")}
+	s.Handle("POST", "/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		AssertMethod(t, r, "POST")
+		AssertHeader(t, r, "Content-Type", "application/json")
+		AssertQuery(t, r, map[string]string{})
+		AssertJSONBody(t, r, input)
+		w.Write([]byte(`{"number":1}`))
+	})
+
+	issue, _, err := s.Client.Issues.Create(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if got, want := issue.GetNumber(), 1; got != want {
+		t.Errorf("Issues.Create returned number %v, want %v", got, want)
+	}
+}
+
+func TestServer_HandlePages(t *testing.T) {
+	s := NewServer(t)
+	defer s.Close()
+
+	s.HandlePages("/orgs/o/repos", `[{"id":1}]`, `[{"id":2}]`)
+
+	opt := &github.RepositoryListByOrgOptions{}
+	var ids []int64
+	for {
+		repos, resp, err := s.Client.Repositories.ListByOrg(context.Background(), "o", opt)
+		if err != nil {
+			t.Fatalf("Repositories.ListByOrg returned error: %v", err)
+		}
+		for _, r := range repos {
+			ids = append(ids, r.GetID())
+		}
+		if resp.NextPage == 0 {
+			if resp.PrevPage != 1 || resp.FirstPage != 1 {
+				t.Errorf("last page has PrevPage %v, FirstPage %v, want 1, 1", resp.PrevPage, resp.FirstPage)
+			}
+			break
+		}
+		if resp.LastPage != 2 {
+			t.Errorf("first page has LastPage %v, want 2", resp.LastPage)
+		}
+		opt.Page = resp.NextPage
+	}
+
+	if want := []int64{1, 2}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Repositories.ListByOrg returned IDs %v, want %v", ids, want)
+	}
+}
+
+func TestServer_HandleRateLimited(t *testing.T) {
+	s := NewServer(t)
+	defer s.Close()
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	s.HandleRateLimited("GET", "/user", reset)
+
+	_, _, err := s.Client.Users.Get(context.Background(), "")
+	rerr, ok := err.(*github.RateLimitError)
+	if !ok {
+		t.Fatalf("Users.Get returned error %#v, want *github.RateLimitError", err)
+	}
+	if got := rerr.Rate.Reset.Time; !got.Equal(reset) {
+		t.Errorf("RateLimitError.Rate.Reset = %v, want %v", got, reset)
+	}
+}
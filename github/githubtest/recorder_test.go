@@ -0,0 +1,150 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v25/github"
+)
+
+// authTransport adds a fake token to every request.
+type authTransport struct{}
+
+func (authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "token secret")
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRecorder_recordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "githubtest")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "fixture.json")
+
+	s := NewServer(t)
+	defer s.Close()
+	s.Handle("POST", "/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		AssertHeader(t, r, "Authorization", "token secret")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number":1}`))
+	})
+
+	// Record.
+	rec, err := NewRecorder(fixture, ModeRecord, authTransport{})
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := github.NewClient(&http.Client{Transport: rec})
+	client.BaseURL = s.Client.BaseURL
+	input := &github.IssueRequest{Title: github.String("t")}
+	if _, _, err := client.Issues.Create(context.Background(), "o", "r", input); err != nil {
+		t.Fatalf("Issues.Create returned error while recording: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("fixture contains sensitive data:\n%s", data)
+	}
+
+	// Replay, with the server gone.
+	s.Close()
+	rep, err := NewRecorder(fixture, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client = github.NewClient(&http.Client{Transport: rep})
+	client.BaseURL = s.Client.BaseURL
+	issue, resp, err := client.Issues.Create(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Fatalf("Issues.Create returned error while replaying: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusCreated; got != want {
+		t.Errorf("replayed status code = %v, want %v", got, want)
+	}
+	if got, want := issue.GetNumber(), 1; got != want {
+		t.Errorf("replayed issue number = %v, want %v", got, want)
+	}
+
+	// Each interaction is replayed once.
+	if _, _, err := client.Issues.Create(context.Background(), "o", "r", input); err == nil {
+		t.Error("Issues.Create replayed the same interaction twice, want error")
+	}
+}
+
+func TestRecorder_redactsClientSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "githubtest")
+	if err != nil {
+		t.Fatalf("TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "fixture.json")
+
+	s := NewServer(t)
+	defer s.Close()
+	s.Handle("GET", "/rate_limit", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	get := func(rt http.RoundTripper) error {
+		client := github.NewClient(&http.Client{Transport: rt})
+		client.BaseURL = s.Client.BaseURL
+		req, err := client.NewRequest("GET", "rate_limit?client_id=id&client_secret=hush", nil)
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(context.Background(), req, nil)
+		return err
+	}
+
+	rec, err := NewRecorder(fixture, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	if err := get(rec); err != nil {
+		t.Fatalf("Do returned error while recording: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+	if strings.Contains(string(data), "hush") || !strings.Contains(string(data), "client_secret=REDACTED") {
+		t.Errorf("fixture does not redact client_secret:\n%s", data)
+	}
+
+	rep, err := NewRecorder(fixture, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	if err := get(rep); err != nil {
+		t.Errorf("Do returned error while replaying: %v", err)
+	}
+}
+
+func TestNewRecorder_missingFixture(t *testing.T) {
+	if _, err := NewRecorder(filepath.Join("testdata", "does-not-exist.json"), ModeReplay, nil); err == nil {
+		t.Error("NewRecorder returned nil error for a missing fixture file")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLListOptions specifies the optional parameters to methods that
+// page through a GraphQL connection.
+type GraphQLListOptions struct {
+	// First is the number of nodes to return, up to 100. Defaults to 30.
+	First int
+
+	// After is the cursor to continue from, as returned in
+	// PageInfo.EndCursor of the previous page.
+	After string
+}
+
+// first returns the page size to request, applying the default.
+func (o *GraphQLListOptions) first() int {
+	if o == nil || o.First <= 0 {
+		return 30
+	}
+	return o.First
+}
+
+// after returns the cursor to continue from, or nil for the first page.
+func (o *GraphQLListOptions) after() *string {
+	if o == nil || o.After == "" {
+		return nil
+	}
+	return &o.After
+}
+
+// PageInfo represents pagination information of a GraphQL connection.
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
+// GraphQLError represents a single error reported by the GraphQL API.
+type GraphQLError struct {
+	Type    string        `json:"type,omitempty"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// GraphQLErrorResponse reports one or more errors returned alongside an
+// otherwise successful (200 OK) GraphQL response.
+type GraphQLErrorResponse struct {
+	Response *http.Response // HTTP response that carried the errors
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrorResponse) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		msgs[i] = e.Message
+	}
+	return fmt.Sprintf("%v %v: GraphQL errors: %v",
+		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL), strings.Join(msgs, "; "))
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []*GraphQLError `json:"errors"`
+}
+
+// graphQLURL returns the GraphQL endpoint relative to BaseURL. GitHub
+// Enterprise serves the REST API under /api/v3/ and GraphQL under
+// /api/graphql, whereas GitHub.com serves both from the same root.
+func (c *Client) graphQLURL() string {
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
+// graphQL sends a GraphQL query or mutation with the given variables and
+// decodes the "data" member of the response into v. Errors reported in the
+// response body are returned as a *GraphQLErrorResponse.
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*Response, error) {
+	req, err := c.NewRequest("POST", c.graphQLURL(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(graphQLResponse)
+	resp, err := c.Do(ctx, req, result)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(result.Errors) > 0 {
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: result.Errors}
+	}
+
+	if v != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
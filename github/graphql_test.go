@@ -0,0 +1,123 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_graphQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"query($login: String!) { user(login: $login) { name } }","variables":{"login":"octocat"}}`+"\n")
+		fmt.Fprint(w, `{"data":{"user":{"name":"The Octocat"}}}`)
+	})
+
+	var v struct {
+		User struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	query := "query($login: String!) { user(login: $login) { name } }"
+	_, err := client.graphQL(context.Background(), query, map[string]interface{}{"login": "octocat"}, &v)
+	if err != nil {
+		t.Fatalf("graphQL returned error: %v", err)
+	}
+
+	if want := "The Octocat"; v.User.Name != want {
+		t.Errorf("graphQL decoded name %q, want %q", v.User.Name, want)
+	}
+}
+
+func TestClient_graphQL_errors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":null,"errors":[{"type":"NOT_FOUND","path":["user"],"message":"Could not resolve to a User."}]}`)
+	})
+
+	_, err := client.graphQL(context.Background(), "{ user { name } }", nil, nil)
+	gerr, ok := err.(*GraphQLErrorResponse)
+	if !ok {
+		t.Fatalf("graphQL returned error %v, want *GraphQLErrorResponse", err)
+	}
+
+	want := []*GraphQLError{{Type: "NOT_FOUND", Path: []interface{}{"user"}, Message: "Could not resolve to a User."}}
+	if !reflect.DeepEqual(gerr.Errors, want) {
+		t.Errorf("GraphQLErrorResponse.Errors = %+v, want %+v", gerr.Errors, want)
+	}
+	if !strings.Contains(gerr.Error(), "Could not resolve to a User.") {
+		t.Errorf("GraphQLErrorResponse.Error() = %q, want it to contain the message", gerr.Error())
+	}
+}
+
+func TestClient_graphQLURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.github.com/", "https://api.github.com/graphql"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil)
+		c.BaseURL, _ = url.Parse(tt.baseURL)
+		u, err := c.BaseURL.Parse(c.graphQLURL())
+		if err != nil {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		if got := u.String(); got != tt.want {
+			t.Errorf("graphQL URL for %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestGraphQLListOptions(t *testing.T) {
+	var opt *GraphQLListOptions
+	if got := opt.first(); got != 30 {
+		t.Errorf("nil first() = %v, want 30", got)
+	}
+	if got := opt.after(); got != nil {
+		t.Errorf("nil after() = %v, want nil", got)
+	}
+
+	opt = &GraphQLListOptions{First: 5, After: "c"}
+	b, _ := json.Marshal(map[string]interface{}{"first": opt.first(), "after": opt.after()})
+	if want := `{"after":"c","first":5}`; string(b) != want {
+		t.Errorf("options marshaled to %s, want %s", b, want)
+	}
+}
+
+// testGraphQLRequest checks that r is a GraphQL request whose query
+// contains operation and whose variables equal want. Numbers in want must
+// be float64, as decoded by encoding/json.
+func testGraphQLRequest(t *testing.T, r *http.Request, operation string, want map[string]interface{}) {
+	t.Helper()
+	testMethod(t, r, "POST")
+
+	body := new(graphQLRequest)
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		t.Fatalf("Error decoding GraphQL request body: %v", err)
+	}
+	if !strings.Contains(body.Query, operation) {
+		t.Errorf("GraphQL query = %q, want it to contain %q", body.Query, operation)
+	}
+	if !reflect.DeepEqual(body.Variables, want) {
+		t.Errorf("GraphQL variables = %#v, want %#v", body.Variables, want)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// IssueImportService handles communication with the issue import related
+// methods of the Issue Import GitHub API. It allows issues to be created
+// with their comments, historical timestamps and closed state in a single
+// request, as used by tools migrating from other issue trackers.
+//
+// GitHub API docs: https://gist.github.com/jonmagic/5282384165e0f86ef105
+type IssueImportService service
+
+// IssueImportRequest represents a request to import an issue and its
+// comments.
+type IssueImportRequest struct {
+	IssueImport IssueImport           `json:"issue"`
+	Comments    []*IssueImportComment `json:"comments,omitempty"`
+}
+
+// IssueImport represents the issue to import.
+type IssueImport struct {
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Assignee  *string    `json:"assignee,omitempty"`
+	Milestone *int       `json:"milestone,omitempty"`
+	Closed    *bool      `json:"closed,omitempty"`
+	Labels    []string   `json:"labels,omitempty"`
+}
+
+// IssueImportComment represents a comment of an imported issue.
+type IssueImportComment struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Body      string     `json:"body"`
+}
+
+// IssueImportResponse represents the status of an issue import.
+type IssueImportResponse struct {
+	ID               *int64              `json:"id,omitempty"`
+	Status           *string             `json:"status,omitempty"`
+	URL              *string             `json:"url,omitempty"`
+	ImportIssuesURL  *string             `json:"import_issues_url,omitempty"`
+	RepositoryURL    *string             `json:"repository_url,omitempty"`
+	CreatedAt        *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time          `json:"updated_at,omitempty"`
+	Message          *string             `json:"message,omitempty"`
+	DocumentationURL *string             `json:"documentation_url,omitempty"`
+	Errors           []*IssueImportError `json:"errors,omitempty"`
+}
+
+// IssueImportError represents an error of a failed issue import.
+type IssueImportError struct {
+	Location *string `json:"location,omitempty"`
+	Resource *string `json:"resource,omitempty"`
+	Field    *string `json:"field,omitempty"`
+	Value    *string `json:"value,omitempty"`
+	Code     *string `json:"code,omitempty"`
+}
+
+// Create starts the import of an issue. The import is processed
+// asynchronously; use CheckStatus to follow it.
+//
+// GitHub API docs: https://gist.github.com/jonmagic/5282384165e0f86ef105#start-an-issue-import
+func (s *IssueImportService) Create(ctx context.Context, owner, repo string, issue *IssueImportRequest) (*IssueImportResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/import/issues", owner, repo)
+	req, err := s.client.NewRequest("POST", u, issue)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept headers when APIs fully launch.
+	req.Header.Set("Accept", mediaTypeIssueImportAPI)
+
+	i := new(IssueImportResponse)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		// The API responds with 202 Accepted and the import status.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, i); err != nil {
+				return i, resp, err
+			}
+
+			return i, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
+// CheckStatus checks the status of an issue import.
+//
+// GitHub API docs: https://gist.github.com/jonmagic/5282384165e0f86ef105#import-status-request
+func (s *IssueImportService) CheckStatus(ctx context.Context, owner, repo string, issueID int64) (*IssueImportResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/import/issues/%v", owner, repo, issueID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept headers when APIs fully launch.
+	req.Header.Set("Accept", mediaTypeIssueImportAPI)
+
+	i := new(IssueImportResponse)
+	resp, err := s.client.Do(ctx, req, i)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return i, resp, nil
+}
+
+// CheckStatusSince checks the status of the issue imports started since
+// the given time.
+//
+// GitHub API docs: https://gist.github.com/jonmagic/5282384165e0f86ef105#check-status-of-multiple-issues
+func (s *IssueImportService) CheckStatusSince(ctx context.Context, owner, repo string, since time.Time) ([]*IssueImportResponse, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/import/issues?since=%v", owner, repo, since.Format("2006-01-02"))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept headers when APIs fully launch.
+	req.Header.Set("Accept", mediaTypeIssueImportAPI)
+
+	var imports []*IssueImportResponse
+	resp, err := s.client.Do(ctx, req, &imports)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return imports, resp, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIssueImportService_Create(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	createdAt := time.Date(2020, time.August, 11, 15, 30, 0, 0, time.UTC)
+	input := &IssueImportRequest{
+		IssueImport: IssueImport{
+			Title:     "title",
+			Body:      "body",
+			CreatedAt: &createdAt,
+			Closed:    Bool(true),
+			Labels:    []string{"bug"},
+		},
+		Comments: []*IssueImportComment{{CreatedAt: &createdAt, Body: "comment"}},
+	}
+
+	mux.HandleFunc("/repos/o/r/import/issues", func(w http.ResponseWriter, r *http.Request) {
+		v := new(IssueImportRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeIssueImportAPI)
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":3,"status":"pending","url":"https://api.github.com/repos/o/r/import/issues/3"}`)
+	})
+
+	got, _, err := client.IssueImport.Create(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("IssueImport.Create returned error: %v", err)
+	}
+
+	want := &IssueImportResponse{
+		ID:     Int64(3),
+		Status: String("pending"),
+		URL:    String("https://api.github.com/repos/o/r/import/issues/3"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IssueImport.Create returned %+v, want %+v", got, want)
+	}
+}
+
+func TestIssueImportService_Create_invalidOwner(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.IssueImport.Create(context.Background(), "%", "r", nil)
+	testURLParseError(t, err)
+}
+
+func TestIssueImportService_CheckStatus(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/import/issues/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeIssueImportAPI)
+		fmt.Fprint(w, `{"id":3,"status":"failed","errors":[{"location":"/issue/title","resource":"Issue","field":"title","value":"","code":"missing_field"}]}`)
+	})
+
+	got, _, err := client.IssueImport.CheckStatus(context.Background(), "o", "r", 3)
+	if err != nil {
+		t.Errorf("IssueImport.CheckStatus returned error: %v", err)
+	}
+
+	want := &IssueImportResponse{
+		ID:     Int64(3),
+		Status: String("failed"),
+		Errors: []*IssueImportError{{
+			Location: String("/issue/title"),
+			Resource: String("Issue"),
+			Field:    String("title"),
+			Value:    String(""),
+			Code:     String("missing_field"),
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IssueImport.CheckStatus returned %+v, want %+v", got, want)
+	}
+}
+
+func TestIssueImportService_CheckStatusSince(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/import/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeIssueImportAPI)
+		testFormValues(t, r, values{"since": "2020-08-11"})
+		fmt.Fprint(w, `[{"id":3,"status":"imported"}]`)
+	})
+
+	got, _, err := client.IssueImport.CheckStatusSince(context.Background(), "o", "r", time.Date(2020, time.August, 11, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Errorf("IssueImport.CheckStatusSince returned error: %v", err)
+	}
+
+	want := []*IssueImportResponse{{ID: Int64(3), Status: String("imported")}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IssueImport.CheckStatusSince returned %+v, want %+v", got, want)
+	}
+}
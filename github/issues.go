@@ -52,6 +52,7 @@ type Issue struct {
 	Reactions        *Reactions        `json:"reactions,omitempty"`
 	Assignees        []*User           `json:"assignees,omitempty"`
 	NodeID           *string           `json:"node_id,omitempty"`
+	Type             *IssueType        `json:"type,omitempty"`
 
 	// TextMatches is only populated from search results that request text matches
 	// See: search.go and https://developer.github.com/v3/search/#text-match-metadata
@@ -84,6 +85,9 @@ type IssueRequest struct {
 	State     *string   `json:"state,omitempty"`
 	Milestone *int      `json:"milestone,omitempty"`
 	Assignees *[]string `json:"assignees,omitempty"`
+	// Type is the name of the issue type of the issue, as configured for the
+	// organization owning the repository.
+	Type *string `json:"type,omitempty"`
 }
 
 // IssueListOptions specifies the optional parameters to the IssuesService.List
@@ -0,0 +1,196 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LabelSpec is the desired state of a label.
+type LabelSpec struct {
+	Name        string
+	Color       string
+	Description string
+
+	// Aliases are former names of the label. An existing label named after
+	// an alias is renamed, which keeps it on the issues it is applied to,
+	// rather than left as an extra label.
+	Aliases []string
+}
+
+// LabelChange is a change making the labels of a repository match a set of
+// LabelSpecs.
+type LabelChange struct {
+	// Action is "create", "update", "rename" or "delete". Renamed labels
+	// may be updated too.
+	Action string
+
+	// Old is the existing label, or nil for creations.
+	Old *Label
+
+	// New is the label after the change, or nil for deletions.
+	New *Label
+}
+
+func (c *LabelChange) String() string {
+	switch c.Action {
+	case "create":
+		return fmt.Sprintf("+ label %v: color %v, description %q", c.New.GetName(), c.New.GetColor(), c.New.GetDescription())
+	case "delete":
+		return fmt.Sprintf("- label %v: color %v, description %q", c.Old.GetName(), c.Old.GetColor(), c.Old.GetDescription())
+	case "rename":
+		return fmt.Sprintf("~ label %v -> %v: color %v, description %q", c.Old.GetName(), c.New.GetName(), c.New.GetColor(), c.New.GetDescription())
+	default:
+		return fmt.Sprintf("~ label %v: color %v, description %q -> color %v, description %q",
+			c.New.GetName(), c.Old.GetColor(), c.Old.GetDescription(), c.New.GetColor(), c.New.GetDescription())
+	}
+}
+
+// diffLabels returns the changes making existing match specs, in the order
+// of specs, followed by the deletions of the extra labels if deleteExtra is
+// set. Label names are compared case-insensitively, as GitHub does.
+func diffLabels(existing []*Label, specs []*LabelSpec, deleteExtra bool) []*LabelChange {
+	byName := make(map[string]*Label)
+	for _, l := range existing {
+		byName[strings.ToLower(l.GetName())] = l
+	}
+
+	claimed := make(map[*Label]bool)
+	var changes []*LabelChange
+	for _, spec := range specs {
+		color := strings.TrimPrefix(spec.Color, "#")
+		label := &Label{Name: String(spec.Name), Color: String(color), Description: String(spec.Description)}
+
+		// A label already claimed by an earlier spec, such as one renamed
+		// from an alias, is taken as absent.
+		old := byName[strings.ToLower(spec.Name)]
+		if claimed[old] {
+			old = nil
+		}
+		action := "update"
+		if old == nil {
+			for _, alias := range spec.Aliases {
+				if l := byName[strings.ToLower(alias)]; l != nil && !claimed[l] {
+					old, action = l, "rename"
+					break
+				}
+			}
+		}
+
+		switch {
+		case old == nil:
+			changes = append(changes, &LabelChange{Action: "create", New: label})
+		case old.GetName() != spec.Name || !strings.EqualFold(old.GetColor(), color) || old.GetDescription() != spec.Description:
+			claimed[old] = true
+			changes = append(changes, &LabelChange{Action: action, Old: old, New: label})
+		default:
+			claimed[old] = true
+		}
+	}
+
+	if deleteExtra {
+		for _, l := range existing {
+			if !claimed[l] {
+				changes = append(changes, &LabelChange{Action: "delete", Old: l})
+			}
+		}
+	}
+	return changes
+}
+
+// applyLabelChange makes change in a repository.
+func (s *IssuesService) applyLabelChange(ctx context.Context, owner, repo string, change *LabelChange) error {
+	var err error
+	switch change.Action {
+	case "create":
+		_, _, err = s.CreateLabel(ctx, owner, repo, change.New)
+	case "delete":
+		_, err = s.DeleteLabel(ctx, owner, repo, change.Old.GetName())
+	default:
+		_, _, err = s.EditLabel(ctx, owner, repo, change.Old.GetName(), change.New)
+	}
+	return err
+}
+
+// SyncLabelsOptions specifies the optional parameters to the
+// IssuesService.SyncLabels method.
+type SyncLabelsOptions struct {
+	// DeleteExtra deletes the labels that match no LabelSpec, removing them
+	// from their issues and pull requests.
+	DeleteExtra bool
+
+	// DryRun computes the changes without making them.
+	DryRun bool
+
+	// Concurrency is the number of repositories synced at the same time.
+	// Default is 4.
+	Concurrency int
+}
+
+// SyncLabelsResult is the result of SyncLabels for one repository.
+type SyncLabelsResult struct {
+	// Repo is the repository, in the "owner/name" form.
+	Repo string
+
+	// Changes are the changes made, or planned for a dry run. If Err is
+	// set, they are the changes made before the failure.
+	Changes []*LabelChange
+
+	Err error
+}
+
+// SyncLabels reconciles the labels of each of repos, given in the
+// "owner/name" form, with specs: missing labels are created, drifted labels
+// are updated, labels named after an alias are renamed, and extra labels
+// are deleted if opt.DeleteExtra is set. Results are returned in the order
+// of repos.
+//
+// Repositories are synced concurrently. See Batch Methods in the package
+// documentation for how rate limits are handled.
+func (s *IssuesService) SyncLabels(ctx context.Context, repos []string, specs []*LabelSpec, opt *SyncLabelsOptions) []*SyncLabelsResult {
+	if opt == nil {
+		opt = &SyncLabelsOptions{}
+	}
+
+	results := make([]*SyncLabelsResult, len(repos))
+	s.client.forEachConcurrently(ctx, len(repos), opt.Concurrency, func(ctx context.Context, i int) {
+		results[i] = s.syncRepoLabels(ctx, repos[i], specs, opt)
+	})
+	return results
+}
+
+// syncRepoLabels syncs the labels of repo for SyncLabels.
+func (s *IssuesService) syncRepoLabels(ctx context.Context, repo string, specs []*LabelSpec, opt *SyncLabelsOptions) *SyncLabelsResult {
+	result := &SyncLabelsResult{Repo: repo}
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		result.Err = fmt.Errorf("github: invalid repository %q, want owner/name", repo)
+		return result
+	}
+	owner, name := parts[0], parts[1]
+
+	existing, err := s.client.Repositories.listAllLabels(ctx, owner, name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	changes := diffLabels(existing, specs, opt.DeleteExtra)
+	if opt.DryRun {
+		result.Changes = changes
+		return result
+	}
+	for _, change := range changes {
+		if err := s.applyLabelChange(ctx, owner, name, change); err != nil {
+			result.Err = err
+			return result
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestDiffLabels(t *testing.T) {
+	existing := []*Label{
+		{Name: String("Bug"), Color: String("ff0000")},
+		{Name: String("enhancement"), Color: String("a2eeef")},
+		{Name: String("docs"), Color: String("0075ca"), Description: String("Documentation")},
+		{Name: String("stale"), Color: String("ffffff")},
+	}
+	specs := []*LabelSpec{
+		{Name: "bug", Color: "#FF0000"},
+		{Name: "feature", Color: "a2eeef", Aliases: []string{"enhancement"}},
+		{Name: "docs", Color: "0075CA", Description: "Documentation"},
+		{Name: "security", Color: "000000"},
+	}
+
+	var got []string
+	for _, c := range diffLabels(existing, specs, true) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		`~ label bug: color ff0000, description "" -> color FF0000, description ""`,
+		`~ label enhancement -> feature: color a2eeef, description ""`,
+		`+ label security: color 000000, description ""`,
+		`- label stale: color ffffff, description ""`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffLabels returned\n%v\nwant\n%v", got, want)
+	}
+
+	if changes := diffLabels(existing, specs, false); len(changes) != 3 {
+		t.Errorf("diffLabels without deleteExtra returned %v changes, want 3", len(changes))
+	}
+}
+
+func TestDiffLabels_aliasClaimed(t *testing.T) {
+	existing := []*Label{{Name: String("bug"), Color: String("ff0000")}}
+	specs := []*LabelSpec{
+		{Name: "defect", Color: "ff0000", Aliases: []string{"bug"}},
+		{Name: "bug", Color: "ff0000"},
+	}
+
+	var got []string
+	for _, c := range diffLabels(existing, specs, true) {
+		got = append(got, c.String())
+	}
+	want := []string{
+		`~ label bug -> defect: color ff0000, description ""`,
+		`+ label bug: color ff0000, description ""`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffLabels returned\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestIssuesService_SyncLabels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var requests []string
+	record := func(r *http.Request, body string) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+body)
+		mu.Unlock()
+	}
+
+	mux.HandleFunc("/repos/o/a/labels", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var l Label
+			json.NewDecoder(r.Body).Decode(&l)
+			record(r, l.GetName())
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"enhancement","color":"a2eeef"},{"name":"extra","color":"ffffff"}]`)
+	})
+	mux.HandleFunc("/repos/o/a/labels/enhancement", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		var l Label
+		json.NewDecoder(r.Body).Decode(&l)
+		record(r, l.GetName())
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/repos/o/missing/labels", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	specs := []*LabelSpec{
+		{Name: "feature", Color: "a2eeef", Aliases: []string{"enhancement"}},
+		{Name: "bug", Color: "d73a4a"},
+	}
+
+	results := client.Issues.SyncLabels(context.Background(), []string{"o/a", "o/missing", "bad"}, specs, nil)
+	if len(results) != 3 {
+		t.Fatalf("Issues.SyncLabels returned %v results, want 3", len(results))
+	}
+	if err := results[0].Err; err != nil {
+		t.Errorf("Issues.SyncLabels returned error for o/a: %v", err)
+	}
+	if len(results[0].Changes) != 2 {
+		t.Errorf("Issues.SyncLabels made %v changes in o/a, want 2", len(results[0].Changes))
+	}
+	for _, r := range results[1:] {
+		if r.Err == nil {
+			t.Errorf("Issues.SyncLabels returned no error for %v", r.Repo)
+		}
+	}
+
+	want := []string{
+		"PATCH /repos/o/a/labels/enhancement feature",
+		"POST /repos/o/a/labels bug",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Issues.SyncLabels made requests %v, want %v", requests, want)
+	}
+}
+
+func TestIssuesService_SyncLabels_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/a/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"extra","color":"ffffff"}]`)
+	})
+
+	opt := &SyncLabelsOptions{DryRun: true, DeleteExtra: true}
+	results := client.Issues.SyncLabels(context.Background(), []string{"o/a"}, []*LabelSpec{{Name: "bug", Color: "d73a4a"}}, opt)
+	if err := results[0].Err; err != nil {
+		t.Fatalf("Issues.SyncLabels returned error: %v", err)
+	}
+
+	var got []string
+	for _, c := range results[0].Changes {
+		got = append(got, c.Action)
+	}
+	if want := []string{"create", "delete"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Issues.SyncLabels planned %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ModerationOptions specifies what IssuesService.ModerateIssues does to each
+// matching issue. The actions are taken in the order of the fields.
+type ModerationOptions struct {
+	// Labels are added to each issue.
+	Labels []string
+
+	// Lock locks the conversation of each issue, for LockReason if set.
+	Lock       bool
+	LockReason string
+
+	// Close closes each issue.
+	Close bool
+
+	// DryRun only searches for the matching issues, without changing them.
+	DryRun bool
+
+	// MaxIssues is the maximum number of issues to moderate. Zero means all
+	// the issues the search returns, which is at most 1000.
+	MaxIssues int
+}
+
+// ModerationResult is the outcome of ModerateIssues for one issue.
+type ModerationResult struct {
+	Owner  string
+	Repo   string
+	Number int
+
+	// Issue is the issue as returned by the search.
+	Issue *Issue
+
+	// Err is the error of the first action that failed, after which the
+	// following actions are skipped.
+	Err error
+}
+
+// ModerateIssues searches for the issues and pull requests matching query,
+// such as "repo:o/r is:open label:spam", and labels, locks and closes them
+// as opt specifies.
+//
+// All the matching issues are found before any is changed, so that changing
+// them does not affect the search. A request that hits a rate limit is
+// retried once the limit resets. A failure to moderate an issue is recorded
+// in its result, and the others are still moderated. The returned error is
+// only set if the search fails or ctx is done.
+func (s *IssuesService) ModerateIssues(ctx context.Context, query string, opt *ModerationOptions) ([]*ModerationResult, error) {
+	if opt == nil {
+		opt = &ModerationOptions{}
+	}
+
+	var results []*ModerationResult
+	searchOpt := &SearchOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		var issues *IssuesSearchResult
+		var resp *Response
+		err := retryOnRateLimit(ctx, func() (err error) {
+			issues, resp, err = s.client.Search.Issues(ctx, query, searchOpt)
+			return err
+		})
+		if err != nil {
+			return results, err
+		}
+
+		for i := range issues.Issues {
+			issue := &issues.Issues[i]
+			owner, repo := issueRepository(issue)
+			results = append(results, &ModerationResult{
+				Owner:  owner,
+				Repo:   repo,
+				Number: issue.GetNumber(),
+				Issue:  issue,
+			})
+			if len(results) == opt.MaxIssues {
+				break
+			}
+		}
+		if resp.NextPage == 0 || len(results) == opt.MaxIssues {
+			break
+		}
+		searchOpt.Page = resp.NextPage
+	}
+
+	if opt.DryRun {
+		return results, nil
+	}
+	for _, r := range results {
+		if r.Owner == "" {
+			r.Err = fmt.Errorf("github: unknown repository of issue %v", r.Issue.GetHTMLURL())
+			continue
+		}
+		r.Err = s.moderateIssue(ctx, r.Owner, r.Repo, r.Number, opt)
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// moderateIssue takes the actions of opt on an issue.
+func (s *IssuesService) moderateIssue(ctx context.Context, owner, repo string, number int, opt *ModerationOptions) error {
+	if len(opt.Labels) > 0 {
+		err := retryOnRateLimit(ctx, func() error {
+			_, _, err := s.AddLabelsToIssue(ctx, owner, repo, number, opt.Labels)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if opt.Lock {
+		var lockOpt *LockIssueOptions
+		if opt.LockReason != "" {
+			lockOpt = &LockIssueOptions{LockReason: opt.LockReason}
+		}
+		err := retryOnRateLimit(ctx, func() error {
+			_, err := s.Lock(ctx, owner, repo, number, lockOpt)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if opt.Close {
+		err := retryOnRateLimit(ctx, func() error {
+			_, _, err := s.Edit(ctx, owner, repo, number, &IssueRequest{State: String("closed")})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issueRepository returns the owner and name of the repository of issue, as
+// given by its repository URL.
+func issueRepository(issue *Issue) (owner, repo string) {
+	u := issue.GetRepositoryURL()
+	i := strings.LastIndex(u, "/repos/")
+	if i < 0 {
+		return "", ""
+	}
+	parts := strings.Split(u[i+len("/repos/"):], "/")
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
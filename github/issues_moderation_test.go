@@ -0,0 +1,105 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIssuesService_ModerateIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { defaultAbusePause = d }(defaultAbusePause)
+	defaultAbusePause = time.Millisecond
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"q": "label:spam", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/search/issues?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":3,"items":[{"number":1,"repository_url":"https://api.github.com/repos/o/r"},{"number":2,"repository_url":"https://api.github.com/repos/o/r"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total_count":3,"items":[{"number":3,"repository_url":"https://api.github.com/repos/o/s"}]}`)
+		}
+	})
+
+	var actions []string
+	labelCalls := 0
+	mux.HandleFunc("/repos/o/r/issues/1/labels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `["spam"]`+"\n")
+		labelCalls++
+		if labelCalls == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://developer.github.com/v3/#abuse-rate-limits"}`)
+			return
+		}
+		actions = append(actions, "label 1")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"lock_reason":"spam"}`+"\n")
+		actions = append(actions, "lock 1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"state":"closed"}`+"\n")
+		actions = append(actions, "close 1")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/issues/2/labels", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	opt := &ModerationOptions{Labels: []string{"spam"}, Lock: true, LockReason: "spam", Close: true, MaxIssues: 2}
+	results, err := client.Issues.ModerateIssues(context.Background(), "label:spam", opt)
+	if err != nil {
+		t.Fatalf("Issues.ModerateIssues returned error: %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Issues.ModerateIssues returned %v results, want 2", len(results))
+	}
+	if r := results[0]; r.Owner != "o" || r.Repo != "r" || r.Number != 1 || r.Err != nil {
+		t.Errorf("results[0] = %+v, want o/r#1 without error", r)
+	}
+	if r := results[1]; r.Number != 2 || r.Err == nil {
+		t.Errorf("results[1] = %+v, want o/r#2 with error", r)
+	}
+	if got, want := fmt.Sprint(actions), "[label 1 lock 1 close 1]"; got != want {
+		t.Errorf("Issues.ModerateIssues took actions %v, want %v", got, want)
+	}
+}
+
+func TestIssuesService_ModerateIssues_dryRun(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"items":[{"number":1,"repository_url":"https://api.github.com/repos/o/r"}]}`)
+	})
+	mux.HandleFunc("/repos/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %v %v in a dry run", r.Method, r.URL.Path)
+	})
+
+	opt := &ModerationOptions{Close: true, DryRun: true}
+	results, err := client.Issues.ModerateIssues(context.Background(), "q", opt)
+	if err != nil {
+		t.Fatalf("Issues.ModerateIssues returned error: %v", err)
+	}
+	if len(results) != 1 || results[0].Owner != "o" || results[0].Repo != "r" || results[0].Number != 1 {
+		t.Errorf("Issues.ModerateIssues returned %+v, want o/r#1", results)
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// issueTemplatesDir is the directory GitHub reads issue templates from.
+const issueTemplatesDir = ".github/ISSUE_TEMPLATE"
+
+// IssueTemplate represents an issue template of a repository: either an
+// issue form, written in YAML, or a Markdown template.
+type IssueTemplate struct {
+	// Path is the path of the template file in the repository.
+	Path string
+
+	Name        string
+	Description string // The "about" of a Markdown template.
+	Title       string // The default title of new issues.
+	Labels      []string
+	Assignees   []string
+
+	// Body is the body of a Markdown template. It is empty for forms.
+	Body string
+
+	// Fields are the fields of an issue form. They are nil for Markdown
+	// templates.
+	Fields []*IssueFormField
+}
+
+// IsForm reports whether t is an issue form rather than a Markdown template.
+func (t *IssueTemplate) IsForm() bool {
+	return t.Fields != nil
+}
+
+// IssueFormField represents a field of an issue form.
+type IssueFormField struct {
+	// Type is one of "markdown", "input", "textarea", "dropdown" or
+	// "checkboxes".
+	Type string
+	ID   string
+
+	Label       string
+	Description string
+	Placeholder string
+	Value       string // The default value, or the text of a markdown field.
+	Render      string // The language a textarea is rendered as code in.
+	Multiple    bool   // Whether several options of a dropdown can be chosen.
+	Required    bool
+
+	// Options are the options of a dropdown or checkboxes field.
+	Options []*IssueFormOption
+}
+
+// IssueFormOption represents an option of a dropdown or checkboxes field.
+// Only checkboxes can be required individually.
+type IssueFormOption struct {
+	Label    string
+	Required bool
+}
+
+// IssueTemplateError reports the ways an issue does not follow the template
+// it should have been created from.
+type IssueTemplateError struct {
+	Template *IssueTemplate
+	Errors   []string
+}
+
+func (e *IssueTemplateError) Error() string {
+	return fmt.Sprintf("github: issue does not follow template %v: %v", e.Template.Path, strings.Join(e.Errors, "; "))
+}
+
+// isIssueTemplateFile reports whether name is the name of an issue template,
+// rather than of the template chooser configuration or of another file.
+func isIssueTemplateFile(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md":
+		return true
+	case ".yml", ".yaml":
+		return !strings.EqualFold(strings.TrimSuffix(name, path.Ext(name)), "config")
+	}
+	return false
+}
+
+// ParseIssueTemplate parses an issue template. The name of the file, such
+// as "bug_report.yml", tells forms from Markdown templates.
+//
+// Only the subset of YAML used by issue templates is understood: block
+// mappings and sequences, flow sequences, quoted and block scalars.
+func ParseIssueTemplate(name string, data []byte) (*IssueTemplate, error) {
+	if !isIssueTemplateFile(path.Base(name)) {
+		return nil, fmt.Errorf("github: %v is not an issue template", name)
+	}
+	if strings.EqualFold(path.Ext(name), ".md") {
+		return parseMarkdownIssueTemplate(name, string(data))
+	}
+
+	lines := splitYAMLLines(string(data))
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("github: issue form %v is empty", name)
+	}
+	root, _ := parseYAMLBlock(lines, 0)
+	body := root.child("body")
+	if body == nil || len(body.sequence) == 0 {
+		return nil, fmt.Errorf("github: issue form %v has no body", name)
+	}
+
+	t := &IssueTemplate{
+		Path:        name,
+		Name:        root.value("name"),
+		Description: root.value("description"),
+		Title:       root.value("title"),
+		Labels:      yamlCommaStrings(root.child("labels")),
+		Assignees:   yamlCommaStrings(root.child("assignees")),
+		Fields:      []*IssueFormField{},
+	}
+	for _, n := range body.sequence {
+		attrs := n.child("attributes")
+		f := &IssueFormField{
+			Type:        n.value("type"),
+			ID:          n.value("id"),
+			Label:       attrs.value("label"),
+			Description: attrs.value("description"),
+			Placeholder: attrs.value("placeholder"),
+			Value:       attrs.value("value"),
+			Render:      attrs.value("render"),
+			Multiple:    attrs.value("multiple") == "true",
+			Required:    n.child("validations").value("required") == "true",
+		}
+		if options := attrs.child("options"); options != nil {
+			for _, o := range options.sequence {
+				option := &IssueFormOption{Label: o.scalar}
+				if o.mapping != nil {
+					option.Label = o.value("label")
+					option.Required = o.value("required") == "true"
+				}
+				f.Options = append(f.Options, option)
+			}
+		}
+		if f.Type == "" {
+			return nil, fmt.Errorf("github: issue form %v has a field without type", name)
+		}
+		t.Fields = append(t.Fields, f)
+	}
+	return t, nil
+}
+
+// parseMarkdownIssueTemplate parses a Markdown template, whose settings are
+// in a YAML front matter.
+func parseMarkdownIssueTemplate(name, data string) (*IssueTemplate, error) {
+	data = strings.Replace(data, "\r\n", "\n", -1)
+	if !strings.HasPrefix(data, "---\n") {
+		return nil, fmt.Errorf("github: issue template %v has no front matter", name)
+	}
+	end := strings.Index(data[4:], "\n---")
+	if end < 0 {
+		return nil, fmt.Errorf("github: issue template %v has an unterminated front matter", name)
+	}
+	front, body := data[4:4+end], data[4+end+len("\n---"):]
+	if i := strings.IndexByte(body, '\n'); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = ""
+	}
+
+	root := new(yamlNode)
+	if lines := splitYAMLLines(front); len(lines) > 0 {
+		root, _ = parseYAMLBlock(lines, 0)
+	}
+	return &IssueTemplate{
+		Path:        name,
+		Name:        root.value("name"),
+		Description: root.value("about"),
+		Title:       root.value("title"),
+		Labels:      yamlCommaStrings(root.child("labels")),
+		Assignees:   yamlCommaStrings(root.child("assignees")),
+		Body:        body,
+	}, nil
+}
+
+// yamlCommaStrings returns the scalars of the sequence n, or the
+// comma-separated values of the scalar n, as the labels and assignees of
+// templates may be written either way.
+func yamlCommaStrings(n *yamlNode) []string {
+	if n == nil || n.sequence != nil {
+		return yamlStrings(n)
+	}
+	var values []string
+	for _, v := range strings.Split(n.scalar, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// ListIssueTemplates lists and parses the issue templates of a repository,
+// found in its .github/ISSUE_TEMPLATE directory. It takes a request per
+// template. A repository without templates has none, rather than an error.
+//
+// GitHub API docs: https://docs.github.com/en/communities/using-templates-to-encourage-useful-issues-and-pull-requests/configuring-issue-templates-for-your-repository
+func (s *IssuesService) ListIssueTemplates(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) ([]*IssueTemplate, *Response, error) {
+	_, dir, resp, err := s.client.Repositories.GetContents(ctx, owner, repo, issueTemplatesDir, opt)
+	if err, ok := err.(*ErrorResponse); ok && err.Response.StatusCode == http.StatusNotFound {
+		return nil, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var templates []*IssueTemplate
+	for _, entry := range dir {
+		if entry.GetType() != "file" || !isIssueTemplateFile(entry.GetName()) {
+			continue
+		}
+		var file *RepositoryContent
+		file, _, resp, err = s.client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), opt)
+		if err != nil {
+			return nil, resp, err
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, resp, err
+		}
+		t, err := ParseIssueTemplate(entry.GetPath(), []byte(content))
+		if err != nil {
+			return nil, resp, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, resp, nil
+}
+
+// issueFormNoResponse is how GitHub renders a field left empty.
+const issueFormNoResponse = "_No response_"
+
+// ParseIssueForm extracts the responses to the fields of the form t from the
+// body of an issue created with it, which GitHub renders as a "### Label"
+// heading per field. Responses are keyed by the ID of their field, or by its
+// label if it has no ID. Fields left empty have an empty response, and
+// fields missing from body have none.
+func (t *IssueTemplate) ParseIssueForm(body string) map[string]string {
+	sections := make(map[string]string)
+	var heading string
+	var text []string
+	flush := func() {
+		if heading != "" {
+			sections[heading] = strings.TrimSpace(strings.Join(text, "\n"))
+		}
+	}
+	for _, line := range strings.Split(strings.Replace(body, "\r\n", "\n", -1), "\n") {
+		if strings.HasPrefix(line, "### ") {
+			flush()
+			heading, text = strings.TrimSpace(line[4:]), nil
+			continue
+		}
+		text = append(text, line)
+	}
+	flush()
+
+	responses := make(map[string]string)
+	for _, f := range t.Fields {
+		if f.Type == "markdown" {
+			continue
+		}
+		v, ok := sections[f.Label]
+		if !ok {
+			continue
+		}
+		if v == issueFormNoResponse {
+			v = ""
+		}
+		if f.Render != "" {
+			v = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(v, "```"+f.Render), "```"))
+		}
+		key := f.ID
+		if key == "" {
+			key = f.Label
+		}
+		responses[key] = v
+	}
+	return responses
+}
+
+// Validate checks that issue was created from t. For a form, it checks that
+// the body has a response to every field, that required fields and
+// checkboxes are filled in, and that dropdown responses are options of their
+// field. For a Markdown template, it checks that the body keeps the headings
+// of the template. The error is an *IssueTemplateError.
+func (t *IssueTemplate) Validate(issue *Issue) error {
+	var errs []string
+	if t.IsForm() {
+		errs = t.validateForm(issue.GetBody())
+	} else {
+		body := issue.GetBody()
+		for _, line := range strings.Split(t.Body, "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "#") && !strings.Contains(body, line) {
+				errs = append(errs, fmt.Sprintf("missing heading %q", line))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &IssueTemplateError{Template: t, Errors: errs}
+	}
+	return nil
+}
+
+func (t *IssueTemplate) validateForm(body string) []string {
+	var errs []string
+	responses := t.ParseIssueForm(body)
+	for _, f := range t.Fields {
+		if f.Type == "markdown" {
+			continue
+		}
+		key := f.ID
+		if key == "" {
+			key = f.Label
+		}
+		v, ok := responses[key]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Sprintf("missing field %q", f.Label))
+			continue
+		case v == "" && f.Required:
+			errs = append(errs, fmt.Sprintf("field %q is required", f.Label))
+			continue
+		}
+
+		switch f.Type {
+		case "dropdown":
+			if v == "" {
+				continue
+			}
+			choices := []string{v}
+			if f.Multiple {
+				choices = strings.Split(v, ", ")
+			}
+			for _, c := range choices {
+				if !f.hasOption(c) {
+					errs = append(errs, fmt.Sprintf("field %q has no option %q", f.Label, c))
+				}
+			}
+		case "checkboxes":
+			for _, o := range f.Options {
+				if o.Required && !strings.Contains(v, "- [X] "+o.Label) && !strings.Contains(v, "- [x] "+o.Label) {
+					errs = append(errs, fmt.Sprintf("field %q requires checking %q", f.Label, o.Label))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+func (f *IssueFormField) hasOption(label string) bool {
+	for _, o := range f.Options {
+		if o.Label == label {
+			return true
+		}
+	}
+	return false
+}
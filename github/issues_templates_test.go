@@ -0,0 +1,231 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testIssueForm = `name: Bug report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+assignees:
+  - octocat
+body:
+  - type: markdown
+    attributes:
+      value: |
+        Thanks for taking the time to fill out this bug report!
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+      placeholder: Tell us what you see!
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options:
+        - "1.0"
+        - "2.0"
+  - type: textarea
+    id: logs
+    attributes:
+      label: Logs
+      render: shell
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow the Code of Conduct
+          required: true
+`
+
+const testIssueMarkdownTemplate = `---
+name: Feature request
+about: Suggest an idea
+title: ''
+labels: enhancement, help wanted
+assignees: ''
+---
+
+## Problem
+
+## Solution
+`
+
+func TestParseIssueTemplate_form(t *testing.T) {
+	got, err := ParseIssueTemplate(".github/ISSUE_TEMPLATE/bug.yml", []byte(testIssueForm))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+
+	want := &IssueTemplate{
+		Path:        ".github/ISSUE_TEMPLATE/bug.yml",
+		Name:        "Bug report",
+		Description: "File a bug report",
+		Title:       "[Bug]: ",
+		Labels:      []string{"bug", "triage"},
+		Assignees:   []string{"octocat"},
+		Fields: []*IssueFormField{
+			{Type: "markdown", Value: "Thanks for taking the time to fill out this bug report!"},
+			{Type: "textarea", ID: "what-happened", Label: "What happened?", Placeholder: "Tell us what you see!", Required: true},
+			{Type: "dropdown", ID: "version", Label: "Version", Options: []*IssueFormOption{{Label: "1.0"}, {Label: "2.0"}}},
+			{Type: "textarea", ID: "logs", Label: "Logs", Render: "shell"},
+			{Type: "checkboxes", ID: "terms", Label: "Code of Conduct", Options: []*IssueFormOption{{Label: "I agree to follow the Code of Conduct", Required: true}}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIssueTemplate returned %+v, want %+v", got, want)
+	}
+	if !got.IsForm() {
+		t.Errorf("IsForm returned false, want true")
+	}
+}
+
+func TestParseIssueTemplate_markdown(t *testing.T) {
+	got, err := ParseIssueTemplate("feature.md", []byte(testIssueMarkdownTemplate))
+	if err != nil {
+		t.Fatalf("ParseIssueTemplate returned error: %v", err)
+	}
+
+	want := &IssueTemplate{
+		Path:        "feature.md",
+		Name:        "Feature request",
+		Description: "Suggest an idea",
+		Labels:      []string{"enhancement", "help wanted"},
+		Body:        "\n## Problem\n\n## Solution\n",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIssueTemplate returned %+v, want %+v", got, want)
+	}
+	if got.IsForm() {
+		t.Errorf("IsForm returned true, want false")
+	}
+}
+
+func TestParseIssueTemplate_invalid(t *testing.T) {
+	tests := map[string]string{
+		"config.yml":    "blank_issues_enabled: false",
+		"notes.txt":     "",
+		"bug.yml":       "name: Bug",
+		"bug.md":        "## Problem",
+		"unfinished.md": "---\nname: x\n",
+	}
+	for name, data := range tests {
+		if _, err := ParseIssueTemplate(name, []byte(data)); err == nil {
+			t.Errorf("ParseIssueTemplate(%q) returned no error", name)
+		}
+	}
+}
+
+func TestIssueTemplate_ParseIssueForm(t *testing.T) {
+	form, _ := ParseIssueTemplate("bug.yml", []byte(testIssueForm))
+
+	body := "### What happened?\n\nIt broke.\n\n### Version\n\n2.0\n\n### Logs\n\n```shell\npanic: oops\n```\n\n### Code of Conduct\n\n- [X] I agree to follow the Code of Conduct"
+	got := form.ParseIssueForm(body)
+	want := map[string]string{
+		"what-happened": "It broke.",
+		"version":       "2.0",
+		"logs":          "panic: oops",
+		"terms":         "- [X] I agree to follow the Code of Conduct",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseIssueForm returned %+v, want %+v", got, want)
+	}
+	if err := form.Validate(&Issue{Body: String(body)}); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+}
+
+func TestIssueTemplate_Validate(t *testing.T) {
+	form, _ := ParseIssueTemplate("bug.yml", []byte(testIssueForm))
+
+	body := "### What happened?\n\n_No response_\n\n### Version\n\n3.0\n\n### Code of Conduct\n\n- [ ] I agree to follow the Code of Conduct"
+	err := form.Validate(&Issue{Body: String(body)})
+	templateErr, ok := err.(*IssueTemplateError)
+	if !ok {
+		t.Fatalf("Validate returned %v, want *IssueTemplateError", err)
+	}
+	want := []string{
+		`field "What happened?" is required`,
+		`field "Version" has no option "3.0"`,
+		`missing field "Logs"`,
+		`field "Code of Conduct" requires checking "I agree to follow the Code of Conduct"`,
+	}
+	if !reflect.DeepEqual(templateErr.Errors, want) {
+		t.Errorf("Validate returned errors %q, want %q", templateErr.Errors, want)
+	}
+
+	markdown, _ := ParseIssueTemplate("feature.md", []byte(testIssueMarkdownTemplate))
+	if err := markdown.Validate(&Issue{Body: String("## Problem\n\nSlow.\n\n## Solution\n\nFast.")}); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+	if err := markdown.Validate(&Issue{Body: String("Make it fast.")}); err == nil {
+		t.Errorf("Validate returned no error for an issue without the template headings")
+	}
+}
+
+func TestIssuesService_ListIssueTemplates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/.github/ISSUE_TEMPLATE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, `[
+			{"type":"file","name":"bug.yml","path":".github/ISSUE_TEMPLATE/bug.yml"},
+			{"type":"file","name":"config.yml","path":".github/ISSUE_TEMPLATE/config.yml"},
+			{"type":"dir","name":"old","path":".github/ISSUE_TEMPLATE/old"},
+			{"type":"file","name":"feature.md","path":".github/ISSUE_TEMPLATE/feature.md"}
+		]`)
+	})
+	for name, content := range map[string]string{"bug.yml": testIssueForm, "feature.md": testIssueMarkdownTemplate} {
+		content := base64.StdEncoding.EncodeToString([]byte(content))
+		mux.HandleFunc("/repos/o/r/contents/.github/ISSUE_TEMPLATE/"+name, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"type":"file","encoding":"base64","content":%q}`, content)
+		})
+	}
+
+	templates, _, err := client.Issues.ListIssueTemplates(context.Background(), "o", "r", &RepositoryContentGetOptions{Ref: "main"})
+	if err != nil {
+		t.Fatalf("Issues.ListIssueTemplates returned error: %v", err)
+	}
+	var names []string
+	for _, tmpl := range templates {
+		names = append(names, tmpl.Name)
+	}
+	if want := []string{"Bug report", "Feature request"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Issues.ListIssueTemplates returned %v, want %v", names, want)
+	}
+}
+
+func TestIssuesService_ListIssueTemplates_none(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/.github/ISSUE_TEMPLATE", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	templates, _, err := client.Issues.ListIssueTemplates(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Issues.ListIssueTemplates returned error: %v", err)
+	}
+	if len(templates) != 0 {
+		t.Errorf("Issues.ListIssueTemplates returned %v, want none", templates)
+	}
+}
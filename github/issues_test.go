@@ -180,6 +180,40 @@ func TestIssuesService_Get(t *testing.T) {
 	}
 }
 
+func TestIssuesService_Get_reactions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"reactions":{"total_count":9,"+1":1,"-1":1,"laugh":1,"confused":1,"heart":1,"hooray":1,"rocket":2,"eyes":1,"url":"u"}}`)
+	})
+
+	issue, _, err := client.Issues.Get(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Errorf("Issues.Get returned error: %v", err)
+	}
+
+	want := &Issue{
+		Number: Int(1),
+		Reactions: &Reactions{
+			TotalCount: Int(9),
+			PlusOne:    Int(1),
+			MinusOne:   Int(1),
+			Laugh:      Int(1),
+			Confused:   Int(1),
+			Heart:      Int(1),
+			Hooray:     Int(1),
+			Rocket:     Int(2),
+			Eyes:       Int(1),
+			URL:        String("u"),
+		},
+	}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("Issues.Get returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestIssuesService_Get_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -223,6 +257,27 @@ func TestIssuesService_Create(t *testing.T) {
 	}
 }
 
+func TestIssuesService_Edit_type(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"type":"Bug"}`+"\n")
+		fmt.Fprint(w, `{"number":1,"type":{"id":410,"name":"Bug","color":"red"}}`)
+	})
+
+	issue, _, err := client.Issues.Edit(context.Background(), "o", "r", 1, &IssueRequest{Type: String("Bug")})
+	if err != nil {
+		t.Errorf("Issues.Edit returned error: %v", err)
+	}
+
+	want := &Issue{Number: Int(1), Type: &IssueType{ID: Int64(410), Name: String("Bug"), Color: String("red")}}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("Issues.Edit returned %+v, want %+v", issue, want)
+	}
+}
+
 func TestIssuesService_Create_invalidOwner(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
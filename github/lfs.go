@@ -0,0 +1,219 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const mediaTypeLFS = "application/vnd.git-lfs+json"
+
+// LFSService handles communication with the Git LFS server of GitHub
+// repositories. It implements the batch API, used to negotiate object
+// transfers, and the basic transfer adapter, used to download and upload
+// objects.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/master/docs/api/batch.md
+type LFSService service
+
+// Operations of an LFS batch request.
+const (
+	LFSDownload = "download"
+	LFSUpload   = "upload"
+)
+
+// LFSBatchRequest represents a request to the LFS batch API.
+type LFSBatchRequest struct {
+	// Operation is either LFSDownload or LFSUpload.
+	Operation string       `json:"operation"`
+	Transfers []string     `json:"transfers,omitempty"`
+	Ref       *LFSRef      `json:"ref,omitempty"`
+	Objects   []*LFSObject `json:"objects"`
+	HashAlgo  *string      `json:"hash_algo,omitempty"`
+}
+
+// LFSRef represents the ref an LFS batch request applies to.
+type LFSRef struct {
+	Name string `json:"name"`
+}
+
+// LFSObject represents an LFS object, identified by the SHA-256 of its
+// contents.
+type LFSObject struct {
+	OID           string                `json:"oid"`
+	Size          int64                 `json:"size"`
+	Authenticated *bool                 `json:"authenticated,omitempty"`
+	Actions       map[string]*LFSAction `json:"actions,omitempty"`
+	Error         *LFSObjectError       `json:"error,omitempty"`
+}
+
+func (o LFSObject) String() string {
+	return Stringify(o)
+}
+
+// LFSAction describes how to transfer an object. Objects of a batch
+// response have a "download" action, or "upload" and optionally "verify"
+// actions. Objects that are already on the server have no upload action.
+type LFSAction struct {
+	Href      string            `json:"href"`
+	Header    map[string]string `json:"header,omitempty"`
+	ExpiresIn *int              `json:"expires_in,omitempty"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+}
+
+// LFSObjectError reports why an object of a batch request cannot be
+// transferred.
+type LFSObjectError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *LFSObjectError) Error() string {
+	return fmt.Sprintf("LFS object error %v: %v", e.Code, e.Message)
+}
+
+// LFSBatchResponse represents a response of the LFS batch API.
+type LFSBatchResponse struct {
+	Transfer *string      `json:"transfer,omitempty"`
+	Objects  []*LFSObject `json:"objects"`
+	HashAlgo *string      `json:"hash_algo,omitempty"`
+}
+
+// lfsURL returns the LFS batch endpoint of a repository relative to BaseURL.
+// The LFS server is part of the git host rather than of the API: it is
+// github.com for api.github.com, and the root of the host for GitHub
+// Enterprise, which serves the API under /api/v3/.
+func (c *Client) lfsURL(owner, repo string) string {
+	u := fmt.Sprintf("%v/%v.git/info/lfs/objects/batch", owner, repo)
+	switch {
+	case c.BaseURL.Host == "api.github.com":
+		return "https://github.com/" + u
+	case strings.HasSuffix(c.BaseURL.Path, "/api/v3/"):
+		return "../../" + u
+	}
+	return u
+}
+
+// Batch requests the actions to transfer the objects of batch.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/master/docs/api/batch.md#requests
+func (s *LFSService) Batch(ctx context.Context, owner, repo string, batch *LFSBatchRequest) (*LFSBatchResponse, *Response, error) {
+	req, err := s.client.NewRequest("POST", s.client.lfsURL(owner, repo), batch)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", mediaTypeLFS)
+	req.Header.Set("Content-Type", mediaTypeLFS)
+
+	b := new(LFSBatchResponse)
+	resp, err := s.client.Do(ctx, req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// newActionRequest creates a request for an action of a batch response.
+func (s *LFSService) newActionRequest(method string, action *LFSAction, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, action.Href, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range action.Header {
+		req.Header.Set(k, v)
+	}
+	if s.client.UserAgent != "" {
+		req.Header.Set("User-Agent", s.client.UserAgent)
+	}
+	return req, nil
+}
+
+// doAction sends the request for an action. Actions often point to object
+// storage outside GitHub, and carry the authentication it needs in their
+// headers, so they are sent without the client's authentication.
+func (s *LFSService) doAction(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := s.client.bareClient().Do(withContext(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Download downloads an object using its "download" action. The caller must
+// close the returned io.ReadCloser.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/master/docs/api/basic-transfers.md#downloads
+func (s *LFSService) Download(ctx context.Context, action *LFSAction) (io.ReadCloser, error) {
+	req, err := s.newActionRequest("GET", action, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.doAction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Upload uploads size bytes of object content read from r using the
+// object's "upload" action.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/master/docs/api/basic-transfers.md#uploads
+func (s *LFSService) Upload(ctx context.Context, action *LFSAction, r io.Reader, size int64) (*Response, error) {
+	req, err := s.newActionRequest("PUT", action, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", defaultMediaType)
+	}
+
+	resp, err := s.doAction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return newResponse(resp), nil
+}
+
+// Verify confirms an upload using the object's "verify" action.
+//
+// Git LFS API docs: https://github.com/git-lfs/git-lfs/blob/master/docs/api/basic-transfers.md#uploads
+func (s *LFSService) Verify(ctx context.Context, action *LFSAction, object *LFSObject) (*Response, error) {
+	body, err := json.Marshal(&LFSObject{OID: object.OID, Size: object.Size})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.newActionRequest("POST", action, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaTypeLFS)
+	req.Header.Set("Content-Type", mediaTypeLFS)
+
+	resp, err := s.doAction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return newResponse(resp), nil
+}
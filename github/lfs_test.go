@@ -0,0 +1,166 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLFSService_Batch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/o/r.git/info/lfs/objects/batch", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeLFS)
+		testHeader(t, r, "Content-Type", mediaTypeLFS)
+		testBody(t, r, `{"operation":"download","transfers":["basic"],"objects":[{"oid":"abc","size":3}]}`+"\n")
+		fmt.Fprint(w, `{"transfer":"basic","objects":[{"oid":"abc","size":3,"actions":{"download":{"href":"https://lfs/abc","header":{"Authorization":"x"}}}}]}`)
+	})
+
+	batch := &LFSBatchRequest{
+		Operation: LFSDownload,
+		Transfers: []string{"basic"},
+		Objects:   []*LFSObject{{OID: "abc", Size: 3}},
+	}
+	got, _, err := client.LFS.Batch(context.Background(), "o", "r", batch)
+	if err != nil {
+		t.Errorf("LFS.Batch returned error: %v", err)
+	}
+
+	want := &LFSBatchResponse{
+		Transfer: String("basic"),
+		Objects: []*LFSObject{{
+			OID:  "abc",
+			Size: 3,
+			Actions: map[string]*LFSAction{
+				LFSDownload: {Href: "https://lfs/abc", Header: map[string]string{"Authorization": "x"}},
+			},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LFS.Batch returned %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_lfsURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.github.com/", "https://github.com/o/r.git/info/lfs/objects/batch"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/o/r.git/info/lfs/objects/batch"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil)
+		c.BaseURL, _ = url.Parse(tt.baseURL)
+		u, err := c.BaseURL.Parse(c.lfsURL("o", "r"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := u.String(); got != tt.want {
+			t.Errorf("lfsURL with base URL %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestLFSService_Download(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/objects/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "x")
+		fmt.Fprint(w, "foo")
+	})
+
+	action := &LFSAction{Href: serverURL + baseURLPath + "/objects/abc", Header: map[string]string{"Authorization": "x"}}
+	rc, err := client.LFS.Download(context.Background(), action)
+	if err != nil {
+		t.Fatalf("LFS.Download returned error: %v", err)
+	}
+	defer rc.Close()
+
+	got, _ := ioutil.ReadAll(rc)
+	if string(got) != "foo" {
+		t.Errorf("LFS.Download returned %q, want %q", got, "foo")
+	}
+}
+
+func TestLFSService_Download_actionAuthorization(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+	client.client = &http.Client{Transport: &BasicAuthTransport{Username: "u", Password: "p"}}
+
+	mux.HandleFunc("/objects/abc", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "RemoteAuth token")
+		fmt.Fprint(w, "foo")
+	})
+
+	action := &LFSAction{Href: serverURL + baseURLPath + "/objects/abc", Header: map[string]string{"Authorization": "RemoteAuth token"}}
+	rc, err := client.LFS.Download(context.Background(), action)
+	if err != nil {
+		t.Fatalf("LFS.Download returned error: %v", err)
+	}
+	rc.Close()
+}
+
+func TestLFSService_Download_error(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/objects/abc", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.LFS.Download(context.Background(), &LFSAction{Href: serverURL + baseURLPath + "/objects/abc"})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("LFS.Download returned %#v, want *ErrorResponse", err)
+	}
+}
+
+func TestLFSService_Upload(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/objects/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", defaultMediaType)
+		testBody(t, r, "foo")
+		if r.ContentLength != 3 {
+			t.Errorf("Content-Length = %v, want 3", r.ContentLength)
+		}
+	})
+
+	action := &LFSAction{Href: serverURL + baseURLPath + "/objects/abc"}
+	if _, err := client.LFS.Upload(context.Background(), action, strings.NewReader("foo"), 3); err != nil {
+		t.Errorf("LFS.Upload returned error: %v", err)
+	}
+}
+
+func TestLFSService_Verify(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/verify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", mediaTypeLFS)
+		testBody(t, r, `{"oid":"abc","size":3}`)
+	})
+
+	action := &LFSAction{Href: serverURL + baseURLPath + "/verify"}
+	if _, err := client.LFS.Verify(context.Background(), action, &LFSObject{OID: "abc", Size: 3}); err != nil {
+		t.Errorf("LFS.Verify returned error: %v", err)
+	}
+}
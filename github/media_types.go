@@ -0,0 +1,63 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// Media types of the representations of resources GitHub can return, to be
+// used with WithMediaType or as the Accept header of requests.
+//
+// GitHub API docs: https://developer.github.com/v3/media/
+const (
+	// MediaTypeJSON is the default JSON representation.
+	MediaTypeJSON = "application/vnd.github.v3+json"
+
+	// MediaTypeRaw is the raw contents of a file or blob, or the JSON
+	// representation with the raw markdown body of issues and comments.
+	MediaTypeRaw = "application/vnd.github.v3.raw"
+
+	// MediaTypeHTML, MediaTypeText and MediaTypeFull are JSON representations
+	// of issues and comments with a body rendered as HTML, as text, or in
+	// all forms, respectively.
+	MediaTypeHTML = "application/vnd.github.v3.html+json"
+	MediaTypeText = "application/vnd.github.v3.text+json"
+	MediaTypeFull = "application/vnd.github.v3.full+json"
+
+	// MediaTypeDiff, MediaTypePatch and MediaTypeSHA are the diff, the patch
+	// and the SHA-1 of commits, comparisons and pull requests.
+	MediaTypeDiff  = "application/vnd.github.v3.diff"
+	MediaTypePatch = "application/vnd.github.v3.patch"
+	MediaTypeSHA   = "application/vnd.github.v3.sha"
+
+	// MediaTypeStar is the representation of stargazers and starred
+	// repositories including when they were starred.
+	MediaTypeStar = "application/vnd.github.v3.star+json"
+
+	// MediaTypeTopics is the representation of repositories including their
+	// topics.
+	MediaTypeTopics = "application/vnd.github.mercy-preview+json"
+
+	// MediaTypeTextMatch is the representation of search results including
+	// the matching fragments.
+	MediaTypeTextMatch = "application/vnd.github.v3.text-match+json"
+
+	// MediaTypeSARIF is the media type of SARIF code scanning reports.
+	MediaTypeSARIF = "application/sarif+json"
+
+	// MediaTypeSBOM is the media type of SPDX JSON software bills of
+	// materials, such as the ones exported from the dependency graph.
+	MediaTypeSBOM = "application/spdx+json"
+)
+
+type mediaTypeKey struct{}
+
+// WithMediaType returns a copy of ctx that makes Client.Do request the
+// mediaType representation, such as MediaTypeFull, overriding the Accept
+// header set by the method making the request. Representations other than
+// JSON can only be read by passing an io.Writer to Client.Do.
+func WithMediaType(ctx context.Context, mediaType string) context.Context {
+	return context.WithValue(ctx, mediaTypeKey{}, mediaType)
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWithMediaType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", MediaTypeFull)
+		fmt.Fprint(w, `{"number":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/s", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", MediaTypeDiff)
+		fmt.Fprint(w, "diff")
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.Get(WithMediaType(ctx, MediaTypeFull), "o", "r", 1); err != nil {
+		t.Errorf("Issues.Get returned error: %v", err)
+	}
+
+	req, err := client.NewRequest("GET", "repos/o/r/commits/s", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	var buf bytes.Buffer
+	if _, err := client.Do(WithMediaType(ctx, MediaTypeDiff), req, &buf); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if got := buf.String(); got != "diff" {
+		t.Errorf("Do wrote %q, want %q", got, "diff")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -156,25 +157,71 @@ func (s *MigrationService) UserMigrationArchiveURL(ctx context.Context, id int64
 	// TODO: remove custom Accept header when this API fully launches.
 	req.Header.Set("Accept", mediaTypeMigrationsPreview)
 
-	m := &UserMigration{}
+	s.client.clientMu.Lock()
+	defer s.client.clientMu.Unlock()
 
-	var loc string
+	// Disable the redirect mechanism because AWS fails if the GitHub auth token is provided.
 	originalRedirect := s.client.client.CheckRedirect
 	s.client.client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-		loc = req.URL.String()
 		return http.ErrUseLastResponse
 	}
 	defer func() {
 		s.client.client.CheckRedirect = originalRedirect
 	}()
-	resp, err := s.client.Do(ctx, req, m)
-	if err == nil {
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if resp == nil || resp.StatusCode < 300 || resp.StatusCode > 399 {
+		if err != nil {
+			return "", err
+		}
 		return "", errors.New("expected redirect, none provided")
 	}
-	loc = resp.Header.Get("Location")
+
+	loc := resp.Header.Get("Location")
+	if loc == "" {
+		return "", errors.New("redirect response is missing a Location header")
+	}
 	return loc, nil
 }
 
+// DownloadUserMigrationArchive downloads a user migration archive. The
+// archive is a gzipped tarball streamed from the returned io.ReadCloser,
+// which the caller must close.
+//
+// The archive is fetched from the storage URL reported by
+// UserMigrationArchiveURL without the client's authentication, which the
+// storage service rejects.
+//
+// GitHub API docs: https://developer.github.com/v3/migrations/users/#download-a-user-migration-archive
+func (s *MigrationService) DownloadUserMigrationArchive(ctx context.Context, id int64) (io.ReadCloser, error) {
+	loc, err := s.UserMigrationArchiveURL(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.client.BaseURL.Parse(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.bareClient().Do(withContext(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
 // DeleteUserMigration will delete a previous migration archive.
 // id is the migration ID.
 //
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strings"
@@ -116,6 +117,112 @@ func TestMigrationService_UserMigrationArchiveURL(t *testing.T) {
 	}
 }
 
+func TestMigrationService_UserMigrationArchiveURL_noRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := client.Migrations.UserMigrationArchiveURL(context.Background(), 1); err == nil {
+		t.Error("UserMigrationArchiveURL returned no error, want error for missing redirect")
+	}
+}
+
+func TestMigrationService_DownloadUserMigrationArchive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "0123456789abcdef")
+	})
+
+	rc, err := client.Migrations.DownloadUserMigrationArchive(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("DownloadUserMigrationArchive returned error: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ioutil.ReadAll returned error: %v", err)
+	}
+	if want := "0123456789abcdef"; string(got) != want {
+		t.Errorf("DownloadUserMigrationArchive returned %q, want %q", got, want)
+	}
+}
+
+func TestMigrationService_DownloadUserMigrationArchive_transport(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	// The client authenticates on top of a transport recording the requests
+	// it sends.
+	var sent []string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req.URL.Path)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client.client = &http.Client{Transport: &BasicAuthTransport{Username: "u", Password: "p", Transport: base}}
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "")
+		fmt.Fprint(w, "archive")
+	})
+
+	rc, err := client.Migrations.DownloadUserMigrationArchive(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("DownloadUserMigrationArchive returned error: %v", err)
+	}
+	rc.Close()
+
+	if len(sent) != 2 {
+		t.Errorf("requests sent through the client's transport: %v, want the API and storage requests", sent)
+	}
+}
+
+func TestMigrationService_DownloadUserMigrationArchive_storageError(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Migrations.DownloadUserMigrationArchive(context.Background(), 1)
+	if err, ok := err.(*ErrorResponse); !ok || err.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("DownloadUserMigrationArchive returned error %v, want 403 *ErrorResponse", err)
+	}
+}
+
+func TestMigrationService_UserMigrationArchiveURL_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/migrations/1/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	_, err := client.Migrations.UserMigrationArchiveURL(context.Background(), 1)
+	if err, ok := err.(*ErrorResponse); !ok || err.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("UserMigrationArchiveURL returned error %v, want 404 *ErrorResponse", err)
+	}
+}
+
 func TestMigrationService_DeleteUserMigration(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
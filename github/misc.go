@@ -162,6 +162,26 @@ type APIMeta struct {
 	// An Array of IP addresses specifying the addresses that source imports
 	// will originate from on GitHub.com.
 	Importer []string `json:"importer,omitempty"`
+
+	// An array of IP addresses in CIDR format specifying the addresses
+	// that GitHub Actions hosted runners will originate from on GitHub.com.
+	Actions []string `json:"actions,omitempty"`
+
+	// An array of IP addresses in CIDR format specifying the addresses
+	// that Dependabot will originate from on GitHub.com.
+	Dependabot []string `json:"dependabot,omitempty"`
+
+	// An array of IP addresses in CIDR format specifying the addresses
+	// which serve github.com and the GitHub API respectively.
+	Web []string `json:"web,omitempty"`
+	API []string `json:"api,omitempty"`
+
+	// A map of algorithms to SSH key fingerprints, such as "SHA256_RSA".
+	SSHKeyFingerprints map[string]string `json:"ssh_key_fingerprints,omitempty"`
+
+	// An array of SSH public keys, in authorized_keys format, used by the
+	// Git servers.
+	SSHKeys []string `json:"ssh_keys,omitempty"`
 }
 
 // APIMeta returns information about GitHub.com, the service. Or, if you access
@@ -134,7 +134,7 @@ func TestAPIMeta(t *testing.T) {
 
 	mux.HandleFunc("/meta", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"hooks":["h"], "git":["g"], "pages":["p"], "importer":["i"], "verifiable_password_authentication": true}`)
+		fmt.Fprint(w, `{"hooks":["h"], "git":["g"], "pages":["p"], "importer":["i"], "actions":["a"], "dependabot":["d"], "web":["w"], "api":["api"], "ssh_key_fingerprints":{"SHA256_ED25519":"fp"}, "ssh_keys":["ssh-ed25519 k"], "verifiable_password_authentication": true}`)
 	})
 
 	meta, _, err := client.APIMeta(context.Background())
@@ -143,10 +143,17 @@ func TestAPIMeta(t *testing.T) {
 	}
 
 	want := &APIMeta{
-		Hooks:    []string{"h"},
-		Git:      []string{"g"},
-		Pages:    []string{"p"},
-		Importer: []string{"i"},
+		Hooks:      []string{"h"},
+		Git:        []string{"g"},
+		Pages:      []string{"p"},
+		Importer:   []string{"i"},
+		Actions:    []string{"a"},
+		Dependabot: []string{"d"},
+		Web:        []string{"w"},
+		API:        []string{"api"},
+
+		SSHKeyFingerprints: map[string]string{"SHA256_ED25519": "fp"},
+		SSHKeys:            []string{"ssh-ed25519 k"},
 
 		VerifiablePasswordAuthentication: Bool(true),
 	}
@@ -0,0 +1,264 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Node is an object of the GraphQL API, as returned by Client.GetNode.
+type Node struct {
+	// Type is the GraphQL type of the node, such as "Repository".
+	Type string `json:"__typename"`
+	ID   string `json:"id"`
+
+	// DatabaseID is the ID of the node in the REST API. It is only set for
+	// nodes of the types that have one, such as users, organizations,
+	// repositories, issues, pull requests and comments.
+	DatabaseID *int64 `json:"databaseId,omitempty"`
+
+	// URL is the HTML URL of the node, for nodes that have one.
+	URL *string `json:"url,omitempty"`
+}
+
+func (n Node) String() string {
+	return Stringify(n)
+}
+
+// nodeFields are the fields of Node. databaseId is not part of an interface,
+// so it is requested from each type that has it.
+const nodeFields = `__typename id
+		... on UniformResourceLocatable { url }
+		... on User { databaseId }
+		... on Organization { databaseId }
+		... on Repository { databaseId }
+		... on Issue { databaseId }
+		... on PullRequest { databaseId }
+		... on IssueComment { databaseId }
+		... on PullRequestReview { databaseId }
+		... on PullRequestReviewComment { databaseId }
+		... on CommitComment { databaseId }
+		... on Release { databaseId }
+		... on Team { databaseId }
+		... on Project { databaseId }`
+
+// GetNode looks up a node of the GraphQL API by its global ID, such as the
+// NodeID field of REST resources, and returns its type and REST ID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/guides/using-global-node-ids
+func (c *Client) GetNode(ctx context.Context, id string) (*Node, *Response, error) {
+	query := `query($id: ID!) {
+	node(id: $id) {
+		` + nodeFields + `
+	}
+}`
+	var result struct {
+		Node *Node `json:"node"`
+	}
+	vars := map[string]interface{}{"id": id}
+	resp, err := c.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil {
+		return nil, resp, errors.New("github: node not found")
+	}
+
+	return result.Node, resp, nil
+}
+
+// NodeID is a decoded global node ID. GitHub issues node IDs in two
+// formats: legacy IDs, such as "MDQ6VXNlcjU4MzIzMQ==", which encode the
+// GraphQL type name and the database ID of the node, and next IDs, such as
+// "U_kgDOAAhEkg", which encode a type prefix and a list of database IDs.
+// Decoding does not validate that a node exists; use Client.GetNode for
+// that.
+type NodeID struct {
+	// Legacy reports whether the ID is in the legacy format.
+	Legacy bool
+
+	// Type is the GraphQL type name of a legacy ID, such as "User", or the
+	// type prefix of a next ID, such as "U" or "PR".
+	Type string
+
+	// IDs are the database IDs encoded in the ID. A legacy ID has one. A
+	// next ID starts with a format version, 0, followed by the IDs of the
+	// node and of the nodes it belongs to, such as the repository of a pull
+	// request, with the ID of the node itself last.
+	IDs []int64
+}
+
+// DatabaseID returns the ID of the node in the REST API, which is the last
+// of its IDs.
+func (n *NodeID) DatabaseID() int64 {
+	if len(n.IDs) == 0 {
+		return 0
+	}
+	return n.IDs[len(n.IDs)-1]
+}
+
+// String encodes n as a node ID.
+func (n *NodeID) String() string {
+	if n.Legacy {
+		return LegacyNodeID(n.Type, n.DatabaseID())
+	}
+	return n.Type + "_" + base64.RawURLEncoding.EncodeToString(msgpackInts(n.IDs))
+}
+
+// LegacyNodeID returns the legacy node ID of the node of the given GraphQL
+// type and database ID, such as LegacyNodeID("User", 583231).
+func LegacyNodeID(typeName string, id int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("0%d:%v%d", len(typeName), typeName, id)))
+}
+
+// NextNodeID returns the next node ID of the node of the given type prefix
+// and database IDs, such as NextNodeID("R", repoID) for a repository or
+// NextNodeID("PR", repoID, pullRequestID) for a pull request. The format
+// version is prepended to ids.
+func NextNodeID(prefix string, ids ...int64) string {
+	n := &NodeID{Type: prefix, IDs: append([]int64{0}, ids...)}
+	return n.String()
+}
+
+// nextNodeIDRE matches next node IDs, made of a type prefix and base64url
+// encoded MessagePack data.
+var nextNodeIDRE = regexp.MustCompile(`^([A-Za-z]+)_([A-Za-z0-9_-]+)$`)
+
+// legacyNodeIDRE matches decoded legacy node IDs.
+var legacyNodeIDRE = regexp.MustCompile(`^0(\d+):(.*)$`)
+
+// ParseNodeID decodes a node ID in the legacy or next format. It fails for
+// IDs that do not encode numeric database IDs, such as the IDs of commits.
+func ParseNodeID(id string) (*NodeID, error) {
+	if m := nextNodeIDRE.FindStringSubmatch(id); m != nil {
+		data, err := base64.RawURLEncoding.DecodeString(m[2])
+		if err == nil {
+			ids, err := unmsgpackInts(data)
+			if err != nil {
+				return nil, fmt.Errorf("github: invalid node ID %q: %v", id, err)
+			}
+			return &NodeID{Type: m[1], IDs: ids}, nil
+		}
+	}
+
+	data, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("github: invalid node ID %q", id)
+	}
+	m := legacyNodeIDRE.FindStringSubmatch(string(data))
+	if m == nil {
+		return nil, fmt.Errorf("github: invalid node ID %q", id)
+	}
+	n, _ := strconv.Atoi(m[1])
+	if n > len(m[2]) {
+		return nil, fmt.Errorf("github: invalid node ID %q", id)
+	}
+	typeName, rest := m[2][:n], m[2][n:]
+	dbID, err := strconv.ParseInt(rest, 10, 64)
+	if err != nil || strings.HasPrefix(rest, "+") {
+		return nil, fmt.Errorf("github: node ID %q has no numeric database ID", id)
+	}
+	return &NodeID{Legacy: true, Type: typeName, IDs: []int64{dbID}}, nil
+}
+
+// msgpackInts encodes ids as a MessagePack array of integers.
+func msgpackInts(ids []int64) []byte {
+	var b []byte
+	switch n := len(ids); {
+	case n < 16:
+		b = append(b, 0x90|byte(n))
+	default:
+		b = append(b, 0xdc, byte(n>>8), byte(n))
+	}
+	for _, id := range ids {
+		switch {
+		case id >= 0 && id < 0x80:
+			b = append(b, byte(id))
+		case id >= 0 && id <= 0xff:
+			b = append(b, 0xcc, byte(id))
+		case id >= 0 && id <= 0xffff:
+			b = append(b, 0xcd, 0, 0)
+			binary.BigEndian.PutUint16(b[len(b)-2:], uint16(id))
+		case id >= 0 && id <= 0xffffffff:
+			b = append(b, 0xce, 0, 0, 0, 0)
+			binary.BigEndian.PutUint32(b[len(b)-4:], uint32(id))
+		default:
+			b = append(b, 0xd3, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.BigEndian.PutUint64(b[len(b)-8:], uint64(id))
+		}
+	}
+	return b
+}
+
+// unmsgpackInts decodes a MessagePack array of integers.
+func unmsgpackInts(b []byte) ([]int64, error) {
+	errInvalid := errors.New("not a MessagePack array of integers")
+	if len(b) == 0 {
+		return nil, errInvalid
+	}
+
+	var n int
+	switch {
+	case b[0]&0xf0 == 0x90:
+		n, b = int(b[0]&0x0f), b[1:]
+	case b[0] == 0xdc && len(b) >= 3:
+		n, b = int(binary.BigEndian.Uint16(b[1:3])), b[3:]
+	default:
+		return nil, errInvalid
+	}
+
+	ids := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		if len(b) == 0 {
+			return nil, errInvalid
+		}
+		var size int
+		switch c := b[0]; {
+		case c < 0x80:
+			ids, size = append(ids, int64(c)), 0
+		case c >= 0xe0:
+			ids, size = append(ids, int64(int8(c))), 0
+		case c == 0xcc || c == 0xd0:
+			size = 1
+		case c == 0xcd || c == 0xd1:
+			size = 2
+		case c == 0xce || c == 0xd2:
+			size = 4
+		case c == 0xcf || c == 0xd3:
+			size = 8
+		default:
+			return nil, errInvalid
+		}
+		if size > 0 {
+			if len(b) < 1+size {
+				return nil, errInvalid
+			}
+			var v uint64
+			for _, x := range b[1 : 1+size] {
+				v = v<<8 | uint64(x)
+			}
+			if b[0] >= 0xd0 {
+				// Sign extend signed integers.
+				shift := uint(64 - 8*size)
+				ids = append(ids, int64(v<<shift)>>shift)
+			} else {
+				ids = append(ids, int64(v))
+			}
+		}
+		b = b[1+size:]
+	}
+	if len(b) > 0 {
+		return nil, errInvalid
+	}
+	return ids, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetNode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "node(id: $id)", map[string]interface{}{"id": "R_kgDOAAhEkg"})
+		fmt.Fprint(w, `{"data":{"node":{"__typename":"Repository","id":"R_kgDOAAhEkg","databaseId":541842,"url":"https://github.com/o/r"}}}`)
+	})
+
+	node, _, err := client.GetNode(context.Background(), "R_kgDOAAhEkg")
+	if err != nil {
+		t.Fatalf("GetNode returned error: %v", err)
+	}
+
+	want := &Node{Type: "Repository", ID: "R_kgDOAAhEkg", DatabaseID: Int64(541842), URL: String("https://github.com/o/r")}
+	if !reflect.DeepEqual(node, want) {
+		t.Errorf("GetNode returned %+v, want %+v", node, want)
+	}
+}
+
+func TestClient_GetNode_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"node":null}}`)
+	})
+
+	if _, _, err := client.GetNode(context.Background(), "x"); err == nil {
+		t.Error("GetNode returned no error for a missing node")
+	}
+}
+
+func TestParseNodeID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want *NodeID
+	}{
+		{"MDQ6VXNlcjU4MzIzMQ==", &NodeID{Legacy: true, Type: "User", IDs: []int64{583231}}},
+		{"MDEwOlJlcG9zaXRvcnkxMjk2MjY5", &NodeID{Legacy: true, Type: "Repository", IDs: []int64{1296269}}},
+		{"U_kgDOAAhEkg", &NodeID{Type: "U", IDs: []int64{0, 541842}}},
+		{"PR_kwDOAAhEks4AAQDk", &NodeID{Type: "PR", IDs: []int64{0, 541842, 65764}}},
+	}
+	for _, tt := range tests {
+		got, err := ParseNodeID(tt.id)
+		if err != nil {
+			t.Errorf("ParseNodeID(%q) returned error: %v", tt.id, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseNodeID(%q) = %+v, want %+v", tt.id, got, tt.want)
+		}
+		if got.String() != tt.id {
+			t.Errorf("ParseNodeID(%q).String() = %q", tt.id, got.String())
+		}
+	}
+
+	if got, want := LegacyNodeID("User", 583231), "MDQ6VXNlcjU4MzIzMQ=="; got != want {
+		t.Errorf("LegacyNodeID = %q, want %q", got, want)
+	}
+	if got, want := NextNodeID("PR", 541842, 65764), "PR_kwDOAAhEks4AAQDk"; got != want {
+		t.Errorf("NextNodeID = %q, want %q", got, want)
+	}
+	n, _ := ParseNodeID("PR_kwDOAAhEks4AAQDk")
+	if got := n.DatabaseID(); got != 65764 {
+		t.Errorf("DatabaseID = %v, want 65764", got)
+	}
+}
+
+func TestParseNodeID_invalid(t *testing.T) {
+	for _, id := range []string{
+		"",
+		"not base64!",
+		"MDY6Q29tbWl0MTI6YWJj", // "06:Commit12:abc" has no numeric ID.
+		"U_wA",                 // Not a MessagePack array.
+	} {
+		if _, err := ParseNodeID(id); err == nil {
+			t.Errorf("ParseNodeID(%q) returned no error", id)
+		}
+	}
+}
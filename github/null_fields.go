@@ -0,0 +1,48 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NullFields is a request body that encodes as Body with each of the
+// top-level JSON fields named in Fields set to null.
+//
+// Request structs use omitempty, so a nil field is left out of the request
+// and the API leaves it unchanged. NullFields distinguishes "unset" from
+// "set to null", so that PATCH requests can clear a value:
+//
+//	body := github.NullFields{Body: &github.Repository{Name: github.String("r")}, Fields: []string{"homepage"}}
+//	req, err := client.NewRequest("PATCH", "repos/o/r", body)
+//
+// encodes as {"homepage":null,"name":"r"}.
+type NullFields struct {
+	Body   interface{}
+	Fields []string
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullFields) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if n.Body != nil {
+		data, err := json.Marshal(n.Body)
+		if err != nil {
+			return nil, err
+		}
+		if string(data) != "null" {
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return nil, fmt.Errorf("github: NullFields body must encode as a JSON object: %v", err)
+			}
+		}
+	}
+
+	for _, f := range n.Fields {
+		fields[f] = json.RawMessage("null")
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullFields_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		body NullFields
+		want string
+	}{
+		{NullFields{}, `{}`},
+		{NullFields{Fields: []string{"homepage"}}, `{"homepage":null}`},
+		{NullFields{Body: (*Repository)(nil), Fields: []string{"homepage"}}, `{"homepage":null}`},
+		{NullFields{Body: &Repository{Name: String("r")}}, `{"name":"r"}`},
+		{
+			NullFields{Body: &Repository{Name: String("r"), Homepage: String("h")}, Fields: []string{"homepage", "description"}},
+			`{"description":null,"homepage":null,"name":"r"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.body)
+		if err != nil {
+			t.Errorf("json.Marshal(%+v) returned error: %v", tt.body, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("json.Marshal(%+v) = %s, want %s", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestNullFields_MarshalJSON_notObject(t *testing.T) {
+	if _, err := json.Marshal(NullFields{Body: []string{"a"}, Fields: []string{"f"}}); err == nil {
+		t.Error("json.Marshal returned nil error for a non-object body")
+	}
+}
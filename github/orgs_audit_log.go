@@ -0,0 +1,88 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// GetAuditLogOptions specifies the optional parameters to the
+// OrganizationsService.GetAuditLog method.
+type GetAuditLogOptions struct {
+	// Phrase filters the events, such as "action:org.block_user".
+	Phrase string `url:"phrase,omitempty"`
+
+	// Include is the event types to include. Possible values are: "web",
+	// "git", "all". Default is "web".
+	Include string `url:"include,omitempty"`
+
+	// Order is the order of the events. Possible values are: "desc", "asc".
+	// Default is "desc".
+	Order string `url:"order,omitempty"`
+
+	ListCursorOptions
+}
+
+// AuditEntry represents an event of the audit log of an organization. The
+// fields set depend on the action.
+type AuditEntry struct {
+	// Timestamp is the time of the event, in milliseconds since the Unix
+	// epoch. Use the Time method to get it as a time.Time.
+	Timestamp  *int64  `json:"@timestamp,omitempty"`
+	DocumentID *string `json:"_document_id,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	Actor      *string `json:"actor,omitempty"`
+	ActorID    *int64  `json:"actor_id,omitempty"`
+	Org        *string `json:"org,omitempty"`
+	OrgID      *int64  `json:"org_id,omitempty"`
+	User       *string `json:"user,omitempty"`
+	UserID     *int64  `json:"user_id,omitempty"`
+	Repo       *string `json:"repo,omitempty"`
+	Team       *string `json:"team,omitempty"`
+	// BlockedUser is the login of the user blocked or unblocked by an
+	// "org.block_user" or "org.unblock_user" action.
+	BlockedUser *string `json:"blocked_user,omitempty"`
+}
+
+func (e AuditEntry) String() string {
+	return Stringify(e)
+}
+
+// Time returns the time of the event, or the zero time if it is unknown.
+func (e *AuditEntry) Time() time.Time {
+	if e == nil || e.Timestamp == nil {
+		return time.Time{}
+	}
+	ms := *e.Timestamp
+	return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond))
+}
+
+// GetAuditLog gets the audit log of an organization. Use the After cursor of
+// the Response to get the next page.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/orgs/orgs#get-the-audit-log-for-an-organization
+func (s *OrganizationsService) GetAuditLog(ctx context.Context, org string, opt *GetAuditLogOptions) ([]*AuditEntry, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/audit-log", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []*AuditEntry
+	resp, err := s.client.Do(ctx, req, &entries)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entries, resp, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_GetAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"phrase": "action:org.block_user", "include": "all", "order": "asc", "per_page": "1", "after": "a"})
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/audit-log?after=b>; rel="next"`)
+		fmt.Fprint(w, `[{"@timestamp":1555555555123,"_document_id":"d","action":"org.block_user","actor":"a","org":"o","blocked_user":"b"}]`)
+	})
+
+	opt := &GetAuditLogOptions{
+		Phrase:            "action:org.block_user",
+		Include:           "all",
+		Order:             "asc",
+		ListCursorOptions: ListCursorOptions{PerPage: 1, After: "a"},
+	}
+	entries, resp, err := client.Organizations.GetAuditLog(context.Background(), "o", opt)
+	if err != nil {
+		t.Fatalf("Organizations.GetAuditLog returned error: %v", err)
+	}
+
+	want := []*AuditEntry{{
+		Timestamp:   Int64(1555555555123),
+		DocumentID:  String("d"),
+		Action:      String("org.block_user"),
+		Actor:       String("a"),
+		Org:         String("o"),
+		BlockedUser: String("b"),
+	}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("Organizations.GetAuditLog returned %+v, want %+v", entries, want)
+	}
+	if resp.After != "b" {
+		t.Errorf("Organizations.GetAuditLog returned After cursor %q, want %q", resp.After, "b")
+	}
+	if got, want := entries[0].Time(), time.Unix(1555555555, 123000000); !got.Equal(want) {
+		t.Errorf("AuditEntry.Time() = %v, want %v", got, want)
+	}
+}
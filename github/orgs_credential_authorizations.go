@@ -0,0 +1,84 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CredentialAuthorization represents a credential, such as a personal access
+// token or an SSH key, authorized by a member for SAML single sign-on access
+// to an organization.
+type CredentialAuthorization struct {
+	Login                  *string    `json:"login,omitempty"`
+	CredentialID           *int64     `json:"credential_id,omitempty"`
+	CredentialType         *string    `json:"credential_type,omitempty"`
+	TokenLastEight         *string    `json:"token_last_eight,omitempty"`
+	CredentialAuthorizedAt *Timestamp `json:"credential_authorized_at,omitempty"`
+	CredentialAccessedAt   *Timestamp `json:"credential_accessed_at,omitempty"`
+	Scopes                 []string   `json:"scopes,omitempty"`
+	Fingerprint            *string    `json:"fingerprint,omitempty"`
+
+	AuthorizedCredentialID        *int64     `json:"authorized_credential_id,omitempty"`
+	AuthorizedCredentialTitle     *string    `json:"authorized_credential_title,omitempty"`
+	AuthorizedCredentialNote      *string    `json:"authorized_credential_note,omitempty"`
+	AuthorizedCredentialExpiresAt *Timestamp `json:"authorized_credential_expires_at,omitempty"`
+}
+
+func (c CredentialAuthorization) String() string {
+	return Stringify(c)
+}
+
+// ListCredentialAuthorizationsOptions specifies the optional parameters to
+// the OrganizationsService.ListCredentialAuthorizations method.
+type ListCredentialAuthorizationsOptions struct {
+	// Login limits the list to the credentials of a single member.
+	Login string `url:"login,omitempty"`
+
+	ListOptions
+}
+
+// ListCredentialAuthorizations lists the credentials authorized for SAML
+// single sign-on access to an organization. The authenticated user must be
+// an owner of the organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/orgs/orgs#list-saml-sso-authorizations-for-an-organization
+func (s *OrganizationsService) ListCredentialAuthorizations(ctx context.Context, org string, opt *ListCredentialAuthorizationsOptions) ([]*CredentialAuthorization, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/credential-authorizations", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var creds []*CredentialAuthorization
+	resp, err := s.client.Do(ctx, req, &creds)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return creds, resp, nil
+}
+
+// RemoveCredentialAuthorization revokes the SAML single sign-on
+// authorization of a credential, which then loses access to the
+// organization until it is authorized again.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/orgs/orgs#remove-a-saml-sso-authorization-for-an-organization
+func (s *OrganizationsService) RemoveCredentialAuthorization(ctx context.Context, org string, credentialID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/credential-authorizations/%v", org, credentialID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
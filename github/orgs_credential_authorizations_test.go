@@ -0,0 +1,59 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_ListCredentialAuthorizations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/credential-authorizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"login": "l", "page": "2"})
+		fmt.Fprint(w, `[{"login":"l","credential_id":1,"credential_type":"personal access token","token_last_eight":"12345678","credential_authorized_at":"2019-01-01T00:00:00Z","scopes":["repo"]}]`)
+	})
+
+	opt := &ListCredentialAuthorizationsOptions{Login: "l", ListOptions: ListOptions{Page: 2}}
+	creds, _, err := client.Organizations.ListCredentialAuthorizations(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Organizations.ListCredentialAuthorizations returned error: %v", err)
+	}
+
+	want := []*CredentialAuthorization{{
+		Login:                  String("l"),
+		CredentialID:           Int64(1),
+		CredentialType:         String("personal access token"),
+		TokenLastEight:         String("12345678"),
+		CredentialAuthorizedAt: &Timestamp{time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		Scopes:                 []string{"repo"},
+	}}
+	if !reflect.DeepEqual(creds, want) {
+		t.Errorf("Organizations.ListCredentialAuthorizations returned %+v, want %+v", creds, want)
+	}
+}
+
+func TestOrganizationsService_RemoveCredentialAuthorization(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/credential-authorizations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Organizations.RemoveCredentialAuthorization(context.Background(), "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.RemoveCredentialAuthorization returned error: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// IssueType represents an issue type of an organization, used to classify
+// the issues of its repositories.
+type IssueType struct {
+	ID          *int64     `json:"id,omitempty"`
+	NodeID      *string    `json:"node_id,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Color       *string    `json:"color,omitempty"`
+	IsEnabled   *bool      `json:"is_enabled,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+}
+
+func (t IssueType) String() string {
+	return Stringify(t)
+}
+
+// IssueTypeRequest represents a request to create or update an issue type.
+type IssueTypeRequest struct {
+	Name        string  `json:"name"`
+	IsEnabled   bool    `json:"is_enabled"`
+	Description *string `json:"description,omitempty"`
+	// Color can be one of: "gray", "blue", "green", "yellow", "orange",
+	// "red", "pink" and "purple".
+	Color *string `json:"color,omitempty"`
+}
+
+// ListIssueTypes lists the issue types of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#list-issue-types-for-an-organization
+func (s *OrganizationsService) ListIssueTypes(ctx context.Context, org string) ([]*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []*IssueType
+	resp, err := s.client.Do(ctx, req, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueTypes, resp, nil
+}
+
+// CreateIssueType creates an issue type for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#create-issue-type-for-an-organization
+func (s *OrganizationsService) CreateIssueType(ctx context.Context, org string, issueType *IssueTypeRequest) (*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types", org)
+	req, err := s.client.NewRequest("POST", u, issueType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(IssueType)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// UpdateIssueType updates an issue type of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#update-issue-type-for-an-organization
+func (s *OrganizationsService) UpdateIssueType(ctx context.Context, org string, issueTypeID int64, issueType *IssueTypeRequest) (*IssueType, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types/%v", org, issueTypeID)
+	req, err := s.client.NewRequest("PUT", u, issueType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(IssueType)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// DeleteIssueType deletes an issue type of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/issue-types#delete-issue-type-for-an-organization
+func (s *OrganizationsService) DeleteIssueType(ctx context.Context, org string, issueTypeID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/issue-types/%v", org, issueTypeID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
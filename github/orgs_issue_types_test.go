@@ -0,0 +1,99 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListIssueTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":410,"node_id":"IT_1","name":"Task","description":"A specific piece of work","color":"yellow","is_enabled":true}]`)
+	})
+
+	issueTypes, _, err := client.Organizations.ListIssueTypes(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Organizations.ListIssueTypes returned error: %v", err)
+	}
+
+	want := []*IssueType{{
+		ID:          Int64(410),
+		NodeID:      String("IT_1"),
+		Name:        String("Task"),
+		Description: String("A specific piece of work"),
+		Color:       String("yellow"),
+		IsEnabled:   Bool(true),
+	}}
+	if !reflect.DeepEqual(issueTypes, want) {
+		t.Errorf("Organizations.ListIssueTypes returned %+v, want %+v", issueTypes, want)
+	}
+}
+
+func TestOrganizationsService_CreateIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Epic","is_enabled":true,"color":"purple"}`+"\n")
+		fmt.Fprint(w, `{"id":411,"name":"Epic"}`)
+	})
+
+	input := &IssueTypeRequest{Name: "Epic", IsEnabled: true, Color: String("purple")}
+	issueType, _, err := client.Organizations.CreateIssueType(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("Organizations.CreateIssueType returned error: %v", err)
+	}
+
+	want := &IssueType{ID: Int64(411), Name: String("Epic")}
+	if !reflect.DeepEqual(issueType, want) {
+		t.Errorf("Organizations.CreateIssueType returned %+v, want %+v", issueType, want)
+	}
+}
+
+func TestOrganizationsService_UpdateIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types/411", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"Epic","is_enabled":false}`+"\n")
+		fmt.Fprint(w, `{"id":411,"is_enabled":false}`)
+	})
+
+	input := &IssueTypeRequest{Name: "Epic"}
+	issueType, _, err := client.Organizations.UpdateIssueType(context.Background(), "o", 411, input)
+	if err != nil {
+		t.Errorf("Organizations.UpdateIssueType returned error: %v", err)
+	}
+
+	want := &IssueType{ID: Int64(411), IsEnabled: Bool(false)}
+	if !reflect.DeepEqual(issueType, want) {
+		t.Errorf("Organizations.UpdateIssueType returned %+v, want %+v", issueType, want)
+	}
+}
+
+func TestOrganizationsService_DeleteIssueType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/issue-types/411", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Organizations.DeleteIssueType(context.Background(), "o", 411); err != nil {
+		t.Errorf("Organizations.DeleteIssueType returned error: %v", err)
+	}
+}
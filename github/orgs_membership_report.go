@@ -0,0 +1,177 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// MemberAccess is the access of a member, or of an invitee, to an
+// organization.
+type MemberAccess struct {
+	// User is the member, or the invitee if they have an account. The ID of
+	// an invitee is not known.
+	User *User
+
+	// Role is the role of the member, "admin" or "member", or the role the
+	// invitee is invited to.
+	Role string
+
+	// TwoFactorEnabled reports whether the member has two-factor
+	// authentication enabled. It is nil for invitees, and when it cannot be
+	// known because the authenticated user is not an owner of the
+	// organization.
+	TwoFactorEnabled *bool
+
+	// LastActivity is the time of the latest event of the member in the
+	// audit log of the organization, or the zero time if there is none.
+	LastActivity time.Time
+
+	// Invitation is the pending invitation of an invitee, and nil for
+	// members.
+	Invitation *Invitation
+}
+
+// MembershipReport lists the members of an organization and its pending
+// invitations.
+type MembershipReport struct {
+	Org         string
+	GeneratedAt time.Time
+
+	// Members are the members, admins first, followed by the invitees.
+	Members []*MemberAccess
+}
+
+// MembershipReport builds a report of the members of an organization, with
+// their role, two-factor authentication status and last activity, and of its
+// pending invitations, as needed for access reviews.
+//
+// The last activity of each member is taken from the audit log of the
+// organization, which is only available to organizations on GitHub
+// Enterprise Cloud; if it cannot be read, the report is built without it.
+func (s *OrganizationsService) MembershipReport(ctx context.Context, org string) (*MembershipReport, error) {
+	report := &MembershipReport{Org: org, GeneratedAt: time.Now()}
+
+	for _, role := range []string{"admin", "member"} {
+		users, err := s.listAllMembers(ctx, org, &ListMembersOptions{Role: role})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			report.Members = append(report.Members, &MemberAccess{User: u, Role: role})
+		}
+	}
+
+	// Only owners can filter members by their two-factor authentication
+	// status; for others, GitHub fails the request.
+	if disabled, err := s.listAllMembers(ctx, org, &ListMembersOptions{Filter: "2fa_disabled"}); err == nil {
+		without2FA := make(map[int64]bool)
+		for _, u := range disabled {
+			without2FA[u.GetID()] = true
+		}
+		for _, m := range report.Members {
+			m.TwoFactorEnabled = Bool(!without2FA[m.User.GetID()])
+		}
+	} else if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	for _, m := range report.Members {
+		logOpt := &GetAuditLogOptions{
+			Phrase:            "actor:" + m.User.GetLogin(),
+			ListCursorOptions: ListCursorOptions{PerPage: 1},
+		}
+		entries, _, err := s.GetAuditLog(ctx, org, logOpt)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			break
+		}
+		if len(entries) > 0 {
+			m.LastActivity = entries[0].Time()
+		}
+	}
+
+	opt := &ListOptions{PerPage: 100}
+	for {
+		invitations, resp, err := s.ListPendingOrgInvitations(ctx, org, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range invitations {
+			m := &MemberAccess{Role: i.GetRole(), Invitation: i}
+			if i.Login != nil {
+				m.User = &User{Login: i.Login}
+			}
+			report.Members = append(report.Members, m)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return report, nil
+}
+
+// listAllMembers lists the members of an organization matching opt, across
+// all pages.
+func (s *OrganizationsService) listAllMembers(ctx context.Context, org string, opt *ListMembersOptions) ([]*User, error) {
+	opt.PerPage = 100
+	var members []*User
+	for {
+		users, resp, err := s.ListMembers(ctx, org, opt)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, users...)
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// WriteCSV writes the report to w as CSV, with a header row followed by a
+// row per member or invitee. Times are formatted as RFC 3339, and unknown
+// values are empty.
+func (r *MembershipReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"org", "login", "id", "role", "two_factor_enabled", "last_activity", "pending", "email", "invitation_id"})
+	for _, m := range r.Members {
+		var id, twoFactor, lastActivity, invitationID string
+		if m.User.GetID() != 0 {
+			id = strconv.FormatInt(m.User.GetID(), 10)
+		}
+		if m.Invitation != nil {
+			invitationID = strconv.FormatInt(m.Invitation.GetID(), 10)
+		}
+		if m.TwoFactorEnabled != nil {
+			twoFactor = strconv.FormatBool(*m.TwoFactorEnabled)
+		}
+		if !m.LastActivity.IsZero() {
+			lastActivity = m.LastActivity.UTC().Format(time.RFC3339)
+		}
+		cw.Write([]string{
+			r.Org,
+			m.User.GetLogin(),
+			id,
+			m.Role,
+			twoFactor,
+			lastActivity,
+			strconv.FormatBool(m.Invitation != nil),
+			m.Invitation.GetEmail(),
+			invitationID,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestOrganizationsService_MembershipReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch {
+		case r.FormValue("role") == "admin":
+			fmt.Fprint(w, `[{"login":"owner","id":1}]`)
+		case r.FormValue("role") == "member" && r.FormValue("page") == "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/members?role=member&page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"login":"dev","id":2}]`)
+		case r.FormValue("role") == "member" && r.FormValue("page") == "2":
+			fmt.Fprint(w, `[{"login":"idle","id":3}]`)
+		case r.FormValue("filter") == "2fa_disabled":
+			fmt.Fprint(w, `[{"login":"dev","id":2}]`)
+		default:
+			t.Errorf("Unexpected members request %v", r.URL)
+		}
+	})
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("phrase") {
+		case "actor:owner":
+			fmt.Fprint(w, `[{"@timestamp":1555555555000,"action":"repo.create","actor":"owner"}]`)
+		case "actor:dev":
+			fmt.Fprint(w, `[{"@timestamp":1444444444000,"action":"git.push","actor":"dev"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":10,"login":"new","role":"direct_member"},{"id":11,"email":"e@example.com","role":"admin"}]`)
+	})
+
+	report, err := client.Organizations.MembershipReport(context.Background(), "o")
+	if err != nil {
+		t.Fatalf("Organizations.MembershipReport returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("MembershipReport.WriteCSV returned error: %v", err)
+	}
+	want := "org,login,id,role,two_factor_enabled,last_activity,pending,email,invitation_id\n" +
+		"o,owner,1,admin,true,2019-04-18T02:45:55Z,false,,\n" +
+		"o,dev,2,member,false,2015-10-10T02:34:04Z,false,,\n" +
+		"o,idle,3,member,true,,false,,\n" +
+		"o,new,,direct_member,,,true,,10\n" +
+		"o,,,admin,,,true,e@example.com,11\n"
+	if got := buf.String(); got != want {
+		t.Errorf("MembershipReport.WriteCSV wrote\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestOrganizationsService_MembershipReport_notOwner(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/members", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("filter") != "" {
+			http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+			return
+		}
+		if r.FormValue("role") == "member" {
+			fmt.Fprint(w, `[{"login":"dev","id":2}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/orgs/o/invitations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	report, err := client.Organizations.MembershipReport(context.Background(), "o")
+	if err != nil {
+		t.Fatalf("Organizations.MembershipReport returned error: %v", err)
+	}
+
+	if len(report.Members) != 1 {
+		t.Fatalf("Organizations.MembershipReport returned %v members, want 1", len(report.Members))
+	}
+	if m := report.Members[0]; m.TwoFactorEnabled != nil || !m.LastActivity.IsZero() {
+		t.Errorf("Organizations.MembershipReport returned 2FA %v and last activity %v, want unknown", m.TwoFactorEnabled, m.LastActivity)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// BlockedUser is a user blocked by an organization.
+type BlockedUser struct {
+	User *User
+
+	// BlockedAt is when the user was last blocked, or the zero time if the
+	// audit log has no record of it.
+	BlockedAt time.Time
+
+	// BlockedBy is the login of who last blocked the user, if known.
+	BlockedBy string
+}
+
+// ModerationReport lists the users blocked by an organization.
+type ModerationReport struct {
+	Org          string
+	GeneratedAt  time.Time
+	BlockedUsers []*BlockedUser
+}
+
+// ModerationReport builds a report of the users blocked by an organization.
+// When each user was blocked, and by whom, is taken from the "org.block_user"
+// events of the audit log of the organization. The audit log is only
+// available to organizations on GitHub Enterprise Cloud; if it cannot be
+// read, the report is built without these details.
+func (s *OrganizationsService) ModerationReport(ctx context.Context, org string) (*ModerationReport, error) {
+	report := &ModerationReport{Org: org, GeneratedAt: time.Now()}
+
+	opt := &ListOptions{PerPage: 100}
+	for {
+		users, resp, err := s.ListBlockedUsers(ctx, org, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			report.BlockedUsers = append(report.BlockedUsers, &BlockedUser{User: u})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	byLogin := make(map[string]*BlockedUser)
+	for _, b := range report.BlockedUsers {
+		byLogin[b.User.GetLogin()] = b
+	}
+	remaining := len(byLogin)
+
+	// Events are returned newest first, so the first event for a user is the
+	// latest time they were blocked.
+	logOpt := &GetAuditLogOptions{
+		Phrase:            "action:org.block_user",
+		ListCursorOptions: ListCursorOptions{PerPage: 100},
+	}
+	for remaining > 0 {
+		entries, resp, err := s.GetAuditLog(ctx, org, logOpt)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			break
+		}
+		for _, e := range entries {
+			b := byLogin[e.GetBlockedUser()]
+			if b == nil || !b.BlockedAt.IsZero() {
+				continue
+			}
+			b.BlockedAt = e.Time()
+			b.BlockedBy = e.GetActor()
+			remaining--
+		}
+		if resp.After == "" {
+			break
+		}
+		logOpt.After = resp.After
+	}
+
+	return report, nil
+}
+
+// WriteCSV writes the report to w as CSV, with a header row followed by a
+// row per blocked user. Times are formatted as RFC 3339 and empty if unknown.
+func (r *ModerationReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"org", "login", "id", "blocked_at", "blocked_by"})
+	for _, b := range r.BlockedUsers {
+		var blockedAt string
+		if !b.BlockedAt.IsZero() {
+			blockedAt = b.BlockedAt.UTC().Format(time.RFC3339)
+		}
+		cw.Write([]string{
+			r.Org,
+			b.User.GetLogin(),
+			strconv.FormatInt(b.User.GetID(), 10),
+			blockedAt,
+			b.BlockedBy,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
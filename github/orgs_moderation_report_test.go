@@ -0,0 +1,86 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_ModerationReport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/blocks?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"login":"spammer","id":1}]`)
+		case "2":
+			fmt.Fprint(w, `[{"login":"troll","id":2}]`)
+		}
+	})
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"phrase": "action:org.block_user", "per_page": "100"})
+		fmt.Fprint(w, `[
+			{"@timestamp":1555555555000,"action":"org.block_user","actor":"admin","blocked_user":"spammer"},
+			{"@timestamp":1444444444000,"action":"org.block_user","actor":"old","blocked_user":"spammer"}
+		]`)
+	})
+
+	report, err := client.Organizations.ModerationReport(context.Background(), "o")
+	if err != nil {
+		t.Fatalf("Organizations.ModerationReport returned error: %v", err)
+	}
+
+	if len(report.BlockedUsers) != 2 {
+		t.Fatalf("Organizations.ModerationReport returned %v blocked users, want 2", len(report.BlockedUsers))
+	}
+	spammer, troll := report.BlockedUsers[0], report.BlockedUsers[1]
+	if !spammer.BlockedAt.Equal(time.Unix(1555555555, 0)) || spammer.BlockedBy != "admin" {
+		t.Errorf("spammer blocked at %v by %q, want latest block by admin", spammer.BlockedAt, spammer.BlockedBy)
+	}
+	if !troll.BlockedAt.IsZero() || troll.BlockedBy != "" {
+		t.Errorf("troll blocked at %v by %q, want unknown", troll.BlockedAt, troll.BlockedBy)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("ModerationReport.WriteCSV returned error: %v", err)
+	}
+	want := "org,login,id,blocked_at,blocked_by\n" +
+		"o,spammer,1,2019-04-18T02:45:55Z,admin\n" +
+		"o,troll,2,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("ModerationReport.WriteCSV wrote\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestOrganizationsService_ModerationReport_noAuditLog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/blocks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"login":"spammer","id":1}]`)
+	})
+	mux.HandleFunc("/orgs/o/audit-log", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	report, err := client.Organizations.ModerationReport(context.Background(), "o")
+	if err != nil {
+		t.Fatalf("Organizations.ModerationReport returned error: %v", err)
+	}
+	if len(report.BlockedUsers) != 1 || !report.BlockedUsers[0].BlockedAt.IsZero() {
+		t.Errorf("Organizations.ModerationReport returned %+v, want 1 user blocked at an unknown time", report.BlockedUsers)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// NetworkConfiguration represents a hosted compute network configuration,
+// which attaches GitHub-hosted runners to a private network such as an
+// Azure virtual network.
+type NetworkConfiguration struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// ComputeService is the hosted compute service using the network
+	// configuration. It can be one of: "none" and "actions".
+	ComputeService     *string    `json:"compute_service,omitempty"`
+	NetworkSettingsIDs []string   `json:"network_settings_ids,omitempty"`
+	CreatedOn          *Timestamp `json:"created_on,omitempty"`
+}
+
+func (n NetworkConfiguration) String() string {
+	return Stringify(n)
+}
+
+// NetworkConfigurations represents a paginated list of hosted compute
+// network configurations.
+type NetworkConfigurations struct {
+	TotalCount            *int                    `json:"total_count,omitempty"`
+	NetworkConfigurations []*NetworkConfiguration `json:"network_configurations,omitempty"`
+}
+
+// NetworkSettingsResource represents a hosted compute network settings
+// resource, which identifies the subnet of a private network that hosted
+// compute can use.
+type NetworkSettingsResource struct {
+	ID                     *string `json:"id,omitempty"`
+	NetworkConfigurationID *string `json:"network_configuration_id,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	SubnetID               *string `json:"subnet_id,omitempty"`
+	Region                 *string `json:"region,omitempty"`
+}
+
+// NetworkConfigurationRequest represents a request to create or update a
+// hosted compute network configuration.
+type NetworkConfigurationRequest struct {
+	Name           *string `json:"name,omitempty"`
+	ComputeService *string `json:"compute_service,omitempty"`
+	// NetworkSettingsIDs are the IDs of the network settings resources to
+	// use. Exactly one is supported.
+	NetworkSettingsIDs []string `json:"network_settings_ids,omitempty"`
+}
+
+// ListNetworkConfigurations lists the hosted compute network
+// configurations of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#list-hosted-compute-network-configurations-for-an-organization
+func (s *OrganizationsService) ListNetworkConfigurations(ctx context.Context, org string, opt *ListOptions) (*NetworkConfigurations, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configurations := new(NetworkConfigurations)
+	resp, err := s.client.Do(ctx, req, configurations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configurations, resp, nil
+}
+
+// GetNetworkConfiguration gets a hosted compute network configuration of
+// an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#get-a-hosted-compute-network-configuration-for-an-organization
+func (s *OrganizationsService) GetNetworkConfiguration(ctx context.Context, org, networkID string) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", org, networkID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(NetworkConfiguration)
+	resp, err := s.client.Do(ctx, req, configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configuration, resp, nil
+}
+
+// CreateNetworkConfiguration creates a hosted compute network
+// configuration for an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#create-a-hosted-compute-network-configuration-for-an-organization
+func (s *OrganizationsService) CreateNetworkConfiguration(ctx context.Context, org string, createReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations", org)
+	req, err := s.client.NewRequest("POST", u, createReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(NetworkConfiguration)
+	resp, err := s.client.Do(ctx, req, configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configuration, resp, nil
+}
+
+// UpdateNetworkConfiguration updates a hosted compute network
+// configuration of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#update-a-hosted-compute-network-configuration-for-an-organization
+func (s *OrganizationsService) UpdateNetworkConfiguration(ctx context.Context, org, networkID string, updateReq NetworkConfigurationRequest) (*NetworkConfiguration, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", org, networkID)
+	req, err := s.client.NewRequest("PATCH", u, updateReq)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(NetworkConfiguration)
+	resp, err := s.client.Do(ctx, req, configuration)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return configuration, resp, nil
+}
+
+// DeleteNetworkConfiguration deletes a hosted compute network
+// configuration of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#delete-a-hosted-compute-network-configuration-from-an-organization
+func (s *OrganizationsService) DeleteNetworkConfiguration(ctx context.Context, org, networkID string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-configurations/%v", org, networkID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// GetNetworkSettingsResource gets a hosted compute network settings
+// resource of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/network-configurations#get-a-hosted-compute-network-settings-resource-for-an-organization
+func (s *OrganizationsService) GetNetworkSettingsResource(ctx context.Context, org, networkID string) (*NetworkSettingsResource, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/settings/network-settings/%v", org, networkID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resource := new(NetworkSettingsResource)
+	resp, err := s.client.Do(ctx, req, resource)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resource, resp, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrganizationsService_ListNetworkConfigurations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"network_configurations":[{"id":"123456789ABCDEF","name":"configuration one","compute_service":"actions","network_settings_ids":["23456789ABDCEF1"],"created_on":"2024-04-09T17:30:15Z"}]}`)
+	})
+
+	configurations, _, err := client.Organizations.ListNetworkConfigurations(context.Background(), "o", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListNetworkConfigurations returned error: %v", err)
+	}
+
+	want := &NetworkConfigurations{
+		TotalCount: Int(1),
+		NetworkConfigurations: []*NetworkConfiguration{{
+			ID:                 String("123456789ABCDEF"),
+			Name:               String("configuration one"),
+			ComputeService:     String("actions"),
+			NetworkSettingsIDs: []string{"23456789ABDCEF1"},
+			CreatedOn:          &Timestamp{time.Date(2024, time.April, 9, 17, 30, 15, 0, time.UTC)},
+		}},
+	}
+	if !reflect.DeepEqual(configurations, want) {
+		t.Errorf("Organizations.ListNetworkConfigurations returned %+v, want %+v", configurations, want)
+	}
+}
+
+func TestOrganizationsService_GetNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"123","name":"c"}`)
+	})
+
+	configuration, _, err := client.Organizations.GetNetworkConfiguration(context.Background(), "o", "123")
+	if err != nil {
+		t.Errorf("Organizations.GetNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("123"), Name: String("c")}
+	if !reflect.DeepEqual(configuration, want) {
+		t.Errorf("Organizations.GetNetworkConfiguration returned %+v, want %+v", configuration, want)
+	}
+}
+
+func TestOrganizationsService_CreateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"c","compute_service":"actions","network_settings_ids":["456"]}`+"\n")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"123","name":"c"}`)
+	})
+
+	createReq := NetworkConfigurationRequest{Name: String("c"), ComputeService: String("actions"), NetworkSettingsIDs: []string{"456"}}
+	configuration, _, err := client.Organizations.CreateNetworkConfiguration(context.Background(), "o", createReq)
+	if err != nil {
+		t.Errorf("Organizations.CreateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("123"), Name: String("c")}
+	if !reflect.DeepEqual(configuration, want) {
+		t.Errorf("Organizations.CreateNetworkConfiguration returned %+v, want %+v", configuration, want)
+	}
+}
+
+func TestOrganizationsService_UpdateNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"compute_service":"none"}`+"\n")
+		fmt.Fprint(w, `{"id":"123","compute_service":"none"}`)
+	})
+
+	updateReq := NetworkConfigurationRequest{ComputeService: String("none")}
+	configuration, _, err := client.Organizations.UpdateNetworkConfiguration(context.Background(), "o", "123", updateReq)
+	if err != nil {
+		t.Errorf("Organizations.UpdateNetworkConfiguration returned error: %v", err)
+	}
+
+	want := &NetworkConfiguration{ID: String("123"), ComputeService: String("none")}
+	if !reflect.DeepEqual(configuration, want) {
+		t.Errorf("Organizations.UpdateNetworkConfiguration returned %+v, want %+v", configuration, want)
+	}
+}
+
+func TestOrganizationsService_DeleteNetworkConfiguration(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-configurations/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Organizations.DeleteNetworkConfiguration(context.Background(), "o", "123"); err != nil {
+		t.Errorf("Organizations.DeleteNetworkConfiguration returned error: %v", err)
+	}
+}
+
+func TestOrganizationsService_GetNetworkSettingsResource(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/settings/network-settings/456", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"456","network_configuration_id":"123","name":"s","subnet_id":"/subscriptions/x","region":"eastus"}`)
+	})
+
+	resource, _, err := client.Organizations.GetNetworkSettingsResource(context.Background(), "o", "456")
+	if err != nil {
+		t.Errorf("Organizations.GetNetworkSettingsResource returned error: %v", err)
+	}
+
+	want := &NetworkSettingsResource{
+		ID:                     String("456"),
+		NetworkConfigurationID: String("123"),
+		Name:                   String("s"),
+		SubnetID:               String("/subscriptions/x"),
+		Region:                 String("eastus"),
+	}
+	if !reflect.DeepEqual(resource, want) {
+		t.Errorf("Organizations.GetNetworkSettingsResource returned %+v, want %+v", resource, want)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// OrgRole represents an organization role, a set of permissions granted
+// on an organization and all its repositories.
+type OrgRole struct {
+	ID           *int64        `json:"id,omitempty"`
+	Name         *string       `json:"name,omitempty"`
+	Description  *string       `json:"description,omitempty"`
+	Permissions  []string      `json:"permissions,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+	Source       *string       `json:"source,omitempty"`
+	BaseRole     *string       `json:"base_role,omitempty"`
+	CreatedAt    *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp    `json:"updated_at,omitempty"`
+}
+
+func (r OrgRole) String() string {
+	return Stringify(r)
+}
+
+// OrgRoles represents the list of roles of an organization.
+type OrgRoles struct {
+	TotalCount *int       `json:"total_count,omitempty"`
+	Roles      []*OrgRole `json:"roles,omitempty"`
+}
+
+// ListOrgRoles lists the roles available in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#get-all-organization-roles-for-an-organization
+func (s *OrganizationsService) ListOrgRoles(ctx context.Context, org string) (*OrgRoles, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles", org)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := new(OrgRoles)
+	resp, err := s.client.Do(ctx, req, roles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roles, resp, nil
+}
+
+// GetOrgRole gets an organization role.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#get-an-organization-role
+func (s *OrganizationsService) GetOrgRole(ctx context.Context, org string, roleID int64) (*OrgRole, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v", org, roleID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(OrgRole)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, nil
+}
+
+// AssignOrgRoleToTeam assigns an organization role to a team.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#assign-an-organization-role-to-a-team
+func (s *OrganizationsService) AssignOrgRoleToTeam(ctx context.Context, org, teamSlug string, roleID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/teams/%v/%v", org, teamSlug, roleID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveOrgRoleFromTeam revokes an organization role from a team.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#remove-an-organization-role-from-a-team
+func (s *OrganizationsService) RemoveOrgRoleFromTeam(ctx context.Context, org, teamSlug string, roleID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/teams/%v/%v", org, teamSlug, roleID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveAllOrgRolesFromTeam revokes all organization roles from a team.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#remove-all-organization-roles-for-a-team
+func (s *OrganizationsService) RemoveAllOrgRolesFromTeam(ctx context.Context, org, teamSlug string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/teams/%v", org, teamSlug)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AssignOrgRoleToUser assigns an organization role to a member of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#assign-an-organization-role-to-a-user
+func (s *OrganizationsService) AssignOrgRoleToUser(ctx context.Context, org, username string, roleID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/users/%v/%v", org, username, roleID)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveOrgRoleFromUser revokes an organization role from a member of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#remove-an-organization-role-from-a-user
+func (s *OrganizationsService) RemoveOrgRoleFromUser(ctx context.Context, org, username string, roleID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/users/%v/%v", org, username, roleID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveAllOrgRolesFromUser revokes all organization roles from a member of
+// an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#remove-all-organization-roles-for-a-user
+func (s *OrganizationsService) RemoveAllOrgRolesFromUser(ctx context.Context, org, username string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/users/%v", org, username)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListTeamsAssignedToOrgRole lists the teams an organization role is
+// assigned to.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#list-teams-that-are-assigned-to-an-organization-role
+func (s *OrganizationsService) ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opt *ListOptions) ([]*Team, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v/teams", org, roleID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var teams []*Team
+	resp, err := s.client.Do(ctx, req, &teams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return teams, resp, nil
+}
+
+// ListUsersAssignedToOrgRole lists the users an organization role is
+// assigned to.
+//
+// GitHub API docs: https://docs.github.com/en/rest/orgs/organization-roles#list-users-that-are-assigned-to-an-organization-role
+func (s *OrganizationsService) ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opt *ListOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v/users", org, roleID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
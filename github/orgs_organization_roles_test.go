@@ -0,0 +1,144 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListOrgRoles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"roles":[{"id":1,"name":"security","permissions":["read_audit_logs"],"source":"Organization"}]}`)
+	})
+
+	roles, _, err := client.Organizations.ListOrgRoles(context.Background(), "o")
+	if err != nil {
+		t.Errorf("Organizations.ListOrgRoles returned error: %v", err)
+	}
+
+	want := &OrgRoles{
+		TotalCount: Int(1),
+		Roles: []*OrgRole{{
+			ID:          Int64(1),
+			Name:        String("security"),
+			Permissions: []string{"read_audit_logs"},
+			Source:      String("Organization"),
+		}},
+	}
+	if !reflect.DeepEqual(roles, want) {
+		t.Errorf("Organizations.ListOrgRoles returned %+v, want %+v", roles, want)
+	}
+}
+
+func TestOrganizationsService_GetOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"base_role":"read"}`)
+	})
+
+	role, _, err := client.Organizations.GetOrgRole(context.Background(), "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetOrgRole returned error: %v", err)
+	}
+
+	want := &OrgRole{ID: Int64(1), BaseRole: String("read")}
+	if !reflect.DeepEqual(role, want) {
+		t.Errorf("Organizations.GetOrgRole returned %+v, want %+v", role, want)
+	}
+}
+
+func TestOrganizationsService_OrgRoleAssignments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tests := []struct {
+		method, path string
+		call         func() (*Response, error)
+	}{
+		{"PUT", "/orgs/o/organization-roles/teams/t/1", func() (*Response, error) {
+			return client.Organizations.AssignOrgRoleToTeam(context.Background(), "o", "t", 1)
+		}},
+		{"DELETE", "/orgs/o/organization-roles/teams/t/2", func() (*Response, error) {
+			return client.Organizations.RemoveOrgRoleFromTeam(context.Background(), "o", "t", 2)
+		}},
+		{"DELETE", "/orgs/o/organization-roles/teams/t", func() (*Response, error) {
+			return client.Organizations.RemoveAllOrgRolesFromTeam(context.Background(), "o", "t")
+		}},
+		{"PUT", "/orgs/o/organization-roles/users/u/1", func() (*Response, error) {
+			return client.Organizations.AssignOrgRoleToUser(context.Background(), "o", "u", 1)
+		}},
+		{"DELETE", "/orgs/o/organization-roles/users/u/2", func() (*Response, error) {
+			return client.Organizations.RemoveOrgRoleFromUser(context.Background(), "o", "u", 2)
+		}},
+		{"DELETE", "/orgs/o/organization-roles/users/u", func() (*Response, error) {
+			return client.Organizations.RemoveAllOrgRolesFromUser(context.Background(), "o", "u")
+		}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, tt.method)
+			w.WriteHeader(http.StatusNoContent)
+		})
+		if _, err := tt.call(); err != nil {
+			t.Errorf("%v %v returned error: %v", tt.method, tt.path, err)
+		}
+	}
+}
+
+func TestOrganizationsService_ListTeamsAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	teams, _, err := client.Organizations.ListTeamsAssignedToOrgRole(context.Background(), "o", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*Team{{ID: Int64(1)}}
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("Organizations.ListTeamsAssignedToOrgRole returned %+v, want %+v", teams, want)
+	}
+}
+
+func TestOrganizationsService_ListUsersAssignedToOrgRole(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/organization-roles/1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	users, _, err := client.Organizations.ListUsersAssignedToOrgRole(context.Background(), "o", 1, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Organizations.ListUsersAssignedToOrgRole returned %+v, want %+v", users, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListPreReceiveHooks lists all pre-receive hooks available to the specified
+// organization, with their enforcement in the organization.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/orgs/pre_receive_hooks/#list-pre-receive-hooks
+func (s *OrganizationsService) ListPreReceiveHooks(ctx context.Context, org string, opt *ListOptions) ([]*PreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/pre-receive-hooks", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	var hooks []*PreReceiveHook
+	resp, err := s.client.Do(ctx, req, &hooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hooks, resp, nil
+}
+
+// GetPreReceiveHook returns a single specified pre-receive hook of an
+// organization.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/orgs/pre_receive_hooks/#get-a-single-pre-receive-hook
+func (s *OrganizationsService) GetPreReceiveHook(ctx context.Context, org string, id int64) (*PreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/pre-receive-hooks/%d", org, id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	h := new(PreReceiveHook)
+	resp, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// UpdatePreReceiveHook updates the enforcement of a specified pre-receive
+// hook in an organization, and whether its repositories may override it.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/orgs/pre_receive_hooks/#update-pre-receive-hook-enforcement
+func (s *OrganizationsService) UpdatePreReceiveHook(ctx context.Context, org string, id int64, hook *PreReceiveHook) (*PreReceiveHook, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/pre-receive-hooks/%d", org, id)
+	req, err := s.client.NewRequest("PATCH", u, hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	h := new(PreReceiveHook)
+	resp, err := s.client.Do(ctx, req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, nil
+}
+
+// DeletePreReceiveHook removes the enforcement overrides of a specified
+// pre-receive hook in an organization, restoring the enforcement set for the
+// instance.
+//
+// GitHub API docs: https://developer.github.com/enterprise/v3/orgs/pre_receive_hooks/#remove-enforcement-overrides-for-a-pre-receive-hook
+func (s *OrganizationsService) DeletePreReceiveHook(ctx context.Context, org string, id int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/pre-receive-hooks/%d", org, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches.
+	req.Header.Set("Accept", mediaTypePreReceiveHooksPreview)
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,115 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListPreReceiveHooks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"enforcement":"enabled","allow_downstream_configuration":true}, {"id":2}]`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	hooks, _, err := client.Organizations.ListPreReceiveHooks(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Organizations.ListPreReceiveHooks returned error: %v", err)
+	}
+
+	want := []*PreReceiveHook{
+		{ID: Int64(1), Enforcement: String("enabled"), AllowDownstreamConfiguration: Bool(true)},
+		{ID: Int64(2)},
+	}
+	if !reflect.DeepEqual(hooks, want) {
+		t.Errorf("Organizations.ListPreReceiveHooks returned %+v, want %+v", hooks, want)
+	}
+}
+
+func TestOrganizationsService_ListPreReceiveHooks_invalidOrg(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Organizations.ListPreReceiveHooks(context.Background(), "%", nil)
+	testURLParseError(t, err)
+}
+
+func TestOrganizationsService_GetPreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	hook, _, err := client.Organizations.GetPreReceiveHook(context.Background(), "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.GetPreReceiveHook returned error: %v", err)
+	}
+
+	want := &PreReceiveHook{ID: Int64(1)}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Organizations.GetPreReceiveHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestOrganizationsService_UpdatePreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &PreReceiveHook{Enforcement: String("testing"), AllowDownstreamConfiguration: Bool(false)}
+
+	mux.HandleFunc("/orgs/o/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(PreReceiveHook)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":1,"enforcement":"testing"}`)
+	})
+
+	hook, _, err := client.Organizations.UpdatePreReceiveHook(context.Background(), "o", 1, input)
+	if err != nil {
+		t.Errorf("Organizations.UpdatePreReceiveHook returned error: %v", err)
+	}
+
+	want := &PreReceiveHook{ID: Int64(1), Enforcement: String("testing")}
+	if !reflect.DeepEqual(hook, want) {
+		t.Errorf("Organizations.UpdatePreReceiveHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestOrganizationsService_DeletePreReceiveHook(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/pre-receive-hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testHeader(t, r, "Accept", mediaTypePreReceiveHooksPreview)
+	})
+
+	_, err := client.Organizations.DeletePreReceiveHook(context.Background(), "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.DeletePreReceiveHook returned error: %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// SSHCertificateAuthority represents an SSH certificate authority of an
+// organization or an enterprise. Members can access its repositories with
+// SSH certificates signed by it.
+type SSHCertificateAuthority struct {
+	ID          *int64     `json:"id,omitempty"`
+	Key         *string    `json:"key,omitempty"`
+	Fingerprint *string    `json:"fingerprint,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+}
+
+func (a SSHCertificateAuthority) String() string {
+	return Stringify(a)
+}
+
+// sshCertificateAuthorityRequest is the body of requests creating an SSH
+// certificate authority.
+type sshCertificateAuthorityRequest struct {
+	Key string `json:"key"`
+}
+
+// ListSSHCertificateAuthorities lists the SSH certificate authorities of an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/organizations/managing-git-access-to-your-organizations-repositories/managing-your-organizations-ssh-certificate-authorities
+func (s *OrganizationsService) ListSSHCertificateAuthorities(ctx context.Context, org string, opt *ListOptions) ([]*SSHCertificateAuthority, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/ssh-certificate-authorities", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var authorities []*SSHCertificateAuthority
+	resp, err := s.client.Do(ctx, req, &authorities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authorities, resp, nil
+}
+
+// CreateSSHCertificateAuthority adds the SSH certificate authority with the
+// given public key, such as "ssh-ed25519 AAAA...", to an organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/organizations/managing-git-access-to-your-organizations-repositories/managing-your-organizations-ssh-certificate-authorities
+func (s *OrganizationsService) CreateSSHCertificateAuthority(ctx context.Context, org, key string) (*SSHCertificateAuthority, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/ssh-certificate-authorities", org)
+	req, err := s.client.NewRequest("POST", u, &sshCertificateAuthorityRequest{Key: key})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authority := new(SSHCertificateAuthority)
+	resp, err := s.client.Do(ctx, req, authority)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return authority, resp, nil
+}
+
+// DeleteSSHCertificateAuthority deletes an SSH certificate authority of an
+// organization. Certificates it signed can no longer access the
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/organizations/managing-git-access-to-your-organizations-repositories/managing-your-organizations-ssh-certificate-authorities
+func (s *OrganizationsService) DeleteSSHCertificateAuthority(ctx context.Context, org string, id int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/ssh-certificate-authorities/%v", org, id)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
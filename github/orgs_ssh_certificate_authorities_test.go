@@ -0,0 +1,71 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationsService_ListSSHCertificateAuthorities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/ssh-certificate-authorities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `[{"id":1,"fingerprint":"SHA256:f"}]`)
+	})
+
+	authorities, _, err := client.Organizations.ListSSHCertificateAuthorities(context.Background(), "o", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Organizations.ListSSHCertificateAuthorities returned error: %v", err)
+	}
+
+	want := []*SSHCertificateAuthority{{ID: Int64(1), Fingerprint: String("SHA256:f")}}
+	if !reflect.DeepEqual(authorities, want) {
+		t.Errorf("Organizations.ListSSHCertificateAuthorities returned %+v, want %+v", authorities, want)
+	}
+}
+
+func TestOrganizationsService_CreateSSHCertificateAuthority(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/ssh-certificate-authorities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"ssh-ed25519 AAAA"}`+"\n")
+		fmt.Fprint(w, `{"id":1,"key":"ssh-ed25519 AAAA"}`)
+	})
+
+	authority, _, err := client.Organizations.CreateSSHCertificateAuthority(context.Background(), "o", "ssh-ed25519 AAAA")
+	if err != nil {
+		t.Errorf("Organizations.CreateSSHCertificateAuthority returned error: %v", err)
+	}
+
+	want := &SSHCertificateAuthority{ID: Int64(1), Key: String("ssh-ed25519 AAAA")}
+	if !reflect.DeepEqual(authority, want) {
+		t.Errorf("Organizations.CreateSSHCertificateAuthority returned %+v, want %+v", authority, want)
+	}
+}
+
+func TestOrganizationsService_DeleteSSHCertificateAuthority(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/ssh-certificate-authorities/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Organizations.DeleteSSHCertificateAuthority(context.Background(), "o", 1)
+	if err != nil {
+		t.Errorf("Organizations.DeleteSSHCertificateAuthority returned error: %v", err)
+	}
+}
@@ -171,3 +171,39 @@ func TestOrganizationsService_Edit_invalidOrg(t *testing.T) {
 	_, _, err := client.Organizations.Edit(context.Background(), "%", nil)
 	testURLParseError(t, err)
 }
+
+func TestOrganizationsService_ListInstallations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/installations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeIntegrationPreview)
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"installations":[{"id":1,"app_id":5,"target_type":"Organization","permissions":{"contents":"read","pull_requests":"write","members":"read"},"events":["push"]}]}`)
+	})
+
+	opt := &ListOptions{Page: 2}
+	installations, _, err := client.Organizations.ListInstallations(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Organizations.ListInstallations returned error: %v", err)
+	}
+
+	want := &OrganizationInstallations{
+		TotalCount: Int(1),
+		Installations: []*Installation{{
+			ID:         Int64(1),
+			AppID:      Int64(5),
+			TargetType: String("Organization"),
+			Permissions: &InstallationPermissions{
+				Contents:     String("read"),
+				PullRequests: String("write"),
+				Members:      String("read"),
+			},
+			Events: []string{"push"},
+		}},
+	}
+	if !reflect.DeepEqual(installations, want) {
+		t.Errorf("Organizations.ListInstallations returned %+v, want %+v", installations, want)
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// PackagesService handles communication with the package related
+// methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages
+type PackagesService service
+
+// Package represents a GitHub package.
+type Package struct {
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// PackageType is the type of the package.
+	// Possible values are: "npm", "maven", "rubygems", "docker", "nuget" and "container".
+	PackageType  *string     `json:"package_type,omitempty"`
+	URL          *string     `json:"url,omitempty"`
+	HTMLURL      *string     `json:"html_url,omitempty"`
+	VersionCount *int64      `json:"version_count,omitempty"`
+	Visibility   *string     `json:"visibility,omitempty"`
+	Owner        *User       `json:"owner,omitempty"`
+	Repository   *Repository `json:"repository,omitempty"`
+	CreatedAt    *Timestamp  `json:"created_at,omitempty"`
+	UpdatedAt    *Timestamp  `json:"updated_at,omitempty"`
+}
+
+func (p Package) String() string {
+	return Stringify(p)
+}
+
+// PackageContainerMetadata represents the container registry specific
+// metadata of a package version.
+type PackageContainerMetadata struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+// PackageMetadata represents the metadata of a package version.
+type PackageMetadata struct {
+	PackageType *string                   `json:"package_type,omitempty"`
+	Container   *PackageContainerMetadata `json:"container,omitempty"`
+}
+
+// PackageVersion represents a version of a GitHub package.
+type PackageVersion struct {
+	ID             *int64           `json:"id,omitempty"`
+	Name           *string          `json:"name,omitempty"`
+	URL            *string          `json:"url,omitempty"`
+	PackageHTMLURL *string          `json:"package_html_url,omitempty"`
+	HTMLURL        *string          `json:"html_url,omitempty"`
+	License        *string          `json:"license,omitempty"`
+	Description    *string          `json:"description,omitempty"`
+	Metadata       *PackageMetadata `json:"metadata,omitempty"`
+	CreatedAt      *Timestamp       `json:"created_at,omitempty"`
+	UpdatedAt      *Timestamp       `json:"updated_at,omitempty"`
+	DeletedAt      *Timestamp       `json:"deleted_at,omitempty"`
+}
+
+func (p PackageVersion) String() string {
+	return Stringify(p)
+}
+
+// PackageListOptions specifies the optional parameters to the
+// PackagesService.ListUserPackages and PackagesService.ListOrgPackages methods.
+type PackageListOptions struct {
+	// PackageType of the packages to list. (Required.)
+	// Possible values are: "npm", "maven", "rubygems", "docker", "nuget" and "container".
+	PackageType string `url:"package_type"`
+
+	// Visibility of the packages to list.
+	// Possible values are: "public", "private" and "internal".
+	Visibility string `url:"visibility,omitempty"`
+
+	ListOptions
+}
+
+// PackageVersionListOptions specifies the optional parameters to the
+// PackagesService.ListUserPackageVersions and
+// PackagesService.ListOrgPackageVersions methods.
+type PackageVersionListOptions struct {
+	// State of the package versions to list.
+	// Possible values are: "active" and "deleted". Default is "active".
+	State string `url:"state,omitempty"`
+
+	ListOptions
+}
+
+// userPackagesURL returns the packages URL of user. Passing the empty string
+// returns the packages URL of the authenticated user.
+func userPackagesURL(user string) string {
+	if user == "" {
+		return "user/packages"
+	}
+	return fmt.Sprintf("users/%v/packages", user)
+}
+
+func orgPackagesURL(org string) string {
+	return fmt.Sprintf("orgs/%v/packages", org)
+}
+
+// packageURL returns the URL of a single package under base. Package names
+// may contain slashes (e.g. container images), so they are path escaped.
+func packageURL(base, packageType, packageName string) string {
+	return fmt.Sprintf("%v/%v/%v", base, packageType, url.PathEscape(packageName))
+}
+
+func (s *PackagesService) listPackages(ctx context.Context, u string, opt *PackageListOptions) ([]*Package, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var packages []*Package
+	resp, err := s.client.Do(ctx, req, &packages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return packages, resp, nil
+}
+
+func (s *PackagesService) getPackage(ctx context.Context, u string) (*Package, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pack := new(Package)
+	resp, err := s.client.Do(ctx, req, pack)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pack, resp, nil
+}
+
+func (s *PackagesService) listPackageVersions(ctx context.Context, u string, opt *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*PackageVersion
+	resp, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+func (s *PackagesService) getPackageVersion(ctx context.Context, u string) (*PackageVersion, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := new(PackageVersion)
+	resp, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// send performs a request that has no request or response body, such as a
+// delete or restore.
+func (s *PackagesService) send(ctx context.Context, method, u string) (*Response, error) {
+	req, err := s.client.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListUserPackages lists the packages of a user. Passing the empty string
+// for user lists packages of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#list-packages-for-a-user
+func (s *PackagesService) ListUserPackages(ctx context.Context, user string, opt *PackageListOptions) ([]*Package, *Response, error) {
+	return s.listPackages(ctx, userPackagesURL(user), opt)
+}
+
+// ListOrgPackages lists the packages of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#list-packages-for-an-organization
+func (s *PackagesService) ListOrgPackages(ctx context.Context, org string, opt *PackageListOptions) ([]*Package, *Response, error) {
+	return s.listPackages(ctx, orgPackagesURL(org), opt)
+}
+
+// GetUserPackage gets a package of a user. Passing the empty string for user
+// gets a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-for-a-user
+func (s *PackagesService) GetUserPackage(ctx context.Context, user, packageType, packageName string) (*Package, *Response, error) {
+	return s.getPackage(ctx, packageURL(userPackagesURL(user), packageType, packageName))
+}
+
+// GetOrgPackage gets a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-for-an-organization
+func (s *PackagesService) GetOrgPackage(ctx context.Context, org, packageType, packageName string) (*Package, *Response, error) {
+	return s.getPackage(ctx, packageURL(orgPackagesURL(org), packageType, packageName))
+}
+
+// DeleteUserPackage deletes a package of a user. Passing the empty string
+// for user deletes a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-a-package-for-a-user
+func (s *PackagesService) DeleteUserPackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	return s.send(ctx, "DELETE", packageURL(userPackagesURL(user), packageType, packageName))
+}
+
+// DeleteOrgPackage deletes a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-a-package-for-an-organization
+func (s *PackagesService) DeleteOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	return s.send(ctx, "DELETE", packageURL(orgPackagesURL(org), packageType, packageName))
+}
+
+// RestoreUserPackage restores a package of a user deleted within the last
+// 30 days. Passing the empty string for user restores a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#restore-a-package-for-a-user
+func (s *PackagesService) RestoreUserPackage(ctx context.Context, user, packageType, packageName string) (*Response, error) {
+	return s.send(ctx, "POST", packageURL(userPackagesURL(user), packageType, packageName)+"/restore")
+}
+
+// RestoreOrgPackage restores a package of an organization deleted within the
+// last 30 days.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#restore-a-package-for-an-organization
+func (s *PackagesService) RestoreOrgPackage(ctx context.Context, org, packageType, packageName string) (*Response, error) {
+	return s.send(ctx, "POST", packageURL(orgPackagesURL(org), packageType, packageName)+"/restore")
+}
+
+// ListUserPackageVersions lists the versions of a package of a user. Passing
+// the empty string for user lists versions of a package of the authenticated
+// user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#list-package-versions-for-a-package-owned-by-a-user
+func (s *PackagesService) ListUserPackageVersions(ctx context.Context, user, packageType, packageName string, opt *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	u := packageURL(userPackagesURL(user), packageType, packageName) + "/versions"
+	return s.listPackageVersions(ctx, u, opt)
+}
+
+// ListOrgPackageVersions lists the versions of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#list-package-versions-for-a-package-owned-by-an-organization
+func (s *PackagesService) ListOrgPackageVersions(ctx context.Context, org, packageType, packageName string, opt *PackageVersionListOptions) ([]*PackageVersion, *Response, error) {
+	u := packageURL(orgPackagesURL(org), packageType, packageName) + "/versions"
+	return s.listPackageVersions(ctx, u, opt)
+}
+
+// GetUserPackageVersion gets a version of a package of a user. Passing the
+// empty string for user gets a version of a package of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-version-for-a-user
+func (s *PackagesService) GetUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error) {
+	u := fmt.Sprintf("%v/versions/%v", packageURL(userPackagesURL(user), packageType, packageName), versionID)
+	return s.getPackageVersion(ctx, u)
+}
+
+// GetOrgPackageVersion gets a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#get-a-package-version-for-an-organization
+func (s *PackagesService) GetOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*PackageVersion, *Response, error) {
+	u := fmt.Sprintf("%v/versions/%v", packageURL(orgPackagesURL(org), packageType, packageName), versionID)
+	return s.getPackageVersion(ctx, u)
+}
+
+// DeleteUserPackageVersion deletes a version of a package of a user. Passing
+// the empty string for user deletes a version of a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-package-version-for-a-user
+func (s *PackagesService) DeleteUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error) {
+	u := fmt.Sprintf("%v/versions/%v", packageURL(userPackagesURL(user), packageType, packageName), versionID)
+	return s.send(ctx, "DELETE", u)
+}
+
+// DeleteOrgPackageVersion deletes a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#delete-package-version-for-an-organization
+func (s *PackagesService) DeleteOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error) {
+	u := fmt.Sprintf("%v/versions/%v", packageURL(orgPackagesURL(org), packageType, packageName), versionID)
+	return s.send(ctx, "DELETE", u)
+}
+
+// RestoreUserPackageVersion restores a version of a package of a user.
+// Passing the empty string for user restores a version of a package of the
+// authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#restore-package-version-for-a-user
+func (s *PackagesService) RestoreUserPackageVersion(ctx context.Context, user, packageType, packageName string, versionID int64) (*Response, error) {
+	u := fmt.Sprintf("%v/versions/%v/restore", packageURL(userPackagesURL(user), packageType, packageName), versionID)
+	return s.send(ctx, "POST", u)
+}
+
+// RestoreOrgPackageVersion restores a version of a package of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/packages#restore-package-version-for-an-organization
+func (s *PackagesService) RestoreOrgPackageVersion(ctx context.Context, org, packageType, packageName string, versionID int64) (*Response, error) {
+	u := fmt.Sprintf("%v/versions/%v/restore", packageURL(orgPackagesURL(org), packageType, packageName), versionID)
+	return s.send(ctx, "POST", u)
+}
@@ -0,0 +1,294 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPackagesService_ListUserPackages_authenticatedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "container", "visibility": "private", "page": "2"})
+		fmt.Fprint(w, `[{"id":197,"name":"hello_docker","package_type":"container","version_count":1,"visibility":"private"}]`)
+	})
+
+	opt := &PackageListOptions{PackageType: "container", Visibility: "private", ListOptions: ListOptions{Page: 2}}
+	packages, _, err := client.Packages.ListUserPackages(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Packages.ListUserPackages returned error: %v", err)
+	}
+
+	want := []*Package{{
+		ID:           Int64(197),
+		Name:         String("hello_docker"),
+		PackageType:  String("container"),
+		VersionCount: Int64(1),
+		Visibility:   String("private"),
+	}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListUserPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_ListUserPackages_specifiedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "npm"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	packages, _, err := client.Packages.ListUserPackages(context.Background(), "u", &PackageListOptions{PackageType: "npm"})
+	if err != nil {
+		t.Errorf("Packages.ListUserPackages returned error: %v", err)
+	}
+
+	want := []*Package{{ID: Int64(1)}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListUserPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_ListOrgPackages(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"package_type": "maven"})
+		fmt.Fprint(w, `[{"id":1,"owner":{"login":"o"},"repository":{"id":2}}]`)
+	})
+
+	packages, _, err := client.Packages.ListOrgPackages(context.Background(), "o", &PackageListOptions{PackageType: "maven"})
+	if err != nil {
+		t.Errorf("Packages.ListOrgPackages returned error: %v", err)
+	}
+
+	want := []*Package{{ID: Int64(1), Owner: &User{Login: String("o")}, Repository: &Repository{ID: Int64(2)}}}
+	if !reflect.DeepEqual(packages, want) {
+		t.Errorf("Packages.ListOrgPackages returned %+v, want %+v", packages, want)
+	}
+}
+
+func TestPackagesService_GetUserPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/container/hello_docker", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":197,"name":"hello_docker","created_at":"2020-05-19T22:19:11Z"}`)
+	})
+
+	pack, _, err := client.Packages.GetUserPackage(context.Background(), "u", "container", "hello_docker")
+	if err != nil {
+		t.Errorf("Packages.GetUserPackage returned error: %v", err)
+	}
+
+	want := &Package{
+		ID:        Int64(197),
+		Name:      String("hello_docker"),
+		CreatedAt: &Timestamp{time.Date(2020, time.May, 19, 22, 19, 11, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Packages.GetUserPackage returned %+v, want %+v", pack, want)
+	}
+}
+
+func TestPackagesService_GetOrgPackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	pack, _, err := client.Packages.GetOrgPackage(context.Background(), "o", "npm", "p")
+	if err != nil {
+		t.Errorf("Packages.GetOrgPackage returned error: %v", err)
+	}
+
+	want := &Package{ID: Int64(1)}
+	if !reflect.DeepEqual(pack, want) {
+		t.Errorf("Packages.GetOrgPackage returned %+v, want %+v", pack, want)
+	}
+}
+
+func TestPackageURL_escapesName(t *testing.T) {
+	if got, want := packageURL("orgs/o/packages", "container", "a/b"), "orgs/o/packages/container/a%2Fb"; got != want {
+		t.Errorf("packageURL returned %q, want %q", got, want)
+	}
+}
+
+func TestPackagesService_DeleteAndRestorePackage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/npm/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/user/packages/npm/p/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/packages/npm/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/packages/npm/p/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Packages.DeleteUserPackage(ctx, "", "npm", "p"); err != nil {
+		t.Errorf("Packages.DeleteUserPackage returned error: %v", err)
+	}
+	if _, err := client.Packages.RestoreUserPackage(ctx, "", "npm", "p"); err != nil {
+		t.Errorf("Packages.RestoreUserPackage returned error: %v", err)
+	}
+	if _, err := client.Packages.DeleteOrgPackage(ctx, "o", "npm", "p"); err != nil {
+		t.Errorf("Packages.DeleteOrgPackage returned error: %v", err)
+	}
+	if _, err := client.Packages.RestoreOrgPackage(ctx, "o", "npm", "p"); err != nil {
+		t.Errorf("Packages.RestoreOrgPackage returned error: %v", err)
+	}
+}
+
+func TestPackagesService_ListUserPackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/container/hello_docker/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "deleted", "per_page": "2"})
+		fmt.Fprint(w, `[{
+			"id": 45763,
+			"name": "sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9",
+			"metadata": {
+				"package_type": "container",
+				"container": {"tags": ["latest"]}
+			}
+		}]`)
+	})
+
+	opt := &PackageVersionListOptions{State: "deleted", ListOptions: ListOptions{PerPage: 2}}
+	versions, _, err := client.Packages.ListUserPackageVersions(context.Background(), "u", "container", "hello_docker", opt)
+	if err != nil {
+		t.Errorf("Packages.ListUserPackageVersions returned error: %v", err)
+	}
+
+	want := []*PackageVersion{{
+		ID:   Int64(45763),
+		Name: String("sha256:08a44bab0bddaddd8837a8b381aebc2e4b933768b981685a9e088360af0d3dd9"),
+		Metadata: &PackageMetadata{
+			PackageType: String("container"),
+			Container:   &PackageContainerMetadata{Tags: []string{"latest"}},
+		},
+	}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Packages.ListUserPackageVersions returned %+v, want %+v", versions, want)
+	}
+}
+
+func TestPackagesService_ListOrgPackageVersions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/packages/npm/p/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	versions, _, err := client.Packages.ListOrgPackageVersions(context.Background(), "o", "npm", "p", nil)
+	if err != nil {
+		t.Errorf("Packages.ListOrgPackageVersions returned error: %v", err)
+	}
+
+	want := []*PackageVersion{{ID: Int64(1)}}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("Packages.ListOrgPackageVersions returned %+v, want %+v", versions, want)
+	}
+}
+
+func TestPackagesService_GetPackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/packages/npm/p/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"license":"MIT"}`)
+	})
+	mux.HandleFunc("/orgs/o/packages/npm/p/versions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2}`)
+	})
+
+	ctx := context.Background()
+	version, _, err := client.Packages.GetUserPackageVersion(ctx, "", "npm", "p", 1)
+	if err != nil {
+		t.Errorf("Packages.GetUserPackageVersion returned error: %v", err)
+	}
+	if want := (&PackageVersion{ID: Int64(1), License: String("MIT")}); !reflect.DeepEqual(version, want) {
+		t.Errorf("Packages.GetUserPackageVersion returned %+v, want %+v", version, want)
+	}
+
+	version, _, err = client.Packages.GetOrgPackageVersion(ctx, "o", "npm", "p", 2)
+	if err != nil {
+		t.Errorf("Packages.GetOrgPackageVersion returned error: %v", err)
+	}
+	if want := (&PackageVersion{ID: Int64(2)}); !reflect.DeepEqual(version, want) {
+		t.Errorf("Packages.GetOrgPackageVersion returned %+v, want %+v", version, want)
+	}
+}
+
+func TestPackagesService_DeleteAndRestorePackageVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/u/packages/npm/p/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/users/u/packages/npm/p/versions/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/packages/npm/p/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/orgs/o/packages/npm/p/versions/1/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	if _, err := client.Packages.DeleteUserPackageVersion(ctx, "u", "npm", "p", 1); err != nil {
+		t.Errorf("Packages.DeleteUserPackageVersion returned error: %v", err)
+	}
+	if _, err := client.Packages.RestoreUserPackageVersion(ctx, "u", "npm", "p", 1); err != nil {
+		t.Errorf("Packages.RestoreUserPackageVersion returned error: %v", err)
+	}
+	if _, err := client.Packages.DeleteOrgPackageVersion(ctx, "o", "npm", "p", 1); err != nil {
+		t.Errorf("Packages.DeleteOrgPackageVersion returned error: %v", err)
+	}
+	if _, err := client.Packages.RestoreOrgPackageVersion(ctx, "o", "npm", "p", 1); err != nil {
+		t.Errorf("Packages.RestoreOrgPackageVersion returned error: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// Paginate calls list for every page of a paginated result set, starting at
+// the page of opt, which must be the ListOptions that list passes to the
+// List method. list typically appends the results of the page to a slice:
+//
+//	opt := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: 100}}
+//	var repos []*github.Repository
+//	err := github.Paginate(ctx, &opt.ListOptions, 0, func() (*github.Response, error) {
+//		page, resp, err := client.Repositories.ListByOrg(ctx, "github", opt)
+//		repos = append(repos, page...)
+//		return resp, err
+//	})
+//
+// maxPages caps the number of pages fetched; zero means no cap. A page that
+// hits a rate limit is fetched again once the limit resets. opt.Page is left
+// at the last page fetched.
+func Paginate(ctx context.Context, opt *ListOptions, maxPages int, list func() (*Response, error)) error {
+	for pages := 1; ; pages++ {
+		var resp *Response
+		err := retryOnRateLimit(ctx, func() (err error) {
+			resp, err = list()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if resp.NextPage == 0 || pages == maxPages {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// PaginateCursor is like Paginate, for the List methods that support cursor
+// pagination. opt.After is left at the cursor of the last page fetched.
+func PaginateCursor(ctx context.Context, opt *ListCursorOptions, maxPages int, list func() (*Response, error)) error {
+	for pages := 1; ; pages++ {
+		var resp *Response
+		err := retryOnRateLimit(ctx, func() (err error) {
+			resp, err = list()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if resp.After == "" || pages == maxPages {
+			return nil
+		}
+		opt.After = resp.After
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	limited := false
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		page, _ := strconv.Atoi(r.FormValue("page"))
+		if page == 2 && !limited {
+			// A rate limit that has already reset is retried right away.
+			limited = true
+			w.Header().Set(headerRateLimit, "60")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, "1372700873")
+			http.Error(w, `{"message":"API rate limit exceeded for 127.0.0.1."}`, http.StatusForbidden)
+			return
+		}
+		if page < 3 {
+			w.Header().Set("Link", fmt.Sprintf(`<https://api.github.com/orgs/o/repos?page=%v>; rel="next"`, page+1))
+		}
+		fmt.Fprintf(w, `[{"id":%v}]`, page)
+	})
+
+	for _, tt := range []struct {
+		maxPages int
+		want     []int64
+	}{
+		{0, []int64{1, 2, 3}},
+		{2, []int64{1, 2}},
+	} {
+		limited = false
+		opt := &RepositoryListByOrgOptions{ListOptions: ListOptions{Page: 1}}
+		var ids []int64
+		err := Paginate(context.Background(), &opt.ListOptions, tt.maxPages, func() (*Response, error) {
+			repos, resp, err := client.Repositories.ListByOrg(context.Background(), "o", opt)
+			for _, r := range repos {
+				ids = append(ids, r.GetID())
+			}
+			return resp, err
+		})
+		if err != nil {
+			t.Fatalf("Paginate returned error: %v", err)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("Paginate with maxPages %v returned %v, want %v", tt.maxPages, ids, tt.want)
+		}
+		if want := len(tt.want); opt.Page != want {
+			t.Errorf("Paginate left opt.Page at %v, want %v", opt.Page, want)
+		}
+	}
+}
+
+func TestPaginate_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	opt := &RepositoryListByOrgOptions{}
+	err := Paginate(context.Background(), &opt.ListOptions, 0, func() (*Response, error) {
+		_, resp, err := client.Repositories.ListByOrg(context.Background(), "o", opt)
+		return resp, err
+	})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Paginate returned %v, want *ErrorResponse", err)
+	}
+}
+
+func TestPaginateCursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("after") == "" {
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/hooks/1/deliveries?after=c2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1}]`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":2}]`)
+	})
+
+	opt := &ListCursorOptions{}
+	pages := 0
+	err := PaginateCursor(context.Background(), opt, 0, func() (*Response, error) {
+		pages++
+		req, _ := client.NewRequest("GET", "repos/o/r/hooks/1/deliveries?after="+opt.After, nil)
+		return client.Do(context.Background(), req, nil)
+	})
+	if err != nil {
+		t.Fatalf("PaginateCursor returned error: %v", err)
+	}
+	if pages != 2 || opt.After != "c2" {
+		t.Errorf("PaginateCursor fetched %v pages and left opt.After at %q, want 2 and %q", pages, opt.After, "c2")
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// ProjectV2 represents a project (beta), the successor of classic
+// projects, which is only available through the GraphQL API.
+type ProjectV2 struct {
+	ID               *string    `json:"id,omitempty"` // GraphQL node ID.
+	Number           *int       `json:"number,omitempty"`
+	Title            *string    `json:"title,omitempty"`
+	ShortDescription *string    `json:"shortDescription,omitempty"`
+	URL              *string    `json:"url,omitempty"`
+	Closed           *bool      `json:"closed,omitempty"`
+	Public           *bool      `json:"public,omitempty"`
+	CreatedAt        *Timestamp `json:"createdAt,omitempty"`
+	UpdatedAt        *Timestamp `json:"updatedAt,omitempty"`
+}
+
+func (p ProjectV2) String() string {
+	return Stringify(p)
+}
+
+// ProjectV2Connection represents a page of projects.
+type ProjectV2Connection struct {
+	TotalCount *int         `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo    `json:"pageInfo,omitempty"`
+	Nodes      []*ProjectV2 `json:"nodes"`
+}
+
+// ProjectV2ItemContent represents the issue, pull request or draft issue
+// that a project item refers to.
+type ProjectV2ItemContent struct {
+	// Typename is one of "Issue", "PullRequest" or "DraftIssue".
+	Typename *string `json:"__typename,omitempty"`
+	ID       *string `json:"id,omitempty"`
+	Title    *string `json:"title,omitempty"`
+	Body     *string `json:"body,omitempty"`
+	Number   *int    `json:"number,omitempty"` // Not set for draft issues.
+	URL      *string `json:"url,omitempty"`    // Not set for draft issues.
+}
+
+// ProjectV2Item represents an item of a project.
+type ProjectV2Item struct {
+	ID         *string               `json:"id,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+	IsArchived *bool                 `json:"isArchived,omitempty"`
+	CreatedAt  *Timestamp            `json:"createdAt,omitempty"`
+	UpdatedAt  *Timestamp            `json:"updatedAt,omitempty"`
+	Content    *ProjectV2ItemContent `json:"content,omitempty"`
+}
+
+func (p ProjectV2Item) String() string {
+	return Stringify(p)
+}
+
+// ProjectV2ItemConnection represents a page of project items.
+type ProjectV2ItemConnection struct {
+	TotalCount *int             `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo        `json:"pageInfo,omitempty"`
+	Nodes      []*ProjectV2Item `json:"nodes"`
+}
+
+// ProjectV2SingleSelectOption represents an option of a single select field.
+type ProjectV2SingleSelectOption struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+// ProjectV2Iteration represents an iteration of an iteration field.
+type ProjectV2Iteration struct {
+	ID        *string `json:"id,omitempty"`
+	Title     *string `json:"title,omitempty"`
+	StartDate *string `json:"startDate,omitempty"` // In YYYY-MM-DD format.
+	Duration  *int    `json:"duration,omitempty"`  // In days.
+}
+
+// ProjectV2Field represents a field of a project. Options is only set for
+// single select fields and Configuration only for iteration fields.
+type ProjectV2Field struct {
+	ID       *string                        `json:"id,omitempty"`
+	Name     *string                        `json:"name,omitempty"`
+	DataType *string                        `json:"dataType,omitempty"`
+	Options  []*ProjectV2SingleSelectOption `json:"options,omitempty"`
+
+	Configuration *struct {
+		Iterations []*ProjectV2Iteration `json:"iterations,omitempty"`
+	} `json:"configuration,omitempty"`
+}
+
+func (p ProjectV2Field) String() string {
+	return Stringify(p)
+}
+
+// ProjectV2FieldConnection represents a page of project fields.
+type ProjectV2FieldConnection struct {
+	TotalCount *int              `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo         `json:"pageInfo,omitempty"`
+	Nodes      []*ProjectV2Field `json:"nodes"`
+}
+
+// ProjectV2FieldValue represents the new value of a project item field.
+// Exactly one of its members must be set, matching the data type of the
+// field being updated.
+type ProjectV2FieldValue struct {
+	Text                 *string  `json:"text,omitempty"`
+	Number               *float64 `json:"number,omitempty"`
+	Date                 *string  `json:"date,omitempty"` // In YYYY-MM-DD format.
+	SingleSelectOptionID *string  `json:"singleSelectOptionId,omitempty"`
+	IterationID          *string  `json:"iterationId,omitempty"`
+}
+
+const projectV2Fields = `id number title shortDescription url closed public createdAt updatedAt`
+
+const projectV2ItemFields = `id type isArchived createdAt updatedAt
+content {
+	__typename
+	... on Issue { id title body number url }
+	... on PullRequest { id title body number url }
+	... on DraftIssue { id title body }
+}`
+
+// ListOrgProjectsV2 lists the projects (beta) of an organization.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#organization
+func (s *ProjectsService) ListOrgProjectsV2(ctx context.Context, org string, opt *GraphQLListOptions) (*ProjectV2Connection, *Response, error) {
+	query := `query($login: String!, $first: Int!, $after: String) {
+	organization(login: $login) {
+		projectsV2(first: $first, after: $after) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes { ` + projectV2Fields + ` }
+		}
+	}
+}`
+	var result struct {
+		Organization *struct {
+			ProjectsV2 *ProjectV2Connection `json:"projectsV2"`
+		} `json:"organization"`
+	}
+	vars := map[string]interface{}{"login": org, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Organization == nil {
+		return nil, resp, errors.New("github: organization not found")
+	}
+
+	return result.Organization.ProjectsV2, resp, nil
+}
+
+// ListUserProjectsV2 lists the projects (beta) of a user.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#user
+func (s *ProjectsService) ListUserProjectsV2(ctx context.Context, user string, opt *GraphQLListOptions) (*ProjectV2Connection, *Response, error) {
+	query := `query($login: String!, $first: Int!, $after: String) {
+	user(login: $login) {
+		projectsV2(first: $first, after: $after) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes { ` + projectV2Fields + ` }
+		}
+	}
+}`
+	var result struct {
+		User *struct {
+			ProjectsV2 *ProjectV2Connection `json:"projectsV2"`
+		} `json:"user"`
+	}
+	vars := map[string]interface{}{"login": user, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.User == nil {
+		return nil, resp, errors.New("github: user not found")
+	}
+
+	return result.User.ProjectsV2, resp, nil
+}
+
+// ListProjectV2Items lists the items of a project, identified by its node ID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#projectv2
+func (s *ProjectsService) ListProjectV2Items(ctx context.Context, projectID string, opt *GraphQLListOptions) (*ProjectV2ItemConnection, *Response, error) {
+	query := `query($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		... on ProjectV2 {
+			items(first: $first, after: $after) {
+				totalCount
+				pageInfo { hasNextPage endCursor }
+				nodes { ` + projectV2ItemFields + ` }
+			}
+		}
+	}
+}`
+	var result struct {
+		Node *struct {
+			Items *ProjectV2ItemConnection `json:"items"`
+		} `json:"node"`
+	}
+	vars := map[string]interface{}{"id": projectID, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.Items == nil {
+		return nil, resp, errors.New("github: project not found")
+	}
+
+	return result.Node.Items, resp, nil
+}
+
+// ListProjectV2Fields lists the fields of a project, including the options
+// of single select fields and the iterations of iteration fields, whose IDs
+// are needed by UpdateProjectV2ItemFieldValue.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#projectv2
+func (s *ProjectsService) ListProjectV2Fields(ctx context.Context, projectID string, opt *GraphQLListOptions) (*ProjectV2FieldConnection, *Response, error) {
+	query := `query($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		... on ProjectV2 {
+			fields(first: $first, after: $after) {
+				totalCount
+				pageInfo { hasNextPage endCursor }
+				nodes {
+					... on ProjectV2FieldCommon { id name dataType }
+					... on ProjectV2SingleSelectField { options { id name } }
+					... on ProjectV2IterationField { configuration { iterations { id title startDate duration } } }
+				}
+			}
+		}
+	}
+}`
+	var result struct {
+		Node *struct {
+			Fields *ProjectV2FieldConnection `json:"fields"`
+		} `json:"node"`
+	}
+	vars := map[string]interface{}{"id": projectID, "first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.Fields == nil {
+		return nil, resp, errors.New("github: project not found")
+	}
+
+	return result.Node.Fields, resp, nil
+}
+
+// AddProjectV2Item adds an existing issue or pull request, identified by
+// its node ID, to a project.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#addprojectv2itembyid
+func (s *ProjectsService) AddProjectV2Item(ctx context.Context, projectID, contentID string) (*ProjectV2Item, *Response, error) {
+	query := `mutation($projectId: ID!, $contentId: ID!) {
+	addProjectV2ItemById(input: {projectId: $projectId, contentId: $contentId}) {
+		item { ` + projectV2ItemFields + ` }
+	}
+}`
+	var result struct {
+		AddProjectV2ItemByID struct {
+			Item *ProjectV2Item `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+	vars := map[string]interface{}{"projectId": projectID, "contentId": contentID}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AddProjectV2ItemByID.Item, resp, nil
+}
+
+// AddProjectV2DraftIssue creates a draft issue in a project.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#addprojectv2draftissue
+func (s *ProjectsService) AddProjectV2DraftIssue(ctx context.Context, projectID, title, body string) (*ProjectV2Item, *Response, error) {
+	query := `mutation($projectId: ID!, $title: String!, $body: String) {
+	addProjectV2DraftIssue(input: {projectId: $projectId, title: $title, body: $body}) {
+		projectItem { ` + projectV2ItemFields + ` }
+	}
+}`
+	var result struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem *ProjectV2Item `json:"projectItem"`
+		} `json:"addProjectV2DraftIssue"`
+	}
+	vars := map[string]interface{}{"projectId": projectID, "title": title}
+	if body != "" {
+		vars["body"] = body
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.AddProjectV2DraftIssue.ProjectItem, resp, nil
+}
+
+// DeleteProjectV2Item removes an item from a project.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#deleteprojectv2item
+func (s *ProjectsService) DeleteProjectV2Item(ctx context.Context, projectID, itemID string) (*Response, error) {
+	query := `mutation($projectId: ID!, $itemId: ID!) {
+	deleteProjectV2Item(input: {projectId: $projectId, itemId: $itemId}) { deletedItemId }
+}`
+	vars := map[string]interface{}{"projectId": projectID, "itemId": itemID}
+	return s.client.graphQL(ctx, query, vars, nil)
+}
+
+// UpdateProjectV2ItemFieldValue sets the value of a text, number, date,
+// single select or iteration field of a project item.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#updateprojectv2itemfieldvalue
+func (s *ProjectsService) UpdateProjectV2ItemFieldValue(ctx context.Context, projectID, itemID, fieldID string, value *ProjectV2FieldValue) (*ProjectV2Item, *Response, error) {
+	query := `mutation($projectId: ID!, $itemId: ID!, $fieldId: ID!, $value: ProjectV2FieldValue!) {
+	updateProjectV2ItemFieldValue(input: {projectId: $projectId, itemId: $itemId, fieldId: $fieldId, value: $value}) {
+		projectV2Item { ` + projectV2ItemFields + ` }
+	}
+}`
+	var result struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item *ProjectV2Item `json:"projectV2Item"`
+		} `json:"updateProjectV2ItemFieldValue"`
+	}
+	vars := map[string]interface{}{"projectId": projectID, "itemId": itemID, "fieldId": fieldID, "value": value}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.UpdateProjectV2ItemFieldValue.ProjectV2Item, resp, nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProjectsService_ListOrgProjectsV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "organization(login: $login)", map[string]interface{}{"login": "o", "first": 10.0, "after": "c1"})
+		fmt.Fprint(w, `{"data":{"organization":{"projectsV2":{
+			"totalCount": 1,
+			"pageInfo": {"hasNextPage": false, "endCursor": "c2"},
+			"nodes": [{"id":"PVT_1","number":1,"title":"Roadmap","closed":false,"public":true,"createdAt":"2022-06-01T10:00:00Z"}]
+		}}}}`)
+	})
+
+	projects, _, err := client.Projects.ListOrgProjectsV2(context.Background(), "o", &GraphQLListOptions{First: 10, After: "c1"})
+	if err != nil {
+		t.Errorf("Projects.ListOrgProjectsV2 returned error: %v", err)
+	}
+
+	want := &ProjectV2Connection{
+		TotalCount: Int(1),
+		PageInfo:   &PageInfo{HasNextPage: false, EndCursor: String("c2")},
+		Nodes: []*ProjectV2{{
+			ID:        String("PVT_1"),
+			Number:    Int(1),
+			Title:     String("Roadmap"),
+			Closed:    Bool(false),
+			Public:    Bool(true),
+			CreatedAt: &Timestamp{time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)},
+		}},
+	}
+	if !reflect.DeepEqual(projects, want) {
+		t.Errorf("Projects.ListOrgProjectsV2 returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestProjectsService_ListOrgProjectsV2_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"organization":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to an Organization with the login of 'o'."}]}`)
+	})
+
+	_, _, err := client.Projects.ListOrgProjectsV2(context.Background(), "o", nil)
+	if _, ok := err.(*GraphQLErrorResponse); !ok {
+		t.Errorf("Projects.ListOrgProjectsV2 returned error %v, want *GraphQLErrorResponse", err)
+	}
+}
+
+func TestProjectsService_ListUserProjectsV2(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "user(login: $login)", map[string]interface{}{"login": "u", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"user":{"projectsV2":{"totalCount":0,"pageInfo":{"hasNextPage":false},"nodes":[]}}}}`)
+	})
+
+	projects, _, err := client.Projects.ListUserProjectsV2(context.Background(), "u", nil)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjectsV2 returned error: %v", err)
+	}
+
+	want := &ProjectV2Connection{TotalCount: Int(0), PageInfo: &PageInfo{}, Nodes: []*ProjectV2{}}
+	if !reflect.DeepEqual(projects, want) {
+		t.Errorf("Projects.ListUserProjectsV2 returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestProjectsService_ListProjectV2Items(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "items(first: $first, after: $after)", map[string]interface{}{"id": "PVT_1", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"node":{"items":{
+			"totalCount": 2,
+			"pageInfo": {"hasNextPage": false},
+			"nodes": [
+				{"id":"PVTI_1","type":"ISSUE","isArchived":false,"content":{"__typename":"Issue","id":"I_1","title":"Bug","number":7,"url":"https://github.com/o/r/issues/7"}},
+				{"id":"PVTI_2","type":"DRAFT_ISSUE","isArchived":false,"content":{"__typename":"DraftIssue","id":"DI_1","title":"Idea","body":"b"}}
+			]
+		}}}}`)
+	})
+
+	items, _, err := client.Projects.ListProjectV2Items(context.Background(), "PVT_1", nil)
+	if err != nil {
+		t.Errorf("Projects.ListProjectV2Items returned error: %v", err)
+	}
+
+	want := &ProjectV2ItemConnection{
+		TotalCount: Int(2),
+		PageInfo:   &PageInfo{},
+		Nodes: []*ProjectV2Item{
+			{
+				ID:         String("PVTI_1"),
+				Type:       String("ISSUE"),
+				IsArchived: Bool(false),
+				Content: &ProjectV2ItemContent{
+					Typename: String("Issue"),
+					ID:       String("I_1"),
+					Title:    String("Bug"),
+					Number:   Int(7),
+					URL:      String("https://github.com/o/r/issues/7"),
+				},
+			},
+			{
+				ID:         String("PVTI_2"),
+				Type:       String("DRAFT_ISSUE"),
+				IsArchived: Bool(false),
+				Content: &ProjectV2ItemContent{
+					Typename: String("DraftIssue"),
+					ID:       String("DI_1"),
+					Title:    String("Idea"),
+					Body:     String("b"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("Projects.ListProjectV2Items returned %+v, want %+v", items, want)
+	}
+}
+
+func TestProjectsService_ListProjectV2Items_notProject(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"node":{}}}`)
+	})
+
+	if _, _, err := client.Projects.ListProjectV2Items(context.Background(), "I_1", nil); err == nil {
+		t.Error("Projects.ListProjectV2Items returned no error for a node that is not a project")
+	}
+}
+
+func TestProjectsService_ListProjectV2Fields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "fields(first: $first, after: $after)", map[string]interface{}{"id": "PVT_1", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"node":{"fields":{"nodes":[
+			{"id":"F_1","name":"Status","dataType":"SINGLE_SELECT","options":[{"id":"o1","name":"Todo"}]},
+			{"id":"F_2","name":"Sprint","dataType":"ITERATION","configuration":{"iterations":[{"id":"it1","title":"Sprint 1","startDate":"2022-06-01","duration":14}]}}
+		]}}}}`)
+	})
+
+	fields, _, err := client.Projects.ListProjectV2Fields(context.Background(), "PVT_1", nil)
+	if err != nil {
+		t.Fatalf("Projects.ListProjectV2Fields returned error: %v", err)
+	}
+
+	if len(fields.Nodes) != 2 {
+		t.Fatalf("Projects.ListProjectV2Fields returned %v fields, want 2", len(fields.Nodes))
+	}
+	wantOptions := []*ProjectV2SingleSelectOption{{ID: String("o1"), Name: String("Todo")}}
+	if !reflect.DeepEqual(fields.Nodes[0].Options, wantOptions) {
+		t.Errorf("Projects.ListProjectV2Fields options = %+v, want %+v", fields.Nodes[0].Options, wantOptions)
+	}
+	wantIterations := []*ProjectV2Iteration{{ID: String("it1"), Title: String("Sprint 1"), StartDate: String("2022-06-01"), Duration: Int(14)}}
+	if !reflect.DeepEqual(fields.Nodes[1].Configuration.Iterations, wantIterations) {
+		t.Errorf("Projects.ListProjectV2Fields iterations = %+v, want %+v", fields.Nodes[1].Configuration.Iterations, wantIterations)
+	}
+}
+
+func TestProjectsService_AddProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "addProjectV2ItemById", map[string]interface{}{"projectId": "PVT_1", "contentId": "I_1"})
+		fmt.Fprint(w, `{"data":{"addProjectV2ItemById":{"item":{"id":"PVTI_1","type":"ISSUE"}}}}`)
+	})
+
+	item, _, err := client.Projects.AddProjectV2Item(context.Background(), "PVT_1", "I_1")
+	if err != nil {
+		t.Errorf("Projects.AddProjectV2Item returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: String("PVTI_1"), Type: String("ISSUE")}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("Projects.AddProjectV2Item returned %+v, want %+v", item, want)
+	}
+}
+
+func TestProjectsService_AddProjectV2DraftIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "addProjectV2DraftIssue", map[string]interface{}{"projectId": "PVT_1", "title": "t", "body": "b"})
+		fmt.Fprint(w, `{"data":{"addProjectV2DraftIssue":{"projectItem":{"id":"PVTI_3","type":"DRAFT_ISSUE"}}}}`)
+	})
+
+	item, _, err := client.Projects.AddProjectV2DraftIssue(context.Background(), "PVT_1", "t", "b")
+	if err != nil {
+		t.Errorf("Projects.AddProjectV2DraftIssue returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: String("PVTI_3"), Type: String("DRAFT_ISSUE")}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("Projects.AddProjectV2DraftIssue returned %+v, want %+v", item, want)
+	}
+}
+
+func TestProjectsService_DeleteProjectV2Item(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "deleteProjectV2Item", map[string]interface{}{"projectId": "PVT_1", "itemId": "PVTI_1"})
+		fmt.Fprint(w, `{"data":{"deleteProjectV2Item":{"deletedItemId":"PVTI_1"}}}`)
+	})
+
+	if _, err := client.Projects.DeleteProjectV2Item(context.Background(), "PVT_1", "PVTI_1"); err != nil {
+		t.Errorf("Projects.DeleteProjectV2Item returned error: %v", err)
+	}
+}
+
+func TestProjectsService_UpdateProjectV2ItemFieldValue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "updateProjectV2ItemFieldValue", map[string]interface{}{
+			"projectId": "PVT_1",
+			"itemId":    "PVTI_1",
+			"fieldId":   "F_1",
+			"value":     map[string]interface{}{"singleSelectOptionId": "o1"},
+		})
+		fmt.Fprint(w, `{"data":{"updateProjectV2ItemFieldValue":{"projectV2Item":{"id":"PVTI_1"}}}}`)
+	})
+
+	value := &ProjectV2FieldValue{SingleSelectOptionID: String("o1")}
+	item, _, err := client.Projects.UpdateProjectV2ItemFieldValue(context.Background(), "PVT_1", "PVTI_1", "F_1", value)
+	if err != nil {
+		t.Errorf("Projects.UpdateProjectV2ItemFieldValue returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: String("PVTI_1")}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("Projects.UpdateProjectV2ItemFieldValue returned %+v, want %+v", item, want)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// MergeQueuePullRequest represents the pull request of a merge queue entry.
+type MergeQueuePullRequest struct {
+	ID     *string `json:"id,omitempty"` // GraphQL node ID.
+	Number *int    `json:"number,omitempty"`
+	Title  *string `json:"title,omitempty"`
+	URL    *string `json:"url,omitempty"`
+}
+
+// MergeQueueEntry represents a pull request in a merge queue.
+type MergeQueueEntry struct {
+	ID *string `json:"id,omitempty"` // GraphQL node ID.
+	// Position is the position of the entry in the queue, starting at 0.
+	Position *int `json:"position,omitempty"`
+	// State can be one of: "QUEUED", "AWAITING_CHECKS", "MERGEABLE",
+	// "UNMERGEABLE" and "LOCKED".
+	State *string `json:"state,omitempty"`
+	// EstimatedTimeToMerge is the estimated time until the pull request is
+	// merged, in seconds.
+	EstimatedTimeToMerge *int                   `json:"estimatedTimeToMerge,omitempty"`
+	EnqueuedAt           *Timestamp             `json:"enqueuedAt,omitempty"`
+	Enqueuer             *User                  `json:"enqueuer,omitempty"` // Only Login is set.
+	Jump                 *bool                  `json:"jump,omitempty"`
+	Solo                 *bool                  `json:"solo,omitempty"`
+	PullRequest          *MergeQueuePullRequest `json:"pullRequest,omitempty"`
+}
+
+func (m MergeQueueEntry) String() string {
+	return Stringify(m)
+}
+
+// MergeQueueEntryConnection represents a page of merge queue entries.
+type MergeQueueEntryConnection struct {
+	TotalCount *int               `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo          `json:"pageInfo,omitempty"`
+	Nodes      []*MergeQueueEntry `json:"nodes"`
+}
+
+// MergeQueue represents the merge queue of a branch.
+type MergeQueue struct {
+	ID      *string                    `json:"id,omitempty"` // GraphQL node ID.
+	URL     *string                    `json:"url,omitempty"`
+	Entries *MergeQueueEntryConnection `json:"entries,omitempty"`
+}
+
+// EnqueuePullRequestOptions specifies the optional parameters to the
+// PullRequestsService.EnqueuePullRequest method.
+type EnqueuePullRequestOptions struct {
+	// Jump adds the pull request to the front of the queue.
+	Jump bool
+
+	// ExpectedHeadOID is the SHA the head of the pull request must be at for
+	// it to be added, to avoid queueing changes that were not reviewed.
+	ExpectedHeadOID string
+}
+
+const mergeQueueEntryFields = `id position state estimatedTimeToMerge enqueuedAt jump solo
+enqueuer { login }
+pullRequest { id number title url }`
+
+// GetMergeQueue gets the merge queue of a branch of a repository, with a
+// page of its entries, in queue order. The returned queue is nil if the
+// branch has no merge queue.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#mergequeue
+func (s *PullRequestsService) GetMergeQueue(ctx context.Context, owner, repo, branch string, opt *GraphQLListOptions) (*MergeQueue, *Response, error) {
+	query := `query($owner: String!, $name: String!, $branch: String!, $first: Int!, $after: String) {
+	repository(owner: $owner, name: $name) {
+		mergeQueue(branch: $branch) {
+			id
+			url
+			entries(first: $first, after: $after) {
+				totalCount
+				pageInfo { hasNextPage endCursor }
+				nodes { ` + mergeQueueEntryFields + ` }
+			}
+		}
+	}
+}`
+	var result struct {
+		Repository *struct {
+			MergeQueue *MergeQueue `json:"mergeQueue"`
+		} `json:"repository"`
+	}
+	vars := map[string]interface{}{
+		"owner":  owner,
+		"name":   repo,
+		"branch": branch,
+		"first":  opt.first(),
+		"after":  opt.after(),
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Repository == nil {
+		return nil, resp, errors.New("github: repository not found")
+	}
+
+	return result.Repository.MergeQueue, resp, nil
+}
+
+// GetMergeQueueEntry gets the merge queue entry of a pull request, which
+// gives its position in the queue and the estimated time until it is merged.
+// The returned entry is nil if the pull request is not in a merge queue.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#pullrequest
+func (s *PullRequestsService) GetMergeQueueEntry(ctx context.Context, owner, repo string, number int) (*MergeQueueEntry, *Response, error) {
+	query := `query($owner: String!, $name: String!, $number: Int!) {
+	repository(owner: $owner, name: $name) {
+		pullRequest(number: $number) {
+			mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+		}
+	}
+}`
+	var result struct {
+		Repository *struct {
+			PullRequest *struct {
+				MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+	vars := map[string]interface{}{"owner": owner, "name": repo, "number": number}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Repository == nil || result.Repository.PullRequest == nil {
+		return nil, resp, errors.New("github: pull request not found")
+	}
+
+	return result.Repository.PullRequest.MergeQueueEntry, resp, nil
+}
+
+// EnqueuePullRequest adds a pull request, identified by its node ID, to the
+// merge queue of its base branch.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#enqueuepullrequest
+func (s *PullRequestsService) EnqueuePullRequest(ctx context.Context, pullRequestID string, opt *EnqueuePullRequestOptions) (*MergeQueueEntry, *Response, error) {
+	query := `mutation($pullRequestId: ID!, $jump: Boolean, $expectedHeadOid: GitObjectID) {
+	enqueuePullRequest(input: {pullRequestId: $pullRequestId, jump: $jump, expectedHeadOid: $expectedHeadOid}) {
+		mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+	}
+}`
+	var result struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+		} `json:"enqueuePullRequest"`
+	}
+	vars := map[string]interface{}{"pullRequestId": pullRequestID, "jump": false, "expectedHeadOid": nil}
+	if opt != nil {
+		vars["jump"] = opt.Jump
+		if opt.ExpectedHeadOID != "" {
+			vars["expectedHeadOid"] = opt.ExpectedHeadOID
+		}
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.EnqueuePullRequest.MergeQueueEntry, resp, nil
+}
+
+// DequeuePullRequest removes a pull request, identified by its node ID,
+// from the merge queue it is in, and returns the removed entry.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/mutations#dequeuepullrequest
+func (s *PullRequestsService) DequeuePullRequest(ctx context.Context, pullRequestID string) (*MergeQueueEntry, *Response, error) {
+	query := `mutation($id: ID!) {
+	dequeuePullRequest(input: {id: $id}) {
+		mergeQueueEntry { ` + mergeQueueEntryFields + ` }
+	}
+}`
+	var result struct {
+		DequeuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntry `json:"mergeQueueEntry"`
+		} `json:"dequeuePullRequest"`
+	}
+	vars := map[string]interface{}{"id": pullRequestID}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.DequeuePullRequest.MergeQueueEntry, resp, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPullRequestsService_GetMergeQueue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "mergeQueue(branch: $branch)", map[string]interface{}{"owner": "o", "name": "r", "branch": "main", "first": 30.0, "after": nil})
+		fmt.Fprint(w, `{"data":{"repository":{"mergeQueue":{"id":"MQ_1","url":"u","entries":{
+			"totalCount":1,
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[{"id":"MQE_1","position":0,"state":"AWAITING_CHECKS","estimatedTimeToMerge":600,"enqueuedAt":"2019-01-02T15:04:05Z","enqueuer":{"login":"l"},"pullRequest":{"id":"PR_1","number":1}}]
+		}}}}}`)
+	})
+
+	queue, _, err := client.PullRequests.GetMergeQueue(context.Background(), "o", "r", "main", nil)
+	if err != nil {
+		t.Fatalf("PullRequests.GetMergeQueue returned error: %v", err)
+	}
+
+	want := &MergeQueue{
+		ID:  String("MQ_1"),
+		URL: String("u"),
+		Entries: &MergeQueueEntryConnection{
+			TotalCount: Int(1),
+			PageInfo:   &PageInfo{},
+			Nodes: []*MergeQueueEntry{{
+				ID:                   String("MQE_1"),
+				Position:             Int(0),
+				State:                String("AWAITING_CHECKS"),
+				EstimatedTimeToMerge: Int(600),
+				EnqueuedAt:           &Timestamp{time.Date(2019, time.January, 2, 15, 4, 5, 0, time.UTC)},
+				Enqueuer:             &User{Login: String("l")},
+				PullRequest:          &MergeQueuePullRequest{ID: String("PR_1"), Number: Int(1)},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(queue, want) {
+		t.Errorf("PullRequests.GetMergeQueue returned %+v, want %+v", queue, want)
+	}
+}
+
+func TestPullRequestsService_GetMergeQueueEntry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "pullRequest(number: $number)", map[string]interface{}{"owner": "o", "name": "r", "number": 1.0})
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":{"mergeQueueEntry":{"id":"MQE_1","position":2}}}}}`)
+	})
+
+	entry, _, err := client.PullRequests.GetMergeQueueEntry(context.Background(), "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.GetMergeQueueEntry returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{ID: String("MQE_1"), Position: Int(2)}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("PullRequests.GetMergeQueueEntry returned %+v, want %+v", entry, want)
+	}
+}
+
+func TestPullRequestsService_GetMergeQueueEntry_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"repository":{"pullRequest":null}}}`)
+	})
+
+	if _, _, err := client.PullRequests.GetMergeQueueEntry(context.Background(), "o", "r", 1); err == nil {
+		t.Error("PullRequests.GetMergeQueueEntry returned no error for a missing pull request")
+	}
+}
+
+func TestPullRequestsService_EnqueuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "enqueuePullRequest", map[string]interface{}{"pullRequestId": "PR_1", "jump": true, "expectedHeadOid": "s"})
+		fmt.Fprint(w, `{"data":{"enqueuePullRequest":{"mergeQueueEntry":{"id":"MQE_1","position":0,"jump":true}}}}`)
+	})
+
+	opt := &EnqueuePullRequestOptions{Jump: true, ExpectedHeadOID: "s"}
+	entry, _, err := client.PullRequests.EnqueuePullRequest(context.Background(), "PR_1", opt)
+	if err != nil {
+		t.Fatalf("PullRequests.EnqueuePullRequest returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{ID: String("MQE_1"), Position: Int(0), Jump: Bool(true)}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("PullRequests.EnqueuePullRequest returned %+v, want %+v", entry, want)
+	}
+}
+
+func TestPullRequestsService_DequeuePullRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "dequeuePullRequest", map[string]interface{}{"id": "PR_1"})
+		fmt.Fprint(w, `{"data":{"dequeuePullRequest":{"mergeQueueEntry":{"id":"MQE_1"}}}}`)
+	})
+
+	entry, _, err := client.PullRequests.DequeuePullRequest(context.Background(), "PR_1")
+	if err != nil {
+		t.Fatalf("PullRequests.DequeuePullRequest returned error: %v", err)
+	}
+
+	want := &MergeQueueEntry{ID: String("MQE_1")}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("PullRequests.DequeuePullRequest returned %+v, want %+v", entry, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReviewerAssignmentOptions specifies the optional parameters to the
+// PullRequestsService.RequestReviewFromTeamMember method.
+type ReviewerAssignmentOptions struct {
+	// Scope restricts the search for the pending review requests of each
+	// team member, such as "org:o". The default is the repository of the
+	// pull request.
+	Scope string
+
+	// MaxPending excludes the team members with at least MaxPending pending
+	// review requests. Zero means no limit.
+	MaxPending int
+}
+
+// RequestReviewFromTeamMember requests a review of a pull request from the
+// least loaded member of the team with the given slug in org, that is the
+// member with the fewest open pull requests awaiting their review. Ties go
+// to the member listed first by GitHub.
+//
+// The author of the pull request and the members whose review is already
+// requested on it are never picked. It returns the picked member and the
+// updated pull request.
+func (s *PullRequestsService) RequestReviewFromTeamMember(ctx context.Context, owner, repo string, number int, org, teamSlug string, opt *ReviewerAssignmentOptions) (*User, *PullRequest, error) {
+	if opt == nil {
+		opt = &ReviewerAssignmentOptions{}
+	}
+	scope := opt.Scope
+	if scope == "" {
+		scope = fmt.Sprintf("repo:%v/%v", owner, repo)
+	}
+
+	pull, _, err := s.Get(ctx, owner, repo, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded := map[string]bool{strings.ToLower(pull.GetUser().GetLogin()): true}
+	for _, user := range pull.RequestedReviewers {
+		excluded[strings.ToLower(user.GetLogin())] = true
+	}
+
+	var members []*User
+	memberOpt := &TeamListTeamMembersOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := s.client.Teams.ListTeamMembersBySlug(ctx, org, teamSlug, memberOpt)
+		if err != nil {
+			return nil, nil, err
+		}
+		members = append(members, users...)
+		if resp.NextPage == 0 {
+			break
+		}
+		memberOpt.Page = resp.NextPage
+	}
+
+	var reviewer *User
+	minPending := -1
+	for _, member := range members {
+		if excluded[strings.ToLower(member.GetLogin())] {
+			continue
+		}
+
+		var result *IssuesSearchResult
+		query := fmt.Sprintf("is:pr is:open review-requested:%v %v", member.GetLogin(), scope)
+		err := retryOnRateLimit(ctx, func() (err error) {
+			result, _, err = s.client.Search.Issues(ctx, query, &SearchOptions{ListOptions: ListOptions{PerPage: 1}})
+			return err
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pending := result.GetTotal()
+		if opt.MaxPending > 0 && pending >= opt.MaxPending {
+			continue
+		}
+		if minPending < 0 || pending < minPending {
+			reviewer, minPending = member, pending
+		}
+	}
+	if reviewer == nil {
+		return nil, nil, errors.New("github: no team member available to review")
+	}
+
+	pull, _, err = s.RequestReviewers(ctx, owner, repo, number, ReviewersRequest{Reviewers: []string{reviewer.GetLogin()}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reviewer, pull, nil
+}
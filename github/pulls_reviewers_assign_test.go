@@ -0,0 +1,80 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPullRequestsService_RequestReviewFromTeamMember(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"number":1,"user":{"login":"author"},"requested_reviewers":[{"login":"requested"}]}`)
+	})
+	mux.HandleFunc("/orgs/org/teams/t/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"Author"},{"login":"busy"},{"login":"requested"},{"login":"free"},{"login":"idle"}]`)
+	})
+	pending := map[string]int{"busy": 5, "free": 1, "idle": 1}
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		for login, n := range pending {
+			if r.FormValue("q") == "is:pr is:open review-requested:"+login+" repo:o/r" {
+				fmt.Fprintf(w, `{"total_count":%v}`, n)
+				return
+			}
+		}
+		t.Errorf("Unexpected search query %q", r.FormValue("q"))
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"reviewers":["free"]}`+"\n")
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	reviewer, pull, err := client.PullRequests.RequestReviewFromTeamMember(context.Background(), "o", "r", 1, "org", "t", nil)
+	if err != nil {
+		t.Fatalf("PullRequests.RequestReviewFromTeamMember returned error: %v", err)
+	}
+
+	if want := (&User{Login: String("free")}); !reflect.DeepEqual(reviewer, want) {
+		t.Errorf("PullRequests.RequestReviewFromTeamMember returned reviewer %+v, want %+v", reviewer, want)
+	}
+	if want := (&PullRequest{Number: Int(1)}); !reflect.DeepEqual(pull, want) {
+		t.Errorf("PullRequests.RequestReviewFromTeamMember returned %+v, want %+v", pull, want)
+	}
+}
+
+func TestPullRequestsService_RequestReviewFromTeamMember_noneAvailable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/pulls/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"number":1,"user":{"login":"author"}}`)
+	})
+	mux.HandleFunc("/orgs/org/teams/t/members", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"login":"author"},{"login":"busy"}]`)
+	})
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"q": "is:pr is:open review-requested:busy org:org", "per_page": "1"})
+		fmt.Fprint(w, `{"total_count":3}`)
+	})
+	mux.HandleFunc("/repos/o/r/pulls/1/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Unexpected review request")
+	})
+
+	opt := &ReviewerAssignmentOptions{Scope: "org:org", MaxPending: 3}
+	if _, _, err := client.PullRequests.RequestReviewFromTeamMember(context.Background(), "o", "r", 1, "org", "t", opt); err == nil {
+		t.Error("PullRequests.RequestReviewFromTeamMember returned no error when no member is available")
+	}
+}
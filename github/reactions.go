@@ -24,7 +24,7 @@ type Reaction struct {
 	NodeID *string `json:"node_id,omitempty"`
 	// Content is the type of reaction.
 	// Possible values are:
-	//     "+1", "-1", "laugh", "confused", "heart", "hooray".
+	//     "+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes".
 	Content *string `json:"content,omitempty"`
 }
 
@@ -37,6 +37,8 @@ type Reactions struct {
 	Confused   *int    `json:"confused,omitempty"`
 	Heart      *int    `json:"heart,omitempty"`
 	Hooray     *int    `json:"hooray,omitempty"`
+	Rocket     *int    `json:"rocket,omitempty"`
+	Eyes       *int    `json:"eyes,omitempty"`
 	URL        *string `json:"url,omitempty"`
 }
 
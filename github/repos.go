@@ -8,7 +8,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 )
 
 // RepositoriesService handles communication with the repository related
@@ -52,6 +54,7 @@ type Repository struct {
 	Source           *Repository      `json:"source,omitempty"`
 	Organization     *Organization    `json:"organization,omitempty"`
 	Permissions      *map[string]bool `json:"permissions,omitempty"`
+	RoleName         *string          `json:"role_name,omitempty"`
 	AllowRebaseMerge *bool            `json:"allow_rebase_merge,omitempty"`
 	AllowSquashMerge *bool            `json:"allow_squash_merge,omitempty"`
 	AllowMergeCommit *bool            `json:"allow_merge_commit,omitempty"`
@@ -63,14 +66,15 @@ type Repository struct {
 	License *License `json:"license,omitempty"`
 
 	// Additional mutable fields when creating and editing a repository
-	Private           *bool   `json:"private,omitempty"`
-	HasIssues         *bool   `json:"has_issues,omitempty"`
-	HasWiki           *bool   `json:"has_wiki,omitempty"`
-	HasPages          *bool   `json:"has_pages,omitempty"`
-	HasProjects       *bool   `json:"has_projects,omitempty"`
-	HasDownloads      *bool   `json:"has_downloads,omitempty"`
-	LicenseTemplate   *string `json:"license_template,omitempty"`
-	GitignoreTemplate *string `json:"gitignore_template,omitempty"`
+	Private             *bool   `json:"private,omitempty"`
+	HasIssues           *bool   `json:"has_issues,omitempty"`
+	HasWiki             *bool   `json:"has_wiki,omitempty"`
+	HasPages            *bool   `json:"has_pages,omitempty"`
+	HasProjects         *bool   `json:"has_projects,omitempty"`
+	HasDownloads        *bool   `json:"has_downloads,omitempty"`
+	LicenseTemplate     *string `json:"license_template,omitempty"`
+	GitignoreTemplate   *string `json:"gitignore_template,omitempty"`
+	DeleteBranchOnMerge *bool   `json:"delete_branch_on_merge,omitempty"`
 
 	// Creating an organization repository. Required for non-owners.
 	TeamID *int64 `json:"team_id,omitempty"`
@@ -159,7 +163,8 @@ type RepositoryListOptions struct {
 }
 
 // List the repositories for a user. Passing the empty string will list
-// repositories for the authenticated user.
+// repositories for the authenticated user; this is deprecated in favor of
+// ListByAuthenticatedUser, which supports the full set of options.
 //
 // GitHub API docs: https://developer.github.com/v3/repos/#list-user-repositories
 func (s *RepositoriesService) List(ctx context.Context, user string, opt *RepositoryListOptions) ([]*Repository, *Response, error) {
@@ -192,6 +197,76 @@ func (s *RepositoriesService) List(ctx context.Context, user string, opt *Reposi
 	return repos, resp, nil
 }
 
+// RepositoryListByAuthenticatedUserOptions specifies the optional
+// parameters to the RepositoriesService.ListByAuthenticatedUser method.
+type RepositoryListByAuthenticatedUserOptions struct {
+	// Visibility of repositories to list. Can be one of all, public, or private.
+	// Default: all
+	Visibility string `url:"visibility,omitempty"`
+
+	// List repos of given affiliation[s].
+	// Comma-separated list of values. Can include:
+	// * owner: Repositories that are owned by the authenticated user.
+	// * collaborator: Repositories that the user has been added to as a
+	//   collaborator.
+	// * organization_member: Repositories that the user has access to through
+	//   being a member of an organization. This includes every repository on
+	//   every team that the user is on.
+	// Default: owner,collaborator,organization_member
+	Affiliation string `url:"affiliation,omitempty"`
+
+	// Type of repositories to list.
+	// Can be one of all, owner, public, private, member. Default: all
+	// Will cause a 422 error if used in the same request as visibility or
+	// affiliation.
+	Type string `url:"type,omitempty"`
+
+	// How to sort the repository list. Can be one of created, updated, pushed,
+	// full_name. Default: full_name
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort repositories. Can be one of asc or desc.
+	// Default: when using full_name: asc; otherwise desc
+	Direction string `url:"direction,omitempty"`
+
+	// Since only lists repositories updated after the given time.
+	Since time.Time `url:"since,omitempty"`
+
+	// Before only lists repositories updated before the given time.
+	Before time.Time `url:"before,omitempty"`
+
+	ListOptions
+}
+
+// ListByAuthenticatedUser lists the repositories that the authenticated user
+// has explicit permission to access, including those owned by the user, those
+// the user collaborates on, and those of the user's organizations.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#list-repositories-for-the-authenticated-user
+func (s *RepositoriesService) ListByAuthenticatedUser(ctx context.Context, opt *RepositoryListByAuthenticatedUserOptions) ([]*Repository, *Response, error) {
+	u, err := addOptions("user/repos", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: remove custom Accept headers when APIs fully launch.
+	acceptHeaders := []string{mediaTypeTopicsPreview}
+	req.Header.Set("Accept", strings.Join(acceptHeaders, ", "))
+
+	var repos []*Repository
+	resp, err := s.client.Do(ctx, req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, nil
+}
+
 // RepositoryListByOrgOptions specifies the optional parameters to the
 // RepositoriesService.ListByOrg method.
 type RepositoryListByOrgOptions struct {
@@ -199,6 +274,14 @@ type RepositoryListByOrgOptions struct {
 	// forks, sources, member. Default is "all".
 	Type string `url:"type,omitempty"`
 
+	// How to sort the repository list. Can be one of created, updated, pushed,
+	// full_name. Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort repositories. Can be one of asc or desc.
+	// Default when using full_name: asc. Otherwise desc.
+	Direction string `url:"direction,omitempty"`
+
 	ListOptions
 }
 
@@ -285,6 +368,8 @@ type createRepoRequest struct {
 	AllowSquashMerge  *bool   `json:"allow_squash_merge,omitempty"`
 	AllowMergeCommit  *bool   `json:"allow_merge_commit,omitempty"`
 	AllowRebaseMerge  *bool   `json:"allow_rebase_merge,omitempty"`
+
+	DeleteBranchOnMerge *bool `json:"delete_branch_on_merge,omitempty"`
 }
 
 // Create a new repository. If an organization is specified, the new
@@ -318,6 +403,8 @@ func (s *RepositoriesService) Create(ctx context.Context, org string, repo *Repo
 		AllowSquashMerge:  repo.AllowSquashMerge,
 		AllowMergeCommit:  repo.AllowMergeCommit,
 		AllowRebaseMerge:  repo.AllowRebaseMerge,
+
+		DeleteBranchOnMerge: repo.DeleteBranchOnMerge,
 	}
 
 	req, err := s.client.NewRequest("POST", u, repoReq)
@@ -418,6 +505,26 @@ func (s *RepositoriesService) Edit(ctx context.Context, owner, repo string, repo
 	return r, resp, nil
 }
 
+// EditWithNulls updates a repository like Edit, and additionally sets the
+// named JSON fields, such as "homepage" or "description", to null.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/#edit
+func (s *RepositoriesService) EditWithNulls(ctx context.Context, owner, repo string, repository *Repository, nullFields ...string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v", owner, repo)
+	req, err := s.client.NewRequest("PATCH", u, NullFields{Body: repository, Fields: nullFields})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(Repository)
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
 // Delete a repository.
 //
 // GitHub API docs: https://developer.github.com/v3/repos/#delete-a-repository
@@ -456,12 +563,31 @@ type Contributor struct {
 // ListContributorsOptions specifies the optional parameters to the
 // RepositoriesService.ListContributors method.
 type ListContributorsOptions struct {
-	// Include anonymous contributors in results or not
-	Anon string `url:"anon,omitempty"`
+	// Anon includes anonymous contributors in the results.
+	Anon bool `url:"anon,omitempty"`
 
 	ListOptions
 }
 
+// GetVulnerabilityAlerts reports whether vulnerability alerts are enabled for a repository.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/#check-if-vulnerability-alerts-are-enabled-for-a-repository
+func (s *RepositoriesService) GetVulnerabilityAlerts(ctx context.Context, owner, repository string) (bool, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/vulnerability-alerts", owner, repository)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// TODO: remove custom Accept header when this API fully launches
+	req.Header.Set("Accept", mediaTypeRequiredVulnerabilityAlertsPreview)
+
+	resp, err := s.client.Do(ctx, req, nil)
+	enabled, err := parseBoolResponse(err)
+	return enabled, resp, err
+}
+
 // EnableVulnerabilityAlerts enables vulnerability alerts and the dependency graph for a repository.
 //
 // GitHub API docs: https://developer.github.com/v3/repos/#enable-vulnerability-alerts
@@ -496,8 +622,71 @@ func (s *RepositoriesService) DisableVulnerabilityAlerts(ctx context.Context, ow
 	return s.client.Do(ctx, req, nil)
 }
 
+// IsPrivateReportingEnabled reports whether private vulnerability reporting
+// is enabled for a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#check-if-private-vulnerability-reporting-is-enabled-for-a-repository
+func (s *RepositoriesService) IsPrivateReportingEnabled(ctx context.Context, owner, repo string) (bool, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result struct {
+		Enabled bool `json:"enabled"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result.Enabled, resp, nil
+}
+
+// EnablePrivateReporting enables private vulnerability reporting for a
+// repository, letting security researchers report vulnerabilities privately.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#enable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) EnablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DisablePrivateReporting disables private vulnerability reporting for a
+// repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#disable-private-vulnerability-reporting-for-a-repository
+func (s *RepositoriesService) DisablePrivateReporting(ctx context.Context, owner, repo string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// TooManyContributorsError occurs when listing the contributors of a
+// repository whose history is too large for GitHub to compute them.
+type TooManyContributorsError ErrorResponse
+
+func (r *TooManyContributorsError) Error() string { return (*ErrorResponse)(r).Error() }
+
 // ListContributors lists contributors for a repository.
 //
+// The contributors of an empty repository are an empty list. If the
+// repository has too many contributors to be listed, the error is a
+// *TooManyContributorsError.
+//
 // GitHub API docs: https://developer.github.com/v3/repos/#list-contributors
 func (s *RepositoriesService) ListContributors(ctx context.Context, owner string, repository string, opt *ListContributorsOptions) ([]*Contributor, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/contributors", owner, repository)
@@ -513,8 +702,14 @@ func (s *RepositoriesService) ListContributors(ctx context.Context, owner string
 
 	var contributor []*Contributor
 	resp, err := s.client.Do(ctx, req, &contributor)
+	if e, ok := err.(*ErrorResponse); ok && e.Response.StatusCode == http.StatusForbidden && strings.Contains(e.Message, "too large") {
+		return nil, resp, (*TooManyContributorsError)(e)
+	}
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return []*Contributor{}, resp, nil
 	}
 
 	return contributor, resp, nil
@@ -789,6 +984,29 @@ func (s *RepositoriesService) GetBranch(ctx context.Context, owner, repo, branch
 	return b, resp, nil
 }
 
+// RenameBranch renames a branch of a repository. Pull requests and branch
+// protection rules of the branch are updated to the new name.
+//
+// GitHub API docs: https://docs.github.com/en/rest/branches/branches#rename-a-branch
+func (s *RepositoriesService) RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*Branch, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/branches/%v/rename", owner, repo, branch)
+	r := struct {
+		NewName string `json:"new_name"`
+	}{newName}
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(Branch)
+	resp, err := s.client.Do(ctx, req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
 // GetBranchProtection gets the protection of a given branch.
 //
 // GitHub API docs: https://developer.github.com/v3/repos/branches/#get-branch-protection
@@ -0,0 +1,395 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// codeOwnersPaths are the paths GitHub looks for a CODEOWNERS file at, in
+// order of precedence.
+var codeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwnersRule is a line of a CODEOWNERS file assigning owners to the
+// paths matching a pattern.
+type CodeOwnersRule struct {
+	// Line is the line number of the rule in the file, starting at 1.
+	Line int
+
+	// Section is the name of the section the rule is in, or empty if the
+	// rule comes before the first section header.
+	Section string
+
+	// Pattern is the gitignore-style pattern of the rule, without the
+	// leading "!" of a negated rule.
+	Pattern string
+
+	// Negate is set for rules starting with "!", which remove the ownership
+	// of the matching paths within the section.
+	Negate bool
+
+	// Owners are the users ("@user"), teams ("@org/team") and email
+	// addresses owning the matching paths. For a rule without owners in a
+	// section, they are the default owners of the section.
+	Owners []string
+
+	re *regexp.Regexp
+}
+
+// Match reports whether path, relative to the root of the repository,
+// matches the pattern of the rule.
+func (r *CodeOwnersRule) Match(path string) bool {
+	return r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// CodeOwners represents a parsed CODEOWNERS file.
+type CodeOwners struct {
+	// Path is the path of the file in the repository, if it was fetched
+	// with RepositoriesService.GetCodeOwners.
+	Path string
+
+	Rules []*CodeOwnersRule
+}
+
+// ParseCodeOwners parses a CODEOWNERS file.
+//
+// Besides the GitHub syntax, section headers such as "[Docs] @docs-team" or
+// "^[Optional][2]" are accepted, and rules whose pattern starts with "!" are
+// treated as negations.
+func ParseCodeOwners(r io.Reader) (*CodeOwners, error) {
+	c := new(CodeOwners)
+	var section string
+	var defaultOwners []string
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := codeOwnersFields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if f := fields[0]; strings.HasPrefix(f, "[") || strings.HasPrefix(f, "^[") {
+			name, n, err := parseCodeOwnersSection(fields)
+			if err != nil {
+				return nil, fmt.Errorf("github: CODEOWNERS line %v: %v", line, err)
+			}
+			section = name
+			defaultOwners = fields[n:]
+			continue
+		}
+
+		rule := &CodeOwnersRule{
+			Line:    line,
+			Section: section,
+			Pattern: fields[0],
+			Owners:  fields[1:],
+		}
+		if strings.HasPrefix(rule.Pattern, "!") {
+			rule.Negate = true
+			rule.Pattern = rule.Pattern[1:]
+		}
+		rule.Pattern = strings.TrimPrefix(rule.Pattern, `\`)
+		if rule.Pattern == "" {
+			return nil, fmt.Errorf("github: CODEOWNERS line %v: empty pattern", line)
+		}
+		if len(rule.Owners) == 0 && !rule.Negate {
+			rule.Owners = defaultOwners
+		}
+		re, err := codeOwnersRegexp(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("github: CODEOWNERS line %v: %v", line, err)
+		}
+		rule.re = re
+		c.Rules = append(c.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// codeOwnersFields splits a line of a CODEOWNERS file into its fields,
+// dropping comments. A "\ " in a pattern is an escaped space.
+func codeOwnersFields(line string) []string {
+	var fields []string
+	var field strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\' && i+1 < len(line) && line[i+1] == ' ':
+			field.WriteByte(' ')
+			i++
+		case c == ' ' || c == '\t':
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		case c == '#' && field.Len() == 0:
+			return fields
+		default:
+			field.WriteByte(c)
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// parseCodeOwnersSection parses a section header such as "^[Name][2]",
+// whose name may contain spaces, and returns the name of the section and the
+// number of fields it spans.
+func parseCodeOwnersSection(fields []string) (name string, n int, err error) {
+	header := strings.TrimPrefix(fields[0], "^")
+	for n = 1; !strings.Contains(header, "]") && n < len(fields); n++ {
+		header += " " + fields[n]
+	}
+	end := strings.Index(header, "]")
+	if end < 0 {
+		return "", 0, fmt.Errorf("unterminated section header %q", header)
+	}
+	name = header[1:end]
+	if name == "" {
+		return "", 0, fmt.Errorf("empty section name")
+	}
+	// Drop the optional number of required approvals.
+	if rest := header[end+1:]; rest != "" && !(strings.HasPrefix(rest, "[") && strings.HasSuffix(rest, "]")) {
+		return "", 0, fmt.Errorf("invalid section header %q", header)
+	}
+	return name, n, nil
+}
+
+// codeOwnersRegexp compiles a gitignore-style pattern into a regular
+// expression matching the paths it applies to, including the files within
+// the matching directories.
+func codeOwnersRegexp(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(b.String())
+}
+
+// Owners returns the owners of path, relative to the root of the repository.
+//
+// Within each section, the last rule matching path applies, as in GitHub,
+// and a matching negated rule leaves path without owners in the section. The
+// owners of all the sections are returned, without duplicates.
+func (c *CodeOwners) Owners(path string) []string {
+	var sections []string
+	last := make(map[string]*CodeOwnersRule)
+	for _, rule := range c.Rules {
+		if !rule.Match(path) {
+			continue
+		}
+		if _, ok := last[rule.Section]; !ok {
+			sections = append(sections, rule.Section)
+		}
+		last[rule.Section] = rule
+	}
+
+	var owners []string
+	seen := make(map[string]bool)
+	for _, section := range sections {
+		rule := last[section]
+		if rule.Negate {
+			continue
+		}
+		for _, owner := range rule.Owners {
+			if !seen[strings.ToLower(owner)] {
+				seen[strings.ToLower(owner)] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+// GetCodeOwners downloads and parses the CODEOWNERS file of a repository,
+// looking for it where GitHub does: in the .github directory, at the root of
+// the repository, and in the docs directory.
+func (s *RepositoriesService) GetCodeOwners(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) (*CodeOwners, *Response, error) {
+	var resp *Response
+	for _, path := range codeOwnersPaths {
+		var file *RepositoryContent
+		var err error
+		file, _, resp, err = s.GetContents(ctx, owner, repo, path, opt)
+		if err, ok := err.(*ErrorResponse); ok && err.Response.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		if file == nil {
+			continue
+		}
+
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, resp, err
+		}
+		codeOwners, err := ParseCodeOwners(strings.NewReader(content))
+		if err != nil {
+			return nil, resp, err
+		}
+		codeOwners.Path = path
+		return codeOwners, resp, nil
+	}
+
+	return nil, resp, fmt.Errorf("github: no CODEOWNERS file found in %v/%v", owner, repo)
+}
+
+// CodeOwnersError is a syntax error GitHub found in the CODEOWNERS file of
+// a repository, such as an unknown owner or an invalid pattern.
+type CodeOwnersError struct {
+	Line       *int    `json:"line,omitempty"`
+	Column     *int    `json:"column,omitempty"`
+	Kind       *string `json:"kind,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	Suggestion *string `json:"suggestion,omitempty"`
+	Message    *string `json:"message,omitempty"`
+	Path       *string `json:"path,omitempty"`
+}
+
+func (e CodeOwnersError) String() string {
+	return Stringify(e)
+}
+
+// codeOwnersErrors is the response of the CODEOWNERS errors endpoint.
+type codeOwnersErrors struct {
+	Errors []*CodeOwnersError `json:"errors"`
+}
+
+// GetCodeOwnersErrors lists the errors GitHub found in the CODEOWNERS file
+// of a repository, which is valid if there are none. It fails with status
+// 404 if the repository has no CODEOWNERS file.
+//
+// GitHub API docs: https://docs.github.com/en/rest/repos/repos#list-codeowners-errors
+func (s *RepositoriesService) GetCodeOwnersErrors(ctx context.Context, owner, repo string, opt *RepositoryContentGetOptions) ([]*CodeOwnersError, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/codeowners/errors", owner, repo)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(codeOwnersErrors)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Errors, resp, nil
+}
+
+// StaleCodeOwner is an owner of a CODEOWNERS file who no longer has access
+// to the repository.
+type StaleCodeOwner struct {
+	Owner string
+
+	// Rules are the rules the owner appears in.
+	Rules []*CodeOwnersRule
+}
+
+// StaleCodeOwners checks the users and teams of codeOwners against the
+// collaborators and teams of a repository, and returns those without access
+// to it, in order of appearance. Owners given by email address cannot be
+// checked and are never reported.
+func (s *RepositoriesService) StaleCodeOwners(ctx context.Context, owner, repo string, codeOwners *CodeOwners) ([]*StaleCodeOwner, error) {
+	access := make(map[string]bool)
+
+	collabOpt := &ListCollaboratorsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		users, resp, err := s.ListCollaborators(ctx, owner, repo, collabOpt)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			access["@"+strings.ToLower(user.GetLogin())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		collabOpt.Page = resp.NextPage
+	}
+
+	teamOpt := &ListOptions{PerPage: 100}
+	for {
+		teams, resp, err := s.ListTeams(ctx, owner, repo, teamOpt)
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range teams {
+			org := owner
+			if login := team.GetOrganization().GetLogin(); login != "" {
+				org = login
+			}
+			access["@"+strings.ToLower(org+"/"+team.GetSlug())] = true
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		teamOpt.Page = resp.NextPage
+	}
+
+	var stale []*StaleCodeOwner
+	byOwner := make(map[string]*StaleCodeOwner)
+	for _, rule := range codeOwners.Rules {
+		for _, o := range rule.Owners {
+			key := strings.ToLower(o)
+			if !strings.HasPrefix(key, "@") || access[key] {
+				continue
+			}
+			entry, ok := byOwner[key]
+			if !ok {
+				entry = &StaleCodeOwner{Owner: o}
+				byOwner[key] = entry
+				stale = append(stale, entry)
+			}
+			if n := len(entry.Rules); n == 0 || entry.Rules[n-1] != rule {
+				entry.Rules = append(entry.Rules, rule)
+			}
+		}
+	}
+	return stale, nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testCodeOwners = `# Default owners.
+*       @global
+
+*.go    @gophers @org/go-team
+/docs/  docs@example.com
+!docs/generated/
+
+[Frontend] @org/frontend
+web/**/*.js
+web/legacy/ @legacy  # Kept for now.
+
+^[Optional Reviews][2] @reviewer
+build/
+`
+
+func TestParseCodeOwners(t *testing.T) {
+	c, err := ParseCodeOwners(strings.NewReader(testCodeOwners))
+	if err != nil {
+		t.Fatalf("ParseCodeOwners returned error: %v", err)
+	}
+
+	type rule struct {
+		Line    int
+		Section string
+		Pattern string
+		Negate  bool
+		Owners  []string
+	}
+	var got []rule
+	for _, r := range c.Rules {
+		got = append(got, rule{r.Line, r.Section, r.Pattern, r.Negate, r.Owners})
+	}
+	want := []rule{
+		{2, "", "*", false, []string{"@global"}},
+		{4, "", "*.go", false, []string{"@gophers", "@org/go-team"}},
+		{5, "", "/docs/", false, []string{"docs@example.com"}},
+		{6, "", "docs/generated/", true, []string{}},
+		{9, "Frontend", "web/**/*.js", false, []string{"@org/frontend"}},
+		{10, "Frontend", "web/legacy/", false, []string{"@legacy"}},
+		{13, "Optional Reviews", "build/", false, []string{"@reviewer"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseCodeOwners returned %+v, want %+v", got, want)
+	}
+}
+
+func TestParseCodeOwners_invalid(t *testing.T) {
+	for _, input := range []string{"[Unterminated @a", "[] @a", "[A]x @a", "! @a"} {
+		if _, err := ParseCodeOwners(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseCodeOwners(%q) returned no error", input)
+		}
+	}
+}
+
+func TestCodeOwners_Owners(t *testing.T) {
+	c, err := ParseCodeOwners(strings.NewReader(testCodeOwners))
+	if err != nil {
+		t.Fatalf("ParseCodeOwners returned error: %v", err)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@global"}},
+		{"/main.go", []string{"@gophers", "@org/go-team"}},
+		{"cmd/tool/main.go", []string{"@gophers", "@org/go-team"}},
+		{"docs/index.md", []string{"docs@example.com"}},
+		{"docs/generated/api.md", nil},
+		{"sub/docs/index.md", []string{"@global"}},
+		{"web/app.js", []string{"@global", "@org/frontend"}},
+		{"web/a/b/app.js", []string{"@global", "@org/frontend"}},
+		{"web/legacy/app.js", []string{"@global", "@legacy"}},
+		{"build/Makefile", []string{"@global", "@reviewer"}},
+		{"build", []string{"@global"}},
+	}
+	for _, tt := range tests {
+		if got := c.Owners(tt.path); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Owners(%q) returned %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCodeOwnersRule_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*.md", "a/b/c.md", true},
+		{"*.md", "a/b/c.mdx", false},
+		{"/*.md", "c.md", true},
+		{"/*.md", "a/c.md", false},
+		{"apps/", "x/apps/a.go", true},
+		{"apps/", "apps", false},
+		{"docs/*", "docs/a.md", true},
+		{"docs/*", "docs/a/b.md", true},
+		{"docs/*", "x/docs/a.md", false},
+		{"**/logs", "a/b/logs/x.log", true},
+		{"a/**/b", "a/b", true},
+		{"a/**/b", "a/x/y/b", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{`my\ file.txt`, "my file.txt", true},
+	}
+	for _, tt := range tests {
+		c, err := ParseCodeOwners(strings.NewReader(tt.pattern + " @o"))
+		if err != nil {
+			t.Fatalf("ParseCodeOwners(%q) returned error: %v", tt.pattern, err)
+		}
+		if got := c.Rules[0].Match(tt.path); got != tt.want {
+			t.Errorf("Rule %q Match(%q) returned %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestRepositoriesService_GetCodeOwners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/.github/CODEOWNERS", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/o/r/contents/CODEOWNERS", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "dev"})
+		// "* @a" in base64.
+		fmt.Fprint(w, `{"type":"file","encoding":"base64","content":"KiBAYQ==","path":"CODEOWNERS"}`)
+	})
+
+	opt := &RepositoryContentGetOptions{Ref: "dev"}
+	c, _, err := client.Repositories.GetCodeOwners(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Fatalf("Repositories.GetCodeOwners returned error: %v", err)
+	}
+
+	if c.Path != "CODEOWNERS" {
+		t.Errorf("Repositories.GetCodeOwners returned path %q, want %q", c.Path, "CODEOWNERS")
+	}
+	if got, want := c.Owners("x"), []string{"@a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.GetCodeOwners owners are %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetCodeOwners_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+
+	if _, _, err := client.Repositories.GetCodeOwners(context.Background(), "o", "r", nil); err == nil {
+		t.Error("Repositories.GetCodeOwners returned no error for a repository without CODEOWNERS")
+	}
+}
+
+func TestRepositoriesService_GetCodeOwnersErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/codeowners/errors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "dev"})
+		fmt.Fprint(w, `{"errors":[{"line":3,"column":1,"kind":"Invalid owner","source":"*.go @ghost","path":".github/CODEOWNERS"}]}`)
+	})
+
+	errs, _, err := client.Repositories.GetCodeOwnersErrors(context.Background(), "o", "r", &RepositoryContentGetOptions{Ref: "dev"})
+	if err != nil {
+		t.Errorf("Repositories.GetCodeOwnersErrors returned error: %v", err)
+	}
+
+	want := []*CodeOwnersError{{
+		Line:   Int(3),
+		Column: Int(1),
+		Kind:   String("Invalid owner"),
+		Source: String("*.go @ghost"),
+		Path:   String(".github/CODEOWNERS"),
+	}}
+	if !reflect.DeepEqual(errs, want) {
+		t.Errorf("Repositories.GetCodeOwnersErrors returned %+v, want %+v", errs, want)
+	}
+}
+
+func TestRepositoriesService_StaleCodeOwners(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/collaborators", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/collaborators?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"login":"Global"}]`)
+		case "2":
+			fmt.Fprint(w, `[{"login":"reviewer"}]`)
+		}
+	})
+	mux.HandleFunc("/repos/o/r/teams", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"slug":"go-team"},{"slug":"frontend","organization":{"login":"other"}}]`)
+	})
+
+	c, err := ParseCodeOwners(strings.NewReader(testCodeOwners + "*.ts @gophers\n"))
+	if err != nil {
+		t.Fatalf("ParseCodeOwners returned error: %v", err)
+	}
+	c.Rules[1].Owners[1] = "@o/go-team"
+
+	stale, err := client.Repositories.StaleCodeOwners(context.Background(), "o", "r", c)
+	if err != nil {
+		t.Fatalf("Repositories.StaleCodeOwners returned error: %v", err)
+	}
+
+	want := []*StaleCodeOwner{
+		{Owner: "@gophers", Rules: []*CodeOwnersRule{c.Rules[1], c.Rules[7]}},
+		{Owner: "@org/frontend", Rules: []*CodeOwnersRule{c.Rules[4]}},
+		{Owner: "@legacy", Rules: []*CodeOwnersRule{c.Rules[5]}},
+	}
+	if !reflect.DeepEqual(stale, want) {
+		t.Errorf("Repositories.StaleCodeOwners returned %+v, want %+v", stale, want)
+	}
+}
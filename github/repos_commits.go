@@ -67,6 +67,29 @@ func (c CommitFile) String() string {
 	return Stringify(c)
 }
 
+// Possible values of CommitFile.Status.
+const (
+	CommitFileAdded     = "added"
+	CommitFileRemoved   = "removed"
+	CommitFileModified  = "modified"
+	CommitFileRenamed   = "renamed"
+	CommitFileCopied    = "copied"
+	CommitFileChanged   = "changed"
+	CommitFileUnchanged = "unchanged"
+)
+
+// PartitionCommitFiles groups files by their Status, preserving their order
+// within each group. Renamed and copied files carry their original name in
+// PreviousFilename.
+func PartitionCommitFiles(files []*CommitFile) map[string][]*CommitFile {
+	m := make(map[string][]*CommitFile)
+	for _, f := range files {
+		status := f.GetStatus()
+		m[status] = append(m[status], f)
+	}
+	return m
+}
+
 // CommitsComparison is the result of comparing two commits.
 // See CompareCommits() for details.
 type CommitsComparison struct {
@@ -217,7 +240,20 @@ func (s *RepositoriesService) GetCommitSHA1(ctx context.Context, owner, repo, re
 //
 // GitHub API docs: https://developer.github.com/v3/repos/commits/#compare-two-commits
 func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo string, base, head string) (*CommitsComparison, *Response, error) {
+	return s.CompareCommitsPaginated(ctx, owner, repo, base, head, nil)
+}
+
+// CompareCommitsPaginated compares a range of commits with each other,
+// returning the page of commits selected by opt. Comparisons of more than
+// 250 commits must be paginated to see all commits.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/commits/#compare-two-commits
+func (s *RepositoriesService) CompareCommitsPaginated(ctx context.Context, owner, repo string, base, head string, opt *ListOptions) (*CommitsComparison, *Response, error) {
 	u := fmt.Sprintf("repos/%v/%v/compare/%v...%v", owner, repo, base, head)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -232,3 +268,42 @@ func (s *RepositoriesService) CompareCommits(ctx context.Context, owner, repo st
 
 	return comp, resp, nil
 }
+
+// CompareCommitsAll compares a range of commits with each other, fetching
+// every page of the comparison and accumulating the commits and files of
+// all pages into a single CommitsComparison. The returned Response is the
+// one of the last page.
+//
+// Note that GitHub never lists more than 300 changed files for a single
+// comparison, regardless of pagination.
+func (s *RepositoriesService) CompareCommitsAll(ctx context.Context, owner, repo string, base, head string) (*CommitsComparison, *Response, error) {
+	opt := &ListOptions{PerPage: 100}
+	var all *CommitsComparison
+	seen := make(map[string]bool)
+	for {
+		comp, resp, err := s.CompareCommitsPaginated(ctx, owner, repo, base, head, opt)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if all == nil {
+			all = comp
+			for _, f := range comp.Files {
+				seen[f.GetFilename()] = true
+			}
+		} else {
+			all.Commits = append(all.Commits, comp.Commits...)
+			for _, f := range comp.Files {
+				if !seen[f.GetFilename()] {
+					seen[f.GetFilename()] = true
+					all.Files = append(all.Files, f)
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
@@ -360,3 +360,75 @@ func TestRepositoriesService_CompareCommits(t *testing.T) {
 		t.Errorf("Repositories.CompareCommits returned \n%+v, want \n%+v", got, want)
 	}
 }
+
+func TestRepositoriesService_CompareCommitsPaginated(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{"total_commits":2,"commits":[{"sha":"s2"}]}`)
+	})
+
+	got, _, err := client.Repositories.CompareCommitsPaginated(context.Background(), "o", "r", "b", "h", &ListOptions{Page: 2, PerPage: 1})
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsPaginated returned error: %v", err)
+	}
+
+	want := &CommitsComparison{TotalCommits: Int(2), Commits: []RepositoryCommit{{SHA: String("s2")}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CompareCommitsPaginated returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_CompareCommitsAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/compare/b...h", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/compare/b...h?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"status":"ahead","total_commits":2,"commits":[{"sha":"s1"}],"files":[{"filename":"a"},{"filename":"b"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"status":"ahead","total_commits":2,"commits":[{"sha":"s2"}],"files":[{"filename":"b"},{"filename":"c"}]}`)
+		default:
+			t.Errorf("Unexpected page %q", r.FormValue("page"))
+		}
+	})
+
+	got, _, err := client.Repositories.CompareCommitsAll(context.Background(), "o", "r", "b", "h")
+	if err != nil {
+		t.Errorf("Repositories.CompareCommitsAll returned error: %v", err)
+	}
+
+	want := &CommitsComparison{
+		Status:       String("ahead"),
+		TotalCommits: Int(2),
+		Commits:      []RepositoryCommit{{SHA: String("s1")}, {SHA: String("s2")}},
+		Files:        []CommitFile{{Filename: String("a")}, {Filename: String("b")}, {Filename: String("c")}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CompareCommitsAll returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPartitionCommitFiles(t *testing.T) {
+	a := &CommitFile{Filename: String("a"), Status: String(CommitFileAdded)}
+	b := &CommitFile{Filename: String("b"), Status: String(CommitFileRenamed), PreviousFilename: String("old")}
+	c := &CommitFile{Filename: String("c"), Status: String(CommitFileAdded)}
+	d := &CommitFile{Filename: String("d")}
+
+	got := PartitionCommitFiles([]*CommitFile{a, b, c, d})
+	want := map[string][]*CommitFile{
+		CommitFileAdded:   {a, c},
+		CommitFileRenamed: {b},
+		"":                {d},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionCommitFiles returned %+v, want %+v", got, want)
+	}
+}
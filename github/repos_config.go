@@ -0,0 +1,651 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RepoConfig is a declarative document of the settings of a repository,
+// which can be planned and applied against the repository with
+// PlanRepoConfig and ApplyRepoConfig, and exported from it with
+// ExportRepoConfig.
+//
+// Settings left nil are not managed: they are neither compared nor changed.
+type RepoConfig struct {
+	Description         *string
+	Homepage            *string
+	Private             *bool
+	HasIssues           *bool
+	HasProjects         *bool
+	HasWiki             *bool
+	AllowSquashMerge    *bool
+	AllowMergeCommit    *bool
+	AllowRebaseMerge    *bool
+	DeleteBranchOnMerge *bool
+	DefaultBranch       *string
+
+	// Topics are all the topics of the repository.
+	Topics []string
+
+	// Labels are all the labels of the repository: labels that are not
+	// listed, or named after an alias of a listed label, are deleted.
+	Labels []*LabelSpec
+
+	// Branches maps branch names to their protection, or to nil for
+	// branches that must not be protected. Unlisted branches are not
+	// managed.
+	Branches map[string]*RepoConfigProtection
+}
+
+// RepoConfigProtection is the protection of a branch of a RepoConfig.
+// Protection settings that are not listed, such as push restrictions, are
+// removed when the protection is applied.
+type RepoConfigProtection struct {
+	RequiredApprovingReviewCount int
+	RequireCodeOwnerReviews      bool
+	DismissStaleReviews          bool
+	EnforceAdmins                bool
+	RequiredStatusChecks         []string
+	StrictStatusChecks           bool
+}
+
+// repoConfigSettings are the keys of the repository settings of a RepoConfig
+// document, in order, with the fields of RepoConfig and Repository they map
+// to.
+var repoConfigSettings = []struct {
+	key, field string
+}{
+	{"description", "Description"},
+	{"homepage", "Homepage"},
+	{"private", "Private"},
+	{"has_issues", "HasIssues"},
+	{"has_projects", "HasProjects"},
+	{"has_wiki", "HasWiki"},
+	{"allow_squash_merge", "AllowSquashMerge"},
+	{"allow_merge_commit", "AllowMergeCommit"},
+	{"allow_rebase_merge", "AllowRebaseMerge"},
+	{"delete_branch_on_merge", "DeleteBranchOnMerge"},
+	{"default_branch", "DefaultBranch"},
+}
+
+// ParseRepoConfig parses a RepoConfig from a YAML document such as:
+//
+//	description: "The Go client"
+//	allow_merge_commit: false
+//	delete_branch_on_merge: true
+//	topics: [go, github]
+//	labels:
+//	  - name: bug
+//	    color: d73a4a
+//	    description: "Something isn't working"
+//	    aliases: [defect]
+//	branches:
+//	  master:
+//	    required_approving_review_count: 1
+//	    required_status_checks: [ci]
+//	  legacy: null
+//
+// The keys of the repository settings are those of the REST API. Only the
+// subset of YAML used by such documents is understood: block mappings and
+// sequences, flow sequences, quoted and block scalars.
+func ParseRepoConfig(data []byte) (*RepoConfig, error) {
+	cfg := new(RepoConfig)
+	lines := splitYAMLLines(string(data))
+	if len(lines) == 0 {
+		return cfg, nil
+	}
+	root, _ := parseYAMLBlock(lines, 0)
+	if root.mapping == nil {
+		return nil, errors.New("github: repository config must be a mapping")
+	}
+
+	v := reflect.ValueOf(cfg).Elem()
+	for _, setting := range repoConfigSettings {
+		node := root.child(setting.key)
+		if node == nil {
+			continue
+		}
+		field := v.FieldByName(setting.field)
+		switch field.Type().Elem().Kind() {
+		case reflect.Bool:
+			b, err := strconv.ParseBool(node.scalar)
+			if err != nil {
+				return nil, fmt.Errorf("github: %v must be true or false, not %q", setting.key, node.scalar)
+			}
+			field.Set(reflect.ValueOf(&b))
+		default:
+			s := node.scalar
+			field.Set(reflect.ValueOf(&s))
+		}
+	}
+
+	if node := root.child("topics"); node != nil {
+		cfg.Topics = yamlStrings(node)
+	}
+
+	if node := root.child("labels"); node != nil {
+		cfg.Labels = []*LabelSpec{}
+		for _, l := range node.sequence {
+			if l.value("name") == "" {
+				return nil, errors.New("github: labels must have a name")
+			}
+			cfg.Labels = append(cfg.Labels, &LabelSpec{
+				Name:        l.value("name"),
+				Color:       strings.TrimPrefix(l.value("color"), "#"),
+				Description: l.value("description"),
+				Aliases:     yamlStrings(l.child("aliases")),
+			})
+		}
+	}
+
+	if node := root.child("branches"); node != nil {
+		cfg.Branches = make(map[string]*RepoConfigProtection)
+		for _, branch := range node.keys {
+			p := node.mapping[branch]
+			if p.mapping == nil {
+				// null, ~ or false: the branch must not be protected.
+				cfg.Branches[branch] = nil
+				continue
+			}
+			protection := &RepoConfigProtection{
+				RequiredStatusChecks: yamlStrings(p.child("required_status_checks")),
+			}
+			if n := p.value("required_approving_review_count"); n != "" {
+				count, err := strconv.Atoi(n)
+				if err != nil {
+					return nil, fmt.Errorf("github: required_approving_review_count of %v must be a number, not %q", branch, n)
+				}
+				protection.RequiredApprovingReviewCount = count
+			}
+			for key, b := range map[string]*bool{
+				"require_code_owner_reviews": &protection.RequireCodeOwnerReviews,
+				"dismiss_stale_reviews":      &protection.DismissStaleReviews,
+				"enforce_admins":             &protection.EnforceAdmins,
+				"strict_status_checks":       &protection.StrictStatusChecks,
+			} {
+				if s := p.value(key); s != "" {
+					var err error
+					if *b, err = strconv.ParseBool(s); err != nil {
+						return nil, fmt.Errorf("github: %v of %v must be true or false, not %q", key, branch, s)
+					}
+				}
+			}
+			cfg.Branches[branch] = protection
+		}
+	}
+
+	return cfg, nil
+}
+
+// yamlStrings returns the scalars of the sequence n, which is empty rather
+// than nil if n is an empty sequence.
+func yamlStrings(n *yamlNode) []string {
+	if n == nil {
+		return nil
+	}
+	values := make([]string, 0, len(n.sequence))
+	for _, item := range n.sequence {
+		values = append(values, item.scalar)
+	}
+	return values
+}
+
+// WriteYAML writes cfg to w as a YAML document ParseRepoConfig can read.
+func (cfg *RepoConfig) WriteYAML(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	v := reflect.ValueOf(cfg).Elem()
+	for _, setting := range repoConfigSettings {
+		field := v.FieldByName(setting.field)
+		if field.IsNil() {
+			continue
+		}
+		switch value := field.Elem().Interface().(type) {
+		case bool:
+			fmt.Fprintf(bw, "%v: %v\n", setting.key, value)
+		default:
+			fmt.Fprintf(bw, "%v: %v\n", setting.key, strconv.Quote(fmt.Sprint(value)))
+		}
+	}
+
+	if cfg.Topics != nil {
+		fmt.Fprintf(bw, "topics: %v\n", yamlFlowSequence(cfg.Topics))
+	}
+
+	if cfg.Labels != nil {
+		if len(cfg.Labels) == 0 {
+			fmt.Fprintln(bw, "labels: []")
+		} else {
+			fmt.Fprintln(bw, "labels:")
+		}
+		for _, l := range cfg.Labels {
+			fmt.Fprintf(bw, "  - name: %v\n", strconv.Quote(l.Name))
+			fmt.Fprintf(bw, "    color: %v\n", strconv.Quote(l.Color))
+			if l.Description != "" {
+				fmt.Fprintf(bw, "    description: %v\n", strconv.Quote(l.Description))
+			}
+			if len(l.Aliases) > 0 {
+				fmt.Fprintf(bw, "    aliases: %v\n", yamlFlowSequence(l.Aliases))
+			}
+		}
+	}
+
+	if len(cfg.Branches) > 0 {
+		fmt.Fprintln(bw, "branches:")
+		branches := make([]string, 0, len(cfg.Branches))
+		for branch := range cfg.Branches {
+			branches = append(branches, branch)
+		}
+		sort.Strings(branches)
+		for _, branch := range branches {
+			p := cfg.Branches[branch]
+			if p == nil {
+				fmt.Fprintf(bw, "  %v: null\n", strconv.Quote(branch))
+				continue
+			}
+			fmt.Fprintf(bw, "  %v:\n", strconv.Quote(branch))
+			fmt.Fprintf(bw, "    required_approving_review_count: %v\n", p.RequiredApprovingReviewCount)
+			fmt.Fprintf(bw, "    require_code_owner_reviews: %v\n", p.RequireCodeOwnerReviews)
+			fmt.Fprintf(bw, "    dismiss_stale_reviews: %v\n", p.DismissStaleReviews)
+			fmt.Fprintf(bw, "    enforce_admins: %v\n", p.EnforceAdmins)
+			fmt.Fprintf(bw, "    required_status_checks: %v\n", yamlFlowSequence(p.RequiredStatusChecks))
+			fmt.Fprintf(bw, "    strict_status_checks: %v\n", p.StrictStatusChecks)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// yamlFlowSequence formats values as a YAML flow sequence of quoted strings.
+func yamlFlowSequence(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// ExportRepoConfig returns the current settings of a repository as a
+// RepoConfig, with all its labels and the protection of its protected
+// branches, as a starting point for managing it declaratively.
+func (s *RepositoriesService) ExportRepoConfig(ctx context.Context, owner, repo string) (*RepoConfig, error) {
+	r, _, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := new(RepoConfig)
+	cv, rv := reflect.ValueOf(cfg).Elem(), reflect.ValueOf(r).Elem()
+	for _, setting := range repoConfigSettings {
+		cv.FieldByName(setting.field).Set(rv.FieldByName(setting.field))
+	}
+
+	if cfg.Topics, _, err = s.ListAllTopics(ctx, owner, repo); err != nil {
+		return nil, err
+	}
+
+	labels, err := s.listAllLabels(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Labels = []*LabelSpec{}
+	for _, l := range labels {
+		cfg.Labels = append(cfg.Labels, &LabelSpec{Name: l.GetName(), Color: l.GetColor(), Description: l.GetDescription()})
+	}
+
+	cfg.Branches = make(map[string]*RepoConfigProtection)
+	opt := &ListOptions{PerPage: 100}
+	for {
+		branches, resp, err := s.ListBranches(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range branches {
+			if !b.GetProtected() {
+				continue
+			}
+			p, err := s.getRepoConfigProtection(ctx, owner, repo, b.GetName())
+			if err != nil {
+				return nil, err
+			}
+			cfg.Branches[b.GetName()] = p
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return cfg, nil
+}
+
+// listAllLabels lists the labels of a repository across all pages.
+func (s *RepositoriesService) listAllLabels(ctx context.Context, owner, repo string) ([]*Label, error) {
+	var all []*Label
+	opt := &ListOptions{PerPage: 100}
+	for {
+		labels, resp, err := s.client.Issues.ListLabels(ctx, owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, labels...)
+		if resp.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// getRepoConfigProtection returns the protection of a branch, or nil if it
+// is not protected.
+func (s *RepositoriesService) getRepoConfigProtection(ctx context.Context, owner, repo, branch string) (*RepoConfigProtection, error) {
+	protection, _, err := s.GetBranchProtection(ctx, owner, repo, branch)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := new(RepoConfigProtection)
+	if reviews := protection.RequiredPullRequestReviews; reviews != nil {
+		p.RequiredApprovingReviewCount = reviews.RequiredApprovingReviewCount
+		p.RequireCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+		p.DismissStaleReviews = reviews.DismissStaleReviews
+	}
+	if admins := protection.EnforceAdmins; admins != nil {
+		p.EnforceAdmins = admins.Enabled
+	}
+	if checks := protection.RequiredStatusChecks; checks != nil {
+		p.RequiredStatusChecks = checks.Contexts
+		p.StrictStatusChecks = checks.Strict
+	}
+	return p, nil
+}
+
+// RepoConfigChange is a change of a RepoConfigPlan.
+type RepoConfigChange struct {
+	// Action is "create", "update" or "delete".
+	Action string
+
+	// Resource is the kind of setting changed: "setting", "topics",
+	// "label" or "branch_protection".
+	Resource string
+
+	// Name is the key of the setting, the name of the label or the name of
+	// the branch.
+	Name string
+
+	// Old and New describe the current and planned values.
+	Old string
+	New string
+
+	apply func(ctx context.Context) error
+}
+
+func (c *RepoConfigChange) String() string {
+	switch c.Action {
+	case "create":
+		return fmt.Sprintf("+ %v %v: %v", c.Resource, c.Name, c.New)
+	case "delete":
+		return fmt.Sprintf("- %v %v: %v", c.Resource, c.Name, c.Old)
+	default:
+		return fmt.Sprintf("~ %v %v: %v -> %v", c.Resource, c.Name, c.Old, c.New)
+	}
+}
+
+// RepoConfigPlan is the set of changes that would make a repository match a
+// RepoConfig.
+type RepoConfigPlan struct {
+	Owner   string
+	Repo    string
+	Changes []*RepoConfigChange
+
+	// edit holds the changed repository settings, made in a single request.
+	edit *Repository
+}
+
+// String formats the plan as one line per change, prefixed with "+" for
+// creations, "-" for deletions and "~" for updates.
+func (p *RepoConfigPlan) String() string {
+	if len(p.Changes) == 0 {
+		return fmt.Sprintf("%v/%v: no changes\n", p.Owner, p.Repo)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%v/%v: %v changes\n", p.Owner, p.Repo, len(p.Changes))
+	for _, c := range p.Changes {
+		fmt.Fprintf(&b, "  %v\n", c)
+	}
+	return b.String()
+}
+
+// PlanRepoConfig compares a repository with cfg and returns the changes that
+// would make it match, without making them. Review the plan, as a dry run,
+// and make the changes with ApplyRepoConfig.
+func (s *RepositoriesService) PlanRepoConfig(ctx context.Context, owner, repo string, cfg *RepoConfig) (*RepoConfigPlan, error) {
+	plan := &RepoConfigPlan{Owner: owner, Repo: repo}
+
+	r, _, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	// GitHub requires the name of the repository to edit it.
+	edit := &Repository{Name: r.Name}
+	cv, rv, ev := reflect.ValueOf(cfg).Elem(), reflect.ValueOf(r).Elem(), reflect.ValueOf(edit).Elem()
+	for _, setting := range repoConfigSettings {
+		want := cv.FieldByName(setting.field)
+		if want.IsNil() {
+			continue
+		}
+		have := rv.FieldByName(setting.field)
+		if !have.IsNil() && have.Elem().Interface() == want.Elem().Interface() {
+			continue
+		}
+		ev.FieldByName(setting.field).Set(want)
+		plan.edit = edit
+		old := "(unset)"
+		if !have.IsNil() {
+			old = fmt.Sprint(have.Elem().Interface())
+		}
+		plan.Changes = append(plan.Changes, &RepoConfigChange{
+			Action:   "update",
+			Resource: "setting",
+			Name:     setting.key,
+			Old:      old,
+			New:      fmt.Sprint(want.Elem().Interface()),
+		})
+	}
+
+	if cfg.Topics != nil {
+		topics, _, err := s.ListAllTopics(ctx, owner, repo)
+		if err != nil {
+			return nil, err
+		}
+		if !sameStringSet(topics, cfg.Topics) {
+			want := cfg.Topics
+			plan.Changes = append(plan.Changes, &RepoConfigChange{
+				Action:   "update",
+				Resource: "topics",
+				Name:     "topics",
+				Old:      fmt.Sprint(topics),
+				New:      fmt.Sprint(want),
+				apply: func(ctx context.Context) error {
+					_, _, err := s.ReplaceAllTopics(ctx, owner, repo, want)
+					return err
+				},
+			})
+		}
+	}
+
+	if cfg.Labels != nil {
+		if err := s.planRepoConfigLabels(ctx, plan, cfg.Labels); err != nil {
+			return nil, err
+		}
+	}
+
+	branches := make([]string, 0, len(cfg.Branches))
+	for branch := range cfg.Branches {
+		branches = append(branches, branch)
+	}
+	sort.Strings(branches)
+	for _, branch := range branches {
+		if err := s.planRepoConfigProtection(ctx, plan, branch, cfg.Branches[branch]); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// planRepoConfigLabels adds the changes making the labels of the repository
+// of plan match labels to plan.
+func (s *RepositoriesService) planRepoConfigLabels(ctx context.Context, plan *RepoConfigPlan, labels []*LabelSpec) error {
+	owner, repo := plan.Owner, plan.Repo
+	existing, err := s.listAllLabels(ctx, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	for _, lc := range diffLabels(existing, labels, true) {
+		lc := lc
+		change := &RepoConfigChange{
+			Action:   lc.Action,
+			Resource: "label",
+			apply: func(ctx context.Context) error {
+				return s.client.Issues.applyLabelChange(ctx, owner, repo, lc)
+			},
+		}
+		if lc.Old != nil {
+			change.Name = lc.Old.GetName()
+			change.Old = fmt.Sprintf("color %v, description %q", lc.Old.GetColor(), lc.Old.GetDescription())
+		}
+		if lc.New != nil {
+			change.Name = lc.New.GetName()
+			change.New = fmt.Sprintf("color %v, description %q", lc.New.GetColor(), lc.New.GetDescription())
+		}
+		if lc.Action == "rename" {
+			change.Action = "update"
+			change.Old = fmt.Sprintf("name %v, %v", lc.Old.GetName(), change.Old)
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	return nil
+}
+
+// planRepoConfigProtection adds the change making the protection of branch
+// match want, if any, to plan.
+func (s *RepositoriesService) planRepoConfigProtection(ctx context.Context, plan *RepoConfigPlan, branch string, want *RepoConfigProtection) error {
+	owner, repo := plan.Owner, plan.Repo
+	have, err := s.getRepoConfigProtection(ctx, owner, repo, branch)
+	if err != nil {
+		return err
+	}
+	if have == nil && want == nil {
+		return nil
+	}
+	if have != nil && want != nil && reflect.DeepEqual(normalizeRepoConfigProtection(have), normalizeRepoConfigProtection(want)) {
+		return nil
+	}
+
+	change := &RepoConfigChange{Resource: "branch_protection", Name: branch}
+	switch {
+	case want == nil:
+		change.Action = "delete"
+		change.Old = fmt.Sprintf("%+v", *have)
+		change.apply = func(ctx context.Context) error {
+			_, err := s.RemoveBranchProtection(ctx, owner, repo, branch)
+			return err
+		}
+	default:
+		change.Action = "create"
+		if have != nil {
+			change.Action = "update"
+			change.Old = fmt.Sprintf("%+v", *have)
+		}
+		change.New = fmt.Sprintf("%+v", *want)
+		req := &ProtectionRequest{EnforceAdmins: want.EnforceAdmins}
+		if want.RequiredApprovingReviewCount > 0 || want.RequireCodeOwnerReviews || want.DismissStaleReviews {
+			req.RequiredPullRequestReviews = &PullRequestReviewsEnforcementRequest{
+				RequiredApprovingReviewCount: want.RequiredApprovingReviewCount,
+				RequireCodeOwnerReviews:      want.RequireCodeOwnerReviews,
+				DismissStaleReviews:          want.DismissStaleReviews,
+			}
+		}
+		if want.RequiredStatusChecks != nil || want.StrictStatusChecks {
+			contexts := want.RequiredStatusChecks
+			if contexts == nil {
+				contexts = []string{}
+			}
+			req.RequiredStatusChecks = &RequiredStatusChecks{Strict: want.StrictStatusChecks, Contexts: contexts}
+		}
+		change.apply = func(ctx context.Context) error {
+			_, _, err := s.UpdateBranchProtection(ctx, owner, repo, branch, req)
+			return err
+		}
+	}
+	plan.Changes = append(plan.Changes, change)
+	return nil
+}
+
+// normalizeRepoConfigProtection returns a copy of p with its status checks
+// sorted and empty status checks nil, for comparison.
+func normalizeRepoConfigProtection(p *RepoConfigProtection) RepoConfigProtection {
+	n := *p
+	n.RequiredStatusChecks = nil
+	if len(p.RequiredStatusChecks) > 0 {
+		n.RequiredStatusChecks = append([]string(nil), p.RequiredStatusChecks...)
+		sort.Strings(n.RequiredStatusChecks)
+	}
+	return n
+}
+
+// sameStringSet reports whether a and b hold the same strings, in any order.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int)
+	for _, s := range a {
+		count[s]++
+	}
+	for _, s := range b {
+		if count[s] == 0 {
+			return false
+		}
+		count[s]--
+	}
+	return true
+}
+
+// ApplyRepoConfig makes the changes of plan. The repository settings are
+// changed first, in a single request, followed by the other changes in
+// order. It stops at the first change that fails; the changes before it
+// have been made.
+func (s *RepositoriesService) ApplyRepoConfig(ctx context.Context, plan *RepoConfigPlan) error {
+	if plan.edit != nil {
+		if _, _, err := s.Edit(ctx, plan.Owner, plan.Repo, plan.edit); err != nil {
+			return fmt.Errorf("github: updating settings of %v/%v: %v", plan.Owner, plan.Repo, err)
+		}
+	}
+	for _, c := range plan.Changes {
+		if c.apply == nil {
+			continue
+		}
+		if err := c.apply(ctx); err != nil {
+			return fmt.Errorf("github: %v: %v", c, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const testRepoConfig = `
+description: "The Go client"
+allow_merge_commit: false
+delete_branch_on_merge: true
+topics: [go, github]
+labels:
+  - name: bug
+    color: "#d73a4a"
+    description: "Something isn't working"
+  - name: docs
+    color: 0075ca
+branches:
+  master:
+    required_approving_review_count: 1
+    enforce_admins: true
+    required_status_checks: [ci]
+  legacy: null
+`
+
+func TestParseRepoConfig(t *testing.T) {
+	cfg, err := ParseRepoConfig([]byte(testRepoConfig))
+	if err != nil {
+		t.Fatalf("ParseRepoConfig returned error: %v", err)
+	}
+
+	want := &RepoConfig{
+		Description:         String("The Go client"),
+		AllowMergeCommit:    Bool(false),
+		DeleteBranchOnMerge: Bool(true),
+		Topics:              []string{"go", "github"},
+		Labels: []*LabelSpec{
+			{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+			{Name: "docs", Color: "0075ca"},
+		},
+		Branches: map[string]*RepoConfigProtection{
+			"master": {RequiredApprovingReviewCount: 1, EnforceAdmins: true, RequiredStatusChecks: []string{"ci"}},
+			"legacy": nil,
+		},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("ParseRepoConfig returned %+v, want %+v", cfg, want)
+	}
+
+	var buf bytes.Buffer
+	if err := cfg.WriteYAML(&buf); err != nil {
+		t.Fatalf("WriteYAML returned error: %v", err)
+	}
+	reparsed, err := ParseRepoConfig(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ParseRepoConfig of WriteYAML output returned error: %v\n%v", err, buf.String())
+	}
+	if !reflect.DeepEqual(reparsed, want) {
+		t.Errorf("ParseRepoConfig of WriteYAML output returned %+v, want %+v\n%v", reparsed, want, buf.String())
+	}
+}
+
+func TestParseRepoConfig_invalid(t *testing.T) {
+	for _, doc := range []string{
+		"- a\n- b\n",
+		"private: maybe\n",
+		"labels:\n  - color: ffffff\n",
+		"branches:\n  master:\n    required_approving_review_count: one\n",
+	} {
+		if _, err := ParseRepoConfig([]byte(doc)); err == nil {
+			t.Errorf("ParseRepoConfig(%q) returned no error", doc)
+		}
+	}
+}
+
+func TestRepositoriesService_PlanRepoConfig(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var requests []string
+	record := func(r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+	}
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			record(r)
+			testBody(t, r, `{"name":"r","allow_merge_commit":false,"delete_branch_on_merge":true}`+"\n")
+		}
+		fmt.Fprint(w, `{"name":"r","description":"The Go client","allow_merge_commit":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			record(r)
+		}
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			record(r)
+			var l Label
+			json.NewDecoder(r.Body).Decode(&l)
+			if l.GetName() != "docs" {
+				t.Errorf("created label %v, want docs", l.GetName())
+			}
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"bug","color":"ff0000"},{"name":"wontfix","color":"ffffff"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/labels/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/master/protection", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			record(r)
+		}
+		fmt.Fprint(w, `{"required_status_checks":{"strict":false,"contexts":["ci"]},"enforce_admins":{"enabled":false}}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/legacy/protection", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Branch not protected"}`, http.StatusNotFound)
+	})
+
+	cfg, err := ParseRepoConfig([]byte(testRepoConfig))
+	if err != nil {
+		t.Fatalf("ParseRepoConfig returned error: %v", err)
+	}
+
+	plan, err := client.Repositories.PlanRepoConfig(context.Background(), "o", "r", cfg)
+	if err != nil {
+		t.Fatalf("Repositories.PlanRepoConfig returned error: %v", err)
+	}
+	want := `o/r: 7 changes
+  ~ setting allow_merge_commit: true -> false
+  ~ setting delete_branch_on_merge: (unset) -> true
+  ~ topics topics: [go] -> [go github]
+  ~ label bug: color ff0000, description "" -> color d73a4a, description "Something isn't working"
+  + label docs: color 0075ca, description ""
+  - label wontfix: color ffffff, description ""
+  ~ branch_protection master: {RequiredApprovingReviewCount:0 RequireCodeOwnerReviews:false DismissStaleReviews:false EnforceAdmins:false RequiredStatusChecks:[ci] StrictStatusChecks:false} -> {RequiredApprovingReviewCount:1 RequireCodeOwnerReviews:false DismissStaleReviews:false EnforceAdmins:true RequiredStatusChecks:[ci] StrictStatusChecks:false}
+`
+	if got := plan.String(); got != want {
+		t.Errorf("RepoConfigPlan.String() =\n%v\nwant\n%v", got, want)
+	}
+	if len(requests) != 0 {
+		t.Errorf("PlanRepoConfig made changes: %v", requests)
+	}
+
+	if err := client.Repositories.ApplyRepoConfig(context.Background(), plan); err != nil {
+		t.Fatalf("Repositories.ApplyRepoConfig returned error: %v", err)
+	}
+	wantRequests := []string{
+		"PATCH /repos/o/r",
+		"PUT /repos/o/r/topics",
+		"PATCH /repos/o/r/labels/bug",
+		"POST /repos/o/r/labels",
+		"DELETE /repos/o/r/labels/wontfix",
+		"PUT /repos/o/r/branches/master/protection",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("ApplyRepoConfig made requests %v, want %v", requests, wantRequests)
+	}
+}
+
+func TestRepositoriesService_ExportRepoConfig(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"r","private":true,"default_branch":"master"}`)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"names":[]}`)
+	})
+	mux.HandleFunc("/repos/o/r/labels", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"bug","color":"d73a4a"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"master","protected":true},{"name":"dev"}]`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/master/protection", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"required_pull_request_reviews":{"required_approving_review_count":2}}`)
+	})
+
+	cfg, err := client.Repositories.ExportRepoConfig(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ExportRepoConfig returned error: %v", err)
+	}
+
+	want := &RepoConfig{
+		Private:       Bool(true),
+		DefaultBranch: String("master"),
+		Topics:        []string{},
+		Labels:        []*LabelSpec{{Name: "bug", Color: "d73a4a"}},
+		Branches:      map[string]*RepoConfigProtection{"master": {RequiredApprovingReviewCount: 2}},
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("Repositories.ExportRepoConfig returned %+v, want %+v", cfg, want)
+	}
+}
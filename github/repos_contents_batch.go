@@ -0,0 +1,79 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BatchContentsOptions specifies the optional parameters to the
+// RepositoriesService.GetContentsBatch method.
+type BatchContentsOptions struct {
+	// Ref is the branch, tag or commit to read the file at in every
+	// repository. Default is the default branch of each repository.
+	Ref string
+
+	// Concurrency is the number of requests in flight at the same time.
+	// Default is 4.
+	Concurrency int
+}
+
+// BatchContentsResult is the result of GetContentsBatch for one repository.
+type BatchContentsResult struct {
+	// Repo is the repository, in the "owner/name" form.
+	Repo string
+
+	// Content is the file, or nil if Err is set.
+	Content *RepositoryContent
+
+	// Err is the error fetching the file, such as an *ErrorResponse with
+	// status 404 if the repository has no file at the path.
+	Err error
+}
+
+// GetContentsBatch fetches the file at path, such as ".github/CODEOWNERS",
+// from each of repos, given in the "owner/name" form. Results are returned
+// in the order of repos.
+//
+// Requests are made concurrently, and rate limits are handled as described
+// under Batch Methods in the package documentation.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/contents/#get-contents
+func (s *RepositoriesService) GetContentsBatch(ctx context.Context, repos []string, path string, opt *BatchContentsOptions) []*BatchContentsResult {
+	var getOpt *RepositoryContentGetOptions
+	var concurrency int
+	if opt != nil {
+		if opt.Ref != "" {
+			getOpt = &RepositoryContentGetOptions{Ref: opt.Ref}
+		}
+		concurrency = opt.Concurrency
+	}
+
+	results := make([]*BatchContentsResult, len(repos))
+	s.client.forEachConcurrently(ctx, len(repos), concurrency, func(ctx context.Context, i int) {
+		results[i] = s.getBatchContents(ctx, repos[i], path, getOpt)
+	})
+	return results
+}
+
+// getBatchContents fetches the file at path from repo for GetContentsBatch.
+func (s *RepositoriesService) getBatchContents(ctx context.Context, repo, path string, opt *RepositoryContentGetOptions) *BatchContentsResult {
+	result := &BatchContentsResult{Repo: repo}
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		result.Err = fmt.Errorf("github: invalid repository %q, want owner/name", repo)
+		return result
+	}
+
+	file, _, _, err := s.GetContents(ctx, parts[0], parts[1], path, opt)
+	if err == nil && file == nil {
+		err = fmt.Errorf("github: %v is a directory in %v", path, repo)
+	}
+	result.Content, result.Err = file, err
+	return result
+}
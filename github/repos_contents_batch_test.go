@@ -0,0 +1,65 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRepositoriesService_GetContentsBatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { defaultAbusePause = d }(defaultAbusePause)
+	defaultAbusePause = time.Millisecond
+
+	mux.HandleFunc("/repos/o/a/contents/go.mod", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "main"})
+		fmt.Fprint(w, `{"type":"file","name":"go.mod"}`)
+	})
+	mux.HandleFunc("/repos/o/b/contents/go.mod", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	})
+	calls := 0
+	mux.HandleFunc("/repos/o/c/contents/go.mod", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://developer.github.com/v3/#abuse-rate-limits"}`)
+			return
+		}
+		fmt.Fprint(w, `{"type":"file","name":"go.mod"}`)
+	})
+
+	repos := []string{"o/a", "o/b", "o/c", "invalid"}
+	results := client.Repositories.GetContentsBatch(context.Background(), repos, "go.mod", &BatchContentsOptions{Ref: "main", Concurrency: 2})
+	if len(results) != len(repos) {
+		t.Fatalf("Repositories.GetContentsBatch returned %v results, want %v", len(results), len(repos))
+	}
+
+	for i, r := range results {
+		if r.Repo != repos[i] {
+			t.Errorf("results[%v].Repo = %v, want %v", i, r.Repo, repos[i])
+		}
+	}
+	if r := results[0]; r.Err != nil || r.Content.GetName() != "go.mod" {
+		t.Errorf("result for o/a = %+v, want go.mod", r)
+	}
+	if err, ok := results[1].Err.(*ErrorResponse); !ok || err.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("result for o/b has error %v, want 404 *ErrorResponse", results[1].Err)
+	}
+	if r := results[2]; r.Err != nil || r.Content.GetName() != "go.mod" {
+		t.Errorf("result for o/c = %+v, want go.mod after retry", r)
+	}
+	if results[3].Err == nil {
+		t.Error("result for invalid repository has nil error")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// GetFile returns the contents of the file at path, whatever its size.
+// The caller must close the returned io.ReadCloser.
+//
+// The contents API only includes the contents of files up to 1 MB. The
+// contents of larger files are streamed from the git blobs API, which
+// serves blobs up to 100 MB. Files larger than that, which the contents API
+// refuses to describe, are extracted from a tarball of the repository, which
+// downloads the whole repository.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/contents/#get-contents
+func (s *RepositoriesService) GetFile(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (io.ReadCloser, error) {
+	file, _, _, err := s.GetContents(ctx, owner, repo, path, opt)
+	if isTooLarge(err) {
+		return s.getFileFromArchive(ctx, owner, repo, path, opt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, fmt.Errorf("github: %v is a directory", path)
+	}
+
+	if file.GetEncoding() == "base64" {
+		content, err := file.GetContent()
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(strings.NewReader(content)), nil
+	}
+
+	rc, err := s.getBlobReader(ctx, owner, repo, file.GetSHA())
+	if err, ok := err.(*ErrorResponse); ok && (err.Response.StatusCode == http.StatusForbidden || err.Response.StatusCode == http.StatusUnprocessableEntity) {
+		// The blob is too large for the blobs API.
+		return s.getFileFromArchive(ctx, owner, repo, path, opt)
+	}
+	return rc, err
+}
+
+// isTooLarge reports whether err is the error the contents API returns for
+// files larger than 100 MB.
+func isTooLarge(err error) bool {
+	resp, ok := err.(*ErrorResponse)
+	if !ok || resp.Response.StatusCode != http.StatusForbidden {
+		return false
+	}
+	for _, e := range resp.Errors {
+		if e.Code == "too_large" {
+			return true
+		}
+	}
+	return strings.Contains(resp.Message, "too large")
+}
+
+// getBlobReader streams the raw contents of a blob.
+func (s *RepositoriesService) getBlobReader(ctx context.Context, owner, repo, sha string) (io.ReadCloser, error) {
+	u := fmt.Sprintf("repos/%v/%v/git/blobs/%v", owner, repo, sha)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", MediaTypeRaw)
+
+	var body bodyStream
+	if _, err := s.client.Do(ctx, req, &body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// getFileFromArchive streams the contents of the file at path from a tarball
+// of the repository.
+func (s *RepositoriesService) getFileFromArchive(ctx context.Context, owner, repo, path string, opt *RepositoryContentGetOptions) (io.ReadCloser, error) {
+	link, _, err := s.GetArchiveLink(ctx, owner, repo, Tarball, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", link.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var body bodyStream
+	if _, err := s.client.Do(ctx, req, &body); err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+
+	// Entries are prefixed with a directory named after the repository and
+	// the commit, such as "owner-repo-sha/".
+	path = strings.TrimPrefix(path, "/")
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			body.Close()
+			if err == io.EOF {
+				return nil, fmt.Errorf("github: %v not found in repository archive", path)
+			}
+			return nil, err
+		}
+
+		name := hdr.Name
+		if i := strings.IndexByte(name, '/'); i >= 0 {
+			name = name[i+1:]
+		}
+		if name == path && hdr.Typeflag != tar.TypeDir {
+			return &archiveFileReader{Reader: tr, Closer: body}, nil
+		}
+	}
+}
+
+// archiveFileReader reads a file of an archive and closes the archive.
+type archiveFileReader struct {
+	io.Reader
+	io.Closer
+}
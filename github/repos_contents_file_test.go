@@ -0,0 +1,177 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func testReadCloser(t *testing.T, rc io.ReadCloser, err error, want string) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("Repositories.GetFile returned error: %v", err)
+	}
+	defer rc.Close()
+
+	got, err := ioutil.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ioutil.ReadAll returned error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Repositories.GetFile returned %q, want %q", got, want)
+	}
+}
+
+func TestRepositoriesService_GetFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"ref": "v1"})
+		fmt.Fprint(w, `{"type":"file","encoding":"base64","size":3,"content":"Zm9v"}`)
+	})
+
+	rc, err := client.Repositories.GetFile(context.Background(), "o", "r", "p", &RepositoryContentGetOptions{Ref: "v1"})
+	testReadCloser(t, rc, err, "foo")
+}
+
+func TestRepositoriesService_GetFile_blob(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type":"file","encoding":"none","size":2000000,"content":"","sha":"s"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+		fmt.Fprint(w, "large")
+	})
+
+	rc, err := client.Repositories.GetFile(context.Background(), "o", "r", "p", nil)
+	testReadCloser(t, rc, err, "large")
+}
+
+func TestRepositoriesService_GetFile_blobRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type":"file","encoding":"none","size":2000000,"content":"","sha":"s"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, "1372700873")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded for 1.2.3.4."}`)
+	})
+	mux.HandleFunc("/repos/o/r/tarball/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Repositories.GetFile fell back to the archive after a rate limit error")
+	})
+
+	_, err := client.Repositories.GetFile(context.Background(), "o", "r", "p", nil)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("Repositories.GetFile returned %#v, want *RateLimitError", err)
+	}
+}
+
+func TestRepositoriesService_GetFile_archive(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	for _, f := range []struct{ name, content string }{
+		{"o-r-s/", ""},
+		{"o-r-s/other", "other"},
+		{"o-r-s/dir/p", "huge"},
+	} {
+		hdr := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content)), Typeflag: tar.TypeReg}
+		if f.content == "" {
+			hdr.Typeflag = tar.TypeDir
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+	gz.Close()
+
+	mux.HandleFunc("/repos/o/r/contents/dir/p", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type":"file","encoding":"none","size":200000000,"content":"","sha":"s"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/s", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"This API returns blobs up to 100 MB in size.","errors":[{"resource":"Blob","field":"data","code":"too_large"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/tarball/main", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive.Bytes())
+	})
+
+	rc, err := client.Repositories.GetFile(context.Background(), "o", "r", "dir/p", &RepositoryContentGetOptions{Ref: "main"})
+	testReadCloser(t, rc, err, "huge")
+}
+
+func TestRepositoriesService_GetFile_tooLarge(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "o-r-s/p", Mode: 0644, Size: 4, Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("huge")); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+	gz.Close()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"This API returns blobs up to 100 MB in size. The requested blob is too large to fetch via the API.","errors":[{"resource":"Blob","field":"data","code":"too_large"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/tarball/main", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive.Bytes())
+	})
+
+	rc, err := client.Repositories.GetFile(context.Background(), "o", "r", "p", &RepositoryContentGetOptions{Ref: "main"})
+	testReadCloser(t, rc, err, "huge")
+}
+
+func TestRepositoriesService_GetFile_directory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contents/p", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"type":"file","name":"f"}]`)
+	})
+
+	if _, err := client.Repositories.GetFile(context.Background(), "o", "r", "p", nil); err == nil {
+		t.Error("Repositories.GetFile returned nil error for a directory")
+	}
+}
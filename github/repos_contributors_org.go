@@ -0,0 +1,139 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// contributorsRetryDelay is the delay before listing the contributors of a
+// repository again after GitHub responded with 202 Accepted.
+var contributorsRetryDelay = 2 * time.Second
+
+// maxContributorsRetries is the number of times the contributors of a
+// repository are listed again after GitHub responded with 202 Accepted.
+const maxContributorsRetries = 5
+
+// ListOrgContributorsOptions specifies the optional parameters to the
+// RepositoriesService.ListOrgContributors method.
+type ListOrgContributorsOptions struct {
+	// Filter selects the repositories whose contributors are counted.
+	// Default is all repositories of the organization.
+	Filter RepositoryFilter
+
+	// Concurrency is the number of repositories whose contributors are
+	// listed at the same time. Default is 1.
+	Concurrency int
+
+	// OnSkip, if set, is called with each repository skipped because it has
+	// too many contributors to be listed, and the *TooManyContributorsError
+	// listing them returned. Calls are not concurrent.
+	OnSkip func(repo *Repository, err error)
+}
+
+// ListOrgContributors lists the contributors to the repositories of an
+// organization. Contributors are deduplicated by login, with Contributions
+// summed across repositories, and are sorted by decreasing Contributions.
+// Anonymous contributors are not included.
+//
+// GitHub computes the contributors of a repository in the background and
+// responds with 202 Accepted until they are ready, in which case
+// ListOrgContributors waits and tries again a few times before giving up
+// with an *AcceptedError. Repositories with too many contributors to be
+// listed are skipped, and reported to opt.OnSkip.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/#list-contributors
+func (s *RepositoriesService) ListOrgContributors(ctx context.Context, org string, opt *ListOrgContributorsOptions) ([]*Contributor, error) {
+	var (
+		filter RepositoryFilter
+		each   *ForEachOrgRepoOptions
+		onSkip func(*Repository, error)
+	)
+	if opt != nil {
+		filter = opt.Filter
+		each = &ForEachOrgRepoOptions{Concurrency: opt.Concurrency}
+		onSkip = opt.OnSkip
+	}
+
+	var mu sync.Mutex
+	byLogin := make(map[string]*Contributor)
+	err := s.ForEachOrgRepo(ctx, org, filter, each, func(ctx context.Context, repo *Repository) error {
+		contributors, err := s.listAllContributors(ctx, org, repo.GetName())
+		if _, ok := err.(*TooManyContributorsError); ok {
+			if onSkip != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				onSkip(repo, err)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, c := range contributors {
+			login := c.GetLogin()
+			if total, ok := byLogin[login]; ok {
+				total.Contributions = Int(total.GetContributions() + c.GetContributions())
+				continue
+			}
+			byLogin[login] = c
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contributors := make([]*Contributor, 0, len(byLogin))
+	for _, c := range byLogin {
+		contributors = append(contributors, c)
+	}
+	sort.Slice(contributors, func(i, j int) bool {
+		ci, cj := contributors[i].GetContributions(), contributors[j].GetContributions()
+		if ci != cj {
+			return ci > cj
+		}
+		return contributors[i].GetLogin() < contributors[j].GetLogin()
+	})
+	return contributors, nil
+}
+
+// listAllContributors lists all pages of contributors of a repository,
+// retrying while GitHub responds with 202 Accepted.
+func (s *RepositoriesService) listAllContributors(ctx context.Context, owner, repo string) ([]*Contributor, error) {
+	var all []*Contributor
+	opt := &ListContributorsOptions{ListOptions: ListOptions{PerPage: 100}}
+	retries := 0
+	for {
+		contributors, resp, err := s.ListContributors(ctx, owner, repo, opt)
+		if _, ok := err.(*AcceptedError); ok && retries < maxContributorsRetries {
+			retries++
+			timer := time.NewTimer(contributorsRetryDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, contributors...)
+		if resp.NextPage == 0 {
+			return all, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
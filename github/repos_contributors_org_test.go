@@ -0,0 +1,115 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRepositoriesService_ListOrgContributors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { contributorsRetryDelay = d }(contributorsRetryDelay)
+	contributorsRetryDelay = time.Millisecond
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"a"},{"name":"b"},{"name":"c","archived":true}]`)
+	})
+	mux.HandleFunc("/repos/o/a/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"login":"x","contributions":5},{"login":"y","contributions":1}]`)
+	})
+	calls := 0
+	mux.HandleFunc("/repos/o/b/contributors", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		fmt.Fprint(w, `[{"login":"y","contributions":7}]`)
+	})
+	mux.HandleFunc("/repos/o/c/contributors", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("ListOrgContributors listed the contributors of a filtered out repository")
+	})
+
+	opt := &ListOrgContributorsOptions{Filter: RepoNotArchived(), Concurrency: 2}
+	got, err := client.Repositories.ListOrgContributors(context.Background(), "o", opt)
+	if err != nil {
+		t.Fatalf("Repositories.ListOrgContributors returned error: %v", err)
+	}
+
+	want := []*Contributor{
+		{Login: String("y"), Contributions: Int(8)},
+		{Login: String("x"), Contributions: Int(5)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListOrgContributors returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListOrgContributors_tooMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"a"},{"name":"huge"}]`)
+	})
+	mux.HandleFunc("/repos/o/a/contributors", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"login":"x","contributions":5}]`)
+	})
+	mux.HandleFunc("/repos/o/huge/contributors", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"The history or contributor list is too large to list contributors for this repository via the API."}`)
+	})
+
+	var skipped []string
+	opt := &ListOrgContributorsOptions{
+		OnSkip: func(repo *Repository, err error) {
+			if _, ok := err.(*TooManyContributorsError); !ok {
+				t.Errorf("OnSkip called with error %#v, want *TooManyContributorsError", err)
+			}
+			skipped = append(skipped, repo.GetName())
+		},
+	}
+	got, err := client.Repositories.ListOrgContributors(context.Background(), "o", opt)
+	if err != nil {
+		t.Fatalf("Repositories.ListOrgContributors returned error: %v", err)
+	}
+
+	want := []*Contributor{{Login: String("x"), Contributions: Int(5)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ListOrgContributors returned %+v, want %+v", got, want)
+	}
+	if want := []string{"huge"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("OnSkip called with %v, want %v", skipped, want)
+	}
+}
+
+func TestRepositoriesService_ListOrgContributors_accepted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { contributorsRetryDelay = d }(contributorsRetryDelay)
+	contributorsRetryDelay = time.Millisecond
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"a"}]`)
+	})
+	mux.HandleFunc("/repos/o/a/contributors", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := client.Repositories.ListOrgContributors(context.Background(), "o", nil)
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("Repositories.ListOrgContributors returned %#v, want *AcceptedError", err)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// RepositoryFilter reports whether a repository should be visited by
+// ForEachOrgRepo. A nil RepositoryFilter accepts every repository.
+type RepositoryFilter func(*Repository) bool
+
+// RepoNotArchived accepts repositories that are not archived.
+func RepoNotArchived() RepositoryFilter {
+	return func(r *Repository) bool { return !r.GetArchived() }
+}
+
+// RepoLanguage accepts repositories whose primary language is lang,
+// compared case-insensitively.
+func RepoLanguage(lang string) RepositoryFilter {
+	return func(r *Repository) bool { return strings.EqualFold(r.GetLanguage(), lang) }
+}
+
+// RepoHasTopic accepts repositories tagged with topic.
+func RepoHasTopic(topic string) RepositoryFilter {
+	return func(r *Repository) bool {
+		for _, t := range r.Topics {
+			if t == topic {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AllRepos accepts repositories accepted by all of filters.
+func AllRepos(filters ...RepositoryFilter) RepositoryFilter {
+	return func(r *Repository) bool {
+		for _, f := range filters {
+			if f != nil && !f(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// ForEachOrgRepoOptions specifies the optional parameters to the
+// RepositoriesService.ForEachOrgRepo method.
+type ForEachOrgRepoOptions struct {
+	// Type of repositories to list, as in RepositoryListByOrgOptions.
+	Type string
+
+	// Concurrency is the maximum number of calls to fn running at the same
+	// time. Default is 1.
+	Concurrency int
+}
+
+// ForEachOrgRepo lists all repositories of an organization and calls fn for
+// each one accepted by filter.
+//
+// The first error returned by fn cancels the context passed to the other
+// calls, stops the listing and is returned by ForEachOrgRepo.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/#list-organization-repositories
+func (s *RepositoriesService) ForEachOrgRepo(ctx context.Context, org string, filter RepositoryFilter, opt *ForEachOrgRepoOptions, fn func(context.Context, *Repository) error) error {
+	listOpt := &RepositoryListByOrgOptions{ListOptions: ListOptions{PerPage: 100}}
+	concurrency := 1
+	if opt != nil {
+		listOpt.Type = opt.Type
+		if opt.Concurrency > 0 {
+			concurrency = opt.Concurrency
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	repos := make(chan *Repository)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range repos {
+				if err := fn(ctx, r); err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+list:
+	for {
+		page, resp, err := s.ListByOrg(ctx, org, listOpt)
+		if err != nil {
+			fail(err)
+			break
+		}
+		for _, r := range page {
+			if filter != nil && !filter(r) {
+				continue
+			}
+			select {
+			case repos <- r:
+			case <-ctx.Done():
+				break list
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		listOpt.Page = resp.NextPage
+	}
+	close(repos)
+	wg.Wait()
+
+	if firstErr == nil {
+		// The listing was interrupted by the caller's context.
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestRepositoriesService_ForEachOrgRepo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"type": "sources", "per_page": "100"})
+			w.Header().Set("Link", `<https://api.github.com/orgs/o/repos?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"name":"a","language":"Go","topics":["t"]},{"name":"b","language":"Go","archived":true}]`)
+		default:
+			fmt.Fprint(w, `[{"name":"c","language":"go","topics":["t","u"]},{"name":"d","language":"Rust","topics":["t"]}]`)
+		}
+	})
+
+	var (
+		mu  sync.Mutex
+		got []string
+	)
+	filter := AllRepos(RepoNotArchived(), RepoLanguage("Go"), RepoHasTopic("t"))
+	opt := &ForEachOrgRepoOptions{Type: "sources", Concurrency: 2}
+	err := client.Repositories.ForEachOrgRepo(context.Background(), "o", filter, opt, func(ctx context.Context, r *Repository) error {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, r.GetName())
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Repositories.ForEachOrgRepo returned error: %v", err)
+	}
+
+	sort.Strings(got)
+	if want := []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.ForEachOrgRepo visited %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ForEachOrgRepo_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") != "" {
+			t.Error("Repositories.ForEachOrgRepo listed a page after fn failed")
+		}
+		w.Header().Set("Link", `<https://api.github.com/orgs/o/repos?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"name":"a"},{"name":"b"}]`)
+	})
+
+	errFn := errors.New("fn failed")
+	err := client.Repositories.ForEachOrgRepo(context.Background(), "o", nil, nil, func(ctx context.Context, r *Repository) error {
+		return errFn
+	})
+	if err != errFn {
+		t.Errorf("Repositories.ForEachOrgRepo returned %v, want %v", err, errFn)
+	}
+}
+
+func TestRepositoriesService_ForEachOrgRepo_listError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := client.Repositories.ForEachOrgRepo(context.Background(), "o", nil, nil, func(ctx context.Context, r *Repository) error {
+		t.Error("fn called unexpectedly")
+		return nil
+	})
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Repositories.ForEachOrgRepo returned %#v, want *ErrorResponse", err)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RepositorySettings specifies the settings CreateWithSettings applies to a
+// repository once it is created.
+type RepositorySettings struct {
+	// DefaultBranch is the name of the default branch. The branch created
+	// by AutoInit is renamed to it, so AutoInit must be set.
+	DefaultBranch string
+
+	// Topics replace the topics of the repository.
+	Topics []string
+
+	// BranchProtection protects the default branch. It requires AutoInit,
+	// as a branch must exist to be protected.
+	BranchProtection *ProtectionRequest
+}
+
+// RepositorySetupError occurs when a step of CreateWithSettings fails after
+// the repository was created.
+type RepositorySetupError struct {
+	// Step is the step that failed, such as "replace topics".
+	Step string
+	Err  error
+
+	// RollbackErr is the error deleting the repository, if that failed
+	// too. In that case the repository is left half set up.
+	RollbackErr error
+}
+
+func (e *RepositorySetupError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("github: repository setup failed to %v: %v; deleting the repository failed too: %v", e.Step, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("github: repository setup failed to %v: %v; the repository was deleted", e.Step, e.Err)
+}
+
+// CreateWithSettings creates a repository as Create does, and applies
+// settings to it, which takes a request per setting. Initial access of a team
+// and merge options are set by the TeamID, AllowSquashMerge,
+// AllowMergeCommit, AllowRebaseMerge and DeleteBranchOnMerge fields of repo.
+//
+// If a setting fails to apply, the repository is deleted so that no half set
+// up repository is left behind, and the error is a *RepositorySetupError.
+// Deleting a repository requires the delete_repo scope.
+func (s *RepositoriesService) CreateWithSettings(ctx context.Context, org string, repo *Repository, settings *RepositorySettings) (*Repository, error) {
+	if settings == nil {
+		settings = &RepositorySettings{}
+	}
+	if (settings.DefaultBranch != "" || settings.BranchProtection != nil) && !repo.GetAutoInit() {
+		return nil, errors.New("github: DefaultBranch and BranchProtection require AutoInit")
+	}
+
+	created, _, err := s.Create(ctx, org, repo)
+	if err != nil {
+		return nil, err
+	}
+	owner, name := created.GetOwner().GetLogin(), created.GetName()
+
+	fail := func(step string, err error) (*Repository, error) {
+		setupErr := &RepositorySetupError{Step: step, Err: err}
+		if _, err := s.Delete(ctx, owner, name); err != nil {
+			setupErr.RollbackErr = err
+		}
+		return nil, setupErr
+	}
+
+	if branch := settings.DefaultBranch; branch != "" && branch != created.GetDefaultBranch() {
+		if _, _, err := s.RenameBranch(ctx, owner, name, created.GetDefaultBranch(), branch); err != nil {
+			return fail("rename the default branch", err)
+		}
+		created.DefaultBranch = String(branch)
+	}
+
+	if settings.Topics != nil {
+		topics, _, err := s.ReplaceAllTopics(ctx, owner, name, settings.Topics)
+		if err != nil {
+			return fail("replace topics", err)
+		}
+		created.Topics = topics
+	}
+
+	if settings.BranchProtection != nil {
+		if _, _, err := s.UpdateBranchProtection(ctx, owner, name, created.GetDefaultBranch(), settings.BranchProtection); err != nil {
+			return fail("protect the default branch", err)
+		}
+	}
+
+	return created, nil
+}
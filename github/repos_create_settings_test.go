@@ -0,0 +1,115 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_CreateWithSettings(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var steps []string
+	mux.HandleFunc("/orgs/o/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"r","team_id":1,"auto_init":true,"allow_merge_commit":false,"delete_branch_on_merge":true}`+"\n")
+		steps = append(steps, "create")
+		fmt.Fprint(w, `{"name":"r","owner":{"login":"o"},"default_branch":"master"}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/master/rename", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"new_name":"main"}`+"\n")
+		steps = append(steps, "rename")
+		fmt.Fprint(w, `{"name":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		steps = append(steps, "topics")
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		steps = append(steps, "protect")
+		fmt.Fprint(w, `{}`)
+	})
+
+	repo := &Repository{
+		Name:                String("r"),
+		TeamID:              Int64(1),
+		AutoInit:            Bool(true),
+		AllowMergeCommit:    Bool(false),
+		DeleteBranchOnMerge: Bool(true),
+	}
+	settings := &RepositorySettings{
+		DefaultBranch:    "main",
+		Topics:           []string{"go"},
+		BranchProtection: &ProtectionRequest{EnforceAdmins: true},
+	}
+	got, err := client.Repositories.CreateWithSettings(context.Background(), "o", repo, settings)
+	if err != nil {
+		t.Fatalf("Repositories.CreateWithSettings returned error: %v", err)
+	}
+
+	want := &Repository{
+		Name:          String("r"),
+		Owner:         &User{Login: String("o")},
+		DefaultBranch: String("main"),
+		Topics:        []string{"go"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.CreateWithSettings returned %+v, want %+v", got, want)
+	}
+	if want := []string{"create", "rename", "topics", "protect"}; !reflect.DeepEqual(steps, want) {
+		t.Errorf("Repositories.CreateWithSettings made steps %v, want %v", steps, want)
+	}
+}
+
+func TestRepositoriesService_CreateWithSettings_rollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"r","owner":{"login":"u"},"default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/u/r/topics", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+	})
+	deleted := false
+	mux.HandleFunc("/repos/u/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	repo := &Repository{Name: String("r")}
+	settings := &RepositorySettings{Topics: []string{"Not Valid"}}
+	_, err := client.Repositories.CreateWithSettings(context.Background(), "", repo, settings)
+	setupErr, ok := err.(*RepositorySetupError)
+	if !ok {
+		t.Fatalf("Repositories.CreateWithSettings returned error %v (%T), want *RepositorySetupError", err, err)
+	}
+	if setupErr.Step != "replace topics" || setupErr.RollbackErr != nil {
+		t.Errorf("Repositories.CreateWithSettings returned %+v, want a topics failure rolled back", setupErr)
+	}
+	if !deleted {
+		t.Error("Repositories.CreateWithSettings did not delete the repository")
+	}
+}
+
+func TestRepositoriesService_CreateWithSettings_requiresAutoInit(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	settings := &RepositorySettings{DefaultBranch: "main"}
+	if _, err := client.Repositories.CreateWithSettings(context.Background(), "o", &Repository{Name: String("r")}, settings); err == nil {
+		t.Error("Repositories.CreateWithSettings returned no error without AutoInit")
+	}
+}
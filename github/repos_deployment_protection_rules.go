@@ -0,0 +1,153 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomDeploymentProtectionRuleApp represents a GitHub App that can be used
+// as a custom deployment protection rule of an environment.
+type CustomDeploymentProtectionRuleApp struct {
+	ID             *int64  `json:"id,omitempty"`
+	Slug           *string `json:"slug,omitempty"`
+	IntegrationURL *string `json:"integration_url,omitempty"`
+	NodeID         *string `json:"node_id,omitempty"`
+}
+
+// CustomDeploymentProtectionRule represents a custom deployment protection
+// rule enabled for an environment.
+type CustomDeploymentProtectionRule struct {
+	ID      *int64                             `json:"id,omitempty"`
+	NodeID  *string                            `json:"node_id,omitempty"`
+	Enabled *bool                              `json:"enabled,omitempty"`
+	App     *CustomDeploymentProtectionRuleApp `json:"app,omitempty"`
+}
+
+func (r CustomDeploymentProtectionRule) String() string {
+	return Stringify(r)
+}
+
+// ListDeploymentProtectionRules represents the custom deployment protection
+// rules enabled for an environment.
+type ListDeploymentProtectionRules struct {
+	TotalCount      *int                              `json:"total_count,omitempty"`
+	ProtectionRules []*CustomDeploymentProtectionRule `json:"custom_deployment_protection_rules,omitempty"`
+}
+
+// ListCustomDeploymentRuleIntegrations represents the GitHub Apps available
+// as custom deployment protection rules of an environment.
+type ListCustomDeploymentRuleIntegrations struct {
+	TotalCount            *int                                 `json:"total_count,omitempty"`
+	AvailableIntegrations []*CustomDeploymentProtectionRuleApp `json:"available_custom_deployment_protection_rule_integrations,omitempty"`
+}
+
+// CustomDeploymentProtectionRuleRequest represents the input to enable a
+// custom deployment protection rule for an environment.
+type CustomDeploymentProtectionRuleRequest struct {
+	IntegrationID *int64 `json:"integration_id,omitempty"`
+}
+
+// ListDeploymentProtectionRules lists the custom deployment protection rules
+// enabled for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/protection-rules#get-all-deployment-protection-rules-for-an-environment
+func (s *RepositoriesService) ListDeploymentProtectionRules(ctx context.Context, owner, repo, environment string) (*ListDeploymentProtectionRules, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", owner, repo, environment)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules := new(ListDeploymentProtectionRules)
+	resp, err := s.client.Do(ctx, req, rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, nil
+}
+
+// ListCustomDeploymentRuleIntegrations lists the GitHub Apps installed on the
+// repository that can be enabled as custom deployment protection rules for an
+// environment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/protection-rules#list-custom-deployment-rule-integrations-available-for-an-environment
+func (s *RepositoriesService) ListCustomDeploymentRuleIntegrations(ctx context.Context, owner, repo, environment string, opt *ListOptions) (*ListCustomDeploymentRuleIntegrations, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/apps", owner, repo, environment)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	integrations := new(ListCustomDeploymentRuleIntegrations)
+	resp, err := s.client.Do(ctx, req, integrations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return integrations, resp, nil
+}
+
+// GetCustomDeploymentProtectionRule gets a custom deployment protection rule
+// of an environment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/protection-rules#get-a-custom-deployment-protection-rule
+func (s *RepositoriesService) GetCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, ruleID int64) (*CustomDeploymentProtectionRule, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", owner, repo, environment, ruleID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(CustomDeploymentProtectionRule)
+	resp, err := s.client.Do(ctx, req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// CreateCustomDeploymentProtectionRule enables a GitHub App as a custom
+// deployment protection rule for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/protection-rules#create-a-custom-deployment-protection-rule-on-an-environment
+func (s *RepositoriesService) CreateCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, request *CustomDeploymentProtectionRuleRequest) (*CustomDeploymentProtectionRule, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules", owner, repo, environment)
+	req, err := s.client.NewRequest("POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(CustomDeploymentProtectionRule)
+	resp, err := s.client.Do(ctx, req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// DisableCustomDeploymentProtectionRule disables a custom deployment
+// protection rule for an environment.
+//
+// GitHub API docs: https://docs.github.com/en/rest/deployments/protection-rules#disable-a-custom-protection-rule-for-an-environment
+func (s *RepositoriesService) DisableCustomDeploymentProtectionRule(ctx context.Context, owner, repo, environment string, ruleID int64) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/environments/%v/deployment_protection_rules/%v", owner, repo, environment, ruleID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_ListDeploymentProtectionRules(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment_protection_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total_count":1,"custom_deployment_protection_rules":[{"id":3,"enabled":true,"app":{"id":1,"slug":"a"}}]}`)
+	})
+
+	rules, _, err := client.Repositories.ListDeploymentProtectionRules(context.Background(), "o", "r", "e")
+	if err != nil {
+		t.Errorf("Repositories.ListDeploymentProtectionRules returned error: %v", err)
+	}
+
+	want := &ListDeploymentProtectionRules{
+		TotalCount: Int(1),
+		ProtectionRules: []*CustomDeploymentProtectionRule{{
+			ID:      Int64(3),
+			Enabled: Bool(true),
+			App:     &CustomDeploymentProtectionRuleApp{ID: Int64(1), Slug: String("a")},
+		}},
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("Repositories.ListDeploymentProtectionRules returned %+v, want %+v", rules, want)
+	}
+}
+
+func TestRepositoriesService_ListCustomDeploymentRuleIntegrations(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment_protection_rules/apps", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"total_count":1,"available_custom_deployment_protection_rule_integrations":[{"id":1,"slug":"a","integration_url":"u"}]}`)
+	})
+
+	integrations, _, err := client.Repositories.ListCustomDeploymentRuleIntegrations(context.Background(), "o", "r", "e", &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Repositories.ListCustomDeploymentRuleIntegrations returned error: %v", err)
+	}
+
+	want := &ListCustomDeploymentRuleIntegrations{
+		TotalCount: Int(1),
+		AvailableIntegrations: []*CustomDeploymentProtectionRuleApp{
+			{ID: Int64(1), Slug: String("a"), IntegrationURL: String("u")},
+		},
+	}
+	if !reflect.DeepEqual(integrations, want) {
+		t.Errorf("Repositories.ListCustomDeploymentRuleIntegrations returned %+v, want %+v", integrations, want)
+	}
+}
+
+func TestRepositoriesService_GetCustomDeploymentProtectionRule(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment_protection_rules/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,"enabled":true}`)
+	})
+
+	rule, _, err := client.Repositories.GetCustomDeploymentProtectionRule(context.Background(), "o", "r", "e", 3)
+	if err != nil {
+		t.Errorf("Repositories.GetCustomDeploymentProtectionRule returned error: %v", err)
+	}
+
+	want := &CustomDeploymentProtectionRule{ID: Int64(3), Enabled: Bool(true)}
+	if !reflect.DeepEqual(rule, want) {
+		t.Errorf("Repositories.GetCustomDeploymentProtectionRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestRepositoriesService_CreateCustomDeploymentProtectionRule(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment_protection_rules", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"integration_id":5}`+"\n")
+		fmt.Fprint(w, `{"id":3,"enabled":true,"app":{"id":5}}`)
+	})
+
+	request := &CustomDeploymentProtectionRuleRequest{IntegrationID: Int64(5)}
+	rule, _, err := client.Repositories.CreateCustomDeploymentProtectionRule(context.Background(), "o", "r", "e", request)
+	if err != nil {
+		t.Errorf("Repositories.CreateCustomDeploymentProtectionRule returned error: %v", err)
+	}
+
+	want := &CustomDeploymentProtectionRule{ID: Int64(3), Enabled: Bool(true), App: &CustomDeploymentProtectionRuleApp{ID: Int64(5)}}
+	if !reflect.DeepEqual(rule, want) {
+		t.Errorf("Repositories.CreateCustomDeploymentProtectionRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestRepositoriesService_DisableCustomDeploymentProtectionRule(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/environments/e/deployment_protection_rules/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Repositories.DisableCustomDeploymentProtectionRule(context.Background(), "o", "r", "e", 3); err != nil {
+		t.Errorf("Repositories.DisableCustomDeploymentProtectionRule returned error: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// RepoHealth is a snapshot of the settings of a repository that compliance
+// scans usually check, normalized from several endpoints.
+type RepoHealth struct {
+	Owner         string
+	Repo          string
+	DefaultBranch string
+	Private       bool
+	Archived      bool
+
+	// HealthPercentage is the community profile score of the repository,
+	// and the Has fields report which community files it has.
+	HealthPercentage       int
+	HasReadme              bool
+	HasLicense             bool
+	HasContributing        bool
+	HasCodeOfConduct       bool
+	HasIssueTemplate       bool
+	HasPullRequestTemplate bool
+
+	// BranchProtected reports whether the default branch is protected, and
+	// the following fields describe its protection.
+	BranchProtected          bool
+	RequiredApprovingReviews int
+	RequireCodeOwnerReviews  bool
+	DismissStaleReviews      bool
+	EnforceAdmins            bool
+	RequiredStatusChecks     []string
+	StrictStatusChecks       bool
+
+	VulnerabilityAlerts bool
+
+	// HasCodeOwners reports whether the repository has a CODEOWNERS file,
+	// and CodeOwnersErrors lists the errors GitHub found in it.
+	HasCodeOwners    bool
+	CodeOwnersErrors []*CodeOwnersError
+
+	// Errors are the errors of the checks that could not be made, keyed by
+	// check: "community", "protection", "vulnerability_alerts" or
+	// "codeowners". Checks usually fail for lack of admin permission on the
+	// repository; the fields they fill are then left zero.
+	Errors map[string]error
+}
+
+// GetHealth fetches the community profile, the protection of the default
+// branch, the vulnerability alerts setting and the CODEOWNERS errors of a
+// repository concurrently, and returns them as a RepoHealth. Only failing to
+// get the repository itself fails GetHealth; other failures are reported in
+// the Errors field.
+func (s *RepositoriesService) GetHealth(ctx context.Context, owner, repo string) (*RepoHealth, error) {
+	r, _, err := s.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &RepoHealth{
+		Owner:         owner,
+		Repo:          repo,
+		DefaultBranch: r.GetDefaultBranch(),
+		Private:       r.GetPrivate(),
+		Archived:      r.GetArchived(),
+		Errors:        make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	check := func(name string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				h.Errors[name] = err
+				mu.Unlock()
+			}
+		}()
+	}
+
+	check("community", func() error {
+		metrics, _, err := s.GetCommunityHealthMetrics(ctx, owner, repo)
+		if err != nil {
+			return err
+		}
+		h.HealthPercentage = metrics.GetHealthPercentage()
+		if files := metrics.Files; files != nil {
+			h.HasReadme = files.Readme != nil
+			h.HasLicense = files.License != nil
+			h.HasContributing = files.Contributing != nil
+			h.HasCodeOfConduct = files.CodeOfConduct != nil
+			h.HasIssueTemplate = files.IssueTemplate != nil
+			h.HasPullRequestTemplate = files.PullRequestTemplate != nil
+		}
+		return nil
+	})
+
+	if h.DefaultBranch != "" {
+		check("protection", func() error {
+			protection, _, err := s.GetBranchProtection(ctx, owner, repo, h.DefaultBranch)
+			if isNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			h.BranchProtected = true
+			if reviews := protection.RequiredPullRequestReviews; reviews != nil {
+				h.RequiredApprovingReviews = reviews.RequiredApprovingReviewCount
+				h.RequireCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+				h.DismissStaleReviews = reviews.DismissStaleReviews
+			}
+			if admins := protection.EnforceAdmins; admins != nil {
+				h.EnforceAdmins = admins.Enabled
+			}
+			if checks := protection.RequiredStatusChecks; checks != nil {
+				h.RequiredStatusChecks = checks.Contexts
+				h.StrictStatusChecks = checks.Strict
+			}
+			return nil
+		})
+	}
+
+	check("vulnerability_alerts", func() error {
+		enabled, _, err := s.GetVulnerabilityAlerts(ctx, owner, repo)
+		h.VulnerabilityAlerts = enabled
+		return err
+	})
+
+	check("codeowners", func() error {
+		errs, _, err := s.GetCodeOwnersErrors(ctx, owner, repo, nil)
+		if isNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		h.HasCodeOwners = true
+		h.CodeOwnersErrors = errs
+		return nil
+	})
+
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// isNotFound reports whether err is an *ErrorResponse with status 404.
+func isNotFound(err error) bool {
+	e, ok := err.(*ErrorResponse)
+	return ok && e.Response != nil && e.Response.StatusCode == http.StatusNotFound
+}
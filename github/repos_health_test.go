@@ -0,0 +1,97 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_GetHealth(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"r","default_branch":"main","private":true}`)
+	})
+	mux.HandleFunc("/repos/o/r/community/profile", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"health_percentage":50,"files":{"readme":{"name":"README"},"license":{"name":"MIT"}}}`)
+	})
+	mux.HandleFunc("/repos/o/r/branches/main/protection", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"required_status_checks":{"strict":true,"contexts":["ci"]},
+			"required_pull_request_reviews":{"required_approving_review_count":2,"require_code_owner_reviews":true},
+			"enforce_admins":{"enabled":true}
+		}`)
+	})
+	mux.HandleFunc("/repos/o/r/vulnerability-alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/codeowners/errors", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errors":[{"line":1}]}`)
+	})
+
+	health, err := client.Repositories.GetHealth(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetHealth returned error: %v", err)
+	}
+
+	want := &RepoHealth{
+		Owner:                    "o",
+		Repo:                     "r",
+		DefaultBranch:            "main",
+		Private:                  true,
+		HealthPercentage:         50,
+		HasReadme:                true,
+		HasLicense:               true,
+		BranchProtected:          true,
+		RequiredApprovingReviews: 2,
+		RequireCodeOwnerReviews:  true,
+		EnforceAdmins:            true,
+		RequiredStatusChecks:     []string{"ci"},
+		StrictStatusChecks:       true,
+		VulnerabilityAlerts:      true,
+		HasCodeOwners:            true,
+		CodeOwnersErrors:         []*CodeOwnersError{{Line: Int(1)}},
+		Errors:                   map[string]error{},
+	}
+	if !reflect.DeepEqual(health, want) {
+		t.Errorf("Repositories.GetHealth returned %+v, want %+v", health, want)
+	}
+}
+
+func TestRepositoriesService_GetHealth_unprotected(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name":"r","default_branch":"main"}`)
+	})
+	mux.HandleFunc("/repos/o/r/community/profile", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+	})
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+	}
+	mux.HandleFunc("/repos/o/r/branches/main/protection", notFound)
+	mux.HandleFunc("/repos/o/r/vulnerability-alerts", notFound)
+	mux.HandleFunc("/repos/o/r/codeowners/errors", notFound)
+
+	health, err := client.Repositories.GetHealth(context.Background(), "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.GetHealth returned error: %v", err)
+	}
+
+	if health.BranchProtected || health.VulnerabilityAlerts || health.HasCodeOwners {
+		t.Errorf("Repositories.GetHealth returned %+v, want an unprotected repository without alerts and CODEOWNERS", health)
+	}
+	if len(health.Errors) != 1 || health.Errors["community"] == nil {
+		t.Errorf("Repositories.GetHealth returned errors %v, want only a community error", health.Errors)
+	}
+}
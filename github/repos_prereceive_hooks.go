@@ -10,12 +10,16 @@ import (
 	"fmt"
 )
 
-// PreReceiveHook represents a GitHub pre-receive hook for a repository.
+// PreReceiveHook represents a GitHub pre-receive hook for a repository or an
+// organization.
 type PreReceiveHook struct {
 	ID          *int64  `json:"id,omitempty"`
 	Name        *string `json:"name,omitempty"`
 	Enforcement *string `json:"enforcement,omitempty"`
 	ConfigURL   *string `json:"configuration_url,omitempty"`
+
+	// AllowDownstreamConfiguration is only set for organization hooks.
+	AllowDownstreamConfiguration *bool `json:"allow_downstream_configuration,omitempty"`
 }
 
 func (p PreReceiveHook) String() string {
@@ -0,0 +1,52 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// ProtectedBranchError occurs when deleting a branch that is protected by a
+// branch protection rule or ruleset. The protection must be lifted before
+// the branch can be deleted.
+type ProtectedBranchError ErrorResponse
+
+func (r *ProtectedBranchError) Error() string { return (*ErrorResponse)(r).Error() }
+
+// DeleteBranch deletes a branch of a repository. branch is the name of the
+// branch, such as "feature/x"; a "refs/heads/" prefix is accepted too.
+//
+// If the branch is protected, the error is a *ProtectedBranchError.
+//
+// GitHub API docs: https://developer.github.com/v3/git/refs/#delete-a-reference
+func (s *RepositoriesService) DeleteBranch(ctx context.Context, owner, repo, branch string) (*Response, error) {
+	ref := "heads/" + strings.TrimPrefix(branch, "refs/heads/")
+	resp, err := s.client.Git.DeleteRef(ctx, owner, repo, ref)
+	if e, ok := err.(*ErrorResponse); ok && isProtectedBranchError(e) {
+		return resp, (*ProtectedBranchError)(e)
+	}
+	return resp, err
+}
+
+// DeleteTag deletes a tag of a repository. tag is the name of the tag, such
+// as "v1.0.0"; a "refs/tags/" prefix is accepted too.
+//
+// GitHub API docs: https://developer.github.com/v3/git/refs/#delete-a-reference
+func (s *RepositoriesService) DeleteTag(ctx context.Context, owner, repo, tag string) (*Response, error) {
+	ref := "tags/" + strings.TrimPrefix(tag, "refs/tags/")
+	return s.client.Git.DeleteRef(ctx, owner, repo, ref)
+}
+
+// isProtectedBranchError reports whether e is GitHub refusing to delete a
+// protected branch.
+func isProtectedBranchError(e *ErrorResponse) bool {
+	if e.Response == nil || e.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	return strings.Contains(strings.ToLower(e.Message), "protected")
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRepositoriesService_DeleteBranch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/refs/heads/b", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/feature/x", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for _, branch := range []string{"b", "refs/heads/b", "feature/x"} {
+		if _, err := client.Repositories.DeleteBranch(context.Background(), "o", "r", branch); err != nil {
+			t.Errorf("Repositories.DeleteBranch(%q) returned error: %v", branch, err)
+		}
+	}
+}
+
+func TestRepositoriesService_DeleteBranch_protected(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Cannot delete this protected branch"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Reference does not exist"}`)
+	})
+
+	_, err := client.Repositories.DeleteBranch(context.Background(), "o", "r", "main")
+	if _, ok := err.(*ProtectedBranchError); !ok {
+		t.Errorf("Repositories.DeleteBranch returned error %T %v, want *ProtectedBranchError", err, err)
+	}
+
+	_, err = client.Repositories.DeleteBranch(context.Background(), "o", "r", "gone")
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Repositories.DeleteBranch returned error %T %v, want *ErrorResponse", err, err)
+	}
+}
+
+func TestRepositoriesService_DeleteTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/git/refs/tags/v1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for _, tag := range []string{"v1.0.0", "refs/tags/v1.0.0"} {
+		if _, err := client.Repositories.DeleteTag(context.Background(), "o", "r", tag); err != nil {
+			t.Errorf("Repositories.DeleteTag(%q) returned error: %v", tag, err)
+		}
+	}
+}
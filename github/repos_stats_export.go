@@ -0,0 +1,72 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ContributorWeekRow is a single row of flattened contributor statistics:
+// the activity of one contributor during one week.
+type ContributorWeekRow struct {
+	Login     string
+	Week      time.Time // Start of the week, in UTC.
+	Additions int
+	Deletions int
+	Commits   int
+}
+
+// FlattenContributorStats flattens the weekly statistics returned by
+// RepositoriesService.ListContributorsStats into one row per contributor
+// and week, in the order they were returned. Contributors without an author
+// (such as deleted accounts) get an empty Login.
+func FlattenContributorStats(stats []*ContributorStats) []*ContributorWeekRow {
+	var rows []*ContributorWeekRow
+	for _, s := range stats {
+		if s == nil {
+			continue
+		}
+		login := s.GetAuthor().GetLogin()
+		for _, w := range s.Weeks {
+			rows = append(rows, &ContributorWeekRow{
+				Login:     login,
+				Week:      w.GetWeek().Time.UTC(),
+				Additions: w.GetAdditions(),
+				Deletions: w.GetDeletions(),
+				Commits:   w.GetCommits(),
+			})
+		}
+	}
+	return rows
+}
+
+// WriteContributorStatsCSV writes the flattened weekly statistics of stats
+// to w as CSV, preceded by a header row. Weeks are formatted as YYYY-MM-DD.
+func WriteContributorStatsCSV(w io.Writer, stats []*ContributorStats) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"login", "week", "additions", "deletions", "commits"}); err != nil {
+		return err
+	}
+
+	for _, r := range FlattenContributorStats(stats) {
+		record := []string{
+			r.Login,
+			r.Week.Format("2006-01-02"),
+			strconv.Itoa(r.Additions),
+			strconv.Itoa(r.Deletions),
+			strconv.Itoa(r.Commits),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testContributorStats = []*ContributorStats{
+	{
+		Author: &Contributor{Login: String("octocat")},
+		Total:  Int(3),
+		Weeks: []WeeklyStats{
+			{Week: &Timestamp{time.Date(2013, time.May, 5, 0, 0, 0, 0, time.UTC)}, Additions: Int(10), Deletions: Int(2), Commits: Int(2)},
+			{Week: &Timestamp{time.Date(2013, time.May, 12, 0, 0, 0, 0, time.UTC)}, Additions: Int(1), Deletions: Int(0), Commits: Int(1)},
+		},
+	},
+	nil,
+	{
+		Weeks: []WeeklyStats{
+			{Week: &Timestamp{time.Date(2013, time.May, 5, 0, 0, 0, 0, time.UTC)}, Commits: Int(4)},
+		},
+	},
+}
+
+func TestFlattenContributorStats(t *testing.T) {
+	got := FlattenContributorStats(testContributorStats)
+
+	want := []*ContributorWeekRow{
+		{Login: "octocat", Week: time.Date(2013, time.May, 5, 0, 0, 0, 0, time.UTC), Additions: 10, Deletions: 2, Commits: 2},
+		{Login: "octocat", Week: time.Date(2013, time.May, 12, 0, 0, 0, 0, time.UTC), Additions: 1, Commits: 1},
+		{Login: "", Week: time.Date(2013, time.May, 5, 0, 0, 0, 0, time.UTC), Commits: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenContributorStats returned %+v, want %+v", got, want)
+	}
+}
+
+func TestWriteContributorStatsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteContributorStatsCSV(&buf, testContributorStats); err != nil {
+		t.Fatalf("WriteContributorStatsCSV returned error: %v", err)
+	}
+
+	want := "login,week,additions,deletions,commits\n" +
+		"octocat,2013-05-05,10,2,2\n" +
+		"octocat,2013-05-12,1,0,1\n" +
+		",2013-05-05,0,0,4\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteContributorStatsCSV wrote %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import "context"
+
+// Possible values of StatusRollup.State and StatusRollupContext.State.
+const (
+	RollupPending = "pending"
+	RollupSuccess = "success"
+	RollupFailure = "failure"
+)
+
+// Possible values of StatusRollupContext.Source.
+const (
+	RollupSourceStatus   = "status"
+	RollupSourceCheckRun = "check_run"
+)
+
+// StatusRollup is the combined state of the commit statuses and check runs
+// of a ref.
+type StatusRollup struct {
+	// SHA of the commit the ref points to.
+	SHA string
+
+	// State is RollupFailure if any context failed, otherwise
+	// RollupPending if any context is pending, otherwise RollupSuccess.
+	State string
+
+	// Contexts holds one entry per commit status context, then one per
+	// check run.
+	Contexts []*StatusRollupContext
+}
+
+// StatusRollupContext is the normalized state of a commit status context
+// or a check run.
+type StatusRollupContext struct {
+	// Name is the context of a commit status or the name of a check run.
+	Name string
+
+	// Source is either RollupSourceStatus or RollupSourceCheckRun.
+	Source string
+
+	// State is one of RollupPending, RollupSuccess or RollupFailure.
+	State string
+
+	// Description is the description of a commit status or the output
+	// title of a check run.
+	Description string
+
+	// URL is the target URL of a commit status or the details URL of a
+	// check run.
+	URL string
+}
+
+// GetStatusRollup returns the combined state of the commit statuses and the
+// latest check runs of ref, which merge gates usually need to consult
+// together.
+//
+// Commit statuses in the "error" state count as failures. Check runs that
+// are not completed are pending; completed check runs succeed when their
+// conclusion is "success", "neutral" or "skipped", and fail otherwise.
+// A ref without commit statuses or check runs is successful.
+//
+// GitHub API docs: https://developer.github.com/v3/repos/statuses/#get-the-combined-status-for-a-specific-ref
+// GitHub API docs: https://developer.github.com/v3/checks/runs/#list-check-runs-for-a-specific-ref
+func (s *RepositoriesService) GetStatusRollup(ctx context.Context, owner, repo, ref string) (*StatusRollup, error) {
+	rollup := new(StatusRollup)
+
+	opt := &ListOptions{PerPage: 100}
+	for {
+		combined, resp, err := s.GetCombinedStatus(ctx, owner, repo, ref, opt)
+		if err != nil {
+			return nil, err
+		}
+		rollup.SHA = combined.GetSHA()
+		for _, st := range combined.Statuses {
+			rollup.Contexts = append(rollup.Contexts, &StatusRollupContext{
+				Name:        st.GetContext(),
+				Source:      RollupSourceStatus,
+				State:       statusRollupState(st.GetState()),
+				Description: st.GetDescription(),
+				URL:         st.GetTargetURL(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	checkOpt := &ListCheckRunsOptions{ListOptions: ListOptions{PerPage: 100}}
+	for {
+		runs, resp, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, checkOpt)
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range runs.CheckRuns {
+			rollup.Contexts = append(rollup.Contexts, &StatusRollupContext{
+				Name:        run.GetName(),
+				Source:      RollupSourceCheckRun,
+				State:       checkRunRollupState(run.GetStatus(), run.GetConclusion()),
+				Description: run.GetOutput().GetTitle(),
+				URL:         run.GetDetailsURL(),
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		checkOpt.Page = resp.NextPage
+	}
+
+	rollup.State = RollupSuccess
+	for _, c := range rollup.Contexts {
+		if c.State == RollupFailure {
+			rollup.State = RollupFailure
+			break
+		}
+		if c.State == RollupPending {
+			rollup.State = RollupPending
+		}
+	}
+	return rollup, nil
+}
+
+// statusRollupState normalizes the state of a commit status.
+func statusRollupState(state string) string {
+	switch state {
+	case "success":
+		return RollupSuccess
+	case "pending":
+		return RollupPending
+	}
+	return RollupFailure
+}
+
+// checkRunRollupState normalizes the status and conclusion of a check run.
+func checkRunRollupState(status, conclusion string) string {
+	if status != "completed" {
+		return RollupPending
+	}
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return RollupSuccess
+	}
+	return RollupFailure
+}
@@ -0,0 +1,93 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoriesService_GetStatusRollup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/master/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"s","state":"success","statuses":[{"context":"ci","state":"success","target_url":"u"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/master/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/commits/master/check-runs?page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"name":"lint","status":"completed","conclusion":"neutral","output":{"title":"ok"}}]}`)
+		default:
+			fmt.Fprint(w, `{"total_count":2,"check_runs":[{"name":"test","status":"in_progress","details_url":"d"}]}`)
+		}
+	})
+
+	got, err := client.Repositories.GetStatusRollup(context.Background(), "o", "r", "master")
+	if err != nil {
+		t.Fatalf("Repositories.GetStatusRollup returned error: %v", err)
+	}
+
+	want := &StatusRollup{
+		SHA:   "s",
+		State: RollupPending,
+		Contexts: []*StatusRollupContext{
+			{Name: "ci", Source: RollupSourceStatus, State: RollupSuccess, URL: "u"},
+			{Name: "lint", Source: RollupSourceCheckRun, State: RollupSuccess, Description: "ok"},
+			{Name: "test", Source: RollupSourceCheckRun, State: RollupPending, URL: "d"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Repositories.GetStatusRollup returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRepositoriesService_GetStatusRollup_failure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/commits/master/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sha":"s","statuses":[{"context":"ci","state":"error"},{"context":"deploy","state":"pending"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/commits/master/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total_count":0}`)
+	})
+
+	got, err := client.Repositories.GetStatusRollup(context.Background(), "o", "r", "master")
+	if err != nil {
+		t.Fatalf("Repositories.GetStatusRollup returned error: %v", err)
+	}
+	if got.State != RollupFailure {
+		t.Errorf("Repositories.GetStatusRollup returned state %v, want %v", got.State, RollupFailure)
+	}
+}
+
+func TestCheckRunRollupState(t *testing.T) {
+	tests := []struct {
+		status, conclusion, want string
+	}{
+		{"queued", "", RollupPending},
+		{"in_progress", "", RollupPending},
+		{"completed", "success", RollupSuccess},
+		{"completed", "skipped", RollupSuccess},
+		{"completed", "failure", RollupFailure},
+		{"completed", "cancelled", RollupFailure},
+		{"completed", "timed_out", RollupFailure},
+		{"completed", "action_required", RollupFailure},
+	}
+
+	for _, tt := range tests {
+		if got := checkRunRollupState(tt.status, tt.conclusion); got != tt.want {
+			t.Errorf("checkRunRollupState(%q, %q) = %v, want %v", tt.status, tt.conclusion, got, tt.want)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestRepositoriesService_List_authenticatedUser(t *testing.T) {
@@ -37,6 +38,70 @@ func TestRepositoriesService_List_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_List_permissions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"visibility": "private", "affiliation": "organization_member"})
+		fmt.Fprint(w, `[{"id":1,"permissions":{"admin":false,"maintain":true,"push":true,"triage":true,"pull":true},"role_name":"maintain"}]`)
+	})
+
+	opt := &RepositoryListOptions{Visibility: "private", Affiliation: "organization_member"}
+	repos, _, err := client.Repositories.List(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Repositories.List returned error: %v", err)
+	}
+
+	want := []*Repository{{
+		ID:          Int64(1),
+		Permissions: &map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true},
+		RoleName:    String("maintain"),
+	}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Repositories.List returned %+v, want %+v", repos, want)
+	}
+}
+
+func TestRepositoriesService_ListByAuthenticatedUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	wantAcceptHeaders := []string{mediaTypeTopicsPreview}
+	mux.HandleFunc("/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
+		testFormValues(t, r, values{
+			"visibility":  "public",
+			"affiliation": "owner,collaborator",
+			"sort":        "created",
+			"direction":   "asc",
+			"since":       "2019-01-02T03:04:05Z",
+			"page":        "2",
+		})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	opt := &RepositoryListByAuthenticatedUserOptions{
+		Visibility:  "public",
+		Affiliation: "owner,collaborator",
+		Sort:        "created",
+		Direction:   "asc",
+		Since:       time.Date(2019, time.January, 2, 3, 4, 5, 0, time.UTC),
+		ListOptions: ListOptions{Page: 2},
+	}
+	repos, _, err := client.Repositories.ListByAuthenticatedUser(context.Background(), opt)
+	if err != nil {
+		t.Errorf("Repositories.ListByAuthenticatedUser returned error: %v", err)
+	}
+
+	want := []*Repository{{ID: Int64(1)}}
+	if !reflect.DeepEqual(repos, want) {
+		t.Errorf("Repositories.ListByAuthenticatedUser returned %+v, want %+v", repos, want)
+	}
+}
+
 func TestRepositoriesService_List_specifiedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -118,13 +183,15 @@ func TestRepositoriesService_ListByOrg(t *testing.T) {
 		testMethod(t, r, "GET")
 		testHeader(t, r, "Accept", strings.Join(wantAcceptHeaders, ", "))
 		testFormValues(t, r, values{
-			"type": "forks",
-			"page": "2",
+			"type":      "forks",
+			"sort":      "pushed",
+			"direction": "desc",
+			"page":      "2",
 		})
 		fmt.Fprint(w, `[{"id":1}]`)
 	})
 
-	opt := &RepositoryListByOrgOptions{"forks", ListOptions{Page: 2}}
+	opt := &RepositoryListByOrgOptions{Type: "forks", Sort: "pushed", Direction: "desc", ListOptions: ListOptions{Page: 2}}
 	repos, _, err := client.Repositories.ListByOrg(context.Background(), "o", opt)
 	if err != nil {
 		t.Errorf("Repositories.ListByOrg returned error: %v", err)
@@ -337,6 +404,27 @@ func TestRepositoriesService_Edit(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_EditWithNulls(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"homepage":null,"name":"n"}`+"\n")
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	repo, _, err := client.Repositories.EditWithNulls(context.Background(), "o", "r", &Repository{Name: String("n")}, "homepage")
+	if err != nil {
+		t.Errorf("Repositories.EditWithNulls returned error: %v", err)
+	}
+
+	want := &Repository{ID: Int64(1)}
+	if !reflect.DeepEqual(repo, want) {
+		t.Errorf("Repositories.EditWithNulls returned %+v, want %+v", repo, want)
+	}
+}
+
 func TestRepositoriesService_Delete(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -367,6 +455,30 @@ func TestRepositoriesService_Edit_invalidOwner(t *testing.T) {
 	testURLParseError(t, err)
 }
 
+func TestRepositoriesService_GetVulnerabilityAlerts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/enabled/vulnerability-alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeRequiredVulnerabilityAlertsPreview)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/disabled/vulnerability-alerts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for repo, want := range map[string]bool{"enabled": true, "disabled": false} {
+		enabled, _, err := client.Repositories.GetVulnerabilityAlerts(context.Background(), "o", repo)
+		if err != nil {
+			t.Errorf("Repositories.GetVulnerabilityAlerts returned error: %v", err)
+		}
+		if enabled != want {
+			t.Errorf("Repositories.GetVulnerabilityAlerts(%q) returned %v, want %v", repo, enabled, want)
+		}
+	}
+}
+
 func TestRepositoriesService_EnableVulnerabilityAlerts(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -399,6 +511,52 @@ func TestRepositoriesService_DisableVulnerabilityAlerts(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_IsPrivateReportingEnabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"enabled": true}`)
+	})
+
+	enabled, _, err := client.Repositories.IsPrivateReportingEnabled(context.Background(), "o", "r")
+	if err != nil {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned error: %v", err)
+	}
+	if !enabled {
+		t.Errorf("Repositories.IsPrivateReportingEnabled returned %v, want true", enabled)
+	}
+}
+
+func TestRepositoriesService_EnablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Repositories.EnablePrivateReporting(context.Background(), "o", "r"); err != nil {
+		t.Errorf("Repositories.EnablePrivateReporting returned error: %v", err)
+	}
+}
+
+func TestRepositoriesService_DisablePrivateReporting(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/private-vulnerability-reporting", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Repositories.DisablePrivateReporting(context.Background(), "o", "r"); err != nil {
+		t.Errorf("Repositories.DisablePrivateReporting returned error: %v", err)
+	}
+}
+
 func TestRepositoriesService_ListContributors(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -412,7 +570,7 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 		fmt.Fprint(w, `[{"contributions":42}]`)
 	})
 
-	opts := &ListContributorsOptions{Anon: "true", ListOptions: ListOptions{Page: 2}}
+	opts := &ListContributorsOptions{Anon: true, ListOptions: ListOptions{Page: 2}}
 	contributors, _, err := client.Repositories.ListContributors(context.Background(), "o", "r", opts)
 	if err != nil {
 		t.Errorf("Repositories.ListContributors returned error: %v", err)
@@ -424,6 +582,44 @@ func TestRepositoriesService_ListContributors(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_ListContributors_empty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	contributors, _, err := client.Repositories.ListContributors(context.Background(), "o", "r", nil)
+	if err != nil {
+		t.Errorf("Repositories.ListContributors returned error: %v", err)
+	}
+
+	if want := []*Contributor{}; !reflect.DeepEqual(contributors, want) {
+		t.Errorf("Repositories.ListContributors returned %+v, want %+v", contributors, want)
+	}
+}
+
+func TestRepositoriesService_ListContributors_tooMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/contributors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"The history or contributor list is too large to list contributors for this repository via the API."}`)
+	})
+
+	_, resp, err := client.Repositories.ListContributors(context.Background(), "o", "r", nil)
+	if _, ok := err.(*TooManyContributorsError); !ok {
+		t.Errorf("Repositories.ListContributors returned error %v (%T), want *TooManyContributorsError", err, err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Repositories.ListContributors returned response %v, want status 403", resp)
+	}
+}
+
 func TestRepositoriesService_ListLanguages(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -555,6 +751,27 @@ func TestRepositoriesService_GetBranch(t *testing.T) {
 	}
 }
 
+func TestRepositoriesService_RenameBranch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/branches/b/rename", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"new_name":"nb"}`+"\n")
+		fmt.Fprint(w, `{"name":"nb"}`)
+	})
+
+	branch, _, err := client.Repositories.RenameBranch(context.Background(), "o", "r", "b", "nb")
+	if err != nil {
+		t.Errorf("Repositories.RenameBranch returned error: %v", err)
+	}
+
+	want := &Branch{Name: String("nb")}
+	if !reflect.DeepEqual(branch, want) {
+		t.Errorf("Repositories.RenameBranch returned %+v, want %+v", branch, want)
+	}
+}
+
 func TestRepositoriesService_GetBranchProtection(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
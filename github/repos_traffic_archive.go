@@ -0,0 +1,147 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kinds of traffic archived by TrafficArchiver.
+const (
+	TrafficKindViews  = "views"
+	TrafficKindClones = "clones"
+)
+
+// TrafficStore stores the daily traffic of repositories archived by a
+// TrafficArchiver. Repositories are named "owner/name", and kind is
+// TrafficKindViews or TrafficKindClones.
+type TrafficStore interface {
+	// LoadTraffic returns the points stored for kind of repo.
+	LoadTraffic(ctx context.Context, repo, kind string) ([]*TrafficData, error)
+
+	// SaveTraffic stores points for kind of repo, replacing any stored
+	// point with the same timestamp.
+	SaveTraffic(ctx context.Context, repo, kind string, points []*TrafficData) error
+}
+
+// TrafficArchiver retains the traffic of repositories beyond the 14 days
+// GitHub keeps, by fetching the daily views and clones periodically and
+// merging them into a TrafficStore.
+type TrafficArchiver struct {
+	client *Client
+	store  TrafficStore
+
+	// Interval is the time between two runs of Run. Default is a day,
+	// which leaves a margin of 13 days before data is lost.
+	Interval time.Duration
+
+	// OnError, if set, is called by Run when archiving a repository fails,
+	// and Run carries on. Otherwise, Run stops with the error.
+	OnError func(repo string, err error)
+}
+
+// NewTrafficArchiver returns a TrafficArchiver merging the traffic fetched
+// with client into store.
+func NewTrafficArchiver(client *Client, store TrafficStore) *TrafficArchiver {
+	return &TrafficArchiver{client: client, store: store}
+}
+
+// Run archives the traffic of repos, named "owner/name", right away and then
+// every Interval, until ctx is done or archiving fails.
+func (a *TrafficArchiver) Run(ctx context.Context, repos []string) error {
+	interval := a.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	for {
+		for _, repo := range repos {
+			if err := a.Archive(ctx, repo); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if a.OnError == nil {
+					return err
+				}
+				a.OnError(repo, err)
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Archive fetches the daily views and clones of repo, named "owner/name",
+// and saves the points that are missing from the store or changed since they
+// were stored, such as today's, which grows until the day ends.
+func (a *TrafficArchiver) Archive(ctx context.Context, repo string) error {
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("github: invalid repository %q, want owner/name", repo)
+	}
+	owner, name := parts[0], parts[1]
+	opt := &TrafficBreakdownOptions{Per: "day"}
+
+	var views *TrafficViews
+	err := retryOnRateLimit(ctx, func() (err error) {
+		views, _, err = a.client.Repositories.ListTrafficViews(ctx, owner, name, opt)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := a.merge(ctx, repo, TrafficKindViews, views.Views); err != nil {
+		return err
+	}
+
+	var clones *TrafficClones
+	err = retryOnRateLimit(ctx, func() (err error) {
+		clones, _, err = a.client.Repositories.ListTrafficClones(ctx, owner, name, opt)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return a.merge(ctx, repo, TrafficKindClones, clones.Clones)
+}
+
+// merge saves the points of fetched that differ from those stored.
+func (a *TrafficArchiver) merge(ctx context.Context, repo, kind string, fetched []*TrafficData) error {
+	stored, err := a.store.LoadTraffic(ctx, repo, kind)
+	if err != nil {
+		return err
+	}
+	byTime := make(map[int64]*TrafficData, len(stored))
+	for _, p := range stored {
+		byTime[p.GetTimestamp().Unix()] = p
+	}
+
+	var points []*TrafficData
+	for _, p := range fetched {
+		if p.Timestamp == nil {
+			continue
+		}
+		old := byTime[p.GetTimestamp().Unix()]
+		if old != nil && old.GetCount() == p.GetCount() && old.GetUniques() == p.GetUniques() {
+			continue
+		}
+		byTime[p.GetTimestamp().Unix()] = p
+		points = append(points, p)
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	return a.store.SaveTraffic(ctx, repo, kind, points)
+}
@@ -0,0 +1,142 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// memoryTrafficStore is a TrafficStore keeping points in memory.
+type memoryTrafficStore struct {
+	points map[string][]*TrafficData
+	saves  int
+}
+
+func (s *memoryTrafficStore) LoadTraffic(ctx context.Context, repo, kind string) ([]*TrafficData, error) {
+	return s.points[repo+" "+kind], nil
+}
+
+func (s *memoryTrafficStore) SaveTraffic(ctx context.Context, repo, kind string, points []*TrafficData) error {
+	s.saves++
+	key := repo + " " + kind
+	for _, p := range points {
+		replaced := false
+		for i, old := range s.points[key] {
+			if old.GetTimestamp().Equal(p.GetTimestamp()) {
+				s.points[key][i] = p
+				replaced = true
+			}
+		}
+		if !replaced {
+			s.points[key] = append(s.points[key], p)
+		}
+	}
+	return nil
+}
+
+func TestTrafficArchiver_Archive(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	// The windows of two successive fetches overlap on the 2nd, whose
+	// count grew since the first fetch.
+	windows := []string{
+		`[{"timestamp":"2019-05-01T00:00:00Z","count":7,"uniques":6},{"timestamp":"2019-05-02T00:00:00Z","count":1,"uniques":1}]`,
+		`[{"timestamp":"2019-05-02T00:00:00Z","count":4,"uniques":2},{"timestamp":"2019-05-03T00:00:00Z","count":3,"uniques":3}]`,
+	}
+	fetch := 0
+	mux.HandleFunc("/repos/o/r/traffic/views", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per": "day"})
+		fmt.Fprintf(w, `{"views":%v}`, windows[fetch])
+	})
+	mux.HandleFunc("/repos/o/r/traffic/clones", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"clones":%v}`, windows[fetch])
+		fetch++
+	})
+
+	store := &memoryTrafficStore{points: make(map[string][]*TrafficData)}
+	archiver := NewTrafficArchiver(client, store)
+	for range windows {
+		if err := archiver.Archive(context.Background(), "o/r"); err != nil {
+			t.Fatalf("Archive returned error: %v", err)
+		}
+	}
+	// Nothing changed since the last fetch, so nothing is saved.
+	fetch--
+	if err := archiver.Archive(context.Background(), "o/r"); err != nil {
+		t.Fatalf("Archive returned error: %v", err)
+	}
+
+	day := func(d int) *Timestamp {
+		return &Timestamp{time.Date(2019, time.May, d, 0, 0, 0, 0, time.UTC)}
+	}
+	want := []*TrafficData{
+		{Timestamp: day(1), Count: Int(7), Uniques: Int(6)},
+		{Timestamp: day(2), Count: Int(4), Uniques: Int(2)},
+		{Timestamp: day(3), Count: Int(3), Uniques: Int(3)},
+	}
+	for _, kind := range []string{TrafficKindViews, TrafficKindClones} {
+		if got := store.points["o/r "+kind]; !reflect.DeepEqual(got, want) {
+			t.Errorf("Archive stored %v %+v, want %+v", kind, got, want)
+		}
+	}
+	if store.saves != 4 {
+		t.Errorf("Archive saved %v times, want 4", store.saves)
+	}
+}
+
+func TestTrafficArchiver_Archive_invalidRepo(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	archiver := NewTrafficArchiver(client, &memoryTrafficStore{})
+	if err := archiver.Archive(context.Background(), "o"); err == nil {
+		t.Error("Archive returned no error for an invalid repository")
+	}
+}
+
+func TestTrafficArchiver_Run(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/traffic/views", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"views":[]}`)
+	})
+	mux.HandleFunc("/repos/o/r/traffic/clones", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"clones":[]}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	archiver := NewTrafficArchiver(client, &memoryTrafficStore{})
+	archiver.Interval = time.Millisecond
+	var failed []string
+	archiver.OnError = func(repo string, err error) {
+		failed = append(failed, repo)
+		if len(failed) == 2 {
+			cancel()
+		}
+	}
+
+	if err := archiver.Run(ctx, []string{"o/r", "bad"}); err != context.Canceled {
+		t.Errorf("Run returned %v, want %v", err, context.Canceled)
+	}
+	if want := []string{"bad", "bad"}; !reflect.DeepEqual(failed, want) {
+		t.Errorf("Run reported failures of %v, want %v", failed, want)
+	}
+
+	archiver.OnError = nil
+	if err := archiver.Run(context.Background(), []string{"bad"}); err == nil || errors.Is(err, context.Canceled) {
+		t.Errorf("Run returned %v, want the error archiving the repository", err)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryBackoff    = 1 * time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RequestPolicy overrides how Client.Do handles the requests made with a
+// context, so that calls with different needs can share one Client. For
+// example, a long-running download can be given a generous timeout, while
+// quick metadata calls are retried on server errors:
+//
+//	ctx := github.WithRequestPolicy(ctx, &github.RequestPolicy{
+//		Timeout: 5 * time.Second,
+//		Retry:   &github.RetryPolicy{MaxRetries: 3},
+//	})
+//	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+type RequestPolicy struct {
+	// Timeout bounds the total duration of the request, including retries.
+	// Zero means no timeout beyond that of the context.
+	Timeout time.Duration
+
+	// Retry controls retries of failed requests. Nil means no retries.
+	Retry *RetryPolicy
+
+	// Reserve is the number of requests of the rate limit that this request
+	// must leave for others. If the last known remaining rate limit of the
+	// request's category is at or below Reserve, Do returns a
+	// *RateReserveError without making a network call. This lets
+	// low-priority background work yield to interactive calls.
+	Reserve int
+
+	// RetainRawBody makes Do keep the raw body of the response in
+	// Response.RawBody, like Client.RetainRawBody.
+	RetainRawBody bool
+
+	// ReportUnknownFields makes Do list the JSON fields of the response that
+	// were ignored while decoding it in Response.UnknownFields, like
+	// Client.ReportUnknownFields.
+	ReportUnknownFields bool
+
+	// UseNumber makes Do decode the JSON numbers of the response into
+	// interface{} values as json.Number, like Client.UseNumber.
+	UseNumber bool
+}
+
+// RetryPolicy controls how failed requests are retried. Requests whose body
+// cannot be replayed are never retried. If the Client has a
+// RetryCoordinator, retries also draw from its shared retry budget.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// Backoff is the delay before the first retry, doubled for each
+	// subsequent retry up to MaxBackoff. They default to 1 and 30 seconds.
+	// An *AbuseRateLimitError with a RetryAfter duration overrides them.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// RetryOn reports whether a failed request should be retried. If nil,
+	// requests are retried on network errors, 5xx responses and abuse rate
+	// limit errors.
+	RetryOn func(resp *Response, err error) bool
+}
+
+// RateReserveError occurs when a request is refused locally because the
+// remaining rate limit is within the Reserve of its RequestPolicy.
+type RateReserveError struct {
+	Rate    Rate // Rate specifies last known rate limit for the client
+	Reserve int  // Reserve is the reserve requested by the RequestPolicy
+}
+
+func (e *RateReserveError) Error() string {
+	return fmt.Sprintf("remaining API rate limit of %v is within reserve of %v until %v, not making remote request", e.Rate.Remaining, e.Reserve, e.Rate.Reset.Time)
+}
+
+type requestPolicyKey struct{}
+
+// WithRequestPolicy returns a copy of ctx that makes Client.Do apply p to
+// requests made with it.
+func WithRequestPolicy(ctx context.Context, p *RequestPolicy) context.Context {
+	return context.WithValue(ctx, requestPolicyKey{}, p)
+}
+
+// RequestPolicyFromContext returns the RequestPolicy attached to ctx by
+// WithRequestPolicy, or nil.
+func RequestPolicyFromContext(ctx context.Context) *RequestPolicy {
+	p, _ := ctx.Value(requestPolicyKey{}).(*RequestPolicy)
+	return p
+}
+
+// retainRawBody reports whether p asks for raw response bodies to be kept.
+func (p *RequestPolicy) retainRawBody() bool {
+	return p != nil && p.RetainRawBody
+}
+
+// reportUnknownFields reports whether p asks for unknown response fields to
+// be listed.
+func (p *RequestPolicy) reportUnknownFields() bool {
+	return p != nil && p.ReportUnknownFields
+}
+
+// useNumber reports whether p asks for JSON numbers to be decoded as
+// json.Number.
+func (p *RequestPolicy) useNumber() bool {
+	return p != nil && p.UseNumber
+}
+
+// doWithPolicy sends an API request like Do, applying p.
+func (c *Client) doWithPolicy(ctx context.Context, req *http.Request, v interface{}, p *RequestPolicy) (*Response, error) {
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	if err := c.checkRateReserve(req, p.Reserve); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doCoordinated(ctx, req, v)
+		if err == nil || !p.Retry.shouldRetry(attempt, resp, err) || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+		if !c.RetryCoordinator.takeRetry() {
+			return resp, err
+		}
+
+		timer := time.NewTimer(p.Retry.backoff(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, bodyErr
+			}
+			req.Body = body
+		}
+	}
+}
+
+// checkRateReserve returns a *RateReserveError if the last known remaining
+// rate limit for req is at or below reserve.
+func (c *Client) checkRateReserve(req *http.Request, reserve int) error {
+	if reserve <= 0 {
+		return nil
+	}
+
+	c.rateMu.Lock()
+	rate := c.rateLimits[category(req.URL.Path)]
+	c.rateMu.Unlock()
+	if !rate.Reset.Time.IsZero() && rate.Remaining <= reserve && time.Now().Before(rate.Reset.Time) {
+		return &RateReserveError{Rate: rate, Reserve: reserve}
+	}
+	return nil
+}
+
+// shouldRetry reports whether a request that failed on the given attempt
+// (starting at 0) should be retried.
+func (p *RetryPolicy) shouldRetry(attempt int, resp *Response, err error) bool {
+	if p == nil || attempt >= p.MaxRetries {
+		return false
+	}
+	if p.RetryOn != nil {
+		return p.RetryOn(resp, err)
+	}
+
+	switch err.(type) {
+	case *AbuseRateLimitError:
+		return true
+	case *RateLimitError:
+		return false
+	}
+	if err == context.Canceled || err == context.DeadlineExceeded {
+		return false
+	}
+	if resp == nil || resp.Response == nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// backoff returns the delay before retrying a request that failed on the
+// given attempt (starting at 0) with err.
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	if e, ok := err.(*AbuseRateLimitError); ok && e.RetryAfter != nil {
+		return *e.RetryAfter
+	}
+
+	d, max := p.Backoff, p.MaxBackoff
+	if d <= 0 {
+		d = defaultRetryBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+	for i := 0; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
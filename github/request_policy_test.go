@@ -0,0 +1,177 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDo_requestPolicyRetry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"t"}`+"\n")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{
+		Retry: &RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond},
+	})
+	issue, _, err := client.Issues.Create(ctx, "o", "r", &IssueRequest{Title: String("t")})
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if got, want := issue.GetNumber(), 1; got != want {
+		t.Errorf("Issues.Create returned number %v, want %v", got, want)
+	}
+	if calls != 3 {
+		t.Errorf("server was called %v times, want 3", calls)
+	}
+}
+
+func TestDo_requestPolicyRetryExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{
+		Retry: &RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond},
+	})
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(ctx, req, nil)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Do returned error %#v, want *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Do returned status %v, want %v", resp.StatusCode, http.StatusInternalServerError)
+	}
+	if calls != 2 {
+		t.Errorf("server was called %v times, want 2", calls)
+	}
+}
+
+func TestDo_requestPolicyRetryOn(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{
+		Retry: &RetryPolicy{
+			MaxRetries: 3,
+			RetryOn:    func(*Response, error) bool { return false },
+		},
+	})
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Error("Do returned nil error, want error")
+	}
+	if calls != 1 {
+		t.Errorf("server was called %v times, want 1", calls)
+	}
+}
+
+func TestDo_requestPolicyTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	done := make(chan struct{})
+	defer close(done)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{Timeout: 10 * time.Millisecond})
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Do returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestDo_requestPolicyReserve(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	reset := time.Now().UTC().Add(time.Minute).Round(time.Second)
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "5")
+		w.Header().Set(headerRateReset, fmt.Sprint(reset.Unix()))
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{Reserve: 5})
+
+	// The first request learns the rate limit.
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("first Do returned error: %v", err)
+	}
+
+	req, _ = client.NewRequest("GET", ".", nil)
+	_, err := client.Do(ctx, req, nil)
+	rerr, ok := err.(*RateReserveError)
+	if !ok {
+		t.Fatalf("second Do returned error %#v, want *RateReserveError", err)
+	}
+	if rerr.Reserve != 5 || rerr.Rate.Remaining != 5 {
+		t.Errorf("RateReserveError = %+v, want Reserve 5 and Rate.Remaining 5", rerr)
+	}
+	if calls != 1 {
+		t.Errorf("server was called %v times, want 1", calls)
+	}
+
+	// Requests without a reserve are still made.
+	req, _ = client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do without policy returned error: %v", err)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	err := errors.New("e")
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.backoff(attempt, err); got != want {
+			t.Errorf("backoff(%v) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	retryAfter := 42 * time.Second
+	if got := p.backoff(0, &AbuseRateLimitError{RetryAfter: &retryAfter}); got != retryAfter {
+		t.Errorf("backoff with RetryAfter = %v, want %v", got, retryAfter)
+	}
+
+	if got := new(RetryPolicy).backoff(0, err); got != defaultRetryBackoff {
+		t.Errorf("default backoff = %v, want %v", got, defaultRetryBackoff)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultAbusePause is how long requests are held back after an abuse rate
+// limit error that does not say when to retry.
+var defaultAbusePause = time.Minute
+
+// RetryCoordinator coordinates the retries of goroutines sharing a Client,
+// so that concurrent use degrades gracefully instead of every goroutine
+// retrying on its own:
+//
+// After a request hits an abuse rate limit, all requests made through the
+// Client wait for the time GitHub asks for. Then a single request is let
+// through to probe the limit; the others are held back until it succeeds.
+//
+// Retries made by a RetryPolicy draw from a token bucket shared by all
+// requests. When it is empty, failed requests are not retried.
+//
+// Set Client.RetryCoordinator to a RetryCoordinator created by
+// NewRetryCoordinator to enable it.
+type RetryCoordinator struct {
+	burst  int
+	refill time.Duration
+
+	mu         sync.Mutex
+	tokens     int
+	lastRefill time.Time
+	until      time.Time     // requests wait until then
+	probe      bool          // a probe must be let through before the others
+	probeDone  chan struct{} // closed when the probe in flight completes, if any
+}
+
+// NewRetryCoordinator returns a RetryCoordinator whose retry budget holds up
+// to burst retries and regains one retry every refill.
+func NewRetryCoordinator(burst int, refill time.Duration) *RetryCoordinator {
+	return &RetryCoordinator{
+		burst:      burst,
+		refill:     refill,
+		tokens:     burst,
+		lastRefill: time.Now(),
+	}
+}
+
+// takeRetry reports whether the retry budget allows one more retry, and
+// consumes it if so. A nil RetryCoordinator allows all retries.
+func (rc *RetryCoordinator) takeRetry() bool {
+	if rc == nil {
+		return true
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.refill > 0 {
+		if n := int(time.Since(rc.lastRefill) / rc.refill); n > 0 {
+			rc.tokens += n
+			rc.lastRefill = rc.lastRefill.Add(time.Duration(n) * rc.refill)
+		}
+	}
+	if rc.tokens > rc.burst {
+		rc.tokens = rc.burst
+	}
+	if rc.tokens <= 0 {
+		return false
+	}
+	rc.tokens--
+	return true
+}
+
+// wait blocks until a request may be sent. The returned function must be
+// called once the request completes.
+func (rc *RetryCoordinator) wait(ctx context.Context) (release func(), err error) {
+	for {
+		rc.mu.Lock()
+		var done <-chan struct{}
+		if d := time.Until(rc.until); d > 0 {
+			rc.mu.Unlock()
+			timer := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+			continue
+		}
+
+		switch {
+		case rc.probeDone != nil:
+			done = rc.probeDone
+		case rc.probe:
+			rc.probe = false
+			probeDone := make(chan struct{})
+			rc.probeDone = probeDone
+			rc.mu.Unlock()
+			return func() {
+				rc.mu.Lock()
+				rc.probeDone = nil
+				rc.mu.Unlock()
+				close(probeDone)
+			}, nil
+		default:
+			rc.mu.Unlock()
+			return func() {}, ctx.Err()
+		}
+		rc.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-done:
+		}
+	}
+}
+
+// observe holds back requests if err is an abuse rate limit error.
+func (rc *RetryCoordinator) observe(err error) {
+	aerr, ok := err.(*AbuseRateLimitError)
+	if !ok {
+		return
+	}
+
+	d := aerr.GetRetryAfter()
+	if d <= 0 {
+		d = defaultAbusePause
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if until := time.Now().Add(d); until.After(rc.until) {
+		rc.until = until
+	}
+	rc.probe = true
+}
+
+type retryCoordinatorKey struct{}
+
+// retryCoordinator returns the RetryCoordinator of the requests made with
+// ctx: the one attached by forEachConcurrently, or c.RetryCoordinator.
+func (c *Client) retryCoordinator(ctx context.Context) *RetryCoordinator {
+	if rc, ok := ctx.Value(retryCoordinatorKey{}).(*RetryCoordinator); ok {
+		return rc
+	}
+	return c.RetryCoordinator
+}
+
+// doCoordinated sends a single API request like do, coordinated by the
+// RetryCoordinator of ctx if any.
+func (c *Client) doCoordinated(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	rc := c.retryCoordinator(ctx)
+	if rc == nil {
+		return c.do(ctx, req, v)
+	}
+
+	release, err := rc.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, err := c.do(ctx, req, v)
+	rc.observe(err)
+	return resp, err
+}
+
+// abuseRetryPolicy retries the requests of forEachConcurrently that hit an
+// abuse rate limit. Their RetryCoordinator holds them back for as long as
+// GitHub asks, so the backoff itself is minimal.
+var abuseRetryPolicy = &RetryPolicy{
+	MaxRetries: maxRateLimitRetries,
+	Backoff:    time.Millisecond,
+	RetryOn: func(resp *Response, err error) bool {
+		_, ok := err.(*AbuseRateLimitError)
+		return ok
+	},
+}
+
+// forEachConcurrently calls fn for each index from 0 to n-1, with up to
+// concurrency calls running at the same time, 4 if concurrency is not
+// positive, and returns once they have all returned. It underlies the batch
+// methods, such as GetContentsBatch.
+//
+// The requests made with the context passed to fn are coordinated by the
+// RetryCoordinator of the client, or by one of their own if it has none:
+// when GitHub signals an abuse rate limit, they all wait for the time it
+// asks for, and the limited request is retried up to maxRateLimitRetries
+// times, unless a RequestPolicy of ctx sets its own retries. Other errors,
+// such as *RateLimitError once the primary rate limit is exhausted, are
+// returned as is.
+func (c *Client) forEachConcurrently(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int)) {
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	if c.retryCoordinator(ctx) == nil {
+		ctx = context.WithValue(ctx, retryCoordinatorKey{}, NewRetryCoordinator(0, 0))
+	}
+	var p RequestPolicy
+	if up := RequestPolicyFromContext(ctx); up != nil {
+		p = *up
+	}
+	if p.Retry == nil {
+		p.Retry = abuseRetryPolicy
+	}
+	ctx = WithRequestPolicy(ctx, &p)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(ctx, i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryCoordinator_takeRetry(t *testing.T) {
+	var nilRC *RetryCoordinator
+	if !nilRC.takeRetry() {
+		t.Error("nil RetryCoordinator refused a retry")
+	}
+
+	rc := NewRetryCoordinator(2, time.Hour)
+	for i, want := range []bool{true, true, false} {
+		if got := rc.takeRetry(); got != want {
+			t.Errorf("takeRetry #%v = %v, want %v", i, got, want)
+		}
+	}
+
+	rc.lastRefill = rc.lastRefill.Add(-3 * time.Hour)
+	for i, want := range []bool{true, true, false} {
+		if got := rc.takeRetry(); got != want {
+			t.Errorf("takeRetry #%v after refill = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestRetryCoordinator_abuseRateLimit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	defer func(d time.Duration) { defaultAbusePause = d }(defaultAbusePause)
+	defaultAbusePause = 20 * time.Millisecond
+	client.RetryCoordinator = NewRetryCoordinator(0, 0)
+
+	var calls, inFlight int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		cur := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		switch n {
+		case 1:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"abuse","documentation_url":"https://developer.github.com/v3/#abuse-rate-limits"}`)
+			return
+		case 2:
+			if cur != 1 {
+				t.Errorf("probe request ran with %v requests in flight, want 1", cur)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(context.Background(), req, nil); err == nil {
+		t.Fatal("Do returned nil error for an abuse rate limit")
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := client.NewRequest("GET", ".", nil)
+			if _, err := client.Do(context.Background(), req, nil); err != nil {
+				t.Errorf("Do returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < defaultAbusePause {
+		t.Errorf("requests were sent after %v, want them to wait %v", elapsed, defaultAbusePause)
+	}
+}
+
+func TestRetryCoordinator_retryBudget(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.RetryCoordinator = NewRetryCoordinator(1, time.Hour)
+
+	var calls int32
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{
+		Retry: &RetryPolicy{MaxRetries: 5, Backoff: time.Millisecond},
+	})
+	req, _ := client.NewRequest("GET", ".", nil)
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Error("Do returned nil error")
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Do made %v requests, want 2", got)
+	}
+}
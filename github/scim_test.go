@@ -0,0 +1,194 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSCIMService_ListSCIMProvisionedIdentities(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"startIndex": "1", "count": "10", "filter": `userName eq "Octocat"`})
+		fmt.Fprint(w, `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+			"totalResults": 1,
+			"itemsPerPage": 1,
+			"startIndex": 1,
+			"Resources": [{
+				"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+				"id": "5fc0c238-1112-11e8-8e45-920c87bdbd75",
+				"externalId": "00u1dhhb1fkIGP7RL1d8",
+				"userName": "octocat@github.com",
+				"displayName": "Mona Octocat",
+				"name": {"givenName": "Mona", "familyName": "Octocat", "formatted": "Mona Octocat"},
+				"emails": [{"value": "octocat@github.com", "primary": true}],
+				"active": true,
+				"meta": {
+					"resourceType": "User",
+					"created": "2018-02-13T15:05:24Z",
+					"lastModified": "2018-02-13T15:05:55Z",
+					"location": "https://api.github.com/scim/v2/organizations/octo-org/Users/5fc0c238-1112-11e8-8e45-920c87bdbd75"
+				}
+			}]
+		}`)
+	})
+
+	opt := &ListSCIMProvisionedIdentitiesOptions{StartIndex: Int(1), Count: Int(10), Filter: String(`userName eq "Octocat"`)}
+	identities, _, err := client.SCIM.ListSCIMProvisionedIdentities(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("SCIM.ListSCIMProvisionedIdentities returned error: %v", err)
+	}
+
+	want := &SCIMProvisionedIdentities{
+		Schemas:      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+		TotalResults: Int(1),
+		ItemsPerPage: Int(1),
+		StartIndex:   Int(1),
+		Resources: []*SCIMUserAttributes{{
+			Schemas:     []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+			ID:          String("5fc0c238-1112-11e8-8e45-920c87bdbd75"),
+			ExternalID:  String("00u1dhhb1fkIGP7RL1d8"),
+			UserName:    "octocat@github.com",
+			DisplayName: String("Mona Octocat"),
+			Name:        SCIMUserName{GivenName: "Mona", FamilyName: "Octocat", Formatted: String("Mona Octocat")},
+			Emails:      []*SCIMUserEmail{{Value: "octocat@github.com", Primary: Bool(true)}},
+			Active:      Bool(true),
+			Meta: &SCIMMeta{
+				ResourceType: String("User"),
+				Created:      &Timestamp{time.Date(2018, time.February, 13, 15, 5, 24, 0, time.UTC)},
+				LastModified: &Timestamp{time.Date(2018, time.February, 13, 15, 5, 55, 0, time.UTC)},
+				Location:     String("https://api.github.com/scim/v2/organizations/octo-org/Users/5fc0c238-1112-11e8-8e45-920c87bdbd75"),
+			},
+		}},
+	}
+	if !reflect.DeepEqual(identities, want) {
+		t.Errorf("SCIM.ListSCIMProvisionedIdentities returned %+v, want %+v", identities, want)
+	}
+}
+
+func TestSCIMService_ProvisionAndInviteSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMUserAttributes{
+		UserName: "userName",
+		Name:     SCIMUserName{GivenName: "givenName", FamilyName: "familyName"},
+		Emails:   []*SCIMUserEmail{{Value: "octocat@github.com"}},
+	}
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users", func(w http.ResponseWriter, r *http.Request) {
+		v := new(SCIMUserAttributes)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"1234567890","userName":"userName"}`)
+	})
+
+	user, _, err := client.SCIM.ProvisionAndInviteSCIMUser(context.Background(), "o", input)
+	if err != nil {
+		t.Errorf("SCIM.ProvisionAndInviteSCIMUser returned error: %v", err)
+	}
+
+	want := &SCIMUserAttributes{ID: String("1234567890"), UserName: "userName"}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("SCIM.ProvisionAndInviteSCIMUser returned %+v, want %+v", user, want)
+	}
+}
+
+func TestSCIMService_GetSCIMProvisioningInfo(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":"123","userName":"octocat@github.com","active":false}`)
+	})
+
+	user, _, err := client.SCIM.GetSCIMProvisioningInfo(context.Background(), "o", "123")
+	if err != nil {
+		t.Errorf("SCIM.GetSCIMProvisioningInfo returned error: %v", err)
+	}
+
+	want := &SCIMUserAttributes{ID: String("123"), UserName: "octocat@github.com", Active: Bool(false)}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("SCIM.GetSCIMProvisioningInfo returned %+v, want %+v", user, want)
+	}
+}
+
+func TestSCIMService_UpdateProvisionedOrgMembership(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SCIMUserAttributes{
+		UserName: "userName",
+		Name:     SCIMUserName{GivenName: "givenName", FamilyName: "familyName"},
+		Emails:   []*SCIMUserEmail{{Value: "octocat@github.com"}},
+	}
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
+		v := new(SCIMUserAttributes)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if _, err := client.SCIM.UpdateProvisionedOrgMembership(context.Background(), "o", "123", input); err != nil {
+		t.Errorf("SCIM.UpdateProvisionedOrgMembership returned error: %v", err)
+	}
+}
+
+func TestSCIMService_UpdateAttributeForSCIMUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`+"\n")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	opt := &UpdateAttributeForSCIMUserOptions{
+		Schemas: []string{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		Operations: []*UpdateAttributeForSCIMUserOperations{
+			{Op: "replace", Path: String("active"), Value: json.RawMessage(`false`)},
+		},
+	}
+	if _, err := client.SCIM.UpdateAttributeForSCIMUser(context.Background(), "o", "123", opt); err != nil {
+		t.Errorf("SCIM.UpdateAttributeForSCIMUser returned error: %v", err)
+	}
+}
+
+func TestSCIMService_DeleteSCIMUserFromOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/scim/v2/organizations/o/Users/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.SCIM.DeleteSCIMUserFromOrg(context.Background(), "o", "123"); err != nil {
+		t.Errorf("SCIM.DeleteSCIMUserFromOrg returned error: %v", err)
+	}
+}
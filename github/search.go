@@ -249,9 +249,9 @@ func (s *SearchService) search(ctx context.Context, searchType string, parameter
 		// TODO: remove custom Accept header when this API fully launches.
 		req.Header.Set("Accept", mediaTypeLabelDescriptionSearchPreview)
 	case opt != nil && opt.TextMatch:
-		// Accept header defaults to "application/vnd.github.v3+json"
+		// Accept header defaults to MediaTypeJSON
 		// We change it here to fetch back text-match metadata
-		req.Header.Set("Accept", "application/vnd.github.v3.text-match+json")
+		req.Header.Set("Accept", MediaTypeTextMatch)
 	}
 
 	return s.client.Do(ctx, req, result)
@@ -0,0 +1,112 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// maxSearchResults is the maximum number of results GitHub returns for a
+// search, however many match.
+const maxSearchResults = 1000
+
+// CommitsByAuthorDate searches the commits matching query, such as
+// "repo:google/go-github", authored from the day of from to the day of to,
+// in UTC.
+//
+// To work around the limit of 1000 results per search, the date range is
+// split into smaller ranges until each matches at most 1000 commits, and the
+// results of all ranges are merged, without duplicates. Commits are sorted
+// by author date, newest first. A request that hits a rate limit is retried
+// once the limit resets.
+//
+// IncompleteResults is set in the result if a single day matches more than
+// 1000 commits, as the commits beyond the first 1000 of that day cannot be
+// retrieved, or if GitHub timed out while searching.
+//
+// GitHub API docs: https://developer.github.com/v3/search/#search-commits
+func (s *SearchService) CommitsByAuthorDate(ctx context.Context, query string, from, to time.Time) (*CommitsSearchResult, error) {
+	if from.IsZero() || to.IsZero() {
+		return nil, errors.New("github: from and to must be set")
+	}
+	from = from.UTC().Truncate(24 * time.Hour)
+	to = to.UTC().Truncate(24 * time.Hour)
+	if to.Before(from) {
+		return nil, errors.New("github: to must not be before from")
+	}
+
+	cs := &commitSearch{
+		s:      s,
+		query:  query,
+		seen:   make(map[string]bool),
+		result: &CommitsSearchResult{IncompleteResults: Bool(false)},
+	}
+	if err := cs.searchRange(ctx, from, to); err != nil {
+		return nil, err
+	}
+	cs.result.Total = Int(len(cs.result.Commits))
+	return cs.result, nil
+}
+
+// commitSearch holds the state of a CommitsByAuthorDate search.
+type commitSearch struct {
+	s      *SearchService
+	query  string
+	seen   map[string]bool // repository full name and SHA of commits found
+	result *CommitsSearchResult
+}
+
+// searchRange adds the commits authored from the day from to the day to,
+// newest first, splitting the range while it matches too many commits.
+func (cs *commitSearch) searchRange(ctx context.Context, from, to time.Time) error {
+	q := strings.TrimSpace(cs.query + " author-date:" + DateRange(from, to))
+	opt := &SearchOptions{Sort: "author-date", Order: "desc", ListOptions: ListOptions{PerPage: 100}}
+	for {
+		var result *CommitsSearchResult
+		var resp *Response
+		err := retryOnRateLimit(ctx, func() (err error) {
+			result, resp, err = cs.s.Commits(ctx, q, opt)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if opt.Page == 0 {
+			if result.GetTotal() > maxSearchResults && to.After(from) {
+				days := int(to.Sub(from) / (24 * time.Hour))
+				mid := from.AddDate(0, 0, days/2)
+				if err := cs.searchRange(ctx, mid.AddDate(0, 0, 1), to); err != nil {
+					return err
+				}
+				return cs.searchRange(ctx, from, mid)
+			}
+			if result.GetTotal() > maxSearchResults {
+				cs.result.IncompleteResults = Bool(true)
+			}
+		}
+		if result.GetIncompleteResults() {
+			cs.result.IncompleteResults = Bool(true)
+		}
+
+		for _, c := range result.Commits {
+			key := c.GetRepository().GetFullName() + "@" + c.GetSHA()
+			if cs.seen[key] {
+				continue
+			}
+			cs.seen[key] = true
+			cs.result.Commits = append(cs.result.Commits, c)
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
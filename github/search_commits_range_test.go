@@ -0,0 +1,85 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSearchService_CommitsByAuthorDate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var queries []string
+	mux.HandleFunc("/search/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.FormValue("sort") + " " + r.FormValue("order"); got != "author-date desc" {
+			t.Errorf("search sorted by %q, want author-date desc", got)
+		}
+		q := r.FormValue("q")
+		queries = append(queries, q)
+		switch q {
+		case "repo:o/r author-date:2019-01-01..2019-01-04", "repo:o/r author-date:2019-01-01..2019-01-02":
+			fmt.Fprint(w, `{"total_count":1500,"items":[{"sha":"x"}]}`)
+		case "repo:o/r author-date:2019-01-03..2019-01-04":
+			if r.FormValue("page") == "" {
+				w.Header().Set("Link", `<https://api.github.com/search/commits?page=2>; rel="next"`)
+				fmt.Fprint(w, `{"total_count":2,"items":[{"sha":"d","repository":{"full_name":"o/r"}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"total_count":2,"items":[{"sha":"c","repository":{"full_name":"o/r"}}]}`)
+		case "repo:o/r author-date:2019-01-02..2019-01-02":
+			fmt.Fprint(w, `{"total_count":2,"items":[{"sha":"c","repository":{"full_name":"o/r"}},{"sha":"b","repository":{"full_name":"o/r"}}]}`)
+		case "repo:o/r author-date:2019-01-01..2019-01-01":
+			fmt.Fprint(w, `{"total_count":1200,"items":[{"sha":"a","repository":{"full_name":"o/r"}}]}`)
+		default:
+			t.Errorf("unexpected query %q", q)
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	from := time.Date(2019, time.January, 1, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2019, time.January, 4, 0, 0, 0, 0, time.UTC)
+	result, err := client.Search.CommitsByAuthorDate(context.Background(), "repo:o/r", from, to)
+	if err != nil {
+		t.Fatalf("Search.CommitsByAuthorDate returned error: %v", err)
+	}
+
+	var shas []string
+	for _, c := range result.Commits {
+		shas = append(shas, c.GetSHA())
+	}
+	if got, want := fmt.Sprint(shas), "[d c b a]"; got != want {
+		t.Errorf("Search.CommitsByAuthorDate returned commits %v, want %v", got, want)
+	}
+	if result.GetTotal() != 4 {
+		t.Errorf("Search.CommitsByAuthorDate returned total %v, want 4", result.GetTotal())
+	}
+	if !result.GetIncompleteResults() {
+		t.Error("Search.CommitsByAuthorDate returned complete results, want incomplete")
+	}
+	if len(queries) != 6 {
+		t.Errorf("Search.CommitsByAuthorDate made %v searches, want 6: %q", len(queries), queries)
+	}
+}
+
+func TestSearchService_CommitsByAuthorDate_invalidRange(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	from := time.Date(2019, time.January, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.Search.CommitsByAuthorDate(context.Background(), "q", from, to); err == nil {
+		t.Error("Search.CommitsByAuthorDate returned no error for an inverted range")
+	}
+	if _, err := client.Search.CommitsByAuthorDate(context.Background(), "q", time.Time{}, to); err == nil {
+		t.Error("Search.CommitsByAuthorDate returned no error for a zero from")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SearchQuery builds a search query string from keywords and qualifiers,
+// quoting values as needed. For example,
+//
+//	q := &github.SearchQuery{
+//		Keywords: []string{"crash"},
+//		Qualifiers: map[string][]string{
+//			"is":      {"issue", "open"},
+//			"repo":    {"google/go-github"},
+//			"label":   {"help wanted"},
+//			"created": {github.DateRange(since, time.Time{})},
+//		},
+//	}
+//
+// produces
+//
+//	crash created:>=2019-01-01 is:issue is:open label:"help wanted" repo:google/go-github
+//
+// Prefix a qualifier with "-" to exclude matching results, as in "-label".
+type SearchQuery struct {
+	// Keywords are searched for as is, and are not quoted.
+	Keywords []string
+
+	// Qualifiers maps a qualifier name, such as "repo" or "label", to its
+	// values. A qualifier with several values is repeated.
+	Qualifiers map[string][]string
+}
+
+// Add appends a value for the qualifier name and returns q, so that calls
+// can be chained.
+func (q *SearchQuery) Add(name, value string) *SearchQuery {
+	if q.Qualifiers == nil {
+		q.Qualifiers = make(map[string][]string)
+	}
+	q.Qualifiers[name] = append(q.Qualifiers[name], value)
+	return q
+}
+
+// String returns the query string. Qualifiers are sorted by name, so the
+// result is deterministic.
+func (q *SearchQuery) String() string {
+	var parts []string
+	for _, k := range q.Keywords {
+		if k = strings.TrimSpace(k); k != "" {
+			parts = append(parts, k)
+		}
+	}
+
+	names := make([]string, 0, len(q.Qualifiers))
+	for name := range q.Qualifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, v := range q.Qualifiers[name] {
+			parts = append(parts, name+":"+quoteSearchValue(v))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// quoteSearchValue quotes v if it contains whitespace or quotes, or is
+// empty, escaping any quotes and backslashes it contains.
+func quoteSearchValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, " \t\r\n\"\\") {
+		return v
+	}
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(v) + `"`
+}
+
+// DateRange formats a date range for qualifiers such as "created" or
+// "updated". A zero from or to leaves that end of the range open.
+func DateRange(from, to time.Time) string {
+	const layout = "2006-01-02"
+	switch {
+	case from.IsZero() && to.IsZero():
+		return "*"
+	case to.IsZero():
+		return ">=" + from.Format(layout)
+	case from.IsZero():
+		return "<=" + to.Format(layout)
+	}
+	return from.Format(layout) + ".." + to.Format(layout)
+}
+
+// IssuesByQualifiers searches issues and pull requests with the query built
+// by q. See Issues.
+//
+// GitHub API docs: https://developer.github.com/v3/search/#search-issues
+func (s *SearchService) IssuesByQualifiers(ctx context.Context, q *SearchQuery, opt *SearchOptions) (*IssuesSearchResult, *Response, error) {
+	return s.Issues(ctx, q.String(), opt)
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchQuery_String(t *testing.T) {
+	since := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2019, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		query *SearchQuery
+		want  string
+	}{
+		{&SearchQuery{}, ""},
+		{&SearchQuery{Keywords: []string{"crash", " "}}, "crash"},
+		{
+			&SearchQuery{
+				Keywords: []string{"crash"},
+				Qualifiers: map[string][]string{
+					"repo":    {"google/go-github"},
+					"is":      {"issue", "open"},
+					"label":   {"help wanted"},
+					"-author": {"octocat"},
+				},
+			},
+			`crash -author:octocat is:issue is:open label:"help wanted" repo:google/go-github`,
+		},
+		{
+			new(SearchQuery).Add("label", `say "hi"`).Add("label", `a\b`).Add("label", ""),
+			`label:"say \"hi\"" label:"a\\b" label:""`,
+		},
+		{new(SearchQuery).Add("created", DateRange(since, until)), "created:2019-01-01..2019-02-01"},
+		{new(SearchQuery).Add("created", DateRange(since, time.Time{})), "created:>=2019-01-01"},
+		{new(SearchQuery).Add("created", DateRange(time.Time{}, until)), "created:<=2019-02-01"},
+		{new(SearchQuery).Add("created", DateRange(time.Time{}, time.Time{})), "created:*"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.query.String(); got != tt.want {
+			t.Errorf("SearchQuery.String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestSearchService_IssuesByQualifiers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"q":        `label:"help wanted" repo:o/r`,
+			"sort":     "created",
+			"order":    "asc",
+			"page":     "2",
+			"per_page": "2",
+		})
+
+		fmt.Fprint(w, `{"total_count": 1, "incomplete_results": false, "items": [{"number":1}]}`)
+	})
+
+	q := new(SearchQuery).Add("repo", "o/r").Add("label", "help wanted")
+	opts := &SearchOptions{Sort: "created", Order: "asc", ListOptions: ListOptions{Page: 2, PerPage: 2}}
+	result, _, err := client.Search.IssuesByQualifiers(context.Background(), q, opts)
+	if err != nil {
+		t.Errorf("Search.IssuesByQualifiers returned error: %v", err)
+	}
+
+	want := &IssuesSearchResult{
+		Total:             Int(1),
+		IncompleteResults: Bool(false),
+		Issues:            []Issue{{Number: Int(1)}},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Search.IssuesByQualifiers returned %+v, want %+v", result, want)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// PublicKey represents the public key that should be used to encrypt secrets.
+type PublicKey struct {
+	KeyID *string `json:"key_id,omitempty"`
+	Key   *string `json:"key,omitempty"`
+}
+
+func (p PublicKey) String() string {
+	return Stringify(p)
+}
+
+// Secret represents a repository or organization secret.
+// The value of a secret is never returned by the GitHub API.
+type Secret struct {
+	Name      *string    `json:"name,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+	// Visibility is only set for organization secrets.
+	// Possible values are: "all", "private" and "selected".
+	Visibility              *string `json:"visibility,omitempty"`
+	SelectedRepositoriesURL *string `json:"selected_repositories_url,omitempty"`
+}
+
+func (s Secret) String() string {
+	return Stringify(s)
+}
+
+// Secrets represents one item from the ListSecrets response.
+type Secrets struct {
+	TotalCount int       `json:"total_count"`
+	Secrets    []*Secret `json:"secrets"`
+}
+
+// EncryptedSecret represents a secret that is encrypted using a public key.
+//
+// The value of EncryptedValue must be your secret, encrypted with
+// LibSodium (see documentation here: https://libsodium.gitbook.io/doc/bindings_for_other_languages)
+// using the public key retrieved using the GetPublicKey method. Use
+// EncryptSecret to build an EncryptedSecret from a plaintext value.
+type EncryptedSecret struct {
+	Name           string `json:"-"`
+	KeyID          string `json:"key_id"`
+	EncryptedValue string `json:"encrypted_value"`
+	// Visibility and SelectedRepositoryIDs are only used for organization secrets.
+	// Possible values for Visibility are: "all", "private" and "selected".
+	Visibility            string          `json:"visibility,omitempty"`
+	SelectedRepositoryIDs SelectedRepoIDs `json:"selected_repository_ids,omitempty"`
+}
+
+// SelectedRepoIDs are the repository IDs that have access to an organization secret.
+type SelectedRepoIDs []int64
+
+// SelectedReposList represents the list of repositories that have access to
+// an organization secret.
+type SelectedReposList struct {
+	TotalCount   *int          `json:"total_count,omitempty"`
+	Repositories []*Repository `json:"repositories,omitempty"`
+}
+
+// EncryptSecret encrypts value using key with a libsodium sealed box and
+// returns an EncryptedSecret ready to be passed to one of the
+// CreateOrUpdate*Secret methods.
+func EncryptSecret(key *PublicKey, name, value string) (*EncryptedSecret, error) {
+	if key == nil || key.Key == nil || key.KeyID == nil {
+		return nil, errors.New("github: public key and key ID must be set")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(*key.Key)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 32 {
+		return nil, errors.New("github: public key must be 32 bytes long")
+	}
+
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+
+	sealed, err := sealAnonymous(rand.Reader, []byte(value), &recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EncryptedSecret{
+		Name:           name,
+		KeyID:          *key.KeyID,
+		EncryptedValue: base64.StdEncoding.EncodeToString(sealed),
+	}, nil
+}
+
+// sealAnonymous implements libsodium's crypto_box_seal. The message is
+// encrypted for recipient with an ephemeral key pair, whose public half is
+// prepended to the returned ciphertext.
+func sealAnonymous(rand io.Reader, message []byte, recipient *[32]byte) ([]byte, error) {
+	ephemeralPublic, ephemeralPrivate, err := box.GenerateKey(rand)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := sealNonce(ephemeralPublic, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return box.Seal(ephemeralPublic[:], message, nonce, recipient, ephemeralPrivate), nil
+}
+
+// sealNonce derives the sealed box nonce as blake2b(ephemeralPublic || recipient).
+func sealNonce(ephemeralPublic, recipient *[32]byte) (*[24]byte, error) {
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(ephemeralPublic[:])
+	h.Write(recipient[:])
+
+	var nonce [24]byte
+	copy(nonce[:], h.Sum(nil))
+	return &nonce, nil
+}
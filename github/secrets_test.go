@@ -0,0 +1,66 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestEncryptSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey returned error: %v", err)
+	}
+
+	key := &PublicKey{
+		KeyID: String("1234"),
+		Key:   String(base64.StdEncoding.EncodeToString(publicKey[:])),
+	}
+	secret, err := EncryptSecret(key, "NAME", "s3cr3t")
+	if err != nil {
+		t.Fatalf("EncryptSecret returned error: %v", err)
+	}
+	if secret.Name != "NAME" || secret.KeyID != "1234" {
+		t.Errorf("EncryptSecret returned %+v, want Name NAME and KeyID 1234", secret)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(secret.EncryptedValue)
+	if err != nil {
+		t.Fatalf("EncryptedValue is not base64: %v", err)
+	}
+
+	var ephemeralPublic [32]byte
+	copy(ephemeralPublic[:], sealed[:32])
+	nonce, err := sealNonce(&ephemeralPublic, publicKey)
+	if err != nil {
+		t.Fatalf("sealNonce returned error: %v", err)
+	}
+	plain, ok := box.Open(nil, sealed[32:], nonce, &ephemeralPublic, privateKey)
+	if !ok {
+		t.Fatal("box.Open failed to decrypt sealed secret")
+	}
+	if got, want := string(plain), "s3cr3t"; got != want {
+		t.Errorf("decrypted secret = %q, want %q", got, want)
+	}
+}
+
+func TestEncryptSecret_invalidKey(t *testing.T) {
+	tests := []*PublicKey{
+		nil,
+		{KeyID: String("1")},
+		{KeyID: String("1"), Key: String("not base64!")},
+		{KeyID: String("1"), Key: String(base64.StdEncoding.EncodeToString([]byte("short")))},
+	}
+	for _, key := range tests {
+		if _, err := EncryptSecret(key, "n", "v"); err == nil {
+			t.Errorf("EncryptSecret(%v) returned no error", key)
+		}
+	}
+}
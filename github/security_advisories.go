@@ -0,0 +1,380 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SecurityAdvisoriesService handles communication with the security advisory
+// related methods of the GitHub API.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories
+type SecurityAdvisoriesService service
+
+// RepoAdvisoryCredit represents the credit given to a user for a repository
+// security advisory.
+type RepoAdvisoryCredit struct {
+	Login *string `json:"login,omitempty"`
+	// Type is the type of credit the user is receiving.
+	// Possible values are: "analyst", "finder", "reporter", "coordinator",
+	// "remediation_developer", "remediation_reviewer", "remediation_verifier",
+	// "tool", "sponsor" and "other".
+	Type *string `json:"type,omitempty"`
+}
+
+// RepoAdvisoryCreditDetailed represents a credit given to a user for a
+// repository security advisory, along with the user and credit state.
+type RepoAdvisoryCreditDetailed struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+	// State is the state of the user's acceptance of the credit.
+	// Possible values are: "accepted", "declined" and "pending".
+	State *string `json:"state,omitempty"`
+}
+
+// SecurityAdvisorySubmission represents the submission details of a
+// privately reported security advisory.
+type SecurityAdvisorySubmission struct {
+	// Accepted represents whether a private vulnerability report was accepted
+	// by the repository's administrators.
+	Accepted *bool `json:"accepted,omitempty"`
+}
+
+// SecurityAdvisory represents a repository security advisory.
+type SecurityAdvisory struct {
+	GHSAID          *string                  `json:"ghsa_id,omitempty"`
+	CVEID           *string                  `json:"cve_id,omitempty"`
+	URL             *string                  `json:"url,omitempty"`
+	HTMLURL         *string                  `json:"html_url,omitempty"`
+	Summary         *string                  `json:"summary,omitempty"`
+	Description     *string                  `json:"description,omitempty"`
+	Severity        *string                  `json:"severity,omitempty"`
+	CVSS            *AdvisoryCVSS            `json:"cvss,omitempty"`
+	CWEs            []*AdvisoryCWE           `json:"cwes,omitempty"`
+	CWEIDs          []string                 `json:"cwe_ids,omitempty"`
+	Identifiers     []*AdvisoryIdentifier    `json:"identifiers,omitempty"`
+	References      []*AdvisoryReference     `json:"references,omitempty"`
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+	Author          *User                    `json:"author,omitempty"`
+	Publisher       *User                    `json:"publisher,omitempty"`
+	// State is the state of the advisory.
+	// Possible values are: "published", "closed", "withdrawn", "draft" and "triage".
+	State              *string                       `json:"state,omitempty"`
+	CreatedAt          *Timestamp                    `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp                    `json:"updated_at,omitempty"`
+	PublishedAt        *Timestamp                    `json:"published_at,omitempty"`
+	ClosedAt           *Timestamp                    `json:"closed_at,omitempty"`
+	WithdrawnAt        *Timestamp                    `json:"withdrawn_at,omitempty"`
+	Submission         *SecurityAdvisorySubmission   `json:"submission,omitempty"`
+	Credits            []*RepoAdvisoryCredit         `json:"credits,omitempty"`
+	CreditsDetailed    []*RepoAdvisoryCreditDetailed `json:"credits_detailed,omitempty"`
+	CollaboratingUsers []*User                       `json:"collaborating_users,omitempty"`
+	CollaboratingTeams []*Team                       `json:"collaborating_teams,omitempty"`
+	PrivateFork        *Repository                   `json:"private_fork,omitempty"`
+}
+
+func (s SecurityAdvisory) String() string {
+	return Stringify(s)
+}
+
+// SecurityAdvisoryRequest represents the request body to create a repository
+// security advisory.
+type SecurityAdvisoryRequest struct {
+	// Summary and Description are required.
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	// Vulnerabilities lists the products affected by the advisory. (Required.)
+	Vulnerabilities []*AdvisoryVulnerability `json:"vulnerabilities"`
+
+	CVEID   *string               `json:"cve_id,omitempty"`
+	CWEIDs  []string              `json:"cwe_ids,omitempty"`
+	Credits []*RepoAdvisoryCredit `json:"credits,omitempty"`
+	// Severity and CVSSVectorString are mutually exclusive.
+	// Possible values for Severity are: "critical", "high", "medium" and "low".
+	Severity         *string `json:"severity,omitempty"`
+	CVSSVectorString *string `json:"cvss_vector_string,omitempty"`
+}
+
+// ListRepositorySecurityAdvisoriesOptions specifies the optional parameters
+// to list the repository security advisories.
+type ListRepositorySecurityAdvisoriesOptions struct {
+	// Direction in which to sort advisories.
+	// Possible values are: "asc" and "desc". Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	// Sort specifies how to sort advisories.
+	// Possible values are: "created", "updated" and "published".
+	// Default is "created".
+	Sort string `url:"sort,omitempty"`
+
+	// State filters advisories by state.
+	// Possible values are: "triage", "draft", "published" and "closed".
+	State string `url:"state,omitempty"`
+
+	ListCursorOptions
+}
+
+// GlobalSecurityVulnerability represents a vulnerability of a package
+// described by a global security advisory.
+type GlobalSecurityVulnerability struct {
+	Package                *VulnerabilityPackage `json:"package,omitempty"`
+	VulnerableVersionRange *string               `json:"vulnerable_version_range,omitempty"`
+	FirstPatchedVersion    *string               `json:"first_patched_version,omitempty"`
+	VulnerableFunctions    []string              `json:"vulnerable_functions,omitempty"`
+}
+
+// Credit represents the credit given to a user for a global security advisory.
+type Credit struct {
+	User *User   `json:"user,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// GlobalSecurityAdvisory represents a security advisory of the GitHub
+// Advisory Database.
+type GlobalSecurityAdvisory struct {
+	ID                    *int64  `json:"id,omitempty"`
+	GHSAID                *string `json:"ghsa_id,omitempty"`
+	CVEID                 *string `json:"cve_id,omitempty"`
+	URL                   *string `json:"url,omitempty"`
+	HTMLURL               *string `json:"html_url,omitempty"`
+	RepositoryAdvisoryURL *string `json:"repository_advisory_url,omitempty"`
+	Summary               *string `json:"summary,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	// Type is the type of the advisory.
+	// Possible values are: "reviewed", "unreviewed" and "malware".
+	Type               *string                        `json:"type,omitempty"`
+	Severity           *string                        `json:"severity,omitempty"`
+	SourceCodeLocation *string                        `json:"source_code_location,omitempty"`
+	Identifiers        []*AdvisoryIdentifier          `json:"identifiers,omitempty"`
+	References         []string                       `json:"references,omitempty"`
+	Vulnerabilities    []*GlobalSecurityVulnerability `json:"vulnerabilities,omitempty"`
+	CVSS               *AdvisoryCVSS                  `json:"cvss,omitempty"`
+	CWEs               []*AdvisoryCWE                 `json:"cwes,omitempty"`
+	Credits            []*Credit                      `json:"credits,omitempty"`
+	PublishedAt        *Timestamp                     `json:"published_at,omitempty"`
+	UpdatedAt          *Timestamp                     `json:"updated_at,omitempty"`
+	GithubReviewedAt   *Timestamp                     `json:"github_reviewed_at,omitempty"`
+	NVDPublishedAt     *Timestamp                     `json:"nvd_published_at,omitempty"`
+	WithdrawnAt        *Timestamp                     `json:"withdrawn_at,omitempty"`
+}
+
+func (g GlobalSecurityAdvisory) String() string {
+	return Stringify(g)
+}
+
+// ListGlobalSecurityAdvisoriesOptions specifies the optional parameters to
+// list the global security advisories.
+type ListGlobalSecurityAdvisoriesOptions struct {
+	// GHSAID filters advisories by GHSA identifier.
+	GHSAID string `url:"ghsa_id,omitempty"`
+
+	// Type filters advisories by type.
+	// Possible values are: "reviewed", "malware" and "unreviewed".
+	// Default is "reviewed".
+	Type string `url:"type,omitempty"`
+
+	// CVEID filters advisories by CVE identifier.
+	CVEID string `url:"cve_id,omitempty"`
+
+	// Ecosystem filters advisories by the ecosystem of the affected packages,
+	// such as "npm", "pip" or "go".
+	Ecosystem string `url:"ecosystem,omitempty"`
+
+	// Severity filters advisories by severity.
+	// Possible values are: "unknown", "low", "medium", "high" and "critical".
+	Severity string `url:"severity,omitempty"`
+
+	// CWEs filters advisories by a comma-separated list of CWE identifiers,
+	// such as "79,284,22".
+	CWEs string `url:"cwes,omitempty"`
+
+	// IsWithdrawn filters advisories by whether they have been withdrawn.
+	IsWithdrawn *bool `url:"is_withdrawn,omitempty"`
+
+	// Affects filters advisories to those affecting a comma-separated list
+	// of packages, optionally with versions, such as "module@1.0.0".
+	Affects string `url:"affects,omitempty"`
+
+	// Published, Updated and Modified filter advisories by date or date
+	// range, such as "2023-01-01..2023-06-30" or ">2023-01-01".
+	Published string `url:"published,omitempty"`
+	Updated   string `url:"updated,omitempty"`
+	Modified  string `url:"modified,omitempty"`
+
+	// Sort specifies how to sort advisories.
+	// Possible values are: "updated" and "published". Default is "published".
+	Sort string `url:"sort,omitempty"`
+
+	// Direction in which to sort advisories.
+	// Possible values are: "asc" and "desc". Default is "desc".
+	Direction string `url:"direction,omitempty"`
+
+	ListCursorOptions
+}
+
+func (s *SecurityAdvisoriesService) listRepositorySecurityAdvisories(ctx context.Context, u string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*SecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// ListRepositorySecurityAdvisories lists the security advisories in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#list-repository-security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisories(ctx context.Context, owner, repo string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+	return s.listRepositorySecurityAdvisories(ctx, u, opt)
+}
+
+// ListRepositorySecurityAdvisoriesForOrg lists the repository security
+// advisories of all repositories in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#list-repository-security-advisories-for-an-organization
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrg(ctx context.Context, org string, opt *ListRepositorySecurityAdvisoriesOptions) ([]*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/security-advisories", org)
+	return s.listRepositorySecurityAdvisories(ctx, u, opt)
+}
+
+// CreateSecurityAdvisory creates a new draft security advisory in a repository.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#create-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) CreateSecurityAdvisory(ctx context.Context, owner, repo string, advisory *SecurityAdvisoryRequest) (*SecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories", owner, repo)
+
+	req, err := s.client.NewRequest("POST", u, advisory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(SecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
+
+// CreateTemporaryPrivateFork creates a temporary private fork to collaborate
+// on fixing a security vulnerability in a repository.
+//
+// This method might return an *AcceptedError and a status code of
+// 202. This is because this is the status that GitHub returns to signify that
+// it is now computing creating the fork in a background task. In this event,
+// the Repository value will be returned, which includes the details about the pending fork.
+// A follow up request, after a delay of a second or so, should result
+// in a successful request.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#create-a-temporary-private-fork
+func (s *SecurityAdvisoriesService) CreateTemporaryPrivateFork(ctx context.Context, owner, repo, ghsaID string) (*Repository, *Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/forks", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fork := new(Repository)
+	resp, err := s.client.Do(ctx, req, fork)
+	if err != nil {
+		// Persist AcceptedError's metadata to the Repository object.
+		if aerr, ok := err.(*AcceptedError); ok {
+			if err := json.Unmarshal(aerr.Raw, fork); err != nil {
+				return fork, resp, err
+			}
+
+			return fork, resp, err
+		}
+		return nil, resp, err
+	}
+
+	return fork, resp, nil
+}
+
+// RequestCVE requests a Common Vulnerabilities and Exposures (CVE)
+// identification number for a repository security advisory.
+//
+// GitHub responds with 202 Accepted when the request is queued; this is not
+// reported as an error.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/repository-advisories#request-a-cve-for-a-repository-security-advisory
+func (s *SecurityAdvisoriesService) RequestCVE(ctx context.Context, owner, repo, ghsaID string) (*Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/security-advisories/%v/cve", owner, repo, ghsaID)
+
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if _, ok := err.(*AcceptedError); ok {
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// ListGlobalSecurityAdvisories lists the security advisories of the GitHub
+// Advisory Database.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/global-advisories#list-global-security-advisories
+func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisories(ctx context.Context, opt *ListGlobalSecurityAdvisoriesOptions) ([]*GlobalSecurityAdvisory, *Response, error) {
+	u, err := addOptions("advisories", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var advisories []*GlobalSecurityAdvisory
+	resp, err := s.client.Do(ctx, req, &advisories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return advisories, resp, nil
+}
+
+// GetGlobalSecurityAdvisory gets a security advisory of the GitHub Advisory
+// Database by its GHSA identifier.
+//
+// GitHub API docs: https://docs.github.com/en/rest/security-advisories/global-advisories#get-a-global-security-advisory
+func (s *SecurityAdvisoriesService) GetGlobalSecurityAdvisory(ctx context.Context, ghsaID string) (*GlobalSecurityAdvisory, *Response, error) {
+	u := fmt.Sprintf("advisories/%v", ghsaID)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(GlobalSecurityAdvisory)
+	resp, err := s.client.Do(ctx, req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, nil
+}
@@ -0,0 +1,232 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"state": "published", "sort": "updated"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-abcd-1234-efgh","state":"published","credits":[{"login":"u","type":"finder"}]}]`)
+	})
+
+	opt := &ListRepositorySecurityAdvisoriesOptions{State: "published", Sort: "updated"}
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisories(context.Background(), "o", "r", opt)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{
+		{
+			GHSAID:  String("GHSA-abcd-1234-efgh"),
+			State:   String("published"),
+			Credits: []*RepoAdvisoryCredit{{Login: String("u"), Type: String("finder")}},
+		},
+	}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListRepositorySecurityAdvisoriesForOrg(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1", "before": "b"})
+		fmt.Fprint(w, `[{"ghsa_id":"GHSA-abcd-1234-efgh"}]`)
+	})
+
+	opt := &ListRepositorySecurityAdvisoriesOptions{ListCursorOptions: ListCursorOptions{PerPage: 1, Before: "b"}}
+	advisories, _, err := client.SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned error: %v", err)
+	}
+
+	want := []*SecurityAdvisory{{GHSAID: String("GHSA-abcd-1234-efgh")}}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListRepositorySecurityAdvisoriesForOrg returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_CreateSecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	input := &SecurityAdvisoryRequest{
+		Summary:     "A new important advisory",
+		Description: "A more in-depth description of what the problem is.",
+		Vulnerabilities: []*AdvisoryVulnerability{
+			{
+				Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("a-package")},
+				VulnerableVersionRange: String("< 1.0.0"),
+				PatchedVersions:        String("1.0.0"),
+				VulnerableFunctions:    []string{"important_function"},
+			},
+		},
+		CWEIDs:   []string{"CWE-1101"},
+		Severity: String("high"),
+	}
+
+	mux.HandleFunc("/repos/o/r/security-advisories", func(w http.ResponseWriter, r *http.Request) {
+		v := new(SecurityAdvisoryRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"ghsa_id":"GHSA-abcd-1234-efgh","state":"draft","cwe_ids":["CWE-1101"]}`)
+	})
+
+	advisory, _, err := client.SecurityAdvisories.CreateSecurityAdvisory(context.Background(), "o", "r", input)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.CreateSecurityAdvisory returned error: %v", err)
+	}
+
+	want := &SecurityAdvisory{
+		GHSAID: String("GHSA-abcd-1234-efgh"),
+		State:  String("draft"),
+		CWEIDs: []string{"CWE-1101"},
+	}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.CreateSecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_CreateTemporaryPrivateFork(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/ghsa_id/forks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"id":1,"name":"r-ghsa-xxxx-xxxx-xxxx","private":true}`)
+	})
+
+	fork, _, err := client.SecurityAdvisories.CreateTemporaryPrivateFork(context.Background(), "o", "r", "ghsa_id")
+	if _, ok := err.(*AcceptedError); !ok {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned error: %v (want AcceptedError)", err)
+	}
+
+	want := &Repository{ID: Int64(1), Name: String("r-ghsa-xxxx-xxxx-xxxx"), Private: Bool(true)}
+	if !reflect.DeepEqual(fork, want) {
+		t.Errorf("SecurityAdvisories.CreateTemporaryPrivateFork returned %+v, want %+v", fork, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_RequestCVE(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/repos/o/r/security-advisories/ghsa_id/cve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.SecurityAdvisories.RequestCVE(context.Background(), "o", "r", "ghsa_id")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.RequestCVE returned error: %v", err)
+	}
+	if got, want := resp.StatusCode, http.StatusAccepted; got != want {
+		t.Errorf("SecurityAdvisories.RequestCVE returned status %v, want %v", got, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_ListGlobalSecurityAdvisories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"cve_id": "CVE-xoxo-1234", "ecosystem": "npm", "is_withdrawn": "false"})
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"ghsa_id": "GHSA-xoxo-1234-xoxo",
+			"cve_id": "CVE-xoxo-1234",
+			"type": "reviewed",
+			"severity": "high",
+			"references": ["https://nvd.nist.gov/vuln/detail/CVE-xoxo-1234"],
+			"vulnerabilities": [{
+				"package": {"ecosystem": "npm", "name": "a-package"},
+				"vulnerable_version_range": "< 1.0.3",
+				"first_patched_version": "1.0.3",
+				"vulnerable_functions": ["a_function"]
+			}],
+			"cvss": {"vector_string": "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:C/C:H/I:H/A:H", "score": 7.6},
+			"cwes": [{"cwe_id": "CWE-400", "name": "Uncontrolled Resource Consumption"}],
+			"credits": [{"user": {"login": "user"}, "type": "analyst"}],
+			"github_reviewed_at": "2023-01-12T00:00:00Z"
+		}]`)
+	})
+
+	opt := &ListGlobalSecurityAdvisoriesOptions{CVEID: "CVE-xoxo-1234", Ecosystem: "npm", IsWithdrawn: Bool(false)}
+	advisories, _, err := client.SecurityAdvisories.ListGlobalSecurityAdvisories(context.Background(), opt)
+	if err != nil {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned error: %v", err)
+	}
+
+	score := 7.6
+	want := []*GlobalSecurityAdvisory{
+		{
+			ID:         Int64(1),
+			GHSAID:     String("GHSA-xoxo-1234-xoxo"),
+			CVEID:      String("CVE-xoxo-1234"),
+			Type:       String("reviewed"),
+			Severity:   String("high"),
+			References: []string{"https://nvd.nist.gov/vuln/detail/CVE-xoxo-1234"},
+			Vulnerabilities: []*GlobalSecurityVulnerability{
+				{
+					Package:                &VulnerabilityPackage{Ecosystem: String("npm"), Name: String("a-package")},
+					VulnerableVersionRange: String("< 1.0.3"),
+					FirstPatchedVersion:    String("1.0.3"),
+					VulnerableFunctions:    []string{"a_function"},
+				},
+			},
+			CVSS:             &AdvisoryCVSS{Score: &score, VectorString: String("CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:C/C:H/I:H/A:H")},
+			CWEs:             []*AdvisoryCWE{{CWEID: String("CWE-400"), Name: String("Uncontrolled Resource Consumption")}},
+			Credits:          []*Credit{{User: &User{Login: String("user")}, Type: String("analyst")}},
+			GithubReviewedAt: &Timestamp{time.Date(2023, time.January, 12, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	if !reflect.DeepEqual(advisories, want) {
+		t.Errorf("SecurityAdvisories.ListGlobalSecurityAdvisories returned %+v, want %+v", advisories, want)
+	}
+}
+
+func TestSecurityAdvisoriesService_GetGlobalSecurityAdvisory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/advisories/GHSA-xoxo-1234-xoxo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"ghsa_id":"GHSA-xoxo-1234-xoxo"}`)
+	})
+
+	advisory, _, err := client.SecurityAdvisories.GetGlobalSecurityAdvisory(context.Background(), "GHSA-xoxo-1234-xoxo")
+	if err != nil {
+		t.Errorf("SecurityAdvisories.GetGlobalSecurityAdvisory returned error: %v", err)
+	}
+
+	want := &GlobalSecurityAdvisory{ID: Int64(1), GHSAID: String("GHSA-xoxo-1234-xoxo")}
+	if !reflect.DeepEqual(advisory, want) {
+		t.Errorf("SecurityAdvisories.GetGlobalSecurityAdvisory returned %+v, want %+v", advisory, want)
+	}
+}
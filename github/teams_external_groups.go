@@ -0,0 +1,144 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExternalGroupMember represents a member of an external group.
+type ExternalGroupMember struct {
+	MemberID    *int64  `json:"member_id,omitempty"`
+	MemberLogin *string `json:"member_login,omitempty"`
+	MemberName  *string `json:"member_name,omitempty"`
+	MemberEmail *string `json:"member_email,omitempty"`
+}
+
+func (e ExternalGroupMember) String() string {
+	return Stringify(e)
+}
+
+// ExternalGroupTeam represents a team connected to an external group.
+type ExternalGroupTeam struct {
+	TeamID   *int64  `json:"team_id,omitempty"`
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+func (e ExternalGroupTeam) String() string {
+	return Stringify(e)
+}
+
+// ExternalGroup represents a group of an identity provider (IdP) that can
+// be connected to teams, so that their membership is managed by the IdP.
+type ExternalGroup struct {
+	GroupID   *int64                 `json:"group_id,omitempty"`
+	GroupName *string                `json:"group_name,omitempty"`
+	UpdatedAt *Timestamp             `json:"updated_at,omitempty"`
+	Teams     []*ExternalGroupTeam   `json:"teams,omitempty"`
+	Members   []*ExternalGroupMember `json:"members,omitempty"`
+}
+
+func (e ExternalGroup) String() string {
+	return Stringify(e)
+}
+
+// ExternalGroupList represents a list of external groups.
+type ExternalGroupList struct {
+	Groups []*ExternalGroup `json:"groups"`
+}
+
+func (e ExternalGroupList) String() string {
+	return Stringify(e)
+}
+
+// ListExternalGroupsOptions specifies the optional parameters to the
+// TeamsService.ListExternalGroups method.
+type ListExternalGroupsOptions struct {
+	// DisplayName filters the groups to those whose name contains it.
+	DisplayName *string `url:"display_name,omitempty"`
+
+	ListOptions
+}
+
+// ListExternalGroups lists the external groups available in an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#list-external-groups-available-to-an-organization
+func (s *TeamsService) ListExternalGroups(ctx context.Context, org string, opt *ListExternalGroupsOptions) (*ExternalGroupList, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/external-groups", org)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	externalGroups := new(ExternalGroupList)
+	resp, err := s.client.Do(ctx, req, externalGroups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return externalGroups, resp, nil
+}
+
+// GetExternalGroup gets an external group, with its members and the teams
+// connected to it.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#get-an-external-group
+func (s *TeamsService) GetExternalGroup(ctx context.Context, org string, groupID int64) (*ExternalGroup, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/external-group/%v", org, groupID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	externalGroup := new(ExternalGroup)
+	resp, err := s.client.Do(ctx, req, externalGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return externalGroup, resp, nil
+}
+
+// UpdateConnectedExternalGroup connects an external group to a team,
+// replacing the group it was connected to, if any.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#update-the-connection-between-an-external-group-and-a-team
+func (s *TeamsService) UpdateConnectedExternalGroup(ctx context.Context, org, slug string, eg *ExternalGroup) (*ExternalGroup, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", org, slug)
+	req, err := s.client.NewRequest("PATCH", u, eg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	externalGroup := new(ExternalGroup)
+	resp, err := s.client.Do(ctx, req, externalGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return externalGroup, resp, nil
+}
+
+// RemoveConnectedExternalGroup removes the connection between a team and its
+// external group.
+//
+// GitHub API docs: https://docs.github.com/en/enterprise-cloud@latest/rest/teams/external-groups#remove-the-connection-between-an-external-group-and-a-team
+func (s *TeamsService) RemoveConnectedExternalGroup(ctx context.Context, org, slug string) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/teams/%v/external-groups", org, slug)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,122 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const externalGroupJSON = `{
+	"group_id": 123,
+	"group_name": "Octocat admins",
+	"updated_at": "2006-01-02T15:04:05Z",
+	"teams": [{"team_id": 1, "team_name": "team-test"}],
+	"members": [{"member_id": 1, "member_login": "mona-lisa_eocsaxrs", "member_name": "Mona Lisa", "member_email": "mona_lisa@github.com"}]
+}`
+
+var externalGroup = &ExternalGroup{
+	GroupID:   Int64(123),
+	GroupName: String("Octocat admins"),
+	UpdatedAt: &Timestamp{Time: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+	Teams: []*ExternalGroupTeam{
+		{TeamID: Int64(1), TeamName: String("team-test")},
+	},
+	Members: []*ExternalGroupMember{
+		{
+			MemberID:    Int64(1),
+			MemberLogin: String("mona-lisa_eocsaxrs"),
+			MemberName:  String("Mona Lisa"),
+			MemberEmail: String("mona_lisa@github.com"),
+		},
+	},
+}
+
+func TestTeamsService_ListExternalGroups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/external-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"display_name": "Octocat", "page": "2"})
+		fmt.Fprint(w, `{"groups":[{"group_id":123,"group_name":"Octocat admins","updated_at":"2006-01-02T15:04:05Z"}]}`)
+	})
+
+	opt := &ListExternalGroupsOptions{DisplayName: String("Octocat"), ListOptions: ListOptions{Page: 2}}
+	list, _, err := client.Teams.ListExternalGroups(context.Background(), "o", opt)
+	if err != nil {
+		t.Errorf("Teams.ListExternalGroups returned error: %v", err)
+	}
+
+	want := &ExternalGroupList{
+		Groups: []*ExternalGroup{{
+			GroupID:   Int64(123),
+			GroupName: String("Octocat admins"),
+			UpdatedAt: &Timestamp{Time: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)},
+		}},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("Teams.ListExternalGroups returned %+v, want %+v", list, want)
+	}
+}
+
+func TestTeamsService_GetExternalGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/external-group/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, externalGroupJSON)
+	})
+
+	group, _, err := client.Teams.GetExternalGroup(context.Background(), "o", 123)
+	if err != nil {
+		t.Errorf("Teams.GetExternalGroup returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(group, externalGroup) {
+		t.Errorf("Teams.GetExternalGroup returned %+v, want %+v", group, externalGroup)
+	}
+}
+
+func TestTeamsService_UpdateConnectedExternalGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/t/external-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"group_id":123}`+"\n")
+		fmt.Fprint(w, externalGroupJSON)
+	})
+
+	group, _, err := client.Teams.UpdateConnectedExternalGroup(context.Background(), "o", "t", &ExternalGroup{GroupID: Int64(123)})
+	if err != nil {
+		t.Errorf("Teams.UpdateConnectedExternalGroup returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(group, externalGroup) {
+		t.Errorf("Teams.UpdateConnectedExternalGroup returned %+v, want %+v", group, externalGroup)
+	}
+}
+
+func TestTeamsService_RemoveConnectedExternalGroup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/t/external-groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Teams.RemoveConnectedExternalGroup(context.Background(), "o", "t")
+	if err != nil {
+		t.Errorf("Teams.RemoveConnectedExternalGroup returned error: %v", err)
+	}
+}
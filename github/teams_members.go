@@ -47,6 +47,33 @@ func (s *TeamsService) ListTeamMembers(ctx context.Context, team int64, opt *Tea
 	return members, resp, nil
 }
 
+// ListTeamMembersBySlug lists all of the users who are members of the team
+// with the given slug in an organization.
+//
+// GitHub API docs: https://docs.github.com/en/rest/teams/members#list-team-members
+func (s *TeamsService) ListTeamMembersBySlug(ctx context.Context, org, slug string, opt *TeamListTeamMembersOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/teams/%v/members", org, slug)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", mediaTypeNestedTeamsPreview)
+
+	var members []*User
+	resp, err := s.client.Do(ctx, req, &members)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return members, resp, nil
+}
+
 // IsTeamMember checks if a user is a member of the specified team.
 //
 // GitHub API docs: https://developer.github.com/v3/teams/members/#get-team-member
@@ -37,6 +37,29 @@ func TestTeamsService__ListTeamMembers(t *testing.T) {
 	}
 }
 
+func TestTeamsService__ListTeamMembersBySlug(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/o/teams/s/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeNestedTeamsPreview)
+		testFormValues(t, r, values{"role": "member", "page": "2"})
+		fmt.Fprint(w, `[{"id":1}]`)
+	})
+
+	opt := &TeamListTeamMembersOptions{Role: "member", ListOptions: ListOptions{Page: 2}}
+	members, _, err := client.Teams.ListTeamMembersBySlug(context.Background(), "o", "s", opt)
+	if err != nil {
+		t.Errorf("Teams.ListTeamMembersBySlug returned error: %v", err)
+	}
+
+	want := []*User{{ID: Int64(1)}}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("Teams.ListTeamMembersBySlug returned %+v, want %+v", members, want)
+	}
+}
+
 func TestTeamsService__IsTeamMember_true(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -0,0 +1,139 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	headerOAuthScopes     = "X-OAuth-Scopes"
+	headerTokenExpiration = "GitHub-Authentication-Token-Expiration"
+)
+
+// tokenExpirationFormats are the layouts GitHub uses for the
+// GitHub-Authentication-Token-Expiration header.
+var tokenExpirationFormats = []string{
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// TokenType identifies the kind of credential a token is.
+type TokenType string
+
+// Token types, as identified by the token prefix or the response headers.
+const (
+	TokenTypeUnknown      TokenType = ""
+	TokenTypeClassic      TokenType = "classic"
+	TokenTypeFineGrained  TokenType = "fine_grained"
+	TokenTypeOAuth        TokenType = "oauth"
+	TokenTypeUserToServer TokenType = "user_to_server"
+	TokenTypeInstallation TokenType = "installation"
+)
+
+// tokenPrefixes maps the documented token prefixes to their type.
+var tokenPrefixes = []struct {
+	prefix string
+	typ    TokenType
+}{
+	{"github_pat_", TokenTypeFineGrained},
+	{"ghp_", TokenTypeClassic},
+	{"gho_", TokenTypeOAuth},
+	{"ghu_", TokenTypeUserToServer},
+	{"ghs_", TokenTypeInstallation},
+}
+
+// TokenInfo describes the credential used to make a request.
+type TokenInfo struct {
+	Type TokenType
+
+	// Scopes are the OAuth scopes granted to a classic or OAuth token. It is
+	// nil if the response did not report scopes, as is the case for
+	// fine-grained tokens, whose permissions are not exposed in headers.
+	Scopes []string
+
+	// ExpiresAt is the expiration time of the token, or nil if the token
+	// does not expire or the response did not report it.
+	ExpiresAt *time.Time
+}
+
+func (t TokenInfo) String() string {
+	return Stringify(t)
+}
+
+// ExpiresWithin reports whether the token expires within d of now.
+func (t *TokenInfo) ExpiresWithin(d time.Duration) bool {
+	return t.ExpiresAt != nil && time.Until(*t.ExpiresAt) < d
+}
+
+// ParseTokenType returns the type of token, based on its prefix. Tokens
+// without a recognized prefix, such as legacy 40 character hex tokens,
+// return TokenTypeUnknown.
+func ParseTokenType(token string) TokenType {
+	for _, p := range tokenPrefixes {
+		if strings.HasPrefix(token, p.prefix) {
+			return p.typ
+		}
+	}
+	return TokenTypeUnknown
+}
+
+// TokenInfoFromResponse builds a TokenInfo from the headers of a response to
+// an authenticated request made with token. token is only used to detect the
+// token type and may be empty, in which case the type is inferred from the
+// headers where possible.
+func TokenInfoFromResponse(token string, resp *Response) *TokenInfo {
+	info := &TokenInfo{Type: ParseTokenType(token)}
+	if resp == nil || resp.Response == nil {
+		return info
+	}
+
+	if values, ok := resp.Header[http.CanonicalHeaderKey(headerOAuthScopes)]; ok {
+		info.Scopes = []string{}
+		for _, v := range values {
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); s != "" {
+					info.Scopes = append(info.Scopes, s)
+				}
+			}
+		}
+		if info.Type == TokenTypeUnknown {
+			info.Type = TokenTypeClassic
+		}
+	}
+
+	if exp := resp.Header.Get(headerTokenExpiration); exp != "" {
+		for _, layout := range tokenExpirationFormats {
+			if t, err := time.Parse(layout, exp); err == nil {
+				info.ExpiresAt = &t
+				break
+			}
+		}
+	}
+
+	return info
+}
+
+// GetTokenInfo makes an authenticated request for the current user and
+// returns information about the token used, as passed in token. token is
+// only inspected locally to detect its type; see TokenInfoFromResponse.
+func (c *Client) GetTokenInfo(ctx context.Context, token string) (*TokenInfo, *Response, error) {
+	req, err := c.NewRequest("GET", "user", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return TokenInfoFromResponse(token, resp), resp, nil
+}
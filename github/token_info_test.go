@@ -0,0 +1,105 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTokenType(t *testing.T) {
+	tests := []struct {
+		token string
+		want  TokenType
+	}{
+		{"ghp_abc", TokenTypeClassic},
+		{"github_pat_abc", TokenTypeFineGrained},
+		{"gho_abc", TokenTypeOAuth},
+		{"ghu_abc", TokenTypeUserToServer},
+		{"ghs_abc", TokenTypeInstallation},
+		{"0123456789abcdef0123456789abcdef01234567", TokenTypeUnknown},
+		{"", TokenTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := ParseTokenType(tt.token); got != tt.want {
+			t.Errorf("ParseTokenType(%q) = %q, want %q", tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestClient_GetTokenInfo_classic(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set(headerOAuthScopes, "repo, read:org")
+		w.Header().Set(headerTokenExpiration, "2019-06-01 12:00:00 UTC")
+		w.Write([]byte(`{"login":"l"}`))
+	})
+
+	info, _, err := client.GetTokenInfo(context.Background(), "")
+	if err != nil {
+		t.Fatalf("GetTokenInfo returned error: %v", err)
+	}
+
+	exp := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	want := &TokenInfo{Type: TokenTypeClassic, Scopes: []string{"repo", "read:org"}, ExpiresAt: &exp}
+	if !info.ExpiresAt.Equal(exp) {
+		t.Errorf("GetTokenInfo returned ExpiresAt %v, want %v", info.ExpiresAt, exp)
+	}
+	info.ExpiresAt = &exp
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("GetTokenInfo returned %+v, want %+v", info, want)
+	}
+	if !info.ExpiresWithin(time.Hour) {
+		t.Error("ExpiresWithin returned false for an expired token")
+	}
+}
+
+func TestClient_GetTokenInfo_fineGrained(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set(headerTokenExpiration, "2019-06-01 12:00:00 -0700")
+		w.Write([]byte(`{"login":"l"}`))
+	})
+
+	info, _, err := client.GetTokenInfo(context.Background(), "github_pat_abc")
+	if err != nil {
+		t.Fatalf("GetTokenInfo returned error: %v", err)
+	}
+
+	if got, want := info.Type, TokenTypeFineGrained; got != want {
+		t.Errorf("GetTokenInfo returned Type %q, want %q", got, want)
+	}
+	if info.Scopes != nil {
+		t.Errorf("GetTokenInfo returned Scopes %v, want nil", info.Scopes)
+	}
+	exp := time.Date(2019, time.June, 1, 19, 0, 0, 0, time.UTC)
+	if info.ExpiresAt == nil || !info.ExpiresAt.Equal(exp) {
+		t.Errorf("GetTokenInfo returned ExpiresAt %v, want %v", info.ExpiresAt, exp)
+	}
+}
+
+func TestTokenInfoFromResponse_noHeaders(t *testing.T) {
+	resp := &Response{Response: &http.Response{Header: http.Header{}}}
+	info := TokenInfoFromResponse("", resp)
+
+	want := &TokenInfo{Type: TokenTypeUnknown}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("TokenInfoFromResponse returned %+v, want %+v", info, want)
+	}
+	if info.ExpiresWithin(time.Hour) {
+		t.Error("ExpiresWithin returned true for a token without expiration")
+	}
+}
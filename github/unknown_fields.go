@@ -0,0 +1,121 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields returns the paths of the members of the JSON document data
+// that decoding into a value of type t ignores, because t has no field for
+// them. Paths are sorted and written like "owner.new_field", with "[]"
+// standing for the elements of an array.
+func unknownFields(data []byte, t reflect.Type) []string {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	collectUnknownFields(v, t, "", seen)
+	if len(seen) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// collectUnknownFields adds to seen the paths of the members of the decoded
+// JSON value v that have no field in t.
+func collectUnknownFields(v interface{}, t reflect.Type, path string, seen map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types that decode themselves accept whatever they are given.
+	if t.Implements(jsonUnmarshalerType) || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := make(map[string]reflect.Type)
+			addJSONFields(t, fields)
+			for name, member := range v {
+				ft, ok := fields[strings.ToLower(name)]
+				if !ok {
+					seen[joinFieldPath(path, name)] = true
+					continue
+				}
+				collectUnknownFields(member, ft, joinFieldPath(path, name), seen)
+			}
+		case reflect.Map:
+			for name, member := range v {
+				collectUnknownFields(member, t.Elem(), joinFieldPath(path, name), seen)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, elem := range v {
+				collectUnknownFields(elem, t.Elem(), path+"[]", seen)
+			}
+		}
+	}
+}
+
+// addJSONFields adds the fields of the struct type t to fields, keyed by
+// their lowercased JSON name, as encoding/json matches names without regard
+// to case. Fields of embedded structs are promoted.
+func addJSONFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addJSONFields(ft, fields)
+				continue
+			}
+		}
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUnknownFields(t *testing.T) {
+	type inner struct {
+		B string `json:"b"`
+	}
+	type embedded struct {
+		E string `json:"e"`
+	}
+	type outer struct {
+		embedded
+		A      string            `json:"a,omitempty"`
+		Ignore string            `json:"-"`
+		Name   string            // matched case-insensitively
+		Inner  *inner            `json:"inner"`
+		List   []inner           `json:"list"`
+		Map    map[string]*inner `json:"map"`
+		Time   *Timestamp        `json:"time"`
+		Any    interface{}       `json:"any"`
+	}
+
+	tests := []struct {
+		data string
+		want []string
+	}{
+		{`{"a":"x","e":"y","name":"n","inner":{"b":"z"}}`, nil},
+		{`{"a":"x","new":1}`, []string{"new"}},
+		{`{"Ignore":"x"}`, []string{"Ignore"}},
+		{`{"inner":{"b":"z","c":1},"list":[{"d":1},{"d":2,"f":3}]}`, []string{"inner.c", "list[].d", "list[].f"}},
+		{`{"map":{"k":{"g":1}}}`, []string{"map.k.g"}},
+		{`{"time":"2019-01-01T00:00:00Z","any":{"h":1}}`, nil},
+		{`not json`, nil},
+	}
+
+	for _, tt := range tests {
+		got := unknownFields([]byte(tt.data), reflect.TypeOf(new(outer)))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("unknownFields(%s) = %v, want %v", tt.data, got, tt.want)
+		}
+	}
+
+	got := unknownFields([]byte(`[{"id":1,"new":2}]`), reflect.TypeOf(new([]*Repository)))
+	if want := []string{"[].new"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unknownFields for a list of repositories = %v, want %v", got, want)
+	}
+}
+
+func TestDo_reportUnknownFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"owner":{"login":"o","new_user_field":true},"new_field":1}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(context.Background(), req, new(Repository))
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if resp.UnknownFields != nil {
+		t.Errorf("Response.UnknownFields = %v, want nil", resp.UnknownFields)
+	}
+
+	ctx := WithRequestPolicy(context.Background(), &RequestPolicy{ReportUnknownFields: true})
+	req, _ = client.NewRequest("GET", ".", nil)
+	resp, err = client.Do(ctx, req, new(Repository))
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if want := []string{"new_field", "owner.new_user_field"}; !reflect.DeepEqual(resp.UnknownFields, want) {
+		t.Errorf("Response.UnknownFields = %v, want %v", resp.UnknownFields, want)
+	}
+	if resp.RawBody != nil {
+		t.Errorf("Response.RawBody = %s, want nil", resp.RawBody)
+	}
+}
@@ -8,6 +8,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // UsersService handles communication with the user related
@@ -40,6 +41,7 @@ type User struct {
 	SuspendedAt             *Timestamp `json:"suspended_at,omitempty"`
 	Type                    *string    `json:"type,omitempty"`
 	SiteAdmin               *bool      `json:"site_admin,omitempty"`
+	LDAPDN                  *string    `json:"ldap_dn,omitempty"`
 	TotalPrivateRepos       *int       `json:"total_private_repos,omitempty"`
 	OwnedPrivateRepos       *int       `json:"owned_private_repos,omitempty"`
 	PrivateGists            *int       `json:"private_gists,omitempty"`
@@ -73,6 +75,25 @@ func (u User) String() string {
 	return Stringify(u)
 }
 
+// Possible values of User.Type.
+const (
+	UserTypeUser         = "User"
+	UserTypeOrganization = "Organization"
+	UserTypeBot          = "Bot"
+)
+
+// GetIsBot reports whether u is a bot account, such as the account of a
+// GitHub App. It returns false if u is nil.
+//
+// Some payloads omit the type of an account; the "[bot]" suffix of the
+// login of bot accounts is used instead.
+func (u *User) GetIsBot() bool {
+	if u == nil {
+		return false
+	}
+	return u.GetType() == UserTypeBot || strings.HasSuffix(u.GetLogin(), "[bot]")
+}
+
 // Get fetches a user. Passing the empty string will fetch the authenticated
 // user.
 //
@@ -0,0 +1,125 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ContributionDay represents the contributions of a user on a day.
+type ContributionDay struct {
+	Date              *string `json:"date,omitempty"` // In YYYY-MM-DD format.
+	ContributionCount *int    `json:"contributionCount,omitempty"`
+	// Color is the color of the day on the calendar of the profile of the
+	// user, such as "#ebedf0".
+	Color *string `json:"color,omitempty"`
+	// Weekday is the day of the week, from 0 for Sunday to 6 for Saturday.
+	Weekday *int `json:"weekday,omitempty"`
+}
+
+// ContributionWeek represents a week of a contribution calendar.
+type ContributionWeek struct {
+	FirstDay         *string            `json:"firstDay,omitempty"` // In YYYY-MM-DD format.
+	ContributionDays []*ContributionDay `json:"contributionDays,omitempty"`
+}
+
+// ContributionCalendar represents the contribution calendar shown on the
+// profile of a user.
+type ContributionCalendar struct {
+	TotalContributions *int                `json:"totalContributions,omitempty"`
+	Weeks              []*ContributionWeek `json:"weeks,omitempty"`
+}
+
+func (c ContributionCalendar) String() string {
+	return Stringify(c)
+}
+
+// GetContributionCalendar gets the contribution calendar of a user between
+// from and to, which must be at most a year apart. If both are zero, the
+// calendar covers the last year.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#contributioncalendar
+func (s *UsersService) GetContributionCalendar(ctx context.Context, user string, from, to time.Time) (*ContributionCalendar, *Response, error) {
+	query := `query($login: String!, $from: DateTime, $to: DateTime) {
+	user(login: $login) {
+		contributionsCollection(from: $from, to: $to) {
+			contributionCalendar {
+				totalContributions
+				weeks {
+					firstDay
+					contributionDays { date contributionCount color weekday }
+				}
+			}
+		}
+	}
+}`
+	var result struct {
+		User *struct {
+			ContributionsCollection struct {
+				ContributionCalendar *ContributionCalendar `json:"contributionCalendar"`
+			} `json:"contributionsCollection"`
+		} `json:"user"`
+	}
+	vars := map[string]interface{}{"login": user, "from": nil, "to": nil}
+	if !from.IsZero() {
+		vars["from"] = from.UTC().Format(time.RFC3339)
+	}
+	if !to.IsZero() {
+		vars["to"] = to.UTC().Format(time.RFC3339)
+	}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.User == nil {
+		return nil, resp, errors.New("github: user not found")
+	}
+
+	return result.User.ContributionsCollection.ContributionCalendar, resp, nil
+}
+
+// Days returns the days of the calendar, oldest first.
+func (c *ContributionCalendar) Days() []*ContributionDay {
+	var days []*ContributionDay
+	for _, w := range c.Weeks {
+		days = append(days, w.ContributionDays...)
+	}
+	return days
+}
+
+// LongestStreak returns the largest number of consecutive days of the
+// calendar with contributions.
+func (c *ContributionCalendar) LongestStreak() int {
+	longest, current := 0, 0
+	for _, d := range c.Days() {
+		if d.GetContributionCount() == 0 {
+			current = 0
+			continue
+		}
+		current++
+		if current > longest {
+			longest = current
+		}
+	}
+	return longest
+}
+
+// CurrentStreak returns the number of consecutive days with contributions
+// ending on the last day of the calendar. As on GitHub, a last day without
+// contributions does not break the streak, since it may not be over yet.
+func (c *ContributionCalendar) CurrentStreak() int {
+	days := c.Days()
+	if n := len(days); n > 0 && days[n-1].GetContributionCount() == 0 {
+		days = days[:n-1]
+	}
+	streak := 0
+	for i := len(days) - 1; i >= 0 && days[i].GetContributionCount() > 0; i-- {
+		streak++
+	}
+	return streak
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUsersService_GetContributionCalendar(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "contributionsCollection(from: $from, to: $to)", map[string]interface{}{
+			"login": "u",
+			"from":  "2019-01-01T00:00:00Z",
+			"to":    nil,
+		})
+		fmt.Fprint(w, `{"data":{"user":{"contributionsCollection":{"contributionCalendar":{
+			"totalContributions":3,
+			"weeks":[{"firstDay":"2019-01-06","contributionDays":[{"date":"2019-01-06","contributionCount":3,"color":"#9be9a8","weekday":0}]}]
+		}}}}}`)
+	})
+
+	from := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	calendar, _, err := client.Users.GetContributionCalendar(context.Background(), "u", from, time.Time{})
+	if err != nil {
+		t.Fatalf("Users.GetContributionCalendar returned error: %v", err)
+	}
+
+	want := &ContributionCalendar{
+		TotalContributions: Int(3),
+		Weeks: []*ContributionWeek{{
+			FirstDay: String("2019-01-06"),
+			ContributionDays: []*ContributionDay{{
+				Date:              String("2019-01-06"),
+				ContributionCount: Int(3),
+				Color:             String("#9be9a8"),
+				Weekday:           Int(0),
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(calendar, want) {
+		t.Errorf("Users.GetContributionCalendar returned %+v, want %+v", calendar, want)
+	}
+}
+
+func TestUsersService_GetContributionCalendar_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"user":null}}`)
+	})
+
+	if _, _, err := client.Users.GetContributionCalendar(context.Background(), "u", time.Time{}, time.Time{}); err == nil {
+		t.Error("Users.GetContributionCalendar returned no error for a missing user")
+	}
+}
+
+func TestContributionCalendar_streaks(t *testing.T) {
+	calendar := func(counts ...int) *ContributionCalendar {
+		c := &ContributionCalendar{}
+		for i, n := range counts {
+			if i%7 == 0 {
+				c.Weeks = append(c.Weeks, &ContributionWeek{})
+			}
+			w := c.Weeks[len(c.Weeks)-1]
+			w.ContributionDays = append(w.ContributionDays, &ContributionDay{ContributionCount: Int(n)})
+		}
+		return c
+	}
+
+	tests := []struct {
+		counts           []int
+		longest, current int
+	}{
+		{nil, 0, 0},
+		{[]int{0, 0, 0}, 0, 0},
+		{[]int{1, 2, 3, 0, 1, 1, 0, 2, 2}, 3, 2},
+		{[]int{1, 1, 0, 1, 1, 1, 1, 1, 0}, 5, 5},
+		{[]int{1, 1, 1, 0, 0}, 3, 0},
+	}
+	for _, tt := range tests {
+		c := calendar(tt.counts...)
+		if got := c.LongestStreak(); got != tt.longest {
+			t.Errorf("LongestStreak of %v returned %v, want %v", tt.counts, got, tt.longest)
+		}
+		if got := c.CurrentStreak(); got != tt.current {
+			t.Errorf("CurrentStreak of %v returned %v, want %v", tt.counts, got, tt.current)
+		}
+	}
+}
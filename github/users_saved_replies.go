@@ -0,0 +1,85 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+)
+
+// SavedReply represents a reply the authenticated user saved to reuse in
+// issue and pull request comments.
+type SavedReply struct {
+	ID         *string `json:"id,omitempty"` // GraphQL node ID.
+	DatabaseID *int64  `json:"databaseId,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	Body       *string `json:"body,omitempty"`
+}
+
+func (s SavedReply) String() string {
+	return Stringify(s)
+}
+
+// SavedReplyConnection represents a page of saved replies.
+type SavedReplyConnection struct {
+	TotalCount *int          `json:"totalCount,omitempty"`
+	PageInfo   *PageInfo     `json:"pageInfo,omitempty"`
+	Nodes      []*SavedReply `json:"nodes"`
+}
+
+const savedReplyFields = `id databaseId title body`
+
+// ListSavedReplies lists the saved replies of the authenticated user.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#savedreply
+func (s *UsersService) ListSavedReplies(ctx context.Context, opt *GraphQLListOptions) (*SavedReplyConnection, *Response, error) {
+	query := `query($first: Int!, $after: String) {
+	viewer {
+		savedReplies(first: $first, after: $after) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			nodes { ` + savedReplyFields + ` }
+		}
+	}
+}`
+	var result struct {
+		Viewer struct {
+			SavedReplies *SavedReplyConnection `json:"savedReplies"`
+		} `json:"viewer"`
+	}
+	vars := map[string]interface{}{"first": opt.first(), "after": opt.after()}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Viewer.SavedReplies, resp, nil
+}
+
+// GetSavedReply gets a saved reply of the authenticated user, identified by
+// its node ID.
+//
+// GitHub API docs: https://docs.github.com/en/graphql/reference/objects#savedreply
+func (s *UsersService) GetSavedReply(ctx context.Context, id string) (*SavedReply, *Response, error) {
+	query := `query($id: ID!) {
+	node(id: $id) {
+		... on SavedReply { ` + savedReplyFields + ` }
+	}
+}`
+	var result struct {
+		Node *SavedReply `json:"node"`
+	}
+	vars := map[string]interface{}{"id": id}
+	resp, err := s.client.graphQL(ctx, query, vars, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	if result.Node == nil || result.Node.ID == nil {
+		return nil, resp, errors.New("github: saved reply not found")
+	}
+
+	return result.Node, resp, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUsersService_ListSavedReplies(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "savedReplies(first: $first, after: $after)", map[string]interface{}{"first": 10.0, "after": "c"})
+		fmt.Fprint(w, `{"data":{"viewer":{"savedReplies":{
+			"totalCount":1,
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[{"id":"SR_1","databaseId":1,"title":"t","body":"b"}]
+		}}}}`)
+	})
+
+	replies, _, err := client.Users.ListSavedReplies(context.Background(), &GraphQLListOptions{First: 10, After: "c"})
+	if err != nil {
+		t.Fatalf("Users.ListSavedReplies returned error: %v", err)
+	}
+
+	want := &SavedReplyConnection{
+		TotalCount: Int(1),
+		PageInfo:   &PageInfo{},
+		Nodes:      []*SavedReply{{ID: String("SR_1"), DatabaseID: Int64(1), Title: String("t"), Body: String("b")}},
+	}
+	if !reflect.DeepEqual(replies, want) {
+		t.Errorf("Users.ListSavedReplies returned %+v, want %+v", replies, want)
+	}
+}
+
+func TestUsersService_GetSavedReply(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testGraphQLRequest(t, r, "... on SavedReply", map[string]interface{}{"id": "SR_1"})
+		fmt.Fprint(w, `{"data":{"node":{"id":"SR_1","title":"t"}}}`)
+	})
+
+	reply, _, err := client.Users.GetSavedReply(context.Background(), "SR_1")
+	if err != nil {
+		t.Fatalf("Users.GetSavedReply returned error: %v", err)
+	}
+
+	want := &SavedReply{ID: String("SR_1"), Title: String("t")}
+	if !reflect.DeepEqual(reply, want) {
+		t.Errorf("Users.GetSavedReply returned %+v, want %+v", reply, want)
+	}
+}
+
+func TestUsersService_GetSavedReply_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		// A node of another type is returned without fields.
+		fmt.Fprint(w, `{"data":{"node":{}}}`)
+	})
+
+	if _, _, err := client.Users.GetSavedReply(context.Background(), "I_1"); err == nil {
+		t.Error("Users.GetSavedReply returned no error for a node that is not a saved reply")
+	}
+}
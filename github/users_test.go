@@ -34,6 +34,7 @@ func TestUser_marshall(t *testing.T) {
 		Following:   Int(1),
 		CreatedAt:   &Timestamp{referenceTime},
 		SuspendedAt: &Timestamp{referenceTime},
+		LDAPDN:      String("cn=l,ou=users,dc=example,dc=com"),
 	}
 	want := `{
 		"login": "l",
@@ -51,11 +52,32 @@ func TestUser_marshall(t *testing.T) {
 		"following": 1,
 		"created_at": ` + referenceTimeStr + `,
 		"suspended_at": ` + referenceTimeStr + `,
+		"ldap_dn": "cn=l,ou=users,dc=example,dc=com",
 		"url": "u"
 	}`
 	testJSONMarshal(t, u, want)
 }
 
+func TestUser_GetIsBot(t *testing.T) {
+	tests := []struct {
+		user *User
+		want bool
+	}{
+		{nil, false},
+		{&User{}, false},
+		{&User{Login: String("octocat"), Type: String(UserTypeUser)}, false},
+		{&User{Login: String("github"), Type: String(UserTypeOrganization)}, false},
+		{&User{Login: String("dependabot[bot]"), Type: String(UserTypeBot)}, true},
+		{&User{Login: String("renovate[bot]")}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.user.GetIsBot(); got != tt.want {
+			t.Errorf("%v.GetIsBot() = %v, want %v", tt.user, got, tt.want)
+		}
+	}
+}
+
 func TestUsersService_Get_authenticatedUser(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()